@@ -103,6 +103,10 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	if isLocalEnabled() {
+		Provider.ConfigureContextFunc = localProviderConfigureContextFunc(Provider, Provider.ConfigureContextFunc)
+	}
 }
 
 func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
@@ -110,12 +114,16 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			if isLocalEnabled() {
+				primary.ConfigureContextFunc = localProviderConfigureContextFunc(primary, primary.ConfigureContextFunc)
+			}
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -203,7 +211,10 @@ func PreCheck(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// When running against the local server, static credentials are configured if none are set.
+		if !isLocalEnabled() {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		}
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
 			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
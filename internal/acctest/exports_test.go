@@ -2,5 +2,13 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder     = closeVCRRecorder
+	NewVCRRetryer        = newVCRRetryer
+	VCRMatcher           = vcrMatcher
+	VCRRedactAccountID   = vcrRedactAccountID
+	VCRRedactInteraction = vcrRedactInteraction
+	VCRRedactor          = vcrRedactor
+	VCRRedactedAccountID = vcrRedactedAccountID
+	VCRRedactedValue     = vcrRedactedValue
+	VCRSDKv1Handlers     = vcrSDKv1Handlers
 )
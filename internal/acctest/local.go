@@ -0,0 +1,102 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/localaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// localServer is the in-process stand-in for the AWS APIs used when acceptance tests are run offline.
+// It is shared by all tests in the test binary so that state is visible to both the provider under test
+// and the Provider instance used by CheckDestroy and CheckExists functions.
+var (
+	localServer     *localaws.Server
+	localServerOnce sync.Once
+)
+
+func isLocalEnabled() bool {
+	return os.Getenv(envvar.AccLocal) != ""
+}
+
+// LocalServer returns the in-process stand-in for the AWS APIs, starting it if necessary.
+// Returns nil if acceptance tests aren't being run offline.
+func LocalServer() *localaws.Server {
+	if !isLocalEnabled() {
+		return nil
+	}
+
+	localServerOnce.Do(func() {
+		localServer = localaws.NewServer()
+	})
+
+	return localServer
+}
+
+// localProviderConfigureContextFunc returns a provider configuration function that points the provider's `endpoints`
+// configuration block at the local server for all services the server supports.
+// Static credentials are configured if none are set; the local server doesn't verify request signatures.
+// As the HTTP client is used in the provider's ConfigureContextFunc we must do this setup before calling the ConfigureContextFunc.
+func localProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		server := LocalServer()
+
+		if err := localProviderConfigure(d, server); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		var meta *conns.AWSClient
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			meta = v
+		} else {
+			meta = new(conns.AWSClient)
+		}
+		meta.SetHTTPClient(server.HTTPClient())
+		provider.SetMeta(meta)
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// localProviderConfigure sets the provider configuration used with the local server.
+func localProviderConfigure(d *schema.ResourceData, server *localaws.Server) error {
+	endpoints := make(map[string]interface{})
+
+	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
+		if tfMap, ok := v.(*schema.Set).List()[0].(map[string]interface{}); ok {
+			for k, v := range tfMap {
+				endpoints[k] = v
+			}
+		}
+	}
+
+	for k, v := range server.Endpoints() {
+		endpoints[k] = v
+	}
+
+	if err := d.Set("endpoints", []interface{}{endpoints}); err != nil {
+		return fmt.Errorf("setting endpoints: %w", err)
+	}
+
+	// The local server only supports path-style S3 requests.
+	if err := d.Set("s3_use_path_style", true); err != nil {
+		return fmt.Errorf("setting s3_use_path_style: %w", err)
+	}
+
+	if d.Get("access_key").(string) == "" && d.Get("profile").(string) == "" && os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+		if err := d.Set("access_key", "AKIALOCALAWS"); err != nil {
+			return fmt.Errorf("setting access_key: %w", err)
+		}
+		if err := d.Set("secret_key", "localaws"); err != nil {
+			return fmt.Errorf("setting secret_key: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,385 @@
+package localaws
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const dynamoDBErrorPrefix = "com.amazonaws.dynamodb.v20120810#"
+
+type table struct {
+	arn                 string
+	createDate          time.Time
+	id                  string
+	input               map[string]any
+	pointInTimeRecovery bool
+	tags                map[string]string
+	timeToLiveEnabled   bool
+	timeToLiveAttribute string
+}
+
+func (s *Server) serveDynamoDB(w http.ResponseWriter, r *http.Request) {
+	s.serveJSON(w, r, dynamoDBErrorPrefix, map[string]jsonHandlerFunc{
+		"CreateTable":               s.dynamoDBCreateTable,
+		"DeleteTable":               s.dynamoDBDeleteTable,
+		"DescribeContinuousBackups": s.dynamoDBDescribeContinuousBackups,
+		"DescribeTable":             s.dynamoDBDescribeTable,
+		"DescribeTimeToLive":        s.dynamoDBDescribeTimeToLive,
+		"ListTables":                s.dynamoDBListTables,
+		"ListTagsOfResource":        s.dynamoDBListTagsOfResource,
+		"TagResource":               s.dynamoDBTagResource,
+		"UntagResource":             s.dynamoDBUntagResource,
+		"UpdateContinuousBackups":   s.dynamoDBUpdateContinuousBackups,
+		"UpdateTable":               s.dynamoDBUpdateTable,
+		"UpdateTimeToLive":          s.dynamoDBUpdateTimeToLive,
+	})
+}
+
+func stringValue(m map[string]any, key string) string {
+	v, _ := m[key].(string)
+
+	return v
+}
+
+func dynamoDBResourceNotFound(format string, a ...any) error {
+	return errBadRequest("ResourceNotFoundException", format, a...)
+}
+
+func (s *Server) dynamoDBTable(name string) (*table, error) {
+	for _, t := range s.tables {
+		if stringValue(t.input, "TableName") == name || t.arn == name {
+			return t, nil
+		}
+	}
+
+	return nil, dynamoDBResourceNotFound("Requested resource not found: Table: %s not found", name)
+}
+
+func dynamoDBTags(input map[string]any) map[string]string {
+	tags := make(map[string]string)
+
+	if v, ok := input["Tags"].([]any); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				tags[stringValue(v, "Key")] = stringValue(v, "Value")
+			}
+		}
+	}
+
+	return tags
+}
+
+func (t *table) description(status string) map[string]any {
+	description := map[string]any{
+		"AttributeDefinitions": t.input["AttributeDefinitions"],
+		"CreationDateTime":     float64(t.createDate.Unix()),
+		"ItemCount":            0,
+		"KeySchema":            t.input["KeySchema"],
+		"TableArn":             t.arn,
+		"TableId":              t.id,
+		"TableName":            t.input["TableName"],
+		"TableSizeBytes":       0,
+		"TableStatus":          status,
+	}
+
+	billingMode := stringValue(t.input, "BillingMode")
+	if billingMode == "" {
+		billingMode = "PROVISIONED"
+	}
+	description["BillingModeSummary"] = map[string]any{
+		"BillingMode": billingMode,
+	}
+
+	provisionedThroughput := map[string]any{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+	if v, ok := t.input["ProvisionedThroughput"].(map[string]any); ok {
+		for k, v := range v {
+			provisionedThroughput[k] = v
+		}
+	}
+	description["ProvisionedThroughput"] = provisionedThroughput
+
+	if v := t.indexDescriptions("GlobalSecondaryIndexes"); len(v) > 0 {
+		description["GlobalSecondaryIndexes"] = v
+	}
+	if v := t.indexDescriptions("LocalSecondaryIndexes"); len(v) > 0 {
+		description["LocalSecondaryIndexes"] = v
+	}
+
+	if v, ok := t.input["StreamSpecification"].(map[string]any); ok {
+		description["StreamSpecification"] = v
+
+		if enabled, _ := v["StreamEnabled"].(bool); enabled {
+			label := t.createDate.Format("2006-01-02T15:04:05.000")
+			description["LatestStreamArn"] = fmt.Sprintf("%s/stream/%s", t.arn, label)
+			description["LatestStreamLabel"] = label
+		}
+	}
+
+	if v, ok := t.input["SSESpecification"].(map[string]any); ok {
+		if enabled, _ := v["Enabled"].(bool); enabled {
+			description["SSEDescription"] = map[string]any{
+				"SSEType":         "KMS",
+				"Status":          "ENABLED",
+				"KMSMasterKeyArn": v["KMSMasterKeyId"],
+			}
+		}
+	}
+
+	return description
+}
+
+func (t *table) indexDescriptions(key string) []any {
+	var indexes []any
+
+	v, _ := t.input[key].([]any)
+
+	for _, v := range v {
+		index, ok := v.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		description := make(map[string]any, len(index)+3)
+		for k, v := range index {
+			description[k] = v
+		}
+		description["IndexArn"] = fmt.Sprintf("%s/index/%s", t.arn, stringValue(index, "IndexName"))
+		description["IndexSizeBytes"] = 0
+		description["ItemCount"] = 0
+		if key == "GlobalSecondaryIndexes" {
+			description["IndexStatus"] = "ACTIVE"
+		}
+
+		indexes = append(indexes, description)
+	}
+
+	return indexes
+}
+
+func (s *Server) dynamoDBCreateTable(region string, input map[string]any) (map[string]any, error) {
+	name := stringValue(input, "TableName")
+
+	if _, err := s.dynamoDBTable(name); err == nil {
+		return nil, errBadRequest("ResourceInUseException", "Table already exists: %s", name)
+	}
+
+	t := &table{
+		arn:        s.arn("dynamodb", region, "table/"+name),
+		createDate: time.Now().UTC(),
+		id:         fmt.Sprintf("00000000-0000-0000-0000-%012d", s.nextSerial()),
+		input:      input,
+		tags:       dynamoDBTags(input),
+	}
+	delete(t.input, "Tags")
+	s.tables[t.arn] = t
+
+	return map[string]any{"TableDescription": t.description("ACTIVE")}, nil
+}
+
+func (s *Server) dynamoDBDescribeTable(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"Table": t.description("ACTIVE")}, nil
+}
+
+func (s *Server) dynamoDBUpdateTable(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range []string{"AttributeDefinitions", "BillingMode", "ProvisionedThroughput", "SSESpecification", "StreamSpecification"} {
+		if v, ok := input[k]; ok {
+			t.input[k] = v
+		}
+	}
+
+	if v, ok := input["GlobalSecondaryIndexUpdates"].([]any); ok {
+		indexes, _ := t.input["GlobalSecondaryIndexes"].([]any)
+
+		for _, v := range v {
+			update, ok := v.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			if v, ok := update["Create"].(map[string]any); ok {
+				indexes = append(indexes, v)
+			}
+
+			if v, ok := update["Delete"].(map[string]any); ok {
+				name := stringValue(v, "IndexName")
+				for i, index := range indexes {
+					if index, ok := index.(map[string]any); ok && stringValue(index, "IndexName") == name {
+						indexes = append(indexes[:i], indexes[i+1:]...)
+						break
+					}
+				}
+			}
+
+			if v, ok := update["Update"].(map[string]any); ok {
+				name := stringValue(v, "IndexName")
+				for _, index := range indexes {
+					if index, ok := index.(map[string]any); ok && stringValue(index, "IndexName") == name {
+						index["ProvisionedThroughput"] = v["ProvisionedThroughput"]
+					}
+				}
+			}
+		}
+
+		t.input["GlobalSecondaryIndexes"] = indexes
+	}
+
+	return map[string]any{"TableDescription": t.description("ACTIVE")}, nil
+}
+
+func (s *Server) dynamoDBDeleteTable(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.tables, t.arn)
+
+	return map[string]any{"TableDescription": t.description("DELETING")}, nil
+}
+
+func (s *Server) dynamoDBListTables(_ string, _ map[string]any) (map[string]any, error) {
+	names := make([]string, 0, len(s.tables))
+
+	for _, arn := range sortedKeys(s.tables) {
+		names = append(names, stringValue(s.tables[arn].input, "TableName"))
+	}
+
+	return map[string]any{"TableNames": names}, nil
+}
+
+func (s *Server) dynamoDBDescribeContinuousBackups(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"ContinuousBackupsDescription": t.continuousBackupsDescription()}, nil
+}
+
+func (s *Server) dynamoDBUpdateContinuousBackups(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := input["PointInTimeRecoverySpecification"].(map[string]any); ok {
+		t.pointInTimeRecovery, _ = v["PointInTimeRecoveryEnabled"].(bool)
+	}
+
+	return map[string]any{"ContinuousBackupsDescription": t.continuousBackupsDescription()}, nil
+}
+
+func (t *table) continuousBackupsDescription() map[string]any {
+	status := "DISABLED"
+	if t.pointInTimeRecovery {
+		status = "ENABLED"
+	}
+
+	return map[string]any{
+		"ContinuousBackupsStatus": "ENABLED",
+		"PointInTimeRecoveryDescription": map[string]any{
+			"PointInTimeRecoveryStatus": status,
+		},
+	}
+}
+
+func (s *Server) dynamoDBDescribeTimeToLive(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	description := map[string]any{
+		"TimeToLiveStatus": "DISABLED",
+	}
+	if t.timeToLiveEnabled {
+		description["TimeToLiveStatus"] = "ENABLED"
+		description["AttributeName"] = t.timeToLiveAttribute
+	}
+
+	return map[string]any{"TimeToLiveDescription": description}, nil
+}
+
+func (s *Server) dynamoDBUpdateTimeToLive(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	specification, _ := input["TimeToLiveSpecification"].(map[string]any)
+	t.timeToLiveEnabled, _ = specification["Enabled"].(bool)
+	t.timeToLiveAttribute = stringValue(specification, "AttributeName")
+
+	return map[string]any{"TimeToLiveSpecification": specification}, nil
+}
+
+func (s *Server) dynamoDBTagResource(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range dynamoDBTags(input) {
+		t.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) dynamoDBUntagResource(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys, _ := input["TagKeys"].([]any)
+	for _, k := range keys {
+		if k, ok := k.(string); ok {
+			delete(t.tags, k)
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Server) dynamoDBListTagsOfResource(_ string, input map[string]any) (map[string]any, error) {
+	t, err := s.dynamoDBTable(stringValue(input, "ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]any, 0, len(t.tags))
+
+	for _, k := range sortedKeys(t.tags) {
+		tags = append(tags, map[string]any{"Key": k, "Value": t.tags[k]})
+	}
+
+	return map[string]any{"Tags": tags}, nil
+}
@@ -0,0 +1,685 @@
+package localaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const iamNamespace = "https://iam.amazonaws.com/doc/2010-05-08/"
+
+type role struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       []string
+	createDate               time.Time
+	description              string
+	id                       string
+	inlinePolicies           map[string]string
+	maxSessionDuration       string
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	tags                     map[string]string
+}
+
+type policy struct {
+	arn              string
+	createDate       time.Time
+	defaultVersionID string
+	description      string
+	id               string
+	name             string
+	path             string
+	tags             map[string]string
+	versions         map[string]string
+	nextVersion      int
+}
+
+func (s *Server) serveIAM(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, iamNamespace, map[string]queryHandlerFunc{
+		"AttachRolePolicy":              s.iamAttachRolePolicy,
+		"CreatePolicy":                  s.iamCreatePolicy,
+		"CreatePolicyVersion":           s.iamCreatePolicyVersion,
+		"CreateRole":                    s.iamCreateRole,
+		"DeletePolicy":                  s.iamDeletePolicy,
+		"DeletePolicyVersion":           s.iamDeletePolicyVersion,
+		"DeleteRole":                    s.iamDeleteRole,
+		"DeleteRolePermissionsBoundary": s.iamDeleteRolePermissionsBoundary,
+		"DeleteRolePolicy":              s.iamDeleteRolePolicy,
+		"DetachRolePolicy":              s.iamDetachRolePolicy,
+		"GetPolicy":                     s.iamGetPolicy,
+		"GetPolicyVersion":              s.iamGetPolicyVersion,
+		"GetRole":                       s.iamGetRole,
+		"GetRolePolicy":                 s.iamGetRolePolicy,
+		"ListAttachedRolePolicies":      s.iamListAttachedRolePolicies,
+		"ListEntitiesForPolicy":         s.iamListEntitiesForPolicy,
+		"ListInstanceProfilesForRole":   s.iamListInstanceProfilesForRole,
+		"ListPolicyTags":                s.iamListPolicyTags,
+		"ListPolicyVersions":            s.iamListPolicyVersions,
+		"ListRolePolicies":              s.iamListRolePolicies,
+		"ListRoleTags":                  s.iamListRoleTags,
+		"ListRoles":                     s.iamListRoles,
+		"PutRolePermissionsBoundary":    s.iamPutRolePermissionsBoundary,
+		"PutRolePolicy":                 s.iamPutRolePolicy,
+		"TagPolicy":                     s.iamTagPolicy,
+		"TagRole":                       s.iamTagRole,
+		"UntagPolicy":                   s.iamUntagPolicy,
+		"UntagRole":                     s.iamUntagRole,
+		"UpdateAssumeRolePolicy":        s.iamUpdateAssumeRolePolicy,
+		"UpdateRole":                    s.iamUpdateRole,
+		"UpdateRoleDescription":         s.iamUpdateRoleDescription,
+	})
+}
+
+func iamPath(params url.Values) string {
+	if v := params.Get("Path"); v != "" {
+		return v
+	}
+
+	return "/"
+}
+
+func iamID(prefix string, n int) string {
+	return fmt.Sprintf("%sLOCALAWS%012d", prefix, n)
+}
+
+func iamTags(params url.Values) map[string]string {
+	return queryMap(params, "Tags.member", "Key", "Value")
+}
+
+func iamTagNodes(tags map[string]string) node {
+	var members []node
+
+	for _, k := range sortedKeys(tags) {
+		members = append(members, elem("member", text("Key", k), text("Value", tags[k])))
+	}
+
+	return elem("Tags", members...)
+}
+
+func iamNoSuchEntity(format string, a ...any) error {
+	return errNotFound("NoSuchEntity", format, a...)
+}
+
+func (s *Server) iamRole(params url.Values) (*role, error) {
+	name := params.Get("RoleName")
+	r, ok := s.roles[name]
+
+	if !ok {
+		return nil, iamNoSuchEntity("The role with name %s cannot be found.", name)
+	}
+
+	return r, nil
+}
+
+func (s *Server) iamPolicy(params url.Values) (*policy, error) {
+	arn := params.Get("PolicyArn")
+	p, ok := s.policies[arn]
+
+	if !ok {
+		return nil, iamNoSuchEntity("Policy %s does not exist or is not attachable.", arn)
+	}
+
+	return p, nil
+}
+
+func (r *role) node() node {
+	children := []node{
+		text("Arn", r.arn),
+		text("AssumeRolePolicyDocument", url.QueryEscape(r.assumeRolePolicyDocument)),
+		text("CreateDate", r.createDate.Format(time.RFC3339)),
+		text("Description", r.description),
+		text("MaxSessionDuration", r.maxSessionDuration),
+		text("Path", r.path),
+		text("RoleId", r.id),
+		text("RoleName", r.name),
+		iamTagNodes(r.tags),
+	}
+
+	if r.permissionsBoundary != "" {
+		children = append(children, elem("PermissionsBoundary",
+			text("PermissionsBoundaryArn", r.permissionsBoundary),
+			text("PermissionsBoundaryType", "Policy"),
+		))
+	}
+
+	return elem("Role", children...)
+}
+
+func (p *policy) node() node {
+	return elem("Policy",
+		text("Arn", p.arn),
+		text("AttachmentCount", "0"),
+		text("CreateDate", p.createDate.Format(time.RFC3339)),
+		text("DefaultVersionId", p.defaultVersionID),
+		text("Description", p.description),
+		text("IsAttachable", "true"),
+		text("Path", p.path),
+		text("PolicyId", p.id),
+		text("PolicyName", p.name),
+		iamTagNodes(p.tags),
+	)
+}
+
+func (s *Server) iamCreateRole(_ string, params url.Values) ([]node, error) {
+	name := params.Get("RoleName")
+
+	if _, ok := s.roles[name]; ok {
+		return nil, errConflict("EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	path := iamPath(params)
+	maxSessionDuration := params.Get("MaxSessionDuration")
+	if maxSessionDuration == "" {
+		maxSessionDuration = "3600"
+	}
+
+	r := &role{
+		arn:                      s.arn("iam", "", "role"+path+name),
+		assumeRolePolicyDocument: params.Get("AssumeRolePolicyDocument"),
+		createDate:               time.Now().UTC(),
+		description:              params.Get("Description"),
+		id:                       iamID("AROA", s.nextSerial()),
+		inlinePolicies:           make(map[string]string),
+		maxSessionDuration:       maxSessionDuration,
+		name:                     name,
+		path:                     path,
+		permissionsBoundary:      params.Get("PermissionsBoundary"),
+		tags:                     iamTags(params),
+	}
+	s.roles[name] = r
+
+	return []node{r.node()}, nil
+}
+
+func (s *Server) iamGetRole(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []node{r.node()}, nil
+}
+
+func (s *Server) iamListRoles(_ string, params url.Values) ([]node, error) {
+	prefix := params.Get("PathPrefix")
+
+	var members []node
+
+	for _, name := range sortedKeys(s.roles) {
+		if r := s.roles[name]; strings.HasPrefix(r.path, prefix) {
+			v := r.node()
+			v.name = "member"
+			members = append(members, v)
+		}
+	}
+
+	return []node{elem("Roles", members...), text("IsTruncated", "false")}, nil
+}
+
+func (s *Server) iamDeleteRole(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.attachedPolicyARNs) > 0 || len(r.inlinePolicies) > 0 {
+		return nil, errConflict("DeleteConflict", "Cannot delete entity, must detach all policies first.")
+	}
+
+	delete(s.roles, r.name)
+
+	return nil, nil
+}
+
+func (s *Server) iamUpdateRole(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := params["Description"]; ok {
+		r.description = v[0]
+	}
+	if v := params.Get("MaxSessionDuration"); v != "" {
+		r.maxSessionDuration = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamUpdateRoleDescription(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.description = params.Get("Description")
+
+	return []node{r.node()}, nil
+}
+
+func (s *Server) iamUpdateAssumeRolePolicy(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.assumeRolePolicyDocument = params.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *Server) iamPutRolePermissionsBoundary(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.permissionsBoundary = params.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (s *Server) iamDeleteRolePermissionsBoundary(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *Server) iamTagRole(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range iamTags(params) {
+		r.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamUntagRole(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryStringList(params, "TagKeys.member") {
+		delete(r.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamListRoleTags(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []node{iamTagNodes(r.tags), text("IsTruncated", "false")}, nil
+}
+
+func (s *Server) iamListInstanceProfilesForRole(_ string, params url.Values) ([]node, error) {
+	if _, err := s.iamRole(params); err != nil {
+		return nil, err
+	}
+
+	return []node{elem("InstanceProfiles"), text("IsTruncated", "false")}, nil
+}
+
+func (s *Server) iamPutRolePolicy(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.inlinePolicies[params.Get("PolicyName")] = params.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *Server) iamGetRolePolicy(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := params.Get("PolicyName")
+	document, ok := r.inlinePolicies[name]
+
+	if !ok {
+		return nil, iamNoSuchEntity("The role policy with name %s cannot be found.", name)
+	}
+
+	return []node{
+		text("PolicyDocument", url.QueryEscape(document)),
+		text("PolicyName", name),
+		text("RoleName", r.name),
+	}, nil
+}
+
+func (s *Server) iamDeleteRolePolicy(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := params.Get("PolicyName")
+
+	if _, ok := r.inlinePolicies[name]; !ok {
+		return nil, iamNoSuchEntity("The role policy with name %s cannot be found.", name)
+	}
+
+	delete(r.inlinePolicies, name)
+
+	return nil, nil
+}
+
+func (s *Server) iamListRolePolicies(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var members []node
+
+	for _, name := range sortedKeys(r.inlinePolicies) {
+		members = append(members, text("member", name))
+	}
+
+	return []node{elem("PolicyNames", members...), text("IsTruncated", "false")}, nil
+}
+
+func (s *Server) iamAttachRolePolicy(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, arn := range r.attachedPolicyARNs {
+		if arn == p.arn {
+			return nil, nil
+		}
+	}
+
+	r.attachedPolicyARNs = append(r.attachedPolicyARNs, p.arn)
+
+	return nil, nil
+}
+
+func (s *Server) iamDetachRolePolicy(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := params.Get("PolicyArn")
+
+	for i, v := range r.attachedPolicyARNs {
+		if v == arn {
+			r.attachedPolicyARNs = append(r.attachedPolicyARNs[:i], r.attachedPolicyARNs[i+1:]...)
+
+			return nil, nil
+		}
+	}
+
+	return nil, iamNoSuchEntity("Policy %s was not found.", arn)
+}
+
+func (s *Server) iamListAttachedRolePolicies(_ string, params url.Values) ([]node, error) {
+	r, err := s.iamRole(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var members []node
+
+	for _, arn := range r.attachedPolicyARNs {
+		name := arn[strings.LastIndex(arn, "/")+1:]
+		members = append(members, elem("member", text("PolicyArn", arn), text("PolicyName", name)))
+	}
+
+	return []node{elem("AttachedPolicies", members...), text("IsTruncated", "false")}, nil
+}
+
+func (s *Server) iamCreatePolicy(_ string, params url.Values) ([]node, error) {
+	name := params.Get("PolicyName")
+	path := iamPath(params)
+	arn := s.arn("iam", "", "policy"+path+name)
+
+	if _, ok := s.policies[arn]; ok {
+		return nil, errConflict("EntityAlreadyExists", "A policy called %s already exists. Duplicate names are not allowed.", name)
+	}
+
+	p := &policy{
+		arn:              arn,
+		createDate:       time.Now().UTC(),
+		defaultVersionID: "v1",
+		description:      params.Get("Description"),
+		id:               iamID("ANPA", s.nextSerial()),
+		name:             name,
+		path:             path,
+		tags:             iamTags(params),
+		versions:         map[string]string{"v1": params.Get("PolicyDocument")},
+		nextVersion:      2,
+	}
+	s.policies[arn] = p
+
+	return []node{p.node()}, nil
+}
+
+func (s *Server) iamGetPolicy(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []node{p.node()}, nil
+}
+
+func (s *Server) iamDeletePolicy(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range s.roles {
+		for _, arn := range r.attachedPolicyARNs {
+			if arn == p.arn {
+				return nil, errConflict("DeleteConflict", "Cannot delete a policy attached to entities.")
+			}
+		}
+	}
+
+	delete(s.policies, p.arn)
+
+	return nil, nil
+}
+
+func (s *Server) iamCreatePolicyVersion(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := "v" + strconv.Itoa(p.nextVersion)
+	p.nextVersion++
+	p.versions[versionID] = params.Get("PolicyDocument")
+
+	if params.Get("SetAsDefault") == "true" {
+		p.defaultVersionID = versionID
+	}
+
+	return []node{elem("PolicyVersion",
+		text("CreateDate", time.Now().UTC().Format(time.RFC3339)),
+		text("IsDefaultVersion", strconv.FormatBool(p.defaultVersionID == versionID)),
+		text("VersionId", versionID),
+	)}, nil
+}
+
+func (s *Server) iamGetPolicyVersion(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := params.Get("VersionId")
+	document, ok := p.versions[versionID]
+
+	if !ok {
+		return nil, iamNoSuchEntity("Policy %s version %s does not exist.", p.arn, versionID)
+	}
+
+	return []node{elem("PolicyVersion",
+		text("CreateDate", p.createDate.Format(time.RFC3339)),
+		text("Document", url.QueryEscape(document)),
+		text("IsDefaultVersion", strconv.FormatBool(p.defaultVersionID == versionID)),
+		text("VersionId", versionID),
+	)}, nil
+}
+
+func (s *Server) iamDeletePolicyVersion(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := params.Get("VersionId")
+
+	if versionID == p.defaultVersionID {
+		return nil, errConflict("DeleteConflict", "Cannot delete the default version of a policy.")
+	}
+
+	if _, ok := p.versions[versionID]; !ok {
+		return nil, iamNoSuchEntity("Policy %s version %s does not exist.", p.arn, versionID)
+	}
+
+	delete(p.versions, versionID)
+
+	return nil, nil
+}
+
+func (s *Server) iamListPolicyVersions(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var members []node
+
+	for _, versionID := range sortedKeys(p.versions) {
+		members = append(members, elem("member",
+			text("CreateDate", p.createDate.Format(time.RFC3339)),
+			text("IsDefaultVersion", strconv.FormatBool(p.defaultVersionID == versionID)),
+			text("VersionId", versionID),
+		))
+	}
+
+	return []node{elem("Versions", members...), text("IsTruncated", "false")}, nil
+}
+
+func (s *Server) iamListEntitiesForPolicy(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var members []node
+
+	for _, name := range sortedKeys(s.roles) {
+		r := s.roles[name]
+
+		for _, arn := range r.attachedPolicyARNs {
+			if arn == p.arn {
+				members = append(members, elem("member", text("RoleId", r.id), text("RoleName", r.name)))
+			}
+		}
+	}
+
+	return []node{
+		elem("PolicyGroups"),
+		elem("PolicyRoles", members...),
+		elem("PolicyUsers"),
+		text("IsTruncated", "false"),
+	}, nil
+}
+
+func (s *Server) iamTagPolicy(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range iamTags(params) {
+		p.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamUntagPolicy(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryStringList(params, "TagKeys.member") {
+		delete(p.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamListPolicyTags(_ string, params url.Values) ([]node, error) {
+	p, err := s.iamPolicy(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []node{iamTagNodes(p.tags), text("IsTruncated", "false")}, nil
+}
@@ -0,0 +1,279 @@
+package localaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// node is a minimal XML element used to build responses.
+type node struct {
+	name     string
+	xmlns    string
+	text     string
+	children []node
+}
+
+func elem(name string, children ...node) node {
+	return node{name: name, children: children}
+}
+
+func text(name, v string) node {
+	return node{name: name, text: v}
+}
+
+func (n node) String() string {
+	var b strings.Builder
+
+	n.write(&b)
+
+	return b.String()
+}
+
+func (n node) write(b *strings.Builder) {
+	b.WriteString("<" + n.name)
+	if n.xmlns != "" {
+		fmt.Fprintf(b, ` xmlns="%s"`, n.xmlns)
+	}
+	b.WriteString(">")
+	_ = xml.EscapeText(b, []byte(n.text))
+	for _, child := range n.children {
+		child.write(b)
+	}
+	b.WriteString("</" + n.name + ">")
+}
+
+// apiError is an AWS API error.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newAPIError(status int, code, format string, a ...any) *apiError {
+	return &apiError{
+		status:  status,
+		code:    code,
+		message: fmt.Sprintf(format, a...),
+	}
+}
+
+func errNotFound(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusNotFound, code, format, a...)
+}
+
+func errBadRequest(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusBadRequest, code, format, a...)
+}
+
+func errConflict(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusConflict, code, format, a...)
+}
+
+func errUnsupportedOperation(operation string) *apiError {
+	return newAPIError(http.StatusBadRequest, "InvalidAction", "operation %s is not supported", operation)
+}
+
+func writeXML(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header + body))
+}
+
+// queryHandlerFunc handles a single query protocol (https://awslabs.github.io/smithy/1.0/spec/aws/aws-query-protocol.html) operation.
+// It returns the result element's children.
+type queryHandlerFunc func(region string, params url.Values) ([]node, error)
+
+// serveQuery serves a query protocol request.
+func (s *Server) serveQuery(w http.ResponseWriter, r *http.Request, xmlns string, handlers map[string]queryHandlerFunc) {
+	requestID := s.requestID()
+	w.Header().Set("X-Amzn-RequestId", requestID)
+
+	if err := r.ParseForm(); err != nil {
+		s.writeQueryError(w, requestID, errBadRequest("MalformedQueryString", "%s", err))
+		return
+	}
+
+	action := r.Form.Get("Action")
+	handler, ok := handlers[action]
+
+	if !ok {
+		s.writeQueryError(w, requestID, errUnsupportedOperation(action))
+		return
+	}
+
+	s.mu.Lock()
+	result, err := handler(signingRegion(r.Header), r.Form)
+	s.mu.Unlock()
+
+	if err != nil {
+		s.writeQueryError(w, requestID, err)
+		return
+	}
+
+	response := elem(action+"Response",
+		elem(action+"Result", result...),
+		elem("ResponseMetadata", text("RequestId", requestID)),
+	)
+	response.xmlns = xmlns
+
+	writeXML(w, http.StatusOK, response.String())
+}
+
+func (s *Server) writeQueryError(w http.ResponseWriter, requestID string, err error) {
+	apiErr, ok := err.(*apiError)
+
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+	}
+
+	writeXML(w, apiErr.status, elem("ErrorResponse",
+		elem("Error",
+			text("Type", "Sender"),
+			text("Code", apiErr.code),
+			text("Message", apiErr.message),
+		),
+		text("RequestId", requestID),
+	).String())
+}
+
+// queryList returns the members of a query protocol list parameter (e.g. "Tags.member.1", "Tags.member.2"), in order.
+// Each member is returned as the parameters beneath it, with the member prefix removed.
+func queryList(params url.Values, prefix string) []url.Values {
+	members := make(map[int]url.Values)
+
+	for k, vs := range params {
+		if !strings.HasPrefix(k, prefix+".") {
+			continue
+		}
+
+		rest := strings.TrimPrefix(k, prefix+".")
+		index, sub, _ := strings.Cut(rest, ".")
+		i, err := strconv.Atoi(index)
+
+		if err != nil {
+			continue
+		}
+
+		if _, ok := members[i]; !ok {
+			members[i] = make(url.Values)
+		}
+		members[i][sub] = vs
+	}
+
+	indices := make([]int, 0, len(members))
+	for i := range members {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+
+	result := make([]url.Values, 0, len(indices))
+	for _, i := range indices {
+		result = append(result, members[i])
+	}
+
+	return result
+}
+
+// queryStringList returns the values of a query protocol list of strings (e.g. "AttributeName.1").
+func queryStringList(params url.Values, prefix string) []string {
+	var result []string
+
+	for _, member := range queryList(params, prefix) {
+		result = append(result, member.Get(""))
+	}
+
+	return result
+}
+
+// queryMap returns a query protocol map parameter (e.g. "Attribute.1.Name", "Attribute.1.Value").
+func queryMap(params url.Values, prefix, keyName, valueName string) map[string]string {
+	result := make(map[string]string)
+
+	for _, member := range queryList(params, prefix) {
+		result[member.Get(keyName)] = member.Get(valueName)
+	}
+
+	return result
+}
+
+// sortedKeys returns the sorted keys of the specified map.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// jsonHandlerFunc handles a single JSON protocol (https://awslabs.github.io/smithy/1.0/spec/aws/aws-json-1_0-protocol.html) operation.
+type jsonHandlerFunc func(region string, input map[string]any) (map[string]any, error)
+
+// serveJSON serves a JSON protocol request.
+func (s *Server) serveJSON(w http.ResponseWriter, r *http.Request, errorPrefix string, handlers map[string]jsonHandlerFunc) {
+	requestID := s.requestID()
+	w.Header().Set("X-Amzn-RequestId", requestID)
+
+	// X-Amz-Target: <service>_<version>.<operation>.
+	_, operation, _ := strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+	handler, ok := handlers[operation]
+
+	if !ok {
+		s.writeJSONError(w, errorPrefix, errUnsupportedOperation(operation))
+		return
+	}
+
+	input := make(map[string]any)
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		s.writeJSONError(w, errorPrefix, errBadRequest("SerializationException", "%s", err))
+		return
+	}
+
+	s.mu.Lock()
+	output, err := handler(signingRegion(r.Header), input)
+	s.mu.Unlock()
+
+	if err != nil {
+		s.writeJSONError(w, errorPrefix, err)
+		return
+	}
+
+	if output == nil {
+		output = make(map[string]any)
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func (s *Server) writeJSONError(w http.ResponseWriter, errorPrefix string, err error) {
+	apiErr, ok := err.(*apiError)
+
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalServerError", "%s", err)
+	}
+
+	writeJSON(w, apiErr.status, map[string]any{
+		"__type":  errorPrefix + apiErr.code,
+		"message": apiErr.message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,526 @@
+package localaws
+
+import (
+	"crypto/md5" //nolint:gosec // ETags are MD5 digests.
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+type bucket struct {
+	createDate time.Time
+	name       string
+	objects    map[string]*object
+	policy     string
+	region     string
+	tags       map[string]string
+	versioning string
+}
+
+type object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     map[string]string
+	tags         map[string]string
+}
+
+type s3Tagging struct {
+	TagSet []struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	} `xml:"TagSet>Tag"`
+}
+
+// Not-configured bucket sub-resources and the error returned when they are read.
+var s3NotFoundSubresources = map[string]string{
+	"cors":              "NoSuchCORSConfiguration",
+	"encryption":        "ServerSideEncryptionConfigurationNotFoundError",
+	"lifecycle":         "NoSuchLifecycleConfiguration",
+	"object-lock":       "ObjectLockConfigurationNotFoundError",
+	"ownershipControls": "OwnershipControlsNotFoundError",
+	"publicAccessBlock": "NoSuchPublicAccessBlockConfiguration",
+	"replication":       "ReplicationConfigurationNotFoundError",
+	"website":           "NoSuchWebsiteConfiguration",
+}
+
+var s3VirtualHostRegexp = regexp.MustCompile(`^(.+)\.s3[.-]`)
+
+func (s *Server) serveS3(w http.ResponseWriter, r *http.Request) {
+	requestID := s.requestID()
+	w.Header().Set("X-Amz-Request-Id", requestID)
+
+	bucketName, key := s3BucketAndKey(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err error
+
+	switch {
+	case bucketName == "":
+		err = s.s3ListBuckets(w, r)
+	case key == "":
+		err = s.serveS3Bucket(w, r, bucketName)
+	default:
+		err = s.serveS3Object(w, r, bucketName, key)
+	}
+
+	if err != nil {
+		s.writeS3Error(w, r, requestID, err)
+	}
+}
+
+// s3BucketAndKey returns the bucket name and object key for virtual-hosted-style and path-style requests.
+func s3BucketAndKey(r *http.Request) (string, string) {
+	host := r.Host
+	if h, _, ok := strings.Cut(host, ":"); ok {
+		host = h
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	if m := s3VirtualHostRegexp.FindStringSubmatch(host); m != nil {
+		return m[1], path
+	}
+
+	bucketName, key, _ := strings.Cut(path, "/")
+
+	return bucketName, key
+}
+
+func (s *Server) writeS3Error(w http.ResponseWriter, r *http.Request, requestID string, err error) {
+	apiErr, ok := err.(*apiError)
+
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalError", "%s", err)
+	}
+
+	// HEAD responses have no body. The AWS SDKs use the status code.
+	if r.Method == http.MethodHead {
+		w.WriteHeader(apiErr.status)
+		return
+	}
+
+	writeXML(w, apiErr.status, elem("Error",
+		text("Code", apiErr.code),
+		text("Message", apiErr.message),
+		text("RequestId", requestID),
+	).String())
+}
+
+func (s *Server) s3Bucket(name string) (*bucket, error) {
+	b, ok := s.buckets[name]
+
+	if !ok {
+		return nil, errNotFound("NoSuchBucket", "The specified bucket does not exist")
+	}
+
+	return b, nil
+}
+
+func s3TagSet(tags map[string]string) node {
+	var members []node
+
+	for _, k := range sortedKeys(tags) {
+		members = append(members, elem("Tag", text("Key", k), text("Value", tags[k])))
+	}
+
+	return elem("TagSet", members...)
+}
+
+func s3ReadTags(r *http.Request) (map[string]string, error) {
+	var tagging s3Tagging
+
+	if err := xml.NewDecoder(r.Body).Decode(&tagging); err != nil {
+		return nil, errBadRequest("MalformedXML", "%s", err)
+	}
+
+	tags := make(map[string]string, len(tagging.TagSet))
+
+	for _, tag := range tagging.TagSet {
+		tags[tag.Key] = tag.Value
+	}
+
+	return tags, nil
+}
+
+func writeS3XML(w http.ResponseWriter, n node) {
+	n.xmlns = s3Namespace
+	writeXML(w, http.StatusOK, n.String())
+}
+
+func (s *Server) s3ListBuckets(w http.ResponseWriter, _ *http.Request) error {
+	var members []node
+
+	for _, name := range sortedKeys(s.buckets) {
+		b := s.buckets[name]
+		members = append(members, elem("Bucket", text("CreationDate", b.createDate.Format(time.RFC3339)), text("Name", b.name)))
+	}
+
+	writeS3XML(w, elem("ListAllMyBucketsResult",
+		elem("Owner", text("ID", s.AccountID)),
+		elem("Buckets", members...),
+	))
+
+	return nil
+}
+
+func (s *Server) serveS3Bucket(w http.ResponseWriter, r *http.Request, name string) error {
+	query := r.URL.Query()
+
+	if r.Method == http.MethodPut && len(query) == 0 {
+		return s.s3CreateBucket(w, r, name)
+	}
+
+	b, err := s.s3Bucket(name)
+
+	if err != nil {
+		return err
+	}
+
+	switch r.Method {
+	case http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", b.region)
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet:
+		return s.s3GetBucket(w, query, b)
+
+	case http.MethodPut:
+		switch {
+		case query.Has("tagging"):
+			tags, err := s3ReadTags(r)
+
+			if err != nil {
+				return err
+			}
+
+			b.tags = tags
+		case query.Has("policy"):
+			body, err := io.ReadAll(r.Body)
+
+			if err != nil {
+				return err
+			}
+
+			b.policy = string(body)
+		case query.Has("versioning"):
+			var configuration struct {
+				Status string `xml:"Status"`
+			}
+
+			if err := xml.NewDecoder(r.Body).Decode(&configuration); err != nil {
+				return errBadRequest("MalformedXML", "%s", err)
+			}
+
+			b.versioning = configuration.Status
+		}
+
+		// Other sub-resources are accepted, but ignored.
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodPost:
+		if query.Has("delete") {
+			return s.s3DeleteObjects(w, r, b)
+		}
+
+		return errUnsupportedOperation("POST " + r.URL.RawQuery)
+
+	case http.MethodDelete:
+		switch {
+		case query.Has("tagging"):
+			b.tags = make(map[string]string)
+		case query.Has("policy"):
+			b.policy = ""
+		case len(query) == 0:
+			if len(b.objects) > 0 {
+				return errConflict("BucketNotEmpty", "The bucket you tried to delete is not empty")
+			}
+
+			delete(s.buckets, b.name)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	return nil
+}
+
+func (s *Server) s3CreateBucket(w http.ResponseWriter, r *http.Request, name string) error {
+	if _, ok := s.buckets[name]; ok {
+		return errConflict("BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	region := signingRegion(r.Header)
+
+	var configuration struct {
+		LocationConstraint string `xml:"LocationConstraint"`
+	}
+
+	if err := xml.NewDecoder(r.Body).Decode(&configuration); err == nil && configuration.LocationConstraint != "" {
+		region = configuration.LocationConstraint
+	}
+
+	s.buckets[name] = &bucket{
+		createDate: time.Now().UTC(),
+		name:       name,
+		objects:    make(map[string]*object),
+		region:     region,
+		tags:       make(map[string]string),
+	}
+
+	w.Header().Set("Location", "/"+name)
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
+
+func (s *Server) s3GetBucket(w http.ResponseWriter, query url.Values, b *bucket) error {
+	for subresource, code := range s3NotFoundSubresources {
+		if query.Has(subresource) {
+			return errNotFound(code, "The %s configuration does not exist", subresource)
+		}
+	}
+
+	switch {
+	case query.Has("location"):
+		region := b.region
+		if region == "us-east-1" {
+			region = ""
+		}
+		writeS3XML(w, text("LocationConstraint", region))
+
+	case query.Has("tagging"):
+		if len(b.tags) == 0 {
+			return errNotFound("NoSuchTagSet", "The TagSet does not exist")
+		}
+		writeS3XML(w, elem("Tagging", s3TagSet(b.tags)))
+
+	case query.Has("policy"):
+		if b.policy == "" {
+			return errNotFound("NoSuchBucketPolicy", "The bucket policy does not exist")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(b.policy))
+
+	case query.Has("versioning"):
+		var children []node
+		if b.versioning != "" {
+			children = append(children, text("Status", b.versioning))
+		}
+		writeS3XML(w, elem("VersioningConfiguration", children...))
+
+	case query.Has("acl"):
+		writeS3XML(w, elem("AccessControlPolicy",
+			elem("Owner", text("ID", s.AccountID)),
+			elem("AccessControlList", elem("Grant",
+				node{name: "Grantee", children: []node{text("ID", s.AccountID)}},
+				text("Permission", "FULL_CONTROL"),
+			)),
+		))
+
+	case query.Has("accelerate"):
+		writeS3XML(w, elem("AccelerateConfiguration"))
+
+	case query.Has("requestPayment"):
+		writeS3XML(w, elem("RequestPaymentConfiguration", text("Payer", "BucketOwner")))
+
+	case query.Has("logging"):
+		writeS3XML(w, elem("BucketLoggingStatus"))
+
+	default:
+		s.s3ListObjects(w, query, b)
+	}
+
+	return nil
+}
+
+func (s *Server) s3ListObjects(w http.ResponseWriter, query url.Values, b *bucket) {
+	prefix := query.Get("prefix")
+	keys := make([]string, 0, len(b.objects))
+
+	for k := range b.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	children := []node{
+		text("Name", b.name),
+		text("Prefix", prefix),
+		text("IsTruncated", "false"),
+		text("MaxKeys", "1000"),
+	}
+
+	if query.Get("list-type") == "2" {
+		children = append(children, text("KeyCount", strconv.Itoa(len(keys))))
+	}
+
+	for _, k := range keys {
+		o := b.objects[k]
+		children = append(children, elem("Contents",
+			text("ETag", o.etag),
+			text("Key", k),
+			text("LastModified", o.lastModified.Format(time.RFC3339)),
+			text("Size", strconv.Itoa(len(o.body))),
+			text("StorageClass", "STANDARD"),
+		))
+	}
+
+	writeS3XML(w, elem("ListBucketResult", children...))
+}
+
+func (s *Server) s3DeleteObjects(w http.ResponseWriter, r *http.Request, b *bucket) error {
+	var input struct {
+		Objects []struct {
+			Key string `xml:"Key"`
+		} `xml:"Object"`
+	}
+
+	if err := xml.NewDecoder(r.Body).Decode(&input); err != nil {
+		return errBadRequest("MalformedXML", "%s", err)
+	}
+
+	var deleted []node
+
+	for _, o := range input.Objects {
+		delete(b.objects, o.Key)
+		deleted = append(deleted, elem("Deleted", text("Key", o.Key)))
+	}
+
+	writeS3XML(w, elem("DeleteResult", deleted...))
+
+	return nil
+}
+
+func (s *Server) serveS3Object(w http.ResponseWriter, r *http.Request, bucketName, key string) error {
+	b, err := s.s3Bucket(bucketName)
+
+	if err != nil {
+		return err
+	}
+
+	query := r.URL.Query()
+
+	if r.Method == http.MethodPut && !query.Has("tagging") && !query.Has("acl") {
+		return s.s3PutObject(w, r, b, key)
+	}
+
+	o, ok := b.objects[key]
+
+	if !ok {
+		if r.Method == http.MethodDelete {
+			// DeleteObject is idempotent.
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+
+		return errNotFound("NoSuchKey", "The specified key does not exist.")
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		if query.Has("tagging") {
+			writeS3XML(w, elem("Tagging", s3TagSet(o.tags)))
+			return nil
+		}
+
+		for k, v := range o.metadata {
+			w.Header().Set("X-Amz-Meta-"+k, v)
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.body)))
+		w.Header().Set("Content-Type", o.contentType)
+		w.Header().Set("ETag", o.etag)
+		w.Header().Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+		if len(o.tags) > 0 {
+			w.Header().Set("X-Amz-Tagging-Count", strconv.Itoa(len(o.tags)))
+		}
+		w.WriteHeader(http.StatusOK)
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(o.body)
+		}
+
+	case http.MethodPut:
+		if query.Has("tagging") {
+			tags, err := s3ReadTags(r)
+
+			if err != nil {
+				return err
+			}
+
+			o.tags = tags
+		}
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodDelete:
+		if query.Has("tagging") {
+			o.tags = make(map[string]string)
+		} else {
+			delete(b.objects, key)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	return nil
+}
+
+func (s *Server) s3PutObject(w http.ResponseWriter, r *http.Request, b *bucket, key string) error {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(body) //nolint:gosec // ETags are MD5 digests.
+	o := &object{
+		body:         body,
+		contentType:  r.Header.Get("Content-Type"),
+		etag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		lastModified: time.Now().UTC().Truncate(time.Second),
+		metadata:     make(map[string]string),
+		tags:         make(map[string]string),
+	}
+
+	if o.contentType == "" {
+		o.contentType = "binary/octet-stream"
+	}
+
+	for k := range r.Header {
+		if name := strings.TrimPrefix(strings.ToLower(k), "x-amz-meta-"); name != strings.ToLower(k) {
+			o.metadata[name] = r.Header.Get(k)
+		}
+	}
+
+	if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+		tags, err := url.ParseQuery(v)
+
+		if err != nil {
+			return errBadRequest("InvalidArgument", "%s", err)
+		}
+
+		for k := range tags {
+			o.tags[k] = tags.Get(k)
+		}
+	}
+
+	b.objects[key] = o
+
+	w.Header().Set("ETag", o.etag)
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
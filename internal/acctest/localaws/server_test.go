@@ -0,0 +1,280 @@
+package localaws_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/localaws"
+)
+
+func newSession(t *testing.T, s *localaws.Server) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("AKIALOCALAWS", "localaws", ""),
+		Endpoint:         aws.String(s.URL()),
+		HTTPClient:       s.HTTPClient(),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String(localaws.DefaultRegion),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sess
+}
+
+func TestServerSTS(t *testing.T) {
+	t.Parallel()
+
+	s := localaws.NewServer()
+	defer s.Close()
+
+	output, err := sts.New(newSession(t, s)).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.StringValue(output.Account), localaws.DefaultAccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestServerIAM(t *testing.T) {
+	t.Parallel()
+
+	s := localaws.NewServer()
+	defer s.Close()
+
+	conn := iam.New(newSession(t, s))
+	name := "test-role"
+
+	_, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+		RoleName:                 aws.String(name),
+		Tags:                     []*iam.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String(name)})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.StringValue(output.Role.Arn), "arn:aws:iam::123456789012:role/test-role"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	if got, want := len(output.Role.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(name)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String(name)})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		t.Errorf("GetRole after DeleteRole: got %v, want %s", err, iam.ErrCodeNoSuchEntityException)
+	}
+}
+
+func TestServerS3(t *testing.T) {
+	t.Parallel()
+
+	s := localaws.NewServer()
+	defer s.Close()
+
+	conn := s3.New(newSession(t, s))
+	bucket := "test-bucket"
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := conn.PutObject(&s3.PutObjectInput{
+		Body:   bytes.NewReader([]byte("hello")),
+		Bucket: aws.String(bucket),
+		Key:    aws.String("a/b"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.GetObject(&s3.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String("a/b")})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := io.ReadAll(output.Body)
+	output.Body.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(body), "hello"; got != want {
+		t.Errorf("Body = %q, want %q", got, want)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("DeleteBucket: got %v, want BucketNotEmpty", err)
+	}
+
+	_, err = conn.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String(bucket)})
+
+	if !tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		t.Errorf("GetBucketPolicy: got %v, want NoSuchBucketPolicy", err)
+	}
+
+	_, err = conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("not-found")})
+
+	if !tfawserr.ErrCodeEquals(err, "NotFound") {
+		t.Errorf("HeadBucket: got %v, want NotFound", err)
+	}
+}
+
+func TestServerSQS(t *testing.T) {
+	t.Parallel()
+
+	s := localaws.NewServer()
+	defer s.Close()
+
+	conn := sqs.New(newSession(t, s))
+
+	output, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameDelaySeconds: "10"}),
+		QueueName:  aws.String("test-queue"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributes, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       output.QueueUrl,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.StringValue(attributes.Attributes[sqs.QueueAttributeNameDelaySeconds]), "10"; got != want {
+		t.Errorf("DelaySeconds = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: output.QueueUrl}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: output.QueueUrl})
+
+	if !tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		t.Errorf("GetQueueAttributes after DeleteQueue: got %v, want %s", err, sqs.ErrCodeQueueDoesNotExist)
+	}
+}
+
+func TestServerSNS(t *testing.T) {
+	t.Parallel()
+
+	s := localaws.NewServer()
+	defer s.Close()
+
+	conn := sns.New(newSession(t, s))
+
+	output, err := conn.CreateTopic(&sns.CreateTopicInput{
+		Name: aws.String("test-topic"),
+		Tags: []*sns.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.StringValue(output.TopicArn), "arn:aws:sns:us-west-2:123456789012:test-topic"; got != want {
+		t.Errorf("TopicArn = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: output.TopicArn})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(tags.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+}
+
+func TestServerDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	s := localaws.NewServer()
+	defer s.Close()
+
+	conn := dynamodb.New(newSession(t, s))
+	name := "test-table"
+
+	_, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{{AttributeName: aws.String("pk"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)}},
+		BillingMode:          aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema:            []*dynamodb.KeySchemaElement{{AttributeName: aws.String("pk"), KeyType: aws.String(dynamodb.KeyTypeHash)}},
+		TableName:            aws.String(name),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String(name)})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := aws.StringValue(output.Table.TableStatus), dynamodb.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(output.Table.BillingModeSummary.BillingMode), dynamodb.BillingModePayPerRequest; got != want {
+		t.Errorf("BillingMode = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String(name)}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String(name)})
+
+	if !tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		t.Errorf("DescribeTable after DeleteTable: got %v, want %s", err, dynamodb.ErrCodeResourceNotFoundException)
+	}
+}
@@ -0,0 +1,168 @@
+package localaws
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const snsNamespace = "http://sns.amazonaws.com/doc/2010-03-31/"
+
+type topic struct {
+	arn        string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+func (s *Server) serveSNS(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, snsNamespace, map[string]queryHandlerFunc{
+		"CreateTopic":         s.snsCreateTopic,
+		"DeleteTopic":         s.snsDeleteTopic,
+		"GetTopicAttributes":  s.snsGetTopicAttributes,
+		"ListTagsForResource": s.snsListTagsForResource,
+		"ListTopics":          s.snsListTopics,
+		"SetTopicAttributes":  s.snsSetTopicAttributes,
+		"TagResource":         s.snsTagResource,
+		"UntagResource":       s.snsUntagResource,
+	})
+}
+
+func (s *Server) snsTopic(arn string) (*topic, error) {
+	t, ok := s.topics[arn]
+
+	if !ok {
+		return nil, errNotFound("NotFound", "Topic does not exist")
+	}
+
+	return t, nil
+}
+
+func snsTags(params url.Values) map[string]string {
+	return queryMap(params, "Tags.member", "Key", "Value")
+}
+
+func (s *Server) snsCreateTopic(region string, params url.Values) ([]node, error) {
+	name := params.Get("Name")
+	arn := s.arn("sns", region, name)
+
+	if t, ok := s.topics[arn]; ok {
+		return []node{text("TopicArn", t.arn)}, nil
+	}
+
+	t := &topic{
+		arn: arn,
+		attributes: map[string]string{
+			"DisplayName":             "",
+			"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+			"Owner":                   s.AccountID,
+			"Policy":                  `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":"` + arn + `","Condition":{"StringEquals":{"AWS:SourceOwner":"` + s.AccountID + `"}}}]}`,
+			"SubscriptionsConfirmed":  "0",
+			"SubscriptionsDeleted":    "0",
+			"SubscriptionsPending":    "0",
+			"TopicArn":                arn,
+		},
+		tags: snsTags(params),
+	}
+
+	if strings.HasSuffix(name, ".fifo") {
+		t.attributes["ContentBasedDeduplication"] = "false"
+		t.attributes["FifoTopic"] = "true"
+	}
+
+	for k, v := range queryMap(params, "Attributes.entry", "key", "value") {
+		t.attributes[k] = v
+	}
+
+	s.topics[arn] = t
+
+	return []node{text("TopicArn", t.arn)}, nil
+}
+
+func (s *Server) snsListTopics(_ string, _ url.Values) ([]node, error) {
+	var members []node
+
+	for _, arn := range sortedKeys(s.topics) {
+		members = append(members, elem("member", text("TopicArn", arn)))
+	}
+
+	return []node{elem("Topics", members...)}, nil
+}
+
+func (s *Server) snsDeleteTopic(_ string, params url.Values) ([]node, error) {
+	// DeleteTopic is idempotent.
+	delete(s.topics, params.Get("TopicArn"))
+
+	return nil, nil
+}
+
+func (s *Server) snsGetTopicAttributes(_ string, params url.Values) ([]node, error) {
+	t, err := s.snsTopic(params.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []node
+
+	for _, k := range sortedKeys(t.attributes) {
+		entries = append(entries, elem("entry", text("key", k), text("value", t.attributes[k])))
+	}
+
+	return []node{elem("Attributes", entries...)}, nil
+}
+
+func (s *Server) snsSetTopicAttributes(_ string, params url.Values) ([]node, error) {
+	t, err := s.snsTopic(params.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.attributes[params.Get("AttributeName")] = params.Get("AttributeValue")
+
+	return nil, nil
+}
+
+func (s *Server) snsTagResource(_ string, params url.Values) ([]node, error) {
+	t, err := s.snsTopic(params.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range snsTags(params) {
+		t.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) snsUntagResource(_ string, params url.Values) ([]node, error) {
+	t, err := s.snsTopic(params.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryStringList(params, "TagKeys.member") {
+		delete(t.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) snsListTagsForResource(_ string, params url.Values) ([]node, error) {
+	t, err := s.snsTopic(params.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var members []node
+
+	for _, k := range sortedKeys(t.tags) {
+		members = append(members, elem("member", text("Key", k), text("Value", t.tags[k])))
+	}
+
+	return []node{elem("Tags", members...)}, nil
+}
@@ -0,0 +1,229 @@
+package localaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sqsNamespace = "http://queue.amazonaws.com/doc/2012-11-05/"
+
+type queue struct {
+	attributes map[string]string
+	name       string
+	tags       map[string]string
+	url        string
+}
+
+func (s *Server) serveSQS(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, sqsNamespace, map[string]queryHandlerFunc{
+		"CreateQueue":        s.sqsCreateQueue,
+		"DeleteQueue":        s.sqsDeleteQueue,
+		"GetQueueAttributes": s.sqsGetQueueAttributes,
+		"GetQueueUrl":        s.sqsGetQueueURL,
+		"ListQueueTags":      s.sqsListQueueTags,
+		"ListQueues":         s.sqsListQueues,
+		"SetQueueAttributes": s.sqsSetQueueAttributes,
+		"TagQueue":           s.sqsTagQueue,
+		"UntagQueue":         s.sqsUntagQueue,
+	})
+}
+
+func (s *Server) sqsQueue(params url.Values) (*queue, error) {
+	queueURL := params.Get("QueueUrl")
+	name := queueURL[strings.LastIndex(queueURL, "/")+1:]
+	q, ok := s.queues[name]
+
+	if !ok {
+		return nil, errBadRequest("AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	return q, nil
+}
+
+func sqsAttributeNodes(attributes map[string]string, names []string) []node {
+	all := len(names) == 0
+
+	for _, name := range names {
+		if name == "All" {
+			all = true
+		}
+	}
+
+	if all {
+		names = sortedKeys(attributes)
+	}
+
+	var members []node
+
+	for _, name := range names {
+		if v, ok := attributes[name]; ok {
+			members = append(members, elem("Attribute", text("Name", name), text("Value", v)))
+		}
+	}
+
+	return members
+}
+
+func (s *Server) sqsCreateQueue(region string, params url.Values) ([]node, error) {
+	name := params.Get("QueueName")
+	attributes := queryMap(params, "Attribute", "Name", "Value")
+
+	if q, ok := s.queues[name]; ok {
+		for k, v := range attributes {
+			if q.attributes[k] != v {
+				return nil, errBadRequest("QueueAlreadyExists", "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return []node{text("QueueUrl", q.url)}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	q := &queue{
+		attributes: map[string]string{
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"CreatedTimestamp":                      now,
+			"DelaySeconds":                          "0",
+			"LastModifiedTimestamp":                 now,
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"QueueArn":                              s.arn("sqs", region, name),
+			"ReceiveMessageWaitTimeSeconds":         "0",
+			"SqsManagedSseEnabled":                  "false",
+			"VisibilityTimeout":                     "30",
+		},
+		name: name,
+		tags: queryMap(params, "Tag", "Key", "Value"),
+		url:  fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", region, s.AccountID, name),
+	}
+
+	if strings.HasSuffix(name, ".fifo") {
+		q.attributes["ContentBasedDeduplication"] = "false"
+		q.attributes["DeduplicationScope"] = "queue"
+		q.attributes["FifoThroughputLimit"] = "perQueue"
+	}
+
+	for k, v := range attributes {
+		q.attributes[k] = v
+	}
+
+	s.queues[name] = q
+
+	return []node{text("QueueUrl", q.url)}, nil
+}
+
+func (s *Server) sqsGetQueueURL(_ string, params url.Values) ([]node, error) {
+	name := params.Get("QueueName")
+	q, ok := s.queues[name]
+
+	if !ok {
+		return nil, errBadRequest("AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	return []node{text("QueueUrl", q.url)}, nil
+}
+
+func (s *Server) sqsListQueues(_ string, params url.Values) ([]node, error) {
+	prefix := params.Get("QueueNamePrefix")
+
+	var urls []node
+
+	for _, name := range sortedKeys(s.queues) {
+		if strings.HasPrefix(name, prefix) {
+			urls = append(urls, text("QueueUrl", s.queues[name].url))
+		}
+	}
+
+	return urls, nil
+}
+
+func (s *Server) sqsDeleteQueue(_ string, params url.Values) ([]node, error) {
+	q, err := s.sqsQueue(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues, q.name)
+
+	return nil, nil
+}
+
+func (s *Server) sqsGetQueueAttributes(_ string, params url.Values) ([]node, error) {
+	q, err := s.sqsQueue(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sqsAttributeNodes(q.attributes, queryStringList(params, "AttributeName")), nil
+}
+
+func (s *Server) sqsSetQueueAttributes(_ string, params url.Values) ([]node, error) {
+	q, err := s.sqsQueue(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range queryMap(params, "Attribute", "Name", "Value") {
+		if v == "" && (k == "Policy" || k == "RedrivePolicy" || k == "RedriveAllowPolicy") {
+			delete(q.attributes, k)
+			continue
+		}
+		q.attributes[k] = v
+	}
+	q.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+func (s *Server) sqsTagQueue(_ string, params url.Values) ([]node, error) {
+	q, err := s.sqsQueue(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range queryMap(params, "Tag", "Key", "Value") {
+		q.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) sqsUntagQueue(_ string, params url.Values) ([]node, error) {
+	q, err := s.sqsQueue(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryStringList(params, "TagKey") {
+		delete(q.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) sqsListQueueTags(_ string, params url.Values) ([]node, error) {
+	q, err := s.sqsQueue(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var members []node
+
+	for _, k := range sortedKeys(q.tags) {
+		members = append(members, elem("Tag", text("Key", k), text("Value", q.tags[k])))
+	}
+
+	return members, nil
+}
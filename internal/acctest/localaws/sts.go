@@ -0,0 +1,22 @@
+package localaws
+
+import (
+	"net/http"
+	"net/url"
+)
+
+const stsNamespace = "https://sts.amazonaws.com/doc/2011-06-15/"
+
+func (s *Server) serveSTS(w http.ResponseWriter, r *http.Request) {
+	s.serveQuery(w, r, stsNamespace, map[string]queryHandlerFunc{
+		"GetCallerIdentity": s.stsGetCallerIdentity,
+	})
+}
+
+func (s *Server) stsGetCallerIdentity(_ string, _ url.Values) ([]node, error) {
+	return []node{
+		text("Account", s.AccountID),
+		text("Arn", s.arn("iam", "", "user/localaws")),
+		text("UserId", "AIDALOCALAWS"),
+	}, nil
+}
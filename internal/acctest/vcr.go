@@ -4,28 +4,27 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/awsv4"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
@@ -168,85 +167,44 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, diag.FromErr(err)
 		}
 
+		// Signing names of recorded interactions, used to apply per-service redactors.
+		// The Authorization header is removed as soon as an interaction is captured.
+		var signingNamesMu sync.Mutex
+		signingNames := make(map[*cassette.Interaction]string)
+
 		// Remove sensitive HTTP headers.
 		r.AddHook(func(i *cassette.Interaction) error {
+			signingNamesMu.Lock()
+			signingNames[i] = awsv4.SigningName(i.Request.Headers)
+			signingNamesMu.Unlock()
+
 			delete(i.Request.Headers, "Authorization")
 			delete(i.Request.Headers, "X-Amz-Security-Token")
 
 			return nil
 		}, recorder.AfterCaptureHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
+		// Redact sensitive values (account IDs, presigned URLs, secrets) in recorded interactions.
+		// Live requests are redacted in the same way before being matched.
+		redact := vcrRedactor(vcrRedactAccountID(func() string {
+			if meta == nil {
+				return ""
 			}
+			return meta.AccountID
+		}))
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		r.AddHook(func(i *cassette.Interaction) error {
+			signingNamesMu.Lock()
+			service := signingNames[i]
+			signingNamesMu.Unlock()
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			vcrRedactInteraction(i, service, redact)
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+			return nil
+		}, recorder.BeforeSaveHook)
 
-			return false
-		})
+		// Defines how VCR will match requests to responses.
+		r.SetMatcher(vcrMatcher(ctx, redact))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
@@ -258,6 +216,16 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = new(conns.AWSClient)
 		}
 		meta.SetHTTPClient(httpClient)
+
+		// Don't retry requests if a recorded interaction isn't found.
+		meta.AddSDKv1HandlersFunc(vcrSDKv1Handlers)
+		meta.AddSDKv2ConfigFunc(func(cfg *aws_sdkv2.Config) {
+			if newRetryer := cfg.Retryer; newRetryer != nil {
+				cfg.Retryer = func() aws_sdkv2.Retryer {
+					return newVCRRetryer(newRetryer())
+				}
+			}
+		})
 		provider.SetMeta(meta)
 
 		if v, diags := configureContextFunc(ctx, d); diags.HasError() {
@@ -266,23 +234,54 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		meta.LogsConn().Handlers.AfterRetry.PushFront(func(r *request.Request) {
-			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-				r.Retryable = aws.Bool(false)
-			}
-		})
-
 		providerMetas[testName] = meta
 
 		return meta, nil
 	}
 }
 
+// vcrSDKv1Handlers customizes AWS SDK for Go v1 request handlers so that requests are not retried if a recorded interaction isn't found.
+func vcrSDKv1Handlers(handlers *request.Handlers) {
+	handlers.AfterRetry.PushFront(func(r *request.Request) {
+		// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
+		if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
+			r.Retryable = aws.Bool(false)
+		}
+	})
+}
+
+// vcrRetryer wraps an AWS SDK for Go v2 retryer so that requests are not retried if a recorded interaction isn't found.
+type vcrRetryer struct {
+	aws_sdkv2.RetryerV2
+}
+
+func newVCRRetryer(r aws_sdkv2.Retryer) aws_sdkv2.Retryer {
+	v, ok := r.(aws_sdkv2.RetryerV2)
+
+	if !ok {
+		v = retryerV2{Retryer: r}
+	}
+
+	return &vcrRetryer{RetryerV2: v}
+}
+
+func (r *vcrRetryer) IsErrorRetryable(err error) bool {
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return false
+	}
+
+	return r.RetryerV2.IsErrorRetryable(err)
+}
+
+// retryerV2 adapts an aws.Retryer to the aws.RetryerV2 interface.
+type retryerV2 struct {
+	aws_sdkv2.Retryer
+}
+
+func (r retryerV2) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	return r.Retryer.GetInitialToken(), nil
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
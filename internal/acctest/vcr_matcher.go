@@ -0,0 +1,377 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/awsv4"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// VCRBodyMatcherFunc reports whether a (redacted) live request body matches a recorded request body.
+type VCRBodyMatcherFunc func(ctx context.Context, requestBody, cassetteBody string) bool
+
+var vcrBodyMatchers = struct {
+	sync.RWMutex
+	byContentType map[string]VCRBodyMatcherFunc
+	byService     map[string]VCRBodyMatcherFunc
+}{
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	byContentType: map[string]VCRBodyMatcherFunc{
+		"application/json":                  vcrJSONBodyMatcher,
+		"application/x-amz-json-1.0":        vcrJSONBodyMatcher,
+		"application/x-amz-json-1.1":        vcrJSONBodyMatcher,
+		"application/x-www-form-urlencoded": vcrFormBodyMatcher,
+		"application/xml":                   vcrXMLBodyMatcher,
+		"text/xml":                          vcrXMLBodyMatcher,
+	},
+	byService: map[string]VCRBodyMatcherFunc{},
+}
+
+// RegisterVCRBodyMatcher registers a request body matcher for the specified service.
+// The service is the AWS Signature Version 4 signing name (e.g. "ec2" or "route53").
+// A service's matcher takes precedence over the matcher for the request's content type.
+func RegisterVCRBodyMatcher(service string, f VCRBodyMatcherFunc) {
+	vcrBodyMatchers.Lock()
+	defer vcrBodyMatchers.Unlock()
+
+	vcrBodyMatchers.byService[service] = f
+}
+
+// vcrMatcher returns a function that defines how VCR will match requests to recorded interactions.
+func vcrMatcher(ctx context.Context, redact func(service, s string) string) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		service := awsv4.SigningName(r.Header)
+
+		if !vcrURLsEqual(redact(service, r.URL.String()), i.URL) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := redact(service, b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		vcrBodyMatchers.RLock()
+		f, ok := vcrBodyMatchers.byService[service]
+		if !ok {
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			f, ok = vcrBodyMatchers.byContentType[mediaType]
+		}
+		vcrBodyMatchers.RUnlock()
+
+		if !ok {
+			return false
+		}
+
+		return f(ctx, body, i.Body)
+	}
+}
+
+// vcrURLsEqual reports whether two URLs are equal, ignoring the order of query string parameters.
+func vcrURLsEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	if ua.Scheme != ub.Scheme || ua.Host != ub.Host || ua.EscapedPath() != ub.EscapedPath() {
+		return false
+	}
+
+	return reflect.DeepEqual(ua.Query(), ub.Query())
+}
+
+// JSON might be the same, but reordered. Try parsing and comparing.
+func vcrJSONBodyMatcher(ctx context.Context, requestBody, cassetteBody string) bool {
+	var requestJson, cassetteJson interface{}
+
+	if err := json.Unmarshal([]byte(requestBody), &requestJson); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(cassetteBody), &cassetteJson); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return vcrEquivalent(requestJson, cassetteJson)
+}
+
+// Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+func vcrFormBodyMatcher(ctx context.Context, requestBody, cassetteBody string) bool {
+	requestForm, err := url.ParseQuery(requestBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	cassetteForm, err := url.ParseQuery(cassetteBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return vcrEquivalent(vcrFormTree(requestForm), vcrFormTree(cassetteForm))
+}
+
+// XML might be the same, but reordered. Try parsing and comparing.
+func vcrXMLBodyMatcher(ctx context.Context, requestBody, cassetteBody string) bool {
+	requestXml, err := vcrXMLTree(requestBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	cassetteXml, err := vcrXMLTree(cassetteBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return vcrEquivalent(requestXml, cassetteXml)
+}
+
+// vcrFormTree converts flattened query protocol parameters (e.g. "Filter.1.Name") to a tree.
+// Numbered list members become slices.
+func vcrFormTree(values url.Values) interface{} {
+	root := make(map[string]interface{})
+
+	for k, vs := range values {
+		node := root
+		parts := strings.Split(k, ".")
+
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]interface{})
+
+			if !ok {
+				child = make(map[string]interface{})
+				if v, ok := node[part]; ok {
+					child[""] = v
+				}
+				node[part] = child
+			}
+
+			node = child
+		}
+
+		var v interface{} = vs
+		if len(vs) == 1 {
+			v = vs[0]
+		}
+
+		last := parts[len(parts)-1]
+		if child, ok := node[last].(map[string]interface{}); ok {
+			child[""] = v
+		} else {
+			node[last] = v
+		}
+	}
+
+	return vcrListify(root)
+}
+
+// vcrListify converts maps whose keys are all list member numbers to slices ordered by member number.
+func vcrListify(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return v
+	}
+
+	indices := make([]int, 0, len(m))
+
+	for k, v := range m {
+		m[k] = vcrListify(v)
+
+		if i, err := strconv.Atoi(k); err == nil {
+			indices = append(indices, i)
+		}
+	}
+
+	if len(indices) == 0 || len(indices) != len(m) {
+		return m
+	}
+
+	sort.Ints(indices)
+
+	s := make([]interface{}, 0, len(indices))
+
+	for _, i := range indices {
+		s = append(s, m[strconv.Itoa(i)])
+	}
+
+	return s
+}
+
+// vcrXMLTree parses an XML document to a tree of elements.
+// Each element is a map holding its name ("#name"), attributes ("@<name>"), character data ("#text") and child elements ("#children").
+func vcrXMLTree(s string) (interface{}, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+
+	var stack []map[string]interface{}
+	var root map[string]interface{}
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			element := map[string]interface{}{
+				"#name": token.Name.Local,
+			}
+
+			for _, attr := range token.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				element["@"+attr.Name.Local] = attr.Value
+			}
+
+			if n := len(stack); n > 0 {
+				parent := stack[n-1]
+				children, _ := parent["#children"].([]interface{})
+				parent["#children"] = append(children, element)
+			} else {
+				root = element
+			}
+
+			stack = append(stack, element)
+
+		case xml.EndElement:
+			if n := len(stack); n > 0 {
+				stack = stack[:n-1]
+			}
+
+		case xml.CharData:
+			if n := len(stack); n > 0 {
+				if text := strings.TrimSpace(string(token)); text != "" {
+					element := stack[n-1]
+					v, _ := element["#text"].(string)
+					element["#text"] = v + text
+				}
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("no root element")
+	}
+
+	return root, nil
+}
+
+// vcrEquivalent reports whether two parsed documents are equivalent.
+// Maps are compared by key and slices are compared without regard to the order of their elements,
+// as lists built from sets are ordered nondeterministically.
+func vcrEquivalent(a, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for k, va := range a {
+			vb, ok := b[k]
+
+			if !ok || !vcrEquivalent(va, vb) {
+				return false
+			}
+		}
+
+		return true
+
+	case []interface{}:
+		b, ok := b.([]interface{})
+
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		matched := make([]bool, len(b))
+
+	outer:
+		for _, va := range a {
+			for j, vb := range b {
+				if !matched[j] && vcrEquivalent(va, vb) {
+					matched[j] = true
+					continue outer
+				}
+			}
+
+			return false
+		}
+
+		return true
+
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
@@ -0,0 +1,163 @@
+package acctest
+
+import (
+	"fmt"
+	"hash/crc32"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrRedactedAccountID replaces the provider's AWS account ID in VCR cassettes.
+	vcrRedactedAccountID = "123456789012"
+	// vcrRedactedValue replaces sensitive values in VCR cassettes.
+	vcrRedactedValue = "REDACTED"
+)
+
+// VCRRedactorFunc returns a copy of the specified string with any sensitive values replaced.
+// Redactors are applied to recorded interactions before a cassette is saved and to live requests before they are matched against a cassette.
+// They must therefore be idempotent.
+type VCRRedactorFunc func(string) string
+
+var vcrRedactors = struct {
+	sync.RWMutex
+	funcs map[string][]VCRRedactorFunc
+}{
+	funcs: map[string][]VCRRedactorFunc{
+		"": {
+			vcrRedactPresignedURLs,
+			VCRRedactFields("SecretAccessKey", "SessionToken"),
+		},
+	},
+}
+
+// RegisterVCRRedactor registers a redactor for the specified service.
+// The service is the AWS Signature Version 4 signing name (e.g. "ec2" or "secretsmanager").
+// An empty service applies the redactor to all interactions.
+func RegisterVCRRedactor(service string, f VCRRedactorFunc) {
+	vcrRedactors.Lock()
+	defer vcrRedactors.Unlock()
+
+	vcrRedactors.funcs[service] = append(vcrRedactors.funcs[service], f)
+}
+
+// VCRRedactFields returns a redactor that replaces the values of the named fields
+// in JSON, XML and query protocol (application/x-www-form-urlencoded) documents.
+func VCRRedactFields(names ...string) VCRRedactorFunc {
+	type replacement struct {
+		re   *regexp.Regexp
+		repl string
+	}
+
+	var replacements []replacement
+
+	for _, name := range names {
+		name := regexp.QuoteMeta(name)
+
+		replacements = append(replacements,
+			replacement{
+				re:   regexp.MustCompile(fmt.Sprintf(`("%s"\s*:\s*")(?:[^"\\]|\\.)*(")`, name)),
+				repl: "${1}" + vcrRedactedValue + "${2}",
+			},
+			replacement{
+				re:   regexp.MustCompile(fmt.Sprintf(`(<%[1]s>)[^<]*(</%[1]s>)`, name)),
+				repl: "${1}" + vcrRedactedValue + "${2}",
+			},
+			replacement{
+				re:   regexp.MustCompile(fmt.Sprintf(`((?:^|&|\.)%s=)[^&]*`, name)),
+				repl: "${1}" + vcrRedactedValue,
+			},
+		)
+	}
+
+	return func(s string) string {
+		for _, r := range replacements {
+			s = r.re.ReplaceAllString(s, r.repl)
+		}
+
+		return s
+	}
+}
+
+var vcrPresignedURLRegexp = regexp.MustCompile(`((?:AWSAccessKeyId|Signature|X-Amz-Credential|X-Amz-Security-Token|X-Amz-Signature)(?:=|%3D))[^&"'<>\s\\]+`)
+
+// vcrRedactPresignedURLs replaces the credentials and signatures in presigned URLs.
+func vcrRedactPresignedURLs(s string) string {
+	return vcrPresignedURLRegexp.ReplaceAllString(s, "${1}"+vcrRedactedValue)
+}
+
+// vcrRedactAccountID returns a redactor that replaces the AWS account ID returned by the specified function.
+func vcrRedactAccountID(accountID func() string) VCRRedactorFunc {
+	return func(s string) string {
+		if v := accountID(); v != "" && v != vcrRedactedAccountID {
+			return strings.ReplaceAll(s, v, vcrRedactedAccountID)
+		}
+
+		return s
+	}
+}
+
+// vcrRedactor returns a function that applies all the redactors registered for the specified service
+// together with any additional redactors.
+func vcrRedactor(additional ...VCRRedactorFunc) func(service, s string) string {
+	return func(service, s string) string {
+		vcrRedactors.RLock()
+		defer vcrRedactors.RUnlock()
+
+		for _, f := range additional {
+			s = f(s)
+		}
+		for _, f := range vcrRedactors.funcs[""] {
+			s = f(s)
+		}
+		if service != "" {
+			for _, f := range vcrRedactors.funcs[service] {
+				s = f(s)
+			}
+		}
+
+		return s
+	}
+}
+
+// vcrRedactInteraction redacts a recorded interaction in place.
+func vcrRedactInteraction(i *cassette.Interaction, service string, redact func(service, s string) string) {
+	f := func(s string) string {
+		return redact(service, s)
+	}
+
+	i.Request.URL = f(i.Request.URL)
+	i.Request.Body = f(i.Request.Body)
+	vcrRedactValues(i.Request.Headers, f)
+	vcrRedactValues(i.Request.Form, f)
+
+	if body := f(i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+
+		// Keep the response consistent with the redacted body.
+		if i.Response.ContentLength >= 0 {
+			i.Response.ContentLength = int64(len(body))
+		}
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+		// AWS SDK for Go v1 DynamoDB clients validate the response CRC32.
+		if i.Response.Headers.Get("X-Amz-Crc32") != "" {
+			i.Response.Headers.Set("X-Amz-Crc32", strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(body))), 10))
+		}
+	}
+	vcrRedactValues(i.Response.Headers, f)
+}
+
+func vcrRedactValues[T ~map[string][]string](m T, f func(string) string) {
+	for k, vs := range m {
+		for i, v := range vs {
+			vs[i] = f(v)
+		}
+		m[k] = vs
+	}
+}
@@ -1,9 +1,20 @@
 package acctest_test
 
 import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) { //nolint:paralleltest
@@ -45,3 +56,201 @@ func TestRandomWithPrefix(t *testing.T) { //nolint:paralleltest
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRMatcher(t *testing.T) {
+	t.Parallel()
+
+	const (
+		url = "https://ec2.us-west-2.amazonaws.com/"
+	)
+
+	testCases := []struct {
+		TestName     string
+		ContentType  string
+		RequestBody  string
+		CassetteBody string
+		Expected     bool
+	}{
+		{
+			TestName:     "identical",
+			ContentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			RequestBody:  "Action=DescribeVpcs&Version=2016-11-15",
+			CassetteBody: "Action=DescribeVpcs&Version=2016-11-15",
+			Expected:     true,
+		},
+		{
+			TestName:     "query protocol reordered parameters",
+			ContentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			RequestBody:  "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-1",
+			CassetteBody: "VpcId.1=vpc-1&Version=2016-11-15&Action=DescribeVpcs",
+			Expected:     true,
+		},
+		{
+			TestName:     "query protocol reordered list members",
+			ContentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			RequestBody:  "Action=DescribeVpcs&Filter.1.Name=tag%3AName&Filter.1.Value.1=a&Filter.2.Name=cidr&Filter.2.Value.1=10.0.0.0%2F16",
+			CassetteBody: "Action=DescribeVpcs&Filter.1.Name=cidr&Filter.1.Value.1=10.0.0.0%2F16&Filter.2.Name=tag%3AName&Filter.2.Value.1=a",
+			Expected:     true,
+		},
+		{
+			TestName:     "query protocol different values",
+			ContentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			RequestBody:  "Action=DescribeVpcs&VpcId.1=vpc-1",
+			CassetteBody: "Action=DescribeVpcs&VpcId.1=vpc-2",
+			Expected:     false,
+		},
+		{
+			TestName:     "JSON reordered",
+			ContentType:  "application/x-amz-json-1.1",
+			RequestBody:  `{"a":"b","c":["d","e"]}`,
+			CassetteBody: `{"c":["e","d"],"a":"b"}`,
+			Expected:     true,
+		},
+		{
+			TestName:     "XML different",
+			ContentType:  "application/xml",
+			RequestBody:  `<A><B>1</B></A>`,
+			CassetteBody: `<A><B>2</B></A>`,
+			Expected:     false,
+		},
+		{
+			TestName:     "XML reordered",
+			ContentType:  "application/xml",
+			RequestBody:  `<A xmlns="x"><B>1</B><C>2</C></A>`,
+			CassetteBody: `<A xmlns="x"><C>2</C> <B>1</B></A>`,
+			Expected:     true,
+		},
+		{
+			TestName:     "redacted",
+			ContentType:  "application/x-amz-json-1.1",
+			RequestBody:  `{"RoleArn":"arn:aws:iam::111122223333:role/test"}`,
+			CassetteBody: `{"RoleArn":"arn:aws:iam::` + acctest.VCRRedactedAccountID + `:role/test"}`,
+			Expected:     true,
+		},
+	}
+
+	redact := acctest.VCRRedactor(acctest.VCRRedactAccountID(func() string { return "111122223333" }))
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequest(http.MethodPost, url, strings.NewReader(testCase.RequestBody))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r.Header.Set("Content-Type", testCase.ContentType)
+
+			got := acctest.VCRMatcher(context.Background(), redact)(r, cassette.Request{
+				Body:   testCase.CassetteBody,
+				Method: http.MethodPost,
+				URL:    url,
+			})
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestVCRRedactInteraction(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "111122223333"
+	)
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body: "Action=AssumeRole&RoleArn=arn%3Aaws%3Aiam%3A%3A" + accountID + "%3Arole%2Ftest",
+			URL:  "https://sts.amazonaws.com/",
+		},
+		Response: cassette.Response{
+			Body:          `<AssumeRoleResponse><Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials><Url>https://example.s3.amazonaws.com/x?X-Amz-Credential=ASIA%2F20230101&amp;X-Amz-Signature=abcdef</Url></AssumeRoleResponse>`,
+			ContentLength: 1,
+			Headers:       http.Header{"Content-Length": []string{"1"}},
+		},
+	}
+
+	acctest.VCRRedactInteraction(i, "sts", acctest.VCRRedactor(acctest.VCRRedactAccountID(func() string { return accountID })))
+
+	for _, s := range []string{i.Request.Body, i.Response.Body} {
+		for _, v := range []string{accountID, "secret", "token", "abcdef", "ASIA%2F"} {
+			if strings.Contains(s, v) {
+				t.Errorf("%q not redacted in %s", v, s)
+			}
+		}
+	}
+
+	if !strings.Contains(i.Request.Body, acctest.VCRRedactedAccountID) {
+		t.Errorf("account ID placeholder not found in %s", i.Request.Body)
+	}
+
+	if !strings.Contains(i.Response.Body, "<SecretAccessKey>"+acctest.VCRRedactedValue+"</SecretAccessKey>") {
+		t.Errorf("redacted secret access key not found in %s", i.Response.Body)
+	}
+
+	if got, expected := i.Response.ContentLength, int64(len(i.Response.Body)); got != expected {
+		t.Errorf("Content-Length: got %d, expected %d", got, expected)
+	}
+}
+
+// interactionNotFoundTransport is an HTTP transport that behaves like a replaying VCR recorder with no matching interaction.
+type interactionNotFoundTransport struct {
+	attempts int32
+}
+
+func (t *interactionNotFoundTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.attempts, 1)
+
+	return nil, cassette.ErrInteractionNotFound
+}
+
+func TestVCRSDKv1HandlersInteractionNotFound(t *testing.T) {
+	t.Parallel()
+
+	transport := &interactionNotFoundTransport{}
+	// The session is not loaded from the environment, which may configure a custom CA bundle.
+	sess := &session.Session{
+		Config: defaults.Config().
+			WithCredentials(credentials.NewStaticCredentials("AKID", "SECRET", "")).
+			WithHTTPClient(&http.Client{Transport: transport}).
+			WithMaxRetries(3).
+			WithRegion("us-west-2"),
+		Handlers: defaults.Handlers(),
+	}
+
+	acctest.VCRSDKv1Handlers(&sess.Handlers)
+
+	if _, err := ec2.New(sess).DescribeVpcsWithContext(context.Background(), &ec2.DescribeVpcsInput{}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, expected := atomic.LoadInt32(&transport.attempts), int32(1); got != expected {
+		t.Errorf("attempts: got %d, expected %d", got, expected)
+	}
+}
+
+func TestVCRRetryerInteractionNotFound(t *testing.T) {
+	t.Parallel()
+
+	transport := &interactionNotFoundTransport{}
+	client := cloudwatchlogs.New(cloudwatchlogs.Options{
+		HTTPClient: &http.Client{Transport: transport},
+		Region:     "us-west-2",
+		Retryer:    acctest.NewVCRRetryer(retry.NewStandard()),
+	})
+
+	if _, err := client.DescribeLogGroups(context.Background(), &cloudwatchlogs.DescribeLogGroupsInput{}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, expected := atomic.LoadInt32(&transport.attempts), int32(1); got != expected {
+		t.Errorf("attempts: got %d, expected %d", got, expected)
+	}
+}
@@ -0,0 +1,52 @@
+// Package awsv4 contains helpers for inspecting AWS Signature Version 4 signed requests.
+package awsv4
+
+import (
+	"net/http"
+	"strings"
+)
+
+// SigningName returns the AWS Signature Version 4 signing name from the specified request headers.
+// Returns an empty string if the request isn't signed.
+func SigningName(h http.Header) string {
+	return credentialScope(h)[3]
+}
+
+// SigningRegion returns the AWS Signature Version 4 signing Region from the specified request headers.
+// Returns an empty string if the request isn't signed.
+func SigningRegion(h http.Header) string {
+	return credentialScope(h)[2]
+}
+
+// credentialScope returns the parts of the credential in the request's Authorization header:
+// <access-key-id>/<date>/<region>/<service>/aws4_request.
+func credentialScope(h http.Header) [5]string {
+	const (
+		prefix = "Credential="
+	)
+
+	var scope [5]string
+
+	v := h.Get("Authorization")
+	i := strings.Index(v, prefix)
+
+	if i < 0 {
+		return scope
+	}
+
+	v = v[i+len(prefix):]
+
+	if i := strings.IndexAny(v, ", "); i >= 0 {
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, "/")
+
+	if len(parts) != len(scope) {
+		return scope
+	}
+
+	copy(scope[:], parts)
+
+	return scope
+}
@@ -0,0 +1,198 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+type resourceTypeNameKey struct{}
+
+// NewResourceContext returns a context that records the type of the Terraform resource or data source that is making AWS API calls.
+func NewResourceContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeNameKey{}, typeName)
+}
+
+func resourceTypeNameFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeNameKey{}).(string)
+
+	return v
+}
+
+// apiCallLogEntry is a single line in the API call log.
+// Terraform does not send resource addresses or instance keys to providers and most resources' identifiers are
+// not known until after they are created, so API calls are attributed only to the type of resource or data source.
+type apiCallLogEntry struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	RetryCount   int       `json:"retry_count"`
+	RequestID    string    `json:"request_id,omitempty"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+type apiCallLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *apiCallLog) write(entry apiCallLogEntry) {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.w.Write(append(b, '\n')) //nolint:errcheck // Logging must not cause API calls to fail.
+}
+
+var (
+	apiCallLogsMu sync.Mutex
+	// apiCallLogs contains the open API call logs, keyed by path.
+	// All provider configurations in a process that log to the same file share its log.
+	apiCallLogs = make(map[string]*apiCallLog)
+)
+
+func openAPICallLog(path string) (*apiCallLog, error) {
+	apiCallLogsMu.Lock()
+	defer apiCallLogsMu.Unlock()
+
+	if v, ok := apiCallLogs[path]; ok {
+		return v, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening API call log (%s): %w", path, err)
+	}
+
+	v := &apiCallLog{w: f}
+	apiCallLogs[path] = v
+
+	return v, nil
+}
+
+// sdkv1APICallLogEntry returns the API call log entry for a completed AWS SDK for Go v1 request.
+func sdkv1APICallLogEntry(r *request.Request, now time.Time) apiCallLogEntry {
+	entry := apiCallLogEntry{
+		Time:         r.Time,
+		Service:      r.ClientInfo.ServiceID,
+		Region:       aws.StringValue(r.Config.Region),
+		ResourceType: resourceTypeNameFromContext(r.Context()),
+		LatencyMS:    now.Sub(r.Time).Milliseconds(),
+		RetryCount:   r.RetryCount,
+		RequestID:    r.RequestID,
+	}
+
+	if r.Operation != nil {
+		entry.Operation = r.Operation.Name
+	}
+
+	if r.Error != nil {
+		var awsErr awserr.Error
+
+		if errors.As(r.Error, &awsErr) {
+			entry.ErrorCode = awsErr.Code()
+		} else {
+			entry.ErrorCode = "Unknown"
+		}
+	}
+
+	return entry
+}
+
+// sdkv2APICallLogEntry returns the API call log entry for a completed AWS SDK for Go v2 operation.
+func sdkv2APICallLogEntry(ctx context.Context, metadata middleware.Metadata, err error, start, now time.Time) apiCallLogEntry {
+	entry := apiCallLogEntry{
+		Time:         start,
+		Service:      awsmiddleware.GetServiceID(ctx),
+		Operation:    awsmiddleware.GetOperationName(ctx),
+		Region:       awsmiddleware.GetRegion(ctx),
+		ResourceType: resourceTypeNameFromContext(ctx),
+		LatencyMS:    now.Sub(start).Milliseconds(),
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+		entry.RetryCount = len(v.Results) - 1
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		entry.RequestID = v
+	}
+
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			entry.ErrorCode = apiErr.ErrorCode()
+		} else {
+			entry.ErrorCode = "Unknown"
+		}
+	}
+
+	return entry
+}
+
+// addAPICallLogHandlers logs every AWS API call made by AWS SDK for Go v1 and v2 API clients
+// if the API call log environment variable is set.
+func (c *Config) addAPICallLogHandlers(client *AWSClient) error {
+	path := os.Getenv(envvar.APICallLog)
+
+	if path == "" {
+		return nil
+	}
+
+	log, err := openAPICallLog(path)
+
+	if err != nil {
+		return err
+	}
+
+	client.AddSDKv1HandlersFunc(func(handlers *request.Handlers) {
+		// Complete is run once, after any retries.
+		handlers.Complete.PushBackNamed(request.NamedHandler{
+			Name: "APICallLog",
+			Fn: func(r *request.Request) {
+				log.write(sdkv1APICallLogEntry(r, time.Now()))
+			},
+		})
+	})
+	client.AddSDKv2ConfigFunc(func(cfg *aws_sdkv2.Config) {
+		cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+			// Added after the service metadata is registered in the context and before the Finalize step's Retry middleware.
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("APICallLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				start := time.Now()
+
+				out, metadata, err := next.HandleInitialize(ctx, in)
+
+				log.write(sdkv2APICallLogEntry(ctx, metadata, err, start, time.Now()))
+
+				return out, metadata, err
+			}), middleware.After)
+		})
+	})
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSDKv1APICallLogEntry(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	r := &request.Request{
+		ClientInfo:  metadata.ClientInfo{ServiceID: "SQS"},
+		HTTPRequest: &http.Request{},
+		Config:      aws.Config{Region: aws.String("us-west-2")}, // lintignore:AWSAT003
+		Error:       awserr.New("AWS.SimpleQueueService.NonExistentQueue", "queue does not exist", nil),
+		Operation:   &request.Operation{Name: "GetQueueUrl"},
+		RequestID:   "req-1",
+		RetryCount:  2,
+		Time:        start,
+	}
+	r.SetContext(NewResourceContext(context.Background(), "aws_sqs_queue"))
+
+	expected := apiCallLogEntry{
+		Time:         start,
+		Service:      "SQS",
+		Operation:    "GetQueueUrl",
+		Region:       "us-west-2", // lintignore:AWSAT003
+		ResourceType: "aws_sqs_queue",
+		LatencyMS:    1500,
+		RetryCount:   2,
+		RequestID:    "req-1",
+		ErrorCode:    "AWS.SimpleQueueService.NonExistentQueue",
+	}
+
+	if diff := cmp.Diff(sdkv1APICallLogEntry(r, start.Add(1500*time.Millisecond)), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSDKv2APICallLogEntry(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx := NewResourceContext(context.Background(), "aws_instance")
+	var md middleware.Metadata
+
+	err := &smithy.GenericAPIError{Code: "RequestLimitExceeded"}
+
+	got := sdkv2APICallLogEntry(ctx, md, err, start, start.Add(time.Second))
+
+	if got, expected := got.ResourceType, "aws_instance"; got != expected {
+		t.Errorf("incorrect resource type. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := got.ErrorCode, "RequestLimitExceeded"; got != expected {
+		t.Errorf("incorrect error code. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := got.LatencyMS, int64(1000); got != expected {
+		t.Errorf("incorrect latency. Expected: %d, got: %d", expected, got)
+	}
+
+	if got := sdkv2APICallLogEntry(ctx, md, errors.New("connection reset"), start, start).ErrorCode; got != "Unknown" {
+		t.Errorf("incorrect error code for non-API error: %s", got)
+	}
+}
+
+func TestAPICallLogWrite(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	log := &apiCallLog{w: &b}
+
+	log.write(apiCallLogEntry{Service: "EC2", Operation: "DescribeVpcs"})
+	log.write(apiCallLogEntry{Service: "EC2", Operation: "CreateVpc", ErrorCode: "VpcLimitExceeded"})
+
+	lines := bytes.Split(bytes.TrimSpace(b.Bytes()), []byte("\n"))
+
+	if got, expected := len(lines), 2; got != expected {
+		t.Fatalf("incorrect number of lines. Expected: %d, got: %d", expected, got)
+	}
+
+	var entry map[string]any
+
+	if err := json.Unmarshal(lines[1], &entry); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := entry["error_code"], "VpcLimitExceeded"; got != expected {
+		t.Errorf("incorrect error_code. Expected: %s, got: %v", expected, got)
+	}
+
+	if _, ok := entry["request_id"]; ok {
+		t.Error("unexpected request_id")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
@@ -43,3 +45,19 @@ func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
 func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
+
+// AddSDKv1HandlersFunc registers a function that customizes the request handlers of every AWS SDK for Go v1 API client.
+// To have effect it must be called before the AWS SDK v1 Session is created.
+func (client *AWSClient) AddSDKv1HandlersFunc(f func(*request.Handlers)) {
+	if client.Session == nil {
+		client.sdkv1HandlersFuncs = append(client.sdkv1HandlersFuncs, f)
+	}
+}
+
+// AddSDKv2ConfigFunc registers a function that customizes the configuration (e.g. API options or retryer) of every AWS SDK for Go v2 API client.
+// To have effect it must be called before the provider is configured, as the functions are applied to the AWS SDK v2 configuration loaded then.
+func (client *AWSClient) AddSDKv2ConfigFunc(f func(*aws_sdkv2.Config)) {
+	if client.Session == nil {
+		client.sdkv2ConfigFuncs = append(client.sdkv2ConfigFuncs, f)
+	}
+}
@@ -4,6 +4,7 @@ package conns
 import (
 	"net/http"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
@@ -28,6 +29,7 @@ import (
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -325,6 +327,7 @@ import (
 type AWSClient struct {
 	AccountID               string
 	DefaultTagsConfig       *tftags.DefaultConfig
+	DefaultTimeouts         map[string]ResourceTimeouts
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert.MediaConvert
@@ -333,9 +336,12 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         []intf.ServicePackage
 	Session                 *session.Session
+	TagPolicyConfig         *tftags.PolicyConfig
 	TerraformVersion        string
 
-	httpClient *http.Client
+	httpClient         *http.Client
+	sdkv1HandlersFuncs []func(*request.Handlers)
+	sdkv2ConfigFuncs   []func(*aws_sdkv2.Config)
 
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
 	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
@@ -50,6 +51,7 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeouts                map[string]ResourceTimeouts // Keyed by Terraform resource type name.
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -60,9 +62,12 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]RateLimit // Keyed by provider package name.
 	Region                         string
+	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	SecretKey                      string
+	ServiceOverrides               map[string]*ServiceOverride // Keyed by provider package name.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -71,10 +76,13 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+
+	serviceCredentials map[string]aws_sdkv2.CredentialsProvider // Keyed by provider package name.
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -86,7 +94,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerDocumentationURL:        "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                    "Terraform AWS Provider",
 		EC2MetadataServiceEnableState: c.EC2MetadataServiceEnableState,
-		IamEndpoint:                   c.Endpoints[names.IAM],
+		IamEndpoint:                   c.endpoint(names.IAM),
 		Insecure:                      c.Insecure,
 		HTTPClient:                    client.HTTPClient(),
 		HTTPProxy:                     c.HTTPProxy,
@@ -96,7 +104,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                     c.SecretKey,
 		SkipCredsValidation:           c.SkipCredsValidation,
 		SkipRequestingAccountId:       c.SkipRequestingAccountId,
-		StsEndpoint:                   c.Endpoints[names.STS],
+		StsEndpoint:                   c.endpoint(names.STS),
 		SuppressDebugLog:              c.SuppressDebugLog,
 		Token:                         c.Token,
 		UseDualStackEndpoint:          c.UseDualStackEndpoint,
@@ -124,8 +132,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.SharedCredentialsFiles = c.SharedCredentialsFiles
 	}
 
-	if c.STSRegion != "" {
-		awsbaseConfig.StsRegion = c.STSRegion
+	if v := c.stsRegion(); v != "" {
+		awsbaseConfig.StsRegion = v
+	}
+
+	// Must be called before the AWS SDK v1 Session is created.
+	c.addRetryModeHandlers(client)
+	c.addRateLimitHandlers(client)
+	if err := c.addAPICallLogHandlers(client); err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
@@ -140,11 +155,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	// Customizations applied to all AWS SDK for Go v2 API clients.
+	for _, f := range client.sdkv2ConfigFuncs {
+		f(&cfg)
+	}
+
+	c.configureServiceCredentials(cfg)
+
 	sess, err := awsbasev1.GetSession(&cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	// Customizations applied to all AWS SDK for Go v1 API clients.
+	// Each client created from the session (or a copy) inherits its handlers.
+	for _, f := range client.sdkv1HandlersFuncs {
+		f(&sess.Handlers)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("retrieving AWS account details: %s", err)
@@ -181,11 +209,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTimeouts = c.DefaultTimeouts
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
@@ -198,59 +228,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	// AWS SDK for Go v1 custom API clients.
 
 	// STS.
-	stsConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.STS]),
-	}
-	if c.STSRegion != "" {
-		stsConfig.Region = aws.String(c.STSRegion)
+	stsConfig := c.sdkv1Config(names.STS)
+	if v := c.stsRegion(); v != "" {
+		stsConfig.Region = aws.String(v)
 	}
 	client.stsConn = sts.New(sess.Copy(stsConfig))
 
 	// Services that require multiple client configurations.
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[names.S3]),
-		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
-	}
+	s3Config := c.sdkv1Config(names.S3)
+	s3Config.S3ForcePathStyle = aws.Bool(c.S3UsePathStyle)
 	client.s3Conn = s3.New(sess.Copy(s3Config))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
 	client.s3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
 
 	// "Global" services that require customizations.
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.GlobalAccelerator]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53]),
-	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53RecoveryControlConfig]),
-	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53RecoveryReadiness]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Shield]),
-	}
-
-	// Force "global" services to correct Regions.
-	switch partition {
-	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
-	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
-		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
-	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
-	}
+	globalAcceleratorConfig := c.globalSDKv1Config(names.GlobalAccelerator, partition)
+	route53Config := c.globalSDKv1Config(names.Route53, partition)
+	route53RecoveryControlConfigConfig := c.globalSDKv1Config(names.Route53RecoveryControlConfig, partition)
+	route53RecoveryReadinessConfig := c.globalSDKv1Config(names.Route53RecoveryReadiness, partition)
+	shieldConfig := c.globalSDKv1Config(names.Shield, partition)
 
 	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
 	client.route53Conn = route53.New(sess.Copy(route53Config))
@@ -517,10 +514,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// AWS SDK for Go v2 custom API clients.
 
-	client.route53domainsClient = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
-		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
+	route53DomainsConfig := c.sdkv2Config(cfg, names.Route53Domains)
+	client.route53domainsClient = route53domains.NewFromConfig(route53DomainsConfig, func(o *route53domains.Options) {
+		if endpoint := c.endpoint(names.Route53Domains); endpoint != "" {
 			o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
-		} else if partition == endpoints.AwsPartitionID {
+		} else if partition == endpoints.AwsPartitionID && c.serviceRegion(names.Route53Domains) == "" {
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
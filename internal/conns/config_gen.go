@@ -26,7 +26,6 @@ import (
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -315,379 +314,379 @@ import (
 
 // sdkv1Conns initializes AWS SDK for Go v1 clients.
 func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
-	client.acmConn = acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])}))
-	client.acmpcaConn = acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])}))
-	client.ampConn = prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])}))
-	client.apigatewayConn = apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])}))
-	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])}))
-	client.apigatewayv2Conn = apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])}))
-	client.accessanalyzerConn = accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])}))
-	client.accountConn = account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])}))
-	client.alexaforbusinessConn = alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])}))
-	client.amplifyConn = amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])}))
-	client.amplifybackendConn = amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])}))
-	client.amplifyuibuilderConn = amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])}))
-	client.applicationautoscalingConn = applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])}))
-	client.appconfigConn = appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])}))
-	client.appconfigdataConn = appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])}))
-	client.appflowConn = appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])}))
-	client.appintegrationsConn = appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])}))
-	client.appmeshConn = appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])}))
-	client.apprunnerConn = apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])}))
-	client.appstreamConn = appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])}))
-	client.appsyncConn = appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])}))
-	client.applicationcostprofilerConn = applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])}))
-	client.applicationinsightsConn = applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])}))
-	client.athenaConn = athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])}))
-	client.autoscalingConn = autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])}))
-	client.autoscalingplansConn = autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])}))
-	client.backupConn = backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])}))
-	client.backupgatewayConn = backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])}))
-	client.batchConn = batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])}))
-	client.billingconductorConn = billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])}))
-	client.braketConn = braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])}))
-	client.budgetsConn = budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])}))
-	client.ceConn = costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])}))
-	client.curConn = costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])}))
-	client.chimeConn = chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])}))
-	client.chimesdkidentityConn = chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])}))
-	client.chimesdkmeetingsConn = chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])}))
-	client.chimesdkmessagingConn = chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])}))
-	client.cloud9Conn = cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])}))
-	client.clouddirectoryConn = clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])}))
-	client.cloudformationConn = cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])}))
-	client.cloudfrontConn = cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])}))
-	client.cloudhsmv2Conn = cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])}))
-	client.cloudsearchConn = cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])}))
-	client.cloudsearchdomainConn = cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])}))
-	client.cloudtrailConn = cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])}))
-	client.cloudwatchConn = cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])}))
-	client.codeartifactConn = codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])}))
-	client.codebuildConn = codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])}))
-	client.codecommitConn = codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])}))
-	client.codeguruprofilerConn = codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])}))
-	client.codegurureviewerConn = codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])}))
-	client.codepipelineConn = codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])}))
-	client.codestarConn = codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])}))
-	client.codestarconnectionsConn = codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])}))
-	client.codestarnotificationsConn = codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])}))
-	client.cognitoidpConn = cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])}))
-	client.cognitoidentityConn = cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])}))
-	client.cognitosyncConn = cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])}))
-	client.comprehendmedicalConn = comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])}))
-	client.configserviceConn = configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])}))
-	client.connectConn = connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])}))
-	client.connectcontactlensConn = connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])}))
-	client.connectparticipantConn = connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])}))
-	client.controltowerConn = controltower.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])}))
-	client.customerprofilesConn = customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])}))
-	client.daxConn = dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])}))
-	client.dlmConn = dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])}))
-	client.dmsConn = databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])}))
-	client.drsConn = drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])}))
-	client.dsConn = directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])}))
-	client.databrewConn = gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])}))
-	client.dataexchangeConn = dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])}))
-	client.datapipelineConn = datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])}))
-	client.datasyncConn = datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])}))
-	client.deployConn = codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])}))
-	client.detectiveConn = detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])}))
-	client.devopsguruConn = devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])}))
-	client.devicefarmConn = devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])}))
-	client.directconnectConn = directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])}))
-	client.discoveryConn = applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])}))
-	client.docdbConn = docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])}))
-	client.dynamodbConn = dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])}))
-	client.dynamodbstreamsConn = dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])}))
-	client.ebsConn = ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])}))
-	client.ec2Conn = ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])}))
-	client.ec2instanceconnectConn = ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])}))
-	client.ecrConn = ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])}))
-	client.ecrpublicConn = ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])}))
-	client.ecsConn = ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])}))
-	client.efsConn = efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])}))
-	client.eksConn = eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])}))
-	client.elbConn = elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])}))
-	client.elbv2Conn = elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])}))
-	client.emrConn = emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])}))
-	client.emrcontainersConn = emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])}))
-	client.emrserverlessConn = emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])}))
-	client.elasticacheConn = elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])}))
-	client.elasticbeanstalkConn = elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])}))
-	client.elasticinferenceConn = elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])}))
-	client.elastictranscoderConn = elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])}))
-	client.esConn = elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])}))
-	client.eventsConn = eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])}))
-	client.evidentlyConn = cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])}))
-	client.fmsConn = fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])}))
-	client.fsxConn = fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])}))
-	client.finspaceConn = finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])}))
-	client.finspacedataConn = finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])}))
-	client.firehoseConn = firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])}))
-	client.forecastConn = forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])}))
-	client.forecastqueryConn = forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])}))
-	client.frauddetectorConn = frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])}))
-	client.gameliftConn = gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])}))
-	client.glacierConn = glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])}))
-	client.glueConn = glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])}))
-	client.grafanaConn = managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])}))
-	client.greengrassConn = greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])}))
-	client.greengrassv2Conn = greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])}))
-	client.groundstationConn = groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])}))
-	client.guarddutyConn = guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])}))
-	client.healthConn = health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])}))
-	client.healthlakeConn = healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.HealthLake])}))
-	client.honeycodeConn = honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])}))
-	client.iamConn = iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])}))
-	client.ivsConn = ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])}))
-	client.imagebuilderConn = imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])}))
-	client.inspectorConn = inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])}))
-	client.iotConn = iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])}))
-	client.iot1clickdevicesConn = iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])}))
-	client.iot1clickprojectsConn = iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])}))
-	client.iotanalyticsConn = iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])}))
-	client.iotdataConn = iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])}))
-	client.iotdeviceadvisorConn = iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])}))
-	client.ioteventsConn = iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])}))
-	client.ioteventsdataConn = ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])}))
-	client.iotfleethubConn = iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])}))
-	client.iotjobsdataConn = iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])}))
-	client.iotsecuretunnelingConn = iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])}))
-	client.iotsitewiseConn = iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])}))
-	client.iotthingsgraphConn = iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])}))
-	client.iottwinmakerConn = iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])}))
-	client.iotwirelessConn = iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])}))
-	client.kmsConn = kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])}))
-	client.kafkaConn = kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])}))
-	client.kafkaconnectConn = kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])}))
-	client.keyspacesConn = keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])}))
-	client.kinesisConn = kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])}))
-	client.kinesisanalyticsConn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])}))
-	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])}))
-	client.kinesisvideoConn = kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])}))
-	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])}))
-	client.kinesisvideomediaConn = kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])}))
-	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])}))
-	client.lakeformationConn = lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])}))
-	client.lambdaConn = lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])}))
-	client.lexmodelsConn = lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])}))
-	client.lexmodelsv2Conn = lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])}))
-	client.lexruntimeConn = lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])}))
-	client.lexruntimev2Conn = lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])}))
-	client.licensemanagerConn = licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])}))
-	client.lightsailConn = lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])}))
-	client.locationConn = locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])}))
-	client.logsConn = cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])}))
-	client.lookoutequipmentConn = lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])}))
-	client.lookoutmetricsConn = lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])}))
-	client.lookoutvisionConn = lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])}))
-	client.mqConn = mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])}))
-	client.mturkConn = mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])}))
-	client.mwaaConn = mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])}))
-	client.machinelearningConn = machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])}))
-	client.macieConn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])}))
-	client.macie2Conn = macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])}))
-	client.managedblockchainConn = managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])}))
-	client.marketplacecatalogConn = marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])}))
-	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])}))
-	client.marketplaceentitlementConn = marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])}))
-	client.marketplacemeteringConn = marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])}))
-	client.mediaconnectConn = mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])}))
-	client.mediaconvertConn = mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])}))
-	client.mediapackageConn = mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])}))
-	client.mediapackagevodConn = mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])}))
-	client.mediastoreConn = mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])}))
-	client.mediastoredataConn = mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])}))
-	client.mediatailorConn = mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])}))
-	client.memorydbConn = memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])}))
-	client.mghConn = migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])}))
-	client.mgnConn = mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])}))
-	client.migrationhubconfigConn = migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])}))
-	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])}))
-	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])}))
-	client.mobileConn = mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])}))
-	client.neptuneConn = neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])}))
-	client.networkfirewallConn = networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])}))
-	client.networkmanagerConn = networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])}))
-	client.nimbleConn = nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])}))
-	client.opensearchConn = opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])}))
-	client.opsworksConn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])}))
-	client.opsworkscmConn = opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])}))
-	client.organizationsConn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])}))
-	client.outpostsConn = outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])}))
-	client.piConn = pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])}))
-	client.panoramaConn = panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])}))
-	client.personalizeConn = personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])}))
-	client.personalizeeventsConn = personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])}))
-	client.personalizeruntimeConn = personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])}))
-	client.pinpointConn = pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])}))
-	client.pinpointemailConn = pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])}))
-	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])}))
-	client.pollyConn = polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])}))
-	client.pricingConn = pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])}))
-	client.protonConn = proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])}))
-	client.qldbConn = qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])}))
-	client.qldbsessionConn = qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])}))
-	client.quicksightConn = quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])}))
-	client.ramConn = ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])}))
-	client.rbinConn = recyclebin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RBin])}))
-	client.rdsConn = rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])}))
-	client.rdsdataConn = rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])}))
-	client.rumConn = cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])}))
-	client.redshiftConn = redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])}))
-	client.redshiftdataConn = redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])}))
-	client.redshiftserverlessConn = redshiftserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])}))
-	client.rekognitionConn = rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])}))
-	client.resiliencehubConn = resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])}))
-	client.resourcegroupsConn = resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])}))
-	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])}))
-	client.robomakerConn = robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])}))
-	client.route53recoveryclusterConn = route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])}))
-	client.route53resolverConn = route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])}))
-	client.s3controlConn = s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])}))
-	client.s3outpostsConn = s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])}))
-	client.sesConn = ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])}))
-	client.sfnConn = sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])}))
-	client.smsConn = sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])}))
-	client.snsConn = sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])}))
-	client.sqsConn = sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])}))
-	client.ssmConn = ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])}))
-	client.ssmcontactsConn = ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMContacts])}))
-	client.ssoConn = sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])}))
-	client.ssoadminConn = ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])}))
-	client.ssooidcConn = ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])}))
-	client.swfConn = swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])}))
-	client.sagemakerConn = sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])}))
-	client.sagemakera2iruntimeConn = augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])}))
-	client.sagemakeredgeConn = sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])}))
-	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])}))
-	client.sagemakerruntimeConn = sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])}))
-	client.savingsplansConn = savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])}))
-	client.schemasConn = schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])}))
-	client.secretsmanagerConn = secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])}))
-	client.securityhubConn = securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])}))
-	client.serverlessrepoConn = serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])}))
-	client.servicecatalogConn = servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])}))
-	client.servicecatalogappregistryConn = appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])}))
-	client.servicediscoveryConn = servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])}))
-	client.servicequotasConn = servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])}))
-	client.signerConn = signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])}))
-	client.sdbConn = simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])}))
-	client.snowdevicemanagementConn = snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])}))
-	client.snowballConn = snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])}))
-	client.storagegatewayConn = storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])}))
-	client.supportConn = support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])}))
-	client.syntheticsConn = synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])}))
-	client.textractConn = textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])}))
-	client.timestreamqueryConn = timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])}))
-	client.timestreamwriteConn = timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])}))
-	client.transcribestreamingConn = transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])}))
-	client.transferConn = transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])}))
-	client.translateConn = translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])}))
-	client.voiceidConn = voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])}))
-	client.wafConn = waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])}))
-	client.wafregionalConn = wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])}))
-	client.wafv2Conn = wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])}))
-	client.wellarchitectedConn = wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])}))
-	client.wisdomConn = connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])}))
-	client.workdocsConn = workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])}))
-	client.worklinkConn = worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])}))
-	client.workmailConn = workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])}))
-	client.workmailmessageflowConn = workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])}))
-	client.workspacesConn = workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])}))
-	client.workspaceswebConn = workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])}))
-	client.xrayConn = xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])}))
+	client.acmConn = acm.New(sess.Copy(c.sdkv1Config(names.ACM)))
+	client.acmpcaConn = acmpca.New(sess.Copy(c.sdkv1Config(names.ACMPCA)))
+	client.ampConn = prometheusservice.New(sess.Copy(c.sdkv1Config(names.AMP)))
+	client.apigatewayConn = apigateway.New(sess.Copy(c.sdkv1Config(names.APIGateway)))
+	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(sess.Copy(c.sdkv1Config(names.APIGatewayManagementAPI)))
+	client.apigatewayv2Conn = apigatewayv2.New(sess.Copy(c.sdkv1Config(names.APIGatewayV2)))
+	client.accessanalyzerConn = accessanalyzer.New(sess.Copy(c.sdkv1Config(names.AccessAnalyzer)))
+	client.accountConn = account.New(sess.Copy(c.sdkv1Config(names.Account)))
+	client.alexaforbusinessConn = alexaforbusiness.New(sess.Copy(c.sdkv1Config(names.AlexaForBusiness)))
+	client.amplifyConn = amplify.New(sess.Copy(c.sdkv1Config(names.Amplify)))
+	client.amplifybackendConn = amplifybackend.New(sess.Copy(c.sdkv1Config(names.AmplifyBackend)))
+	client.amplifyuibuilderConn = amplifyuibuilder.New(sess.Copy(c.sdkv1Config(names.AmplifyUIBuilder)))
+	client.applicationautoscalingConn = applicationautoscaling.New(sess.Copy(c.sdkv1Config(names.AppAutoScaling)))
+	client.appconfigConn = appconfig.New(sess.Copy(c.sdkv1Config(names.AppConfig)))
+	client.appconfigdataConn = appconfigdata.New(sess.Copy(c.sdkv1Config(names.AppConfigData)))
+	client.appflowConn = appflow.New(sess.Copy(c.sdkv1Config(names.AppFlow)))
+	client.appintegrationsConn = appintegrationsservice.New(sess.Copy(c.sdkv1Config(names.AppIntegrations)))
+	client.appmeshConn = appmesh.New(sess.Copy(c.sdkv1Config(names.AppMesh)))
+	client.apprunnerConn = apprunner.New(sess.Copy(c.sdkv1Config(names.AppRunner)))
+	client.appstreamConn = appstream.New(sess.Copy(c.sdkv1Config(names.AppStream)))
+	client.appsyncConn = appsync.New(sess.Copy(c.sdkv1Config(names.AppSync)))
+	client.applicationcostprofilerConn = applicationcostprofiler.New(sess.Copy(c.sdkv1Config(names.ApplicationCostProfiler)))
+	client.applicationinsightsConn = applicationinsights.New(sess.Copy(c.sdkv1Config(names.ApplicationInsights)))
+	client.athenaConn = athena.New(sess.Copy(c.sdkv1Config(names.Athena)))
+	client.autoscalingConn = autoscaling.New(sess.Copy(c.sdkv1Config(names.AutoScaling)))
+	client.autoscalingplansConn = autoscalingplans.New(sess.Copy(c.sdkv1Config(names.AutoScalingPlans)))
+	client.backupConn = backup.New(sess.Copy(c.sdkv1Config(names.Backup)))
+	client.backupgatewayConn = backupgateway.New(sess.Copy(c.sdkv1Config(names.BackupGateway)))
+	client.batchConn = batch.New(sess.Copy(c.sdkv1Config(names.Batch)))
+	client.billingconductorConn = billingconductor.New(sess.Copy(c.sdkv1Config(names.BillingConductor)))
+	client.braketConn = braket.New(sess.Copy(c.sdkv1Config(names.Braket)))
+	client.budgetsConn = budgets.New(sess.Copy(c.sdkv1Config(names.Budgets)))
+	client.ceConn = costexplorer.New(sess.Copy(c.sdkv1Config(names.CE)))
+	client.curConn = costandusagereportservice.New(sess.Copy(c.sdkv1Config(names.CUR)))
+	client.chimeConn = chime.New(sess.Copy(c.sdkv1Config(names.Chime)))
+	client.chimesdkidentityConn = chimesdkidentity.New(sess.Copy(c.sdkv1Config(names.ChimeSDKIdentity)))
+	client.chimesdkmeetingsConn = chimesdkmeetings.New(sess.Copy(c.sdkv1Config(names.ChimeSDKMeetings)))
+	client.chimesdkmessagingConn = chimesdkmessaging.New(sess.Copy(c.sdkv1Config(names.ChimeSDKMessaging)))
+	client.cloud9Conn = cloud9.New(sess.Copy(c.sdkv1Config(names.Cloud9)))
+	client.clouddirectoryConn = clouddirectory.New(sess.Copy(c.sdkv1Config(names.CloudDirectory)))
+	client.cloudformationConn = cloudformation.New(sess.Copy(c.sdkv1Config(names.CloudFormation)))
+	client.cloudfrontConn = cloudfront.New(sess.Copy(c.sdkv1Config(names.CloudFront)))
+	client.cloudhsmv2Conn = cloudhsmv2.New(sess.Copy(c.sdkv1Config(names.CloudHSMV2)))
+	client.cloudsearchConn = cloudsearch.New(sess.Copy(c.sdkv1Config(names.CloudSearch)))
+	client.cloudsearchdomainConn = cloudsearchdomain.New(sess.Copy(c.sdkv1Config(names.CloudSearchDomain)))
+	client.cloudtrailConn = cloudtrail.New(sess.Copy(c.sdkv1Config(names.CloudTrail)))
+	client.cloudwatchConn = cloudwatch.New(sess.Copy(c.sdkv1Config(names.CloudWatch)))
+	client.codeartifactConn = codeartifact.New(sess.Copy(c.sdkv1Config(names.CodeArtifact)))
+	client.codebuildConn = codebuild.New(sess.Copy(c.sdkv1Config(names.CodeBuild)))
+	client.codecommitConn = codecommit.New(sess.Copy(c.sdkv1Config(names.CodeCommit)))
+	client.codeguruprofilerConn = codeguruprofiler.New(sess.Copy(c.sdkv1Config(names.CodeGuruProfiler)))
+	client.codegurureviewerConn = codegurureviewer.New(sess.Copy(c.sdkv1Config(names.CodeGuruReviewer)))
+	client.codepipelineConn = codepipeline.New(sess.Copy(c.sdkv1Config(names.CodePipeline)))
+	client.codestarConn = codestar.New(sess.Copy(c.sdkv1Config(names.CodeStar)))
+	client.codestarconnectionsConn = codestarconnections.New(sess.Copy(c.sdkv1Config(names.CodeStarConnections)))
+	client.codestarnotificationsConn = codestarnotifications.New(sess.Copy(c.sdkv1Config(names.CodeStarNotifications)))
+	client.cognitoidpConn = cognitoidentityprovider.New(sess.Copy(c.sdkv1Config(names.CognitoIDP)))
+	client.cognitoidentityConn = cognitoidentity.New(sess.Copy(c.sdkv1Config(names.CognitoIdentity)))
+	client.cognitosyncConn = cognitosync.New(sess.Copy(c.sdkv1Config(names.CognitoSync)))
+	client.comprehendmedicalConn = comprehendmedical.New(sess.Copy(c.sdkv1Config(names.ComprehendMedical)))
+	client.configserviceConn = configservice.New(sess.Copy(c.sdkv1Config(names.ConfigService)))
+	client.connectConn = connect.New(sess.Copy(c.sdkv1Config(names.Connect)))
+	client.connectcontactlensConn = connectcontactlens.New(sess.Copy(c.sdkv1Config(names.ConnectContactLens)))
+	client.connectparticipantConn = connectparticipant.New(sess.Copy(c.sdkv1Config(names.ConnectParticipant)))
+	client.controltowerConn = controltower.New(sess.Copy(c.sdkv1Config(names.ControlTower)))
+	client.customerprofilesConn = customerprofiles.New(sess.Copy(c.sdkv1Config(names.CustomerProfiles)))
+	client.daxConn = dax.New(sess.Copy(c.sdkv1Config(names.DAX)))
+	client.dlmConn = dlm.New(sess.Copy(c.sdkv1Config(names.DLM)))
+	client.dmsConn = databasemigrationservice.New(sess.Copy(c.sdkv1Config(names.DMS)))
+	client.drsConn = drs.New(sess.Copy(c.sdkv1Config(names.DRS)))
+	client.dsConn = directoryservice.New(sess.Copy(c.sdkv1Config(names.DS)))
+	client.databrewConn = gluedatabrew.New(sess.Copy(c.sdkv1Config(names.DataBrew)))
+	client.dataexchangeConn = dataexchange.New(sess.Copy(c.sdkv1Config(names.DataExchange)))
+	client.datapipelineConn = datapipeline.New(sess.Copy(c.sdkv1Config(names.DataPipeline)))
+	client.datasyncConn = datasync.New(sess.Copy(c.sdkv1Config(names.DataSync)))
+	client.deployConn = codedeploy.New(sess.Copy(c.sdkv1Config(names.Deploy)))
+	client.detectiveConn = detective.New(sess.Copy(c.sdkv1Config(names.Detective)))
+	client.devopsguruConn = devopsguru.New(sess.Copy(c.sdkv1Config(names.DevOpsGuru)))
+	client.devicefarmConn = devicefarm.New(sess.Copy(c.sdkv1Config(names.DeviceFarm)))
+	client.directconnectConn = directconnect.New(sess.Copy(c.sdkv1Config(names.DirectConnect)))
+	client.discoveryConn = applicationdiscoveryservice.New(sess.Copy(c.sdkv1Config(names.Discovery)))
+	client.docdbConn = docdb.New(sess.Copy(c.sdkv1Config(names.DocDB)))
+	client.dynamodbConn = dynamodb.New(sess.Copy(c.sdkv1Config(names.DynamoDB)))
+	client.dynamodbstreamsConn = dynamodbstreams.New(sess.Copy(c.sdkv1Config(names.DynamoDBStreams)))
+	client.ebsConn = ebs.New(sess.Copy(c.sdkv1Config(names.EBS)))
+	client.ec2Conn = ec2.New(sess.Copy(c.sdkv1Config(names.EC2)))
+	client.ec2instanceconnectConn = ec2instanceconnect.New(sess.Copy(c.sdkv1Config(names.EC2InstanceConnect)))
+	client.ecrConn = ecr.New(sess.Copy(c.sdkv1Config(names.ECR)))
+	client.ecrpublicConn = ecrpublic.New(sess.Copy(c.sdkv1Config(names.ECRPublic)))
+	client.ecsConn = ecs.New(sess.Copy(c.sdkv1Config(names.ECS)))
+	client.efsConn = efs.New(sess.Copy(c.sdkv1Config(names.EFS)))
+	client.eksConn = eks.New(sess.Copy(c.sdkv1Config(names.EKS)))
+	client.elbConn = elb.New(sess.Copy(c.sdkv1Config(names.ELB)))
+	client.elbv2Conn = elbv2.New(sess.Copy(c.sdkv1Config(names.ELBV2)))
+	client.emrConn = emr.New(sess.Copy(c.sdkv1Config(names.EMR)))
+	client.emrcontainersConn = emrcontainers.New(sess.Copy(c.sdkv1Config(names.EMRContainers)))
+	client.emrserverlessConn = emrserverless.New(sess.Copy(c.sdkv1Config(names.EMRServerless)))
+	client.elasticacheConn = elasticache.New(sess.Copy(c.sdkv1Config(names.ElastiCache)))
+	client.elasticbeanstalkConn = elasticbeanstalk.New(sess.Copy(c.sdkv1Config(names.ElasticBeanstalk)))
+	client.elasticinferenceConn = elasticinference.New(sess.Copy(c.sdkv1Config(names.ElasticInference)))
+	client.elastictranscoderConn = elastictranscoder.New(sess.Copy(c.sdkv1Config(names.ElasticTranscoder)))
+	client.esConn = elasticsearchservice.New(sess.Copy(c.sdkv1Config(names.Elasticsearch)))
+	client.eventsConn = eventbridge.New(sess.Copy(c.sdkv1Config(names.Events)))
+	client.evidentlyConn = cloudwatchevidently.New(sess.Copy(c.sdkv1Config(names.Evidently)))
+	client.fmsConn = fms.New(sess.Copy(c.sdkv1Config(names.FMS)))
+	client.fsxConn = fsx.New(sess.Copy(c.sdkv1Config(names.FSx)))
+	client.finspaceConn = finspace.New(sess.Copy(c.sdkv1Config(names.FinSpace)))
+	client.finspacedataConn = finspacedata.New(sess.Copy(c.sdkv1Config(names.FinSpaceData)))
+	client.firehoseConn = firehose.New(sess.Copy(c.sdkv1Config(names.Firehose)))
+	client.forecastConn = forecastservice.New(sess.Copy(c.sdkv1Config(names.Forecast)))
+	client.forecastqueryConn = forecastqueryservice.New(sess.Copy(c.sdkv1Config(names.ForecastQuery)))
+	client.frauddetectorConn = frauddetector.New(sess.Copy(c.sdkv1Config(names.FraudDetector)))
+	client.gameliftConn = gamelift.New(sess.Copy(c.sdkv1Config(names.GameLift)))
+	client.glacierConn = glacier.New(sess.Copy(c.sdkv1Config(names.Glacier)))
+	client.glueConn = glue.New(sess.Copy(c.sdkv1Config(names.Glue)))
+	client.grafanaConn = managedgrafana.New(sess.Copy(c.sdkv1Config(names.Grafana)))
+	client.greengrassConn = greengrass.New(sess.Copy(c.sdkv1Config(names.Greengrass)))
+	client.greengrassv2Conn = greengrassv2.New(sess.Copy(c.sdkv1Config(names.GreengrassV2)))
+	client.groundstationConn = groundstation.New(sess.Copy(c.sdkv1Config(names.GroundStation)))
+	client.guarddutyConn = guardduty.New(sess.Copy(c.sdkv1Config(names.GuardDuty)))
+	client.healthConn = health.New(sess.Copy(c.sdkv1Config(names.Health)))
+	client.healthlakeConn = healthlake.New(sess.Copy(c.sdkv1Config(names.HealthLake)))
+	client.honeycodeConn = honeycode.New(sess.Copy(c.sdkv1Config(names.Honeycode)))
+	client.iamConn = iam.New(sess.Copy(c.sdkv1Config(names.IAM)))
+	client.ivsConn = ivs.New(sess.Copy(c.sdkv1Config(names.IVS)))
+	client.imagebuilderConn = imagebuilder.New(sess.Copy(c.sdkv1Config(names.ImageBuilder)))
+	client.inspectorConn = inspector.New(sess.Copy(c.sdkv1Config(names.Inspector)))
+	client.iotConn = iot.New(sess.Copy(c.sdkv1Config(names.IoT)))
+	client.iot1clickdevicesConn = iot1clickdevicesservice.New(sess.Copy(c.sdkv1Config(names.IoT1ClickDevices)))
+	client.iot1clickprojectsConn = iot1clickprojects.New(sess.Copy(c.sdkv1Config(names.IoT1ClickProjects)))
+	client.iotanalyticsConn = iotanalytics.New(sess.Copy(c.sdkv1Config(names.IoTAnalytics)))
+	client.iotdataConn = iotdataplane.New(sess.Copy(c.sdkv1Config(names.IoTData)))
+	client.iotdeviceadvisorConn = iotdeviceadvisor.New(sess.Copy(c.sdkv1Config(names.IoTDeviceAdvisor)))
+	client.ioteventsConn = iotevents.New(sess.Copy(c.sdkv1Config(names.IoTEvents)))
+	client.ioteventsdataConn = ioteventsdata.New(sess.Copy(c.sdkv1Config(names.IoTEventsData)))
+	client.iotfleethubConn = iotfleethub.New(sess.Copy(c.sdkv1Config(names.IoTFleetHub)))
+	client.iotjobsdataConn = iotjobsdataplane.New(sess.Copy(c.sdkv1Config(names.IoTJobsData)))
+	client.iotsecuretunnelingConn = iotsecuretunneling.New(sess.Copy(c.sdkv1Config(names.IoTSecureTunneling)))
+	client.iotsitewiseConn = iotsitewise.New(sess.Copy(c.sdkv1Config(names.IoTSiteWise)))
+	client.iotthingsgraphConn = iotthingsgraph.New(sess.Copy(c.sdkv1Config(names.IoTThingsGraph)))
+	client.iottwinmakerConn = iottwinmaker.New(sess.Copy(c.sdkv1Config(names.IoTTwinMaker)))
+	client.iotwirelessConn = iotwireless.New(sess.Copy(c.sdkv1Config(names.IoTWireless)))
+	client.kmsConn = kms.New(sess.Copy(c.sdkv1Config(names.KMS)))
+	client.kafkaConn = kafka.New(sess.Copy(c.sdkv1Config(names.Kafka)))
+	client.kafkaconnectConn = kafkaconnect.New(sess.Copy(c.sdkv1Config(names.KafkaConnect)))
+	client.keyspacesConn = keyspaces.New(sess.Copy(c.sdkv1Config(names.Keyspaces)))
+	client.kinesisConn = kinesis.New(sess.Copy(c.sdkv1Config(names.Kinesis)))
+	client.kinesisanalyticsConn = kinesisanalytics.New(sess.Copy(c.sdkv1Config(names.KinesisAnalytics)))
+	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(sess.Copy(c.sdkv1Config(names.KinesisAnalyticsV2)))
+	client.kinesisvideoConn = kinesisvideo.New(sess.Copy(c.sdkv1Config(names.KinesisVideo)))
+	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(sess.Copy(c.sdkv1Config(names.KinesisVideoArchivedMedia)))
+	client.kinesisvideomediaConn = kinesisvideomedia.New(sess.Copy(c.sdkv1Config(names.KinesisVideoMedia)))
+	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(sess.Copy(c.sdkv1Config(names.KinesisVideoSignaling)))
+	client.lakeformationConn = lakeformation.New(sess.Copy(c.sdkv1Config(names.LakeFormation)))
+	client.lambdaConn = lambda.New(sess.Copy(c.sdkv1Config(names.Lambda)))
+	client.lexmodelsConn = lexmodelbuildingservice.New(sess.Copy(c.sdkv1Config(names.LexModels)))
+	client.lexmodelsv2Conn = lexmodelsv2.New(sess.Copy(c.sdkv1Config(names.LexModelsV2)))
+	client.lexruntimeConn = lexruntimeservice.New(sess.Copy(c.sdkv1Config(names.LexRuntime)))
+	client.lexruntimev2Conn = lexruntimev2.New(sess.Copy(c.sdkv1Config(names.LexRuntimeV2)))
+	client.licensemanagerConn = licensemanager.New(sess.Copy(c.sdkv1Config(names.LicenseManager)))
+	client.lightsailConn = lightsail.New(sess.Copy(c.sdkv1Config(names.Lightsail)))
+	client.locationConn = locationservice.New(sess.Copy(c.sdkv1Config(names.Location)))
+	client.logsConn = cloudwatchlogs.New(sess.Copy(c.sdkv1Config(names.Logs)))
+	client.lookoutequipmentConn = lookoutequipment.New(sess.Copy(c.sdkv1Config(names.LookoutEquipment)))
+	client.lookoutmetricsConn = lookoutmetrics.New(sess.Copy(c.sdkv1Config(names.LookoutMetrics)))
+	client.lookoutvisionConn = lookoutforvision.New(sess.Copy(c.sdkv1Config(names.LookoutVision)))
+	client.mqConn = mq.New(sess.Copy(c.sdkv1Config(names.MQ)))
+	client.mturkConn = mturk.New(sess.Copy(c.sdkv1Config(names.MTurk)))
+	client.mwaaConn = mwaa.New(sess.Copy(c.sdkv1Config(names.MWAA)))
+	client.machinelearningConn = machinelearning.New(sess.Copy(c.sdkv1Config(names.MachineLearning)))
+	client.macieConn = macie.New(sess.Copy(c.sdkv1Config(names.Macie)))
+	client.macie2Conn = macie2.New(sess.Copy(c.sdkv1Config(names.Macie2)))
+	client.managedblockchainConn = managedblockchain.New(sess.Copy(c.sdkv1Config(names.ManagedBlockchain)))
+	client.marketplacecatalogConn = marketplacecatalog.New(sess.Copy(c.sdkv1Config(names.MarketplaceCatalog)))
+	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(sess.Copy(c.sdkv1Config(names.MarketplaceCommerceAnalytics)))
+	client.marketplaceentitlementConn = marketplaceentitlementservice.New(sess.Copy(c.sdkv1Config(names.MarketplaceEntitlement)))
+	client.marketplacemeteringConn = marketplacemetering.New(sess.Copy(c.sdkv1Config(names.MarketplaceMetering)))
+	client.mediaconnectConn = mediaconnect.New(sess.Copy(c.sdkv1Config(names.MediaConnect)))
+	client.mediaconvertConn = mediaconvert.New(sess.Copy(c.sdkv1Config(names.MediaConvert)))
+	client.mediapackageConn = mediapackage.New(sess.Copy(c.sdkv1Config(names.MediaPackage)))
+	client.mediapackagevodConn = mediapackagevod.New(sess.Copy(c.sdkv1Config(names.MediaPackageVOD)))
+	client.mediastoreConn = mediastore.New(sess.Copy(c.sdkv1Config(names.MediaStore)))
+	client.mediastoredataConn = mediastoredata.New(sess.Copy(c.sdkv1Config(names.MediaStoreData)))
+	client.mediatailorConn = mediatailor.New(sess.Copy(c.sdkv1Config(names.MediaTailor)))
+	client.memorydbConn = memorydb.New(sess.Copy(c.sdkv1Config(names.MemoryDB)))
+	client.mghConn = migrationhub.New(sess.Copy(c.sdkv1Config(names.MgH)))
+	client.mgnConn = mgn.New(sess.Copy(c.sdkv1Config(names.Mgn)))
+	client.migrationhubconfigConn = migrationhubconfig.New(sess.Copy(c.sdkv1Config(names.MigrationHubConfig)))
+	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(sess.Copy(c.sdkv1Config(names.MigrationHubRefactorSpaces)))
+	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(sess.Copy(c.sdkv1Config(names.MigrationHubStrategy)))
+	client.mobileConn = mobile.New(sess.Copy(c.sdkv1Config(names.Mobile)))
+	client.neptuneConn = neptune.New(sess.Copy(c.sdkv1Config(names.Neptune)))
+	client.networkfirewallConn = networkfirewall.New(sess.Copy(c.sdkv1Config(names.NetworkFirewall)))
+	client.networkmanagerConn = networkmanager.New(sess.Copy(c.sdkv1Config(names.NetworkManager)))
+	client.nimbleConn = nimblestudio.New(sess.Copy(c.sdkv1Config(names.Nimble)))
+	client.opensearchConn = opensearchservice.New(sess.Copy(c.sdkv1Config(names.OpenSearch)))
+	client.opsworksConn = opsworks.New(sess.Copy(c.sdkv1Config(names.OpsWorks)))
+	client.opsworkscmConn = opsworkscm.New(sess.Copy(c.sdkv1Config(names.OpsWorksCM)))
+	client.organizationsConn = organizations.New(sess.Copy(c.sdkv1Config(names.Organizations)))
+	client.outpostsConn = outposts.New(sess.Copy(c.sdkv1Config(names.Outposts)))
+	client.piConn = pi.New(sess.Copy(c.sdkv1Config(names.PI)))
+	client.panoramaConn = panorama.New(sess.Copy(c.sdkv1Config(names.Panorama)))
+	client.personalizeConn = personalize.New(sess.Copy(c.sdkv1Config(names.Personalize)))
+	client.personalizeeventsConn = personalizeevents.New(sess.Copy(c.sdkv1Config(names.PersonalizeEvents)))
+	client.personalizeruntimeConn = personalizeruntime.New(sess.Copy(c.sdkv1Config(names.PersonalizeRuntime)))
+	client.pinpointConn = pinpoint.New(sess.Copy(c.sdkv1Config(names.Pinpoint)))
+	client.pinpointemailConn = pinpointemail.New(sess.Copy(c.sdkv1Config(names.PinpointEmail)))
+	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(sess.Copy(c.sdkv1Config(names.PinpointSMSVoice)))
+	client.pollyConn = polly.New(sess.Copy(c.sdkv1Config(names.Polly)))
+	client.pricingConn = pricing.New(sess.Copy(c.sdkv1Config(names.Pricing)))
+	client.protonConn = proton.New(sess.Copy(c.sdkv1Config(names.Proton)))
+	client.qldbConn = qldb.New(sess.Copy(c.sdkv1Config(names.QLDB)))
+	client.qldbsessionConn = qldbsession.New(sess.Copy(c.sdkv1Config(names.QLDBSession)))
+	client.quicksightConn = quicksight.New(sess.Copy(c.sdkv1Config(names.QuickSight)))
+	client.ramConn = ram.New(sess.Copy(c.sdkv1Config(names.RAM)))
+	client.rbinConn = recyclebin.New(sess.Copy(c.sdkv1Config(names.RBin)))
+	client.rdsConn = rds.New(sess.Copy(c.sdkv1Config(names.RDS)))
+	client.rdsdataConn = rdsdataservice.New(sess.Copy(c.sdkv1Config(names.RDSData)))
+	client.rumConn = cloudwatchrum.New(sess.Copy(c.sdkv1Config(names.RUM)))
+	client.redshiftConn = redshift.New(sess.Copy(c.sdkv1Config(names.Redshift)))
+	client.redshiftdataConn = redshiftdataapiservice.New(sess.Copy(c.sdkv1Config(names.RedshiftData)))
+	client.redshiftserverlessConn = redshiftserverless.New(sess.Copy(c.sdkv1Config(names.RedshiftServerless)))
+	client.rekognitionConn = rekognition.New(sess.Copy(c.sdkv1Config(names.Rekognition)))
+	client.resiliencehubConn = resiliencehub.New(sess.Copy(c.sdkv1Config(names.ResilienceHub)))
+	client.resourcegroupsConn = resourcegroups.New(sess.Copy(c.sdkv1Config(names.ResourceGroups)))
+	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(sess.Copy(c.sdkv1Config(names.ResourceGroupsTaggingAPI)))
+	client.robomakerConn = robomaker.New(sess.Copy(c.sdkv1Config(names.RoboMaker)))
+	client.route53recoveryclusterConn = route53recoverycluster.New(sess.Copy(c.sdkv1Config(names.Route53RecoveryCluster)))
+	client.route53resolverConn = route53resolver.New(sess.Copy(c.sdkv1Config(names.Route53Resolver)))
+	client.s3controlConn = s3control.New(sess.Copy(c.sdkv1Config(names.S3Control)))
+	client.s3outpostsConn = s3outposts.New(sess.Copy(c.sdkv1Config(names.S3Outposts)))
+	client.sesConn = ses.New(sess.Copy(c.sdkv1Config(names.SES)))
+	client.sfnConn = sfn.New(sess.Copy(c.sdkv1Config(names.SFN)))
+	client.smsConn = sms.New(sess.Copy(c.sdkv1Config(names.SMS)))
+	client.snsConn = sns.New(sess.Copy(c.sdkv1Config(names.SNS)))
+	client.sqsConn = sqs.New(sess.Copy(c.sdkv1Config(names.SQS)))
+	client.ssmConn = ssm.New(sess.Copy(c.sdkv1Config(names.SSM)))
+	client.ssmcontactsConn = ssmcontacts.New(sess.Copy(c.sdkv1Config(names.SSMContacts)))
+	client.ssoConn = sso.New(sess.Copy(c.sdkv1Config(names.SSO)))
+	client.ssoadminConn = ssoadmin.New(sess.Copy(c.sdkv1Config(names.SSOAdmin)))
+	client.ssooidcConn = ssooidc.New(sess.Copy(c.sdkv1Config(names.SSOOIDC)))
+	client.swfConn = swf.New(sess.Copy(c.sdkv1Config(names.SWF)))
+	client.sagemakerConn = sagemaker.New(sess.Copy(c.sdkv1Config(names.SageMaker)))
+	client.sagemakera2iruntimeConn = augmentedairuntime.New(sess.Copy(c.sdkv1Config(names.SageMakerA2IRuntime)))
+	client.sagemakeredgeConn = sagemakeredgemanager.New(sess.Copy(c.sdkv1Config(names.SageMakerEdge)))
+	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(sess.Copy(c.sdkv1Config(names.SageMakerFeatureStoreRuntime)))
+	client.sagemakerruntimeConn = sagemakerruntime.New(sess.Copy(c.sdkv1Config(names.SageMakerRuntime)))
+	client.savingsplansConn = savingsplans.New(sess.Copy(c.sdkv1Config(names.SavingsPlans)))
+	client.schemasConn = schemas.New(sess.Copy(c.sdkv1Config(names.Schemas)))
+	client.secretsmanagerConn = secretsmanager.New(sess.Copy(c.sdkv1Config(names.SecretsManager)))
+	client.securityhubConn = securityhub.New(sess.Copy(c.sdkv1Config(names.SecurityHub)))
+	client.serverlessrepoConn = serverlessapplicationrepository.New(sess.Copy(c.sdkv1Config(names.ServerlessRepo)))
+	client.servicecatalogConn = servicecatalog.New(sess.Copy(c.sdkv1Config(names.ServiceCatalog)))
+	client.servicecatalogappregistryConn = appregistry.New(sess.Copy(c.sdkv1Config(names.ServiceCatalogAppRegistry)))
+	client.servicediscoveryConn = servicediscovery.New(sess.Copy(c.sdkv1Config(names.ServiceDiscovery)))
+	client.servicequotasConn = servicequotas.New(sess.Copy(c.sdkv1Config(names.ServiceQuotas)))
+	client.signerConn = signer.New(sess.Copy(c.sdkv1Config(names.Signer)))
+	client.sdbConn = simpledb.New(sess.Copy(c.sdkv1Config(names.SimpleDB)))
+	client.snowdevicemanagementConn = snowdevicemanagement.New(sess.Copy(c.sdkv1Config(names.SnowDeviceManagement)))
+	client.snowballConn = snowball.New(sess.Copy(c.sdkv1Config(names.Snowball)))
+	client.storagegatewayConn = storagegateway.New(sess.Copy(c.sdkv1Config(names.StorageGateway)))
+	client.supportConn = support.New(sess.Copy(c.sdkv1Config(names.Support)))
+	client.syntheticsConn = synthetics.New(sess.Copy(c.sdkv1Config(names.Synthetics)))
+	client.textractConn = textract.New(sess.Copy(c.sdkv1Config(names.Textract)))
+	client.timestreamqueryConn = timestreamquery.New(sess.Copy(c.sdkv1Config(names.TimestreamQuery)))
+	client.timestreamwriteConn = timestreamwrite.New(sess.Copy(c.sdkv1Config(names.TimestreamWrite)))
+	client.transcribestreamingConn = transcribestreamingservice.New(sess.Copy(c.sdkv1Config(names.TranscribeStreaming)))
+	client.transferConn = transfer.New(sess.Copy(c.sdkv1Config(names.Transfer)))
+	client.translateConn = translate.New(sess.Copy(c.sdkv1Config(names.Translate)))
+	client.voiceidConn = voiceid.New(sess.Copy(c.sdkv1Config(names.VoiceID)))
+	client.wafConn = waf.New(sess.Copy(c.sdkv1Config(names.WAF)))
+	client.wafregionalConn = wafregional.New(sess.Copy(c.sdkv1Config(names.WAFRegional)))
+	client.wafv2Conn = wafv2.New(sess.Copy(c.sdkv1Config(names.WAFV2)))
+	client.wellarchitectedConn = wellarchitected.New(sess.Copy(c.sdkv1Config(names.WellArchitected)))
+	client.wisdomConn = connectwisdomservice.New(sess.Copy(c.sdkv1Config(names.Wisdom)))
+	client.workdocsConn = workdocs.New(sess.Copy(c.sdkv1Config(names.WorkDocs)))
+	client.worklinkConn = worklink.New(sess.Copy(c.sdkv1Config(names.WorkLink)))
+	client.workmailConn = workmail.New(sess.Copy(c.sdkv1Config(names.WorkMail)))
+	client.workmailmessageflowConn = workmailmessageflow.New(sess.Copy(c.sdkv1Config(names.WorkMailMessageFlow)))
+	client.workspacesConn = workspaces.New(sess.Copy(c.sdkv1Config(names.WorkSpaces)))
+	client.workspaceswebConn = workspacesweb.New(sess.Copy(c.sdkv1Config(names.WorkSpacesWeb)))
+	client.xrayConn = xray.New(sess.Copy(c.sdkv1Config(names.XRay)))
 }
 
 // sdkv2Conns initializes AWS SDK for Go v2 clients.
 func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
-	client.auditmanagerClient = auditmanager.NewFromConfig(cfg, func(o *auditmanager.Options) {
-		if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
+	client.auditmanagerClient = auditmanager.NewFromConfig(c.sdkv2Config(cfg, names.AuditManager), func(o *auditmanager.Options) {
+		if endpoint := c.endpoint(names.AuditManager); endpoint != "" {
 			o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.cloudcontrolClient = cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
-		if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
+	client.cloudcontrolClient = cloudcontrol.NewFromConfig(c.sdkv2Config(cfg, names.CloudControl), func(o *cloudcontrol.Options) {
+		if endpoint := c.endpoint(names.CloudControl); endpoint != "" {
 			o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.comprehendClient = comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
-		if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
+	client.comprehendClient = comprehend.NewFromConfig(c.sdkv2Config(cfg, names.Comprehend), func(o *comprehend.Options) {
+		if endpoint := c.endpoint(names.Comprehend); endpoint != "" {
 			o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.computeoptimizerClient = computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
-		if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
+	client.computeoptimizerClient = computeoptimizer.NewFromConfig(c.sdkv2Config(cfg, names.ComputeOptimizer), func(o *computeoptimizer.Options) {
+		if endpoint := c.endpoint(names.ComputeOptimizer); endpoint != "" {
 			o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.fisClient = fis.NewFromConfig(cfg, func(o *fis.Options) {
-		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
+	client.fisClient = fis.NewFromConfig(c.sdkv2Config(cfg, names.FIS), func(o *fis.Options) {
+		if endpoint := c.endpoint(names.FIS); endpoint != "" {
 			o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.ivschatClient = ivschat.NewFromConfig(cfg, func(o *ivschat.Options) {
-		if endpoint := c.Endpoints[names.IVSChat]; endpoint != "" {
+	client.ivschatClient = ivschat.NewFromConfig(c.sdkv2Config(cfg, names.IVSChat), func(o *ivschat.Options) {
+		if endpoint := c.endpoint(names.IVSChat); endpoint != "" {
 			o.EndpointResolver = ivschat.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.identitystoreClient = identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
-		if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
+	client.identitystoreClient = identitystore.NewFromConfig(c.sdkv2Config(cfg, names.IdentityStore), func(o *identitystore.Options) {
+		if endpoint := c.endpoint(names.IdentityStore); endpoint != "" {
 			o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.inspector2Client = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
-		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
+	client.inspector2Client = inspector2.NewFromConfig(c.sdkv2Config(cfg, names.Inspector2), func(o *inspector2.Options) {
+		if endpoint := c.endpoint(names.Inspector2); endpoint != "" {
 			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.kendraClient = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
-		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
+	client.kendraClient = kendra.NewFromConfig(c.sdkv2Config(cfg, names.Kendra), func(o *kendra.Options) {
+		if endpoint := c.endpoint(names.Kendra); endpoint != "" {
 			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.medialiveClient = medialive.NewFromConfig(cfg, func(o *medialive.Options) {
-		if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
+	client.medialiveClient = medialive.NewFromConfig(c.sdkv2Config(cfg, names.MediaLive), func(o *medialive.Options) {
+		if endpoint := c.endpoint(names.MediaLive); endpoint != "" {
 			o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.opensearchserverlessClient = opensearchserverless.NewFromConfig(cfg, func(o *opensearchserverless.Options) {
-		if endpoint := c.Endpoints[names.OpenSearchServerless]; endpoint != "" {
+	client.opensearchserverlessClient = opensearchserverless.NewFromConfig(c.sdkv2Config(cfg, names.OpenSearchServerless), func(o *opensearchserverless.Options) {
+		if endpoint := c.endpoint(names.OpenSearchServerless); endpoint != "" {
 			o.EndpointResolver = opensearchserverless.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.pipesClient = pipes.NewFromConfig(cfg, func(o *pipes.Options) {
-		if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
+	client.pipesClient = pipes.NewFromConfig(c.sdkv2Config(cfg, names.Pipes), func(o *pipes.Options) {
+		if endpoint := c.endpoint(names.Pipes); endpoint != "" {
 			o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.resourceexplorer2Client = resourceexplorer2.NewFromConfig(cfg, func(o *resourceexplorer2.Options) {
-		if endpoint := c.Endpoints[names.ResourceExplorer2]; endpoint != "" {
+	client.resourceexplorer2Client = resourceexplorer2.NewFromConfig(c.sdkv2Config(cfg, names.ResourceExplorer2), func(o *resourceexplorer2.Options) {
+		if endpoint := c.endpoint(names.ResourceExplorer2); endpoint != "" {
 			o.EndpointResolver = resourceexplorer2.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.rolesanywhereClient = rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
-		if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
+	client.rolesanywhereClient = rolesanywhere.NewFromConfig(c.sdkv2Config(cfg, names.RolesAnywhere), func(o *rolesanywhere.Options) {
+		if endpoint := c.endpoint(names.RolesAnywhere); endpoint != "" {
 			o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.sesv2Client = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
-		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
+	client.sesv2Client = sesv2.NewFromConfig(c.sdkv2Config(cfg, names.SESV2), func(o *sesv2.Options) {
+		if endpoint := c.endpoint(names.SESV2); endpoint != "" {
 			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.ssmincidentsClient = ssmincidents.NewFromConfig(cfg, func(o *ssmincidents.Options) {
-		if endpoint := c.Endpoints[names.SSMIncidents]; endpoint != "" {
+	client.ssmincidentsClient = ssmincidents.NewFromConfig(c.sdkv2Config(cfg, names.SSMIncidents), func(o *ssmincidents.Options) {
+		if endpoint := c.endpoint(names.SSMIncidents); endpoint != "" {
 			o.EndpointResolver = ssmincidents.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.schedulerClient = scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
-		if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
+	client.schedulerClient = scheduler.NewFromConfig(c.sdkv2Config(cfg, names.Scheduler), func(o *scheduler.Options) {
+		if endpoint := c.endpoint(names.Scheduler); endpoint != "" {
 			o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
 		}
 	})
-	client.transcribeClient = transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
-		if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
+	client.transcribeClient = transcribe.NewFromConfig(c.sdkv2Config(cfg, names.Transcribe), func(o *transcribe.Options) {
+		if endpoint := c.endpoint(names.Transcribe); endpoint != "" {
 			o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
 		}
 	})
@@ -696,36 +695,36 @@ func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
 // sdkv2LazyConns initializes AWS SDK for Go v2 lazy-load clients.
 func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 	client.ec2Client.init(&cfg, func() *ec2_sdkv2.Client {
-		return ec2_sdkv2.NewFromConfig(cfg, func(o *ec2_sdkv2.Options) {
-			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
+		return ec2_sdkv2.NewFromConfig(c.sdkv2Config(cfg, names.EC2), func(o *ec2_sdkv2.Options) {
+			if endpoint := c.endpoint(names.EC2); endpoint != "" {
 				o.EndpointResolver = ec2_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
 	client.logsClient.init(&cfg, func() *cloudwatchlogs_sdkv2.Client {
-		return cloudwatchlogs_sdkv2.NewFromConfig(cfg, func(o *cloudwatchlogs_sdkv2.Options) {
-			if endpoint := c.Endpoints[names.Logs]; endpoint != "" {
+		return cloudwatchlogs_sdkv2.NewFromConfig(c.sdkv2Config(cfg, names.Logs), func(o *cloudwatchlogs_sdkv2.Options) {
+			if endpoint := c.endpoint(names.Logs); endpoint != "" {
 				o.EndpointResolver = cloudwatchlogs_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
 	client.rdsClient.init(&cfg, func() *rds_sdkv2.Client {
-		return rds_sdkv2.NewFromConfig(cfg, func(o *rds_sdkv2.Options) {
-			if endpoint := c.Endpoints[names.RDS]; endpoint != "" {
+		return rds_sdkv2.NewFromConfig(c.sdkv2Config(cfg, names.RDS), func(o *rds_sdkv2.Options) {
+			if endpoint := c.endpoint(names.RDS); endpoint != "" {
 				o.EndpointResolver = rds_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
 	client.s3controlClient.init(&cfg, func() *s3control_sdkv2.Client {
-		return s3control_sdkv2.NewFromConfig(cfg, func(o *s3control_sdkv2.Options) {
-			if endpoint := c.Endpoints[names.S3Control]; endpoint != "" {
+		return s3control_sdkv2.NewFromConfig(c.sdkv2Config(cfg, names.S3Control), func(o *s3control_sdkv2.Options) {
+			if endpoint := c.endpoint(names.S3Control); endpoint != "" {
 				o.EndpointResolver = s3control_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
 	client.ssmClient.init(&cfg, func() *ssm_sdkv2.Client {
-		return ssm_sdkv2.NewFromConfig(cfg, func(o *ssm_sdkv2.Options) {
-			if endpoint := c.Endpoints[names.SSM]; endpoint != "" {
+		return ssm_sdkv2.NewFromConfig(c.sdkv2Config(cfg, names.SSM), func(o *ssm_sdkv2.Options) {
+			if endpoint := c.endpoint(names.SSM); endpoint != "" {
 				o.EndpointResolver = ssm_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
@@ -0,0 +1,218 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ServiceOverride contains settings that override the provider configuration for a single service's API clients.
+type ServiceOverride struct {
+	AssumeRole *awsbase.AssumeRole
+	Endpoint   string
+	Region     string
+}
+
+// endpoint returns the custom endpoint, if any, for the specified service.
+func (c *Config) endpoint(pkg string) string {
+	if v := c.ServiceOverrides[pkg]; v != nil && v.Endpoint != "" {
+		return v.Endpoint
+	}
+
+	return c.Endpoints[pkg]
+}
+
+// serviceRegion returns the Region override, if any, for the specified service.
+func (c *Config) serviceRegion(pkg string) string {
+	if v := c.ServiceOverrides[pkg]; v != nil {
+		return v.Region
+	}
+
+	return ""
+}
+
+// stsRegion returns the Region used for STS API calls.
+// A Region override for STS takes precedence over the provider's sts_region argument.
+func (c *Config) stsRegion() string {
+	if v := c.serviceRegion(names.STS); v != "" {
+		return v
+	}
+
+	return c.STSRegion
+}
+
+// sdkv1Config returns the AWS SDK for Go v1 configuration, applied to a copy of the provider's session, for the specified service's API clients.
+func (c *Config) sdkv1Config(pkg string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(c.endpoint(pkg)),
+	}
+
+	if v := c.serviceRegion(pkg); v != "" {
+		config.Region = aws.String(v)
+	}
+
+	if v, ok := c.serviceCredentials[pkg]; ok {
+		config.Credentials = credentials.NewCredentials(&sdkv1CredentialsProvider{provider: v})
+	}
+
+	return config
+}
+
+// globalServiceRegions are the Regions to which "global" services' API clients are forced, keyed by partition ID and then provider package name.
+var globalServiceRegions = map[string]map[string]string{
+	endpoints.AwsPartitionID: {
+		names.GlobalAccelerator:            endpoints.UsWest2RegionID,
+		names.Route53:                      endpoints.UsEast1RegionID,
+		names.Route53RecoveryControlConfig: endpoints.UsWest2RegionID,
+		names.Route53RecoveryReadiness:     endpoints.UsWest2RegionID,
+		names.Shield:                       endpoints.UsEast1RegionID,
+	},
+	endpoints.AwsCnPartitionID: {
+		names.Route53: endpoints.CnNorthwest1RegionID,
+	},
+	endpoints.AwsUsGovPartitionID: {
+		names.Route53: endpoints.UsGovWest1RegionID,
+	},
+}
+
+// globalSDKv1Config returns the AWS SDK for Go v1 configuration for the specified "global" service's API clients.
+// The service's Region in the partition is used unless the service's Region is overridden.
+func (c *Config) globalSDKv1Config(pkg, partition string) *aws.Config {
+	config := c.sdkv1Config(pkg)
+
+	// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+	// This can likely be removed in the future.
+	if pkg == names.Route53 && partition == endpoints.AwsCnPartitionID && aws.StringValue(config.Endpoint) == "" {
+		config.Endpoint = aws.String("https://api.route53.cn")
+	}
+
+	if config.Region == nil {
+		if v, ok := globalServiceRegions[partition][pkg]; ok {
+			config.Region = aws.String(v)
+		}
+	}
+
+	return config
+}
+
+// sdkv2Config returns the AWS SDK for Go v2 configuration for the specified service's API clients.
+func (c *Config) sdkv2Config(cfg aws_sdkv2.Config, pkg string) aws_sdkv2.Config {
+	if v := c.serviceRegion(pkg); v != "" {
+		cfg.Region = v
+	}
+
+	if v, ok := c.serviceCredentials[pkg]; ok {
+		cfg.Credentials = v
+	}
+
+	return cfg
+}
+
+// configureServiceCredentials creates the credentials for services that assume their own IAM role.
+// The role is assumed, using the provider's credentials, the first time a request is made to the service.
+func (c *Config) configureServiceCredentials(cfg aws_sdkv2.Config) {
+	c.serviceCredentials = make(map[string]aws_sdkv2.CredentialsProvider)
+
+	var stsClient *sts_sdkv2.Client
+
+	for pkg, v := range c.ServiceOverrides {
+		if v == nil || v.AssumeRole == nil || v.AssumeRole.RoleARN == "" {
+			continue
+		}
+
+		if stsClient == nil {
+			stsClient = sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+				if endpoint := c.endpoint(names.STS); endpoint != "" {
+					o.EndpointResolver = sts_sdkv2.EndpointResolverFromURL(endpoint)
+				}
+				if v := c.stsRegion(); v != "" {
+					o.Region = v
+				}
+			})
+		}
+
+		c.serviceCredentials[pkg] = aws_sdkv2.NewCredentialsCache(stscreds_sdkv2.NewAssumeRoleProvider(stsClient, v.AssumeRole.RoleARN, assumeRoleOptions(v.AssumeRole)))
+	}
+}
+
+func assumeRoleOptions(ar *awsbase.AssumeRole) func(*stscreds_sdkv2.AssumeRoleOptions) {
+	return func(opts *stscreds_sdkv2.AssumeRoleOptions) {
+		opts.RoleSessionName = ar.SessionName
+		opts.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			opts.ExternalID = aws_sdkv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			opts.Policy = aws_sdkv2.String(ar.Policy)
+		}
+
+		for _, v := range ar.PolicyARNs {
+			opts.PolicyARNs = append(opts.PolicyARNs, ststypes.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		for k, v := range ar.Tags {
+			opts.Tags = append(opts.Tags, ststypes.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+
+		if ar.SourceIdentity != "" {
+			opts.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+		}
+	}
+}
+
+// sdkv1CredentialsProvider adapts an AWS SDK for Go v2 credentials provider for use by AWS SDK for Go v1 API clients.
+// Expiry is handled by the AWS SDK for Go v2 credentials provider, typically a credentials cache.
+type sdkv1CredentialsProvider struct {
+	provider aws_sdkv2.CredentialsProvider
+
+	mu    sync.Mutex
+	value *aws_sdkv2.Credentials
+}
+
+func (p *sdkv1CredentialsProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *sdkv1CredentialsProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	v, err := p.provider.Retrieve(ctx)
+
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	p.mu.Lock()
+	p.value = &v
+	p.mu.Unlock()
+
+	return credentials.Value{
+		AccessKeyID:     v.AccessKeyID,
+		SecretAccessKey: v.SecretAccessKey,
+		SessionToken:    v.SessionToken,
+		ProviderName:    v.Source,
+	}, nil
+}
+
+func (p *sdkv1CredentialsProvider) IsExpired() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.value == nil || p.value.Expired()
+}
@@ -0,0 +1,155 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestServiceOverrides(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		Endpoints: map[string]string{
+			names.EC2: "https://ec2.fake.test",
+			names.S3:  "https://s3.fake.test",
+		},
+		ServiceOverrides: map[string]*ServiceOverride{
+			names.Route53: {
+				AssumeRole: &awsbase.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/dns"},
+			},
+			names.S3: {
+				Endpoint: "https://s3-override.fake.test",
+				Region:   "eu-west-1", // lintignore:AWSAT003
+			},
+		},
+	}
+	cfg := aws_sdkv2.Config{
+		Region:      "us-west-2", // lintignore:AWSAT003
+		Credentials: aws_sdkv2.AnonymousCredentials{},
+	}
+
+	c.configureServiceCredentials(cfg)
+
+	if got, expected := c.endpoint(names.EC2), "https://ec2.fake.test"; got != expected {
+		t.Errorf("incorrect EC2 endpoint. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := c.endpoint(names.S3), "https://s3-override.fake.test"; got != expected {
+		t.Errorf("incorrect S3 endpoint. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := c.sdkv2Config(cfg, names.EC2).Region, "us-west-2"; got != expected { // lintignore:AWSAT003
+		t.Errorf("incorrect EC2 region. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := c.sdkv2Config(cfg, names.S3).Region, "eu-west-1"; got != expected { // lintignore:AWSAT003
+		t.Errorf("incorrect S3 region. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := aws.StringValue(c.sdkv1Config(names.S3).Region), "eu-west-1"; got != expected { // lintignore:AWSAT003
+		t.Errorf("incorrect S3 SDK v1 region. Expected: %s, got: %s", expected, got)
+	}
+
+	if _, ok := c.sdkv2Config(cfg, names.S3).Credentials.(aws_sdkv2.AnonymousCredentials); !ok {
+		t.Error("expected S3 to use the provider's credentials")
+	}
+
+	if _, ok := c.sdkv2Config(cfg, names.Route53).Credentials.(*aws_sdkv2.CredentialsCache); !ok {
+		t.Error("expected Route 53 to use assumed role credentials")
+	}
+
+	if c.sdkv1Config(names.Route53).Credentials == nil {
+		t.Error("expected Route 53 SDK v1 credentials")
+	}
+}
+
+func TestGlobalServiceOverrides(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		Endpoints: map[string]string{
+			names.IAM: "https://iam.fake.test",
+		},
+		ServiceOverrides: map[string]*ServiceOverride{
+			names.Route53: {
+				Region: "us-west-2", // lintignore:AWSAT003
+			},
+			names.STS: {
+				Endpoint: "https://sts-override.fake.test",
+				Region:   "eu-west-1", // lintignore:AWSAT003
+			},
+		},
+		STSRegion: "us-east-2", // lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		pkg       string
+		partition string
+		expected  string
+	}{
+		{pkg: names.Route53, partition: endpoints.AwsPartitionID, expected: "us-west-2"},           // lintignore:AWSAT003
+		{pkg: names.Route53, partition: endpoints.AwsUsGovPartitionID, expected: "us-west-2"},      // lintignore:AWSAT003
+		{pkg: names.Shield, partition: endpoints.AwsPartitionID, expected: "us-east-1"},            // lintignore:AWSAT003
+		{pkg: names.GlobalAccelerator, partition: endpoints.AwsPartitionID, expected: "us-west-2"}, // lintignore:AWSAT003
+		{pkg: names.GlobalAccelerator, partition: endpoints.AwsUsGovPartitionID, expected: ""},
+		{pkg: names.Route53RecoveryReadiness, partition: endpoints.AwsPartitionID, expected: "us-west-2"}, // lintignore:AWSAT003
+	}
+
+	for _, testCase := range testCases {
+		if got := aws.StringValue(c.globalSDKv1Config(testCase.pkg, testCase.partition).Region); got != testCase.expected {
+			t.Errorf("incorrect %s region in %s partition. Expected: %s, got: %s", testCase.pkg, testCase.partition, testCase.expected, got)
+		}
+	}
+
+	if got, expected := c.stsRegion(), "eu-west-1"; got != expected { // lintignore:AWSAT003
+		t.Errorf("incorrect STS region. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := c.endpoint(names.STS), "https://sts-override.fake.test"; got != expected {
+		t.Errorf("incorrect STS endpoint. Expected: %s, got: %s", expected, got)
+	}
+
+	if got, expected := c.endpoint(names.IAM), "https://iam.fake.test"; got != expected {
+		t.Errorf("incorrect IAM endpoint. Expected: %s, got: %s", expected, got)
+	}
+}
+
+type testCredentialsProvider struct {
+	credentials aws_sdkv2.Credentials
+}
+
+func (p testCredentialsProvider) Retrieve(context.Context) (aws_sdkv2.Credentials, error) {
+	return p.credentials, nil
+}
+
+func TestSDKv1CredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	p := &sdkv1CredentialsProvider{provider: testCredentialsProvider{
+		credentials: aws_sdkv2.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET", Source: "test"},
+	}}
+
+	if !p.IsExpired() {
+		t.Error("expected credentials to be expired before retrieval")
+	}
+
+	v, err := p.Retrieve()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v.AccessKeyID != "AKID" || v.SecretAccessKey != "SECRET" {
+		t.Errorf("unexpected credentials: %v", v)
+	}
+
+	if p.IsExpired() {
+		t.Error("expected credentials not to be expired after retrieval")
+	}
+}
@@ -0,0 +1,178 @@
+package conns
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// RateLimit is a client-side limit on the rate of AWS API requests made to a single service.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate at which requests are sent.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that can be sent at once.
+	Burst int
+}
+
+// tokenBucket is a token bucket rate limiter.
+// Tokens are added at a fixed rate up to the bucket's capacity and each request takes one token.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64 // Tokens added per second.
+	capacity float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	capacity := math.Max(float64(burst), 1)
+
+	return &tokenBucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+		now:      time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+// Tokens are handed out in the order in which they are reserved.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve()
+
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// serviceIDReplacer removes the separators from AWS SDK service IDs, e.g. "Route 53" or "CloudWatch Logs".
+var serviceIDReplacer = strings.NewReplacer(" ", "", "-", "")
+
+// providerPackageForServiceID returns the provider package name (e.g. "logs") for an AWS SDK service ID (e.g. "CloudWatch Logs").
+// If no provider package matches, the normalized service ID is returned.
+func providerPackageForServiceID(serviceID string) string {
+	alias := strings.ToLower(serviceIDReplacer.Replace(serviceID))
+
+	if v, err := names.ProviderPackageForAlias(alias); err == nil {
+		return v
+	}
+
+	return alias
+}
+
+// rateLimiters contains the rate limiters for all rate limited services.
+type rateLimiters struct {
+	buckets  map[string]*tokenBucket // Keyed by provider package name.
+	packages sync.Map                // Service ID to provider package name.
+}
+
+func newRateLimiters(rateLimits map[string]RateLimit) *rateLimiters {
+	buckets := make(map[string]*tokenBucket, len(rateLimits))
+
+	for k, v := range rateLimits {
+		buckets[k] = newTokenBucket(v.RequestsPerSecond, v.Burst)
+	}
+
+	return &rateLimiters{
+		buckets: buckets,
+	}
+}
+
+func (l *rateLimiters) providerPackage(serviceID string) string {
+	if v, ok := l.packages.Load(serviceID); ok {
+		return v.(string)
+	}
+
+	v := providerPackageForServiceID(serviceID)
+	l.packages.Store(serviceID, v)
+
+	return v
+}
+
+// wait blocks until a request can be made to the specified service.
+func (l *rateLimiters) wait(ctx context.Context, serviceID string) error {
+	bucket, ok := l.buckets[l.providerPackage(serviceID)]
+
+	if !ok {
+		return nil
+	}
+
+	return bucket.wait(ctx)
+}
+
+// addRateLimitHandlers applies the configured rate limits to all AWS SDK for Go v1 and v2 API clients.
+// Each request attempt, including retries, takes a token from its service's bucket.
+func (c *Config) addRateLimitHandlers(client *AWSClient) {
+	if len(c.RateLimits) == 0 {
+		return
+	}
+
+	limiters := newRateLimiters(c.RateLimits)
+
+	client.AddSDKv1HandlersFunc(func(handlers *request.Handlers) {
+		// Sign is run before each attempt.
+		handlers.Sign.PushFrontNamed(request.NamedHandler{
+			Name: "RateLimit",
+			Fn: func(r *request.Request) {
+				if err := limiters.wait(r.Context(), r.ClientInfo.ServiceID); err != nil {
+					r.Error = err
+				}
+			},
+		})
+	})
+	client.AddSDKv2ConfigFunc(func(cfg *aws_sdkv2.Config) {
+		cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+			m := middleware.FinalizeMiddlewareFunc("RateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				if err := limiters.wait(ctx, awsmiddleware.GetServiceID(ctx)); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+
+				return next.HandleFinalize(ctx, in)
+			})
+
+			// The Finalize step's Retry middleware runs subsequent middleware once per attempt.
+			if _, ok := stack.Finalize.Get("Retry"); ok {
+				return stack.Finalize.Insert(m, "Retry", middleware.After)
+			}
+
+			return stack.Finalize.Add(m, middleware.Before)
+		})
+	})
+}
@@ -0,0 +1,49 @@
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := newTokenBucket(2, 2)
+	b.last = now
+	b.now = func() time.Time { return now }
+
+	for i, expected := range []time.Duration{0, 0, 500 * time.Millisecond, time.Second} {
+		if got := b.reserve(); got != expected {
+			t.Errorf("reservation %d: got: %s, expected: %s", i, got, expected)
+		}
+	}
+
+	// Refill for longer than it takes to fill the bucket.
+	now = now.Add(10 * time.Second)
+
+	for i, expected := range []time.Duration{0, 0, 500 * time.Millisecond} {
+		if got := b.reserve(); got != expected {
+			t.Errorf("reservation %d after refill: got: %s, expected: %s", i, got, expected)
+		}
+	}
+}
+
+func TestProviderPackageForServiceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"EC2":                       "ec2",
+		"Route 53":                  "route53",
+		"Organizations":             "organizations",
+		"CloudWatch Logs":           "logs",
+		"Elastic Load Balancing v2": "elbv2",
+		"Not A Service":             "notaservice",
+	}
+
+	for serviceID, expected := range testCases {
+		if got := providerPackageForServiceID(serviceID); got != expected {
+			t.Errorf("providerPackageForServiceID(%q) = %q, expected %q", serviceID, got, expected)
+		}
+	}
+}
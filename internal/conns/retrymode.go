@@ -0,0 +1,132 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// adaptiveRetryer adds adaptive mode's client-side attempt rate limiting to a standard mode retryer.
+// All other retry behavior, e.g. the maximum number of attempts, is that of the wrapped retryer.
+type adaptiveRetryer struct {
+	aws_sdkv2.RetryerV2
+	adaptive *retry.AdaptiveMode
+}
+
+// GetAttemptToken waits for adaptive mode's attempt rate limiter and then acquires the wrapped retryer's attempt token,
+// which refills the wrapped retryer's retry token bucket when the attempt succeeds.
+func (r *adaptiveRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	releaseAdaptive, err := r.adaptive.GetAttemptToken(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		releaseAdaptive(err) //nolint:errcheck // Adaptive mode's release never returns an error.
+
+		return release(err)
+	}, nil
+}
+
+// GetRetryToken deducts the retry cost from the wrapped retryer's retry token bucket.
+func (r *adaptiveRetryer) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	return r.RetryerV2.GetRetryToken(ctx, opErr)
+}
+
+// isErrorThrottleSDKv1 determines whether an AWS SDK for Go v1 error is a throttling error.
+var isErrorThrottleSDKv1 = retry.IsErrorThrottleFunc(func(err error) aws_sdkv2.Ternary {
+	return aws_sdkv2.BoolTernary(request.IsErrorThrottle(err))
+})
+
+func newAdaptiveMode() *retry.AdaptiveMode {
+	return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+		o.Throttles = append(o.Throttles, isErrorThrottleSDKv1)
+	})
+}
+
+// adaptiveModes contains the adaptive mode rate limiters for AWS SDK for Go v1 API clients, keyed by service ID.
+type adaptiveModes struct {
+	mu    sync.Mutex
+	modes map[string]*retry.AdaptiveMode
+}
+
+func (m *adaptiveModes) get(serviceID string) *retry.AdaptiveMode {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.modes == nil {
+		m.modes = make(map[string]*retry.AdaptiveMode)
+	}
+
+	v, ok := m.modes[serviceID]
+
+	if !ok {
+		v = newAdaptiveMode()
+		m.modes[serviceID] = v
+	}
+
+	return v
+}
+
+// addRetryModeHandlers configures the retry mode of all AWS SDK for Go v1 and v2 API clients.
+// In adaptive mode the rate at which request attempts are sent to a service is reduced when the service throttles requests.
+func (c *Config) addRetryModeHandlers(client *AWSClient) {
+	if c.RetryMode != aws_sdkv2.RetryModeAdaptive {
+		return
+	}
+
+	modes := &adaptiveModes{}
+
+	client.AddSDKv1HandlersFunc(func(handlers *request.Handlers) {
+		// Sign is run before each attempt.
+		handlers.Sign.PushFrontNamed(request.NamedHandler{
+			Name: "AdaptiveRetryMode",
+			Fn: func(r *request.Request) {
+				releaseToken, err := modes.get(r.ClientInfo.ServiceID).GetAttemptToken(r.Context())
+
+				if err != nil {
+					r.Error = err
+					return
+				}
+
+				// Each request has its own copy of the handlers, so the attempt's token release is only seen by this request.
+				r.Handlers.CompleteAttempt.SetBackNamed(request.NamedHandler{
+					Name: "AdaptiveRetryModeRelease",
+					Fn: func(r *request.Request) {
+						releaseToken(r.Error) //nolint:errcheck // Adaptive mode's release never returns an error.
+					},
+				})
+			},
+		})
+	})
+	client.AddSDKv2ConfigFunc(func(cfg *aws_sdkv2.Config) {
+		newRetryer := cfg.Retryer
+
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			adaptive := newAdaptiveMode()
+
+			if newRetryer == nil {
+				return adaptive
+			}
+
+			if v, ok := newRetryer().(aws_sdkv2.RetryerV2); ok {
+				return &adaptiveRetryer{
+					RetryerV2: v,
+					adaptive:  adaptive,
+				}
+			}
+
+			return adaptive
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+func TestAdaptiveRetryerRetryTokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rateLimiter := ratelimit.NewTokenRateLimit(100)
+	r := &adaptiveRetryer{
+		RetryerV2: retry.NewStandard(func(o *retry.StandardOptions) {
+			o.RateLimiter = rateLimiter
+			o.RetryCost = 10
+			o.NoRetryIncrement = 1
+		}),
+		adaptive: newAdaptiveMode(),
+	}
+
+	if _, err := r.GetRetryToken(ctx, errors.New("test")); err != nil {
+		t.Fatalf("getting retry token: %s", err)
+	}
+
+	if got, expected := rateLimiter.Remaining(), uint(90); got != expected {
+		t.Errorf("remaining retry tokens after retry: got: %d, expected: %d", got, expected)
+	}
+
+	release, err := r.GetAttemptToken(ctx)
+
+	if err != nil {
+		t.Fatalf("getting attempt token: %s", err)
+	}
+
+	if err := release(nil); err != nil {
+		t.Fatalf("releasing attempt token: %s", err)
+	}
+
+	if got, expected := rateLimiter.Remaining(), uint(91); got != expected {
+		t.Errorf("remaining retry tokens after successful attempt: got: %d, expected: %d", got, expected)
+	}
+}
@@ -0,0 +1,14 @@
+package conns
+
+import (
+	"time"
+)
+
+// ResourceTimeouts are provider-level default timeouts for a resource type's operations.
+// A zero value leaves the resource type's own default timeout for that operation unchanged.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
@@ -54,6 +54,17 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests offline, against an in-process stand-in for the AWS APIs
+	// Only a subset of services is supported: DynamoDB, IAM, S3, SNS, SQS and STS
+	AccLocal = "TF_ACC_LOCAL"
+)
+
+// Custom environment variables used by the provider
+const (
+	// The path of the file to which AWS API calls are logged.
+	// Each API call is written as a single line of JSON.
+	APICallLog = "TF_AWS_API_CALL_LOG"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +83,16 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used with resource sweepers
+const (
+	// Report the resources that would be deleted instead of deleting them
+	SweepDryRun = "SWEEP_DRY_RUN"
+
+	// The path of the JSON file the dry-run report is written to.
+	// Defaults to sweep-dry-run-report.json in the working directory.
+	SweepDryRunReport = "SWEEP_DRY_RUN_REPORT"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,86 @@
+package errs
+
+import (
+	"fmt"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AWSErrorDetails are the details of an AWS API error.
+type AWSErrorDetails struct {
+	Code       string
+	RequestID  string
+	StatusCode int
+}
+
+// AWSErrorDetailsOf returns the details of the AWS SDK for Go v1 or v2 API error wrapped by the specified error
+// and whether the error wraps an AWS API error.
+func AWSErrorDetailsOf(err error) (AWSErrorDetails, bool) {
+	var details AWSErrorDetails
+
+	if err == nil {
+		return details, false
+	}
+
+	// AWS SDK for Go v1.
+	if awsErr, ok := As[awserr.Error](err); ok {
+		details.Code = awsErr.Code()
+	}
+
+	if reqErr, ok := As[awserr.RequestFailure](err); ok {
+		details.RequestID = reqErr.RequestID()
+		details.StatusCode = reqErr.StatusCode()
+	}
+
+	// AWS SDK for Go v2.
+	if apiErr, ok := As[smithy.APIError](err); ok {
+		details.Code = apiErr.ErrorCode()
+	}
+
+	if respErr, ok := As[*awshttp.ResponseError](err); ok {
+		details.RequestID = respErr.ServiceRequestID()
+	}
+
+	if respErr, ok := As[*smithyhttp.ResponseError](err); ok {
+		details.StatusCode = respErr.HTTPStatusCode()
+	}
+
+	return details, details != AWSErrorDetails{}
+}
+
+// String returns the known details, one "<name>: <value>" line each.
+func (d AWSErrorDetails) String() string {
+	var lines []string
+
+	if d.Code != "" {
+		lines = append(lines, fmt.Sprintf("AWS Error Code: %s", d.Code))
+	}
+
+	if d.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("AWS Request ID: %s", d.RequestID))
+	}
+
+	if d.StatusCode != 0 {
+		lines = append(lines, fmt.Sprintf("HTTP Status Code: %d", d.StatusCode))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ErrorDetail returns the detail of a diagnostic for the specified error:
+// the error's message followed, after an empty line, by the details of any AWS API error it wraps.
+func ErrorDetail(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if details, ok := AWSErrorDetailsOf(err); ok {
+		return fmt.Sprintf("%s\n\n%s", err.Error(), details)
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,87 @@
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestAWSErrorDetailsOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected errs.AWSErrorDetails
+		ok       bool
+	}{
+		"nil": {},
+		"not AWS": {
+			err: errors.New("test"),
+		},
+		"SDK v1 error": {
+			err:      awserr.New("ValidationException", "test", nil),
+			expected: errs.AWSErrorDetails{Code: "ValidationException"},
+			ok:       true,
+		},
+		"SDK v1 request failure": {
+			err:      fmt.Errorf("wrapped: %w", awserr.NewRequestFailure(awserr.New("ThrottlingException", "test", nil), 400, "request-1")),
+			expected: errs.AWSErrorDetails{Code: "ThrottlingException", RequestID: "request-1", StatusCode: 400},
+			ok:       true,
+		},
+		"SDK v2 operation error": {
+			err: fmt.Errorf("wrapped: %w", &smithy.OperationError{
+				ServiceID:     "Scheduler",
+				OperationName: "CreateScheduleGroup",
+				Err: &awshttp.ResponseError{
+					ResponseError: &smithyhttp.ResponseError{
+						Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+						Err:      &smithy.GenericAPIError{Code: "ConflictException", Message: "test"},
+					},
+					RequestID: "request-2",
+				},
+			}),
+			expected: errs.AWSErrorDetails{Code: "ConflictException", RequestID: "request-2", StatusCode: 409},
+			ok:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.AWSErrorDetailsOf(testCase.err)
+
+			if ok != testCase.ok {
+				t.Errorf("got ok %t, expected %t", ok, testCase.ok)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %+v, expected %+v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	err := awserr.NewRequestFailure(awserr.New("ThrottlingException", "Rate exceeded", nil), 400, "request-1")
+
+	expected := fmt.Sprintf("%s\n\nAWS Error Code: ThrottlingException\nAWS Request ID: request-1\nHTTP Status Code: 400", err.Error())
+
+	if got := errs.ErrorDetail(err); got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if got, expected := errs.ErrorDetail(errors.New("test")), "test"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,29 @@
+package fwdiag
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// NewResourceErrorDiagnostic returns an error diagnostic for an action on a resource that failed with the specified error.
+// The summary identifies the action and the resource, e.g. "creating Resource Explorer Index (us-west-2)".
+// The detail is the error's message followed by the AWS error code, request ID and HTTP status code of any AWS API error.
+func NewResourceErrorDiagnostic(service, action, resource, id string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		create.ProblemStandardMessage(service, action, resource, id, nil),
+		errs.ErrorDetail(err),
+	)
+}
+
+// NewResourceAttributeErrorDiagnostic returns an error diagnostic for an action on a resource that failed with the specified error
+// because of the value of the argument at the specified attribute path.
+// See NewResourceErrorDiagnostic.
+func NewResourceAttributeErrorDiagnostic(path path.Path, service, action, resource, id string, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		create.ProblemStandardMessage(service, action, resource, id, nil),
+		errs.ErrorDetail(err),
+	)
+}
@@ -0,0 +1,28 @@
+package sdkdiag
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// NewResourceErrorDiagnostic returns an error diagnostic for an action on a resource that failed with the specified error.
+// The summary identifies the action and the resource, e.g. "creating EventBridge Scheduler Schedule Group (example)".
+// The detail is the error's message followed by the AWS error code, request ID and HTTP status code of any AWS API error.
+func NewResourceErrorDiagnostic(service, action, resource, id string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  create.ProblemStandardMessage(service, action, resource, id, nil),
+		Detail:   errs.ErrorDetail(err),
+	}
+}
+
+// AppendResourceError appends an error diagnostic for an action on a resource that failed with the specified error.
+// See NewResourceErrorDiagnostic.
+func AppendResourceError(diags diag.Diagnostics, service, action, resource, id string, err error) diag.Diagnostics {
+	if err == nil {
+		return diags
+	}
+
+	return append(diags, NewResourceErrorDiagnostic(service, action, resource, id, err))
+}
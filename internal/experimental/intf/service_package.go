@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 type ServicePackage interface {
@@ -22,3 +23,11 @@ type ServicePackage interface {
 	}
 	ServicePackageName() string
 }
+
+// ServicePackageWithTags is implemented by service packages whose resources' tags can be listed and updated
+// given only the resource's identifier, typically its ARN.
+type ServicePackageWithTags interface {
+	ServicePackage
+	ListTags(ctx context.Context, meta any, identifier string) (tftags.KeyValueTags, error)
+	UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error
+}
@@ -0,0 +1,832 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Expand and Flatten convert between Terraform Plugin Framework resource models and AWS SDK for Go v1 and v2 API structs.
+//
+// Model fields are matched to API fields by name, ignoring case.
+// A model field's `autoflex` struct tag overrides the name of the API field it is matched to,
+// and an `autoflex` tag of "-" skips the model field. Model fields with no matching API field are ignored.
+//
+// The following model field types are supported:
+//   - types.String (and custom string types) <-> string, enum (string kind), time.Time (RFC 3339) and pointers to them
+//   - types.Int64 (and custom int64 types) <-> int, int32, int64 and pointers to them
+//   - types.Float64 (and custom float64 types) <-> float32, float64 and pointers to them
+//   - types.Bool (and custom bool types) <-> bool and *bool
+//   - types.List and types.Set <-> slices of any of the above
+//   - types.Map <-> maps with string keys and any of the above values
+//   - types.Object <-> structs and pointers to structs
+//   - types.List and types.Set of types.Object <-> structs, pointers to structs and slices of structs or pointers to structs
+//   - structs, pointers to structs and slices of structs (nested blocks) <-> structs, pointers to structs and slices of structs or pointers to structs
+//
+// Object attributes are matched to API fields by name, ignoring case and underscores, e.g. "filter_string" matches FilterString.
+// A nested block model slice or list or set of objects is expanded to a single API struct, or pointer to struct, from its first element.
+// When flattening, nil pointers and empty slices and maps become null values, as do empty strings and zero times.
+// Object types are taken from the target model's values, so models flattened into must hold typed values, e.g. read from plan or state.
+
+const (
+	autoFlexTagKey = "autoflex"
+)
+
+// Expand populates the AWS SDK for Go API struct pointed to by apiObject from the Terraform Plugin Framework model tfObject.
+// Null and unknown values are not expanded.
+func Expand(ctx context.Context, tfObject, apiObject any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, to, err := autoFlexValues(tfObject, apiObject)
+
+	if err == nil {
+		err = expandStruct(ctx, from, to)
+	}
+
+	if err != nil {
+		diags.AddError("Expanding Terraform model", err.Error())
+	}
+
+	return diags
+}
+
+// Flatten populates the Terraform Plugin Framework model pointed to by tfObject from the AWS SDK for Go API struct apiObject.
+func Flatten(ctx context.Context, apiObject, tfObject any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, to, err := autoFlexValues(apiObject, tfObject)
+
+	if err == nil {
+		err = flattenStruct(ctx, from, to)
+	}
+
+	if err != nil {
+		diags.AddError("Flattening AWS API object", err.Error())
+	}
+
+	return diags
+}
+
+// autoFlexValues returns the source and target structs.
+// The source may be a struct or a non-nil pointer to a struct and the target must be a non-nil pointer to a struct.
+func autoFlexValues(from, to any) (reflect.Value, reflect.Value, error) {
+	valFrom, valTo := reflect.ValueOf(from), reflect.ValueOf(to)
+
+	if valFrom.Kind() == reflect.Pointer {
+		if valFrom.IsNil() {
+			return reflect.Value{}, reflect.Value{}, fmt.Errorf("source is nil")
+		}
+
+		valFrom = valFrom.Elem()
+	}
+
+	if valFrom.Kind() != reflect.Struct {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("source (%T) is not a struct", from)
+	}
+
+	if valTo.Kind() != reflect.Pointer || valTo.IsNil() || valTo.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("target (%T) is not a non-nil pointer to a struct", to)
+	}
+
+	return valFrom, valTo.Elem(), nil
+}
+
+// modelFields calls f for each model struct field along with the name of the API field it is matched to.
+func modelFields(model reflect.Value, f func(i int, name string) error) error {
+	typ := model.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+
+		if v, ok := field.Tag.Lookup(autoFlexTagKey); ok {
+			if v == "-" {
+				continue
+			}
+
+			if v, _, _ := strings.Cut(v, ","); v != "" {
+				name = v
+			}
+		}
+
+		if err := f(i, name); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// apiField returns the API struct field with the specified name, ignoring case.
+// Returns the zero Value if there is no such field.
+func apiField(apiObject reflect.Value, name string) reflect.Value {
+	field, ok := apiObject.Type().FieldByNameFunc(func(s string) bool {
+		return strings.EqualFold(s, name)
+	})
+
+	if !ok || !field.IsExported() {
+		return reflect.Value{}
+	}
+
+	return apiObject.FieldByIndex(field.Index)
+}
+
+func expandStruct(ctx context.Context, from, to reflect.Value) error {
+	return modelFields(from, func(i int, name string) error {
+		target := apiField(to, name)
+
+		if !target.IsValid() {
+			return nil
+		}
+
+		return expandValue(ctx, from.Field(i), target)
+	})
+}
+
+// expandValue expands a model value into an API value.
+func expandValue(ctx context.Context, from, to reflect.Value) error {
+	if v, ok := from.Interface().(attr.Value); ok {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+
+		return expandAttrValue(ctx, v, to)
+	}
+
+	switch from.Kind() {
+	case reflect.Pointer:
+		if from.IsNil() {
+			return nil
+		}
+
+		return expandValue(ctx, from.Elem(), to)
+
+	case reflect.Struct:
+		if to.Kind() == reflect.Pointer {
+			to = allocate(to)
+		}
+
+		if to.Kind() != reflect.Struct {
+			return fmt.Errorf("cannot expand %s into %s", from.Type(), to.Type())
+		}
+
+		return expandStruct(ctx, from, to)
+
+	case reflect.Slice:
+		if from.Len() == 0 {
+			return nil
+		}
+
+		if to.Kind() != reflect.Slice {
+			// Nested block with at most one element.
+			return expandValue(ctx, from.Index(0), to)
+		}
+
+		s := reflect.MakeSlice(to.Type(), from.Len(), from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			if err := expandValue(ctx, from.Index(i), s.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		to.Set(s)
+
+		return nil
+	}
+
+	return fmt.Errorf("unsupported model type: %s", from.Type())
+}
+
+// expandAttrValue expands a known, non-null Terraform Plugin Framework value into an API value.
+func expandAttrValue(ctx context.Context, from attr.Value, to reflect.Value) error {
+	switch v := from.(type) {
+	case basetypes.StringValuable:
+		s, diags := v.ToStringValue(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to String", from.Type(ctx))
+		}
+
+		return setString(allocate(to), s.ValueString())
+
+	case basetypes.Int64Valuable:
+		n, diags := v.ToInt64Value(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to Int64", from.Type(ctx))
+		}
+
+		return setInt(allocate(to), n.ValueInt64())
+
+	case basetypes.Float64Valuable:
+		n, diags := v.ToFloat64Value(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to Float64", from.Type(ctx))
+		}
+
+		to = allocate(to)
+
+		switch to.Kind() {
+		case reflect.Float32, reflect.Float64:
+			to.SetFloat(n.ValueFloat64())
+			return nil
+		}
+
+	case basetypes.BoolValuable:
+		b, diags := v.ToBoolValue(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to Bool", from.Type(ctx))
+		}
+
+		to = allocate(to)
+
+		if to.Kind() == reflect.Bool {
+			to.SetBool(b.ValueBool())
+			return nil
+		}
+
+	case basetypes.ListValuable:
+		l, diags := v.ToListValue(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to List", from.Type(ctx))
+		}
+
+		return expandElements(ctx, l.Elements(), to)
+
+	case basetypes.SetValuable:
+		s, diags := v.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to Set", from.Type(ctx))
+		}
+
+		return expandElements(ctx, s.Elements(), to)
+
+	case basetypes.ObjectValuable:
+		o, diags := v.ToObjectValue(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to Object", from.Type(ctx))
+		}
+
+		model, err := objectModel(ctx, o.AttributeTypes(ctx))
+
+		if err != nil {
+			return err
+		}
+
+		if diags := o.As(ctx, model.Addr().Interface(), basetypes.ObjectAsOptions{}); diags.HasError() {
+			return fmt.Errorf("converting %s to model", from.Type(ctx))
+		}
+
+		return expandValue(ctx, model, to)
+
+	case basetypes.MapValuable:
+		m, diags := v.ToMapValue(ctx)
+
+		if diags.HasError() {
+			return fmt.Errorf("converting %s to Map", from.Type(ctx))
+		}
+
+		if to.Kind() != reflect.Map || to.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		result := reflect.MakeMapWithSize(to.Type(), len(m.Elements()))
+
+		for k, e := range m.Elements() {
+			elem := reflect.New(to.Type().Elem()).Elem()
+
+			if err := expandValue(ctx, reflect.ValueOf(e), elem); err != nil {
+				return fmt.Errorf("%q: %w", k, err)
+			}
+
+			result.SetMapIndex(reflect.ValueOf(k).Convert(to.Type().Key()), elem)
+		}
+
+		to.Set(result)
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot expand %s into %s", from.Type(ctx), to.Type())
+}
+
+func expandElements(ctx context.Context, elems []attr.Value, to reflect.Value) error {
+	if to.Kind() != reflect.Slice {
+		// List or set of objects with at most one element.
+		if isStruct(to.Type()) {
+			if len(elems) == 0 {
+				return nil
+			}
+
+			return expandValue(ctx, reflect.ValueOf(elems[0]), to)
+		}
+
+		return fmt.Errorf("cannot expand elements into %s", to.Type())
+	}
+
+	s := reflect.MakeSlice(to.Type(), len(elems), len(elems))
+
+	for i, e := range elems {
+		if err := expandValue(ctx, reflect.ValueOf(e), s.Index(i)); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	to.Set(s)
+
+	return nil
+}
+
+// allocate returns the value pointed to by v, allocating it if v is a pointer.
+// Any other value is returned unchanged.
+func allocate(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Pointer {
+		return v
+	}
+
+	p := reflect.New(v.Type().Elem())
+	v.Set(p)
+
+	return p.Elem()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func setString(to reflect.Value, s string) error {
+	switch {
+	case to.Kind() == reflect.String:
+		to.SetString(s)
+
+		return nil
+
+	case to.Type() == timeType:
+		t, err := time.Parse(time.RFC3339, s)
+
+		if err != nil {
+			return err
+		}
+
+		to.Set(reflect.ValueOf(t))
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot expand String into %s", to.Type())
+}
+
+func setInt(to reflect.Value, n int64) error {
+	switch to.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if to.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, to.Type())
+		}
+
+		to.SetInt(n)
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot expand Int64 into %s", to.Type())
+}
+
+func flattenStruct(ctx context.Context, from, to reflect.Value) error {
+	return modelFields(to, func(i int, name string) error {
+		source := apiField(from, name)
+
+		if !source.IsValid() {
+			return nil
+		}
+
+		return flattenValue(ctx, source, to.Field(i))
+	})
+}
+
+// flattenValue flattens an API value into a model value.
+func flattenValue(ctx context.Context, from, to reflect.Value) error {
+	if v, ok := to.Interface().(attr.Value); ok {
+		result, err := flattenAttrValue(ctx, from, v.Type(ctx), true)
+
+		if err != nil {
+			return err
+		}
+
+		val := reflect.ValueOf(result)
+
+		if !val.Type().AssignableTo(to.Type()) {
+			return fmt.Errorf("cannot flatten %s into %s", from.Type(), to.Type())
+		}
+
+		to.Set(val)
+
+		return nil
+	}
+
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			to.Set(reflect.Zero(to.Type()))
+
+			return nil
+		}
+
+		from = from.Elem()
+	}
+
+	switch to.Kind() {
+	case reflect.Pointer:
+		return flattenValue(ctx, from, allocate(to))
+
+	case reflect.Struct:
+		if from.Kind() != reflect.Struct {
+			break
+		}
+
+		return flattenStruct(ctx, from, to)
+
+	case reflect.Slice:
+		switch from.Kind() {
+		case reflect.Struct:
+			// Nested block with at most one element.
+			s := reflect.MakeSlice(to.Type(), 1, 1)
+
+			if err := flattenValue(ctx, from, s.Index(0)); err != nil {
+				return err
+			}
+
+			to.Set(s)
+
+			return nil
+
+		case reflect.Slice:
+			if from.Len() == 0 {
+				to.Set(reflect.Zero(to.Type()))
+
+				return nil
+			}
+
+			s := reflect.MakeSlice(to.Type(), from.Len(), from.Len())
+
+			for i := 0; i < from.Len(); i++ {
+				if err := flattenValue(ctx, from.Index(i), s.Index(i)); err != nil {
+					return fmt.Errorf("[%d]: %w", i, err)
+				}
+			}
+
+			to.Set(s)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot flatten %s into %s", from.Type(), to.Type())
+}
+
+// flattenAttrValue flattens an API value into a Terraform Plugin Framework value of the specified type.
+// If nullIfZero is true, empty strings and zero times are flattened to null values.
+func flattenAttrValue(ctx context.Context, from reflect.Value, typ attr.Type, nullIfZero bool) (attr.Value, error) {
+	isNull := false
+
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			isNull = true
+		} else {
+			from = from.Elem()
+		}
+	}
+
+	switch t := typ.(type) {
+	case basetypes.ListType:
+		if isNull && t.ElemType != nil {
+			return types.ListNull(t.ElemType), nil
+		}
+
+		elemType, elems, err := flattenElements(ctx, from, t.ElemType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if isNull || len(elems) == 0 {
+			return types.ListNull(elemType), nil
+		}
+
+		return types.ListValueMust(elemType, elems), nil
+
+	case basetypes.SetType:
+		if isNull && t.ElemType != nil {
+			return types.SetNull(t.ElemType), nil
+		}
+
+		elemType, elems, err := flattenElements(ctx, from, t.ElemType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if isNull || len(elems) == 0 {
+			return types.SetNull(elemType), nil
+		}
+
+		return types.SetValueMust(elemType, elems), nil
+
+	case basetypes.ObjectType:
+		if len(t.AttrTypes) == 0 {
+			return nil, fmt.Errorf("cannot flatten %s into an object of unknown type", from.Type())
+		}
+
+		if isNull {
+			return types.ObjectNull(t.AttrTypes), nil
+		}
+
+		if from.Kind() != reflect.Struct {
+			break
+		}
+
+		model, err := objectModel(ctx, t.AttrTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := flattenStruct(ctx, from, model); err != nil {
+			return nil, err
+		}
+
+		result, diags := types.ObjectValueFrom(ctx, t.AttrTypes, model.Interface())
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting model to %s", typ)
+		}
+
+		return result, nil
+
+	case basetypes.MapType:
+		if from.Kind() != reflect.Map || from.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		elemType := t.ElemType
+
+		if elemType == nil {
+			var err error
+
+			if elemType, err = elementAttrType(from.Type().Elem()); err != nil {
+				return nil, err
+			}
+		}
+
+		if isNull || from.Len() == 0 {
+			return types.MapNull(elemType), nil
+		}
+
+		elems := make(map[string]attr.Value, from.Len())
+		iter := from.MapRange()
+
+		for iter.Next() {
+			v, err := flattenAttrValue(ctx, iter.Value(), elemType, false)
+
+			if err != nil {
+				return nil, fmt.Errorf("%q: %w", iter.Key().String(), err)
+			}
+
+			elems[iter.Key().String()] = v
+		}
+
+		return types.MapValueMust(elemType, elems), nil
+
+	case basetypes.StringTypable:
+		v := types.StringNull()
+
+		if !isNull {
+			switch {
+			case from.Kind() == reflect.String:
+				if s := from.String(); s != "" || !nullIfZero {
+					v = types.StringValue(s)
+				}
+			case from.Type() == timeType:
+				if t := from.Interface().(time.Time); !t.IsZero() || !nullIfZero {
+					v = types.StringValue(t.Format(time.RFC3339))
+				}
+			default:
+				return nil, fmt.Errorf("cannot flatten %s into %s", from.Type(), typ)
+			}
+		}
+
+		result, diags := t.ValueFromString(ctx, v)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting String to %s", typ)
+		}
+
+		return result, nil
+
+	case basetypes.Int64Typable:
+		v := types.Int64Null()
+
+		if !isNull {
+			switch from.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				v = types.Int64Value(from.Int())
+			default:
+				return nil, fmt.Errorf("cannot flatten %s into %s", from.Type(), typ)
+			}
+		}
+
+		result, diags := t.ValueFromInt64(ctx, v)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting Int64 to %s", typ)
+		}
+
+		return result, nil
+
+	case basetypes.Float64Typable:
+		v := types.Float64Null()
+
+		if !isNull {
+			switch from.Kind() {
+			case reflect.Float32, reflect.Float64:
+				v = types.Float64Value(from.Float())
+			default:
+				return nil, fmt.Errorf("cannot flatten %s into %s", from.Type(), typ)
+			}
+		}
+
+		result, diags := t.ValueFromFloat64(ctx, v)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting Float64 to %s", typ)
+		}
+
+		return result, nil
+
+	case basetypes.BoolTypable:
+		v := types.BoolNull()
+
+		if !isNull {
+			if from.Kind() != reflect.Bool {
+				return nil, fmt.Errorf("cannot flatten %s into %s", from.Type(), typ)
+			}
+
+			v = types.BoolValue(from.Bool())
+		}
+
+		result, diags := t.ValueFromBool(ctx, v)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting Bool to %s", typ)
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("cannot flatten %s into %s", from.Type(), typ)
+}
+
+// flattenElements flattens an API slice into Terraform Plugin Framework values.
+// If elemType is nil the element type is determined from the slice's element type.
+func flattenElements(ctx context.Context, from reflect.Value, elemType attr.Type) (attr.Type, []attr.Value, error) {
+	if from.Kind() == reflect.Struct && from.Type() != timeType {
+		// Nested block with at most one element.
+		s := reflect.MakeSlice(reflect.SliceOf(from.Type()), 1, 1)
+		s.Index(0).Set(from)
+		from = s
+	}
+
+	if from.Kind() != reflect.Slice {
+		return nil, nil, fmt.Errorf("cannot flatten %s into a collection", from.Type())
+	}
+
+	if elemType == nil {
+		var err error
+
+		if elemType, err = elementAttrType(from.Type().Elem()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	elems := make([]attr.Value, from.Len())
+
+	for i := 0; i < from.Len(); i++ {
+		v, err := flattenAttrValue(ctx, from.Index(i), elemType, false)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		elems[i] = v
+	}
+
+	return elemType, elems, nil
+}
+
+// elementAttrType returns the Terraform Plugin Framework type for a collection's API element type.
+func elementAttrType(typ reflect.Type) (attr.Type, error) {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return types.StringType, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return types.Int64Type, nil
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, nil
+	case reflect.Bool:
+		return types.BoolType, nil
+	}
+
+	if typ == timeType {
+		return types.StringType, nil
+	}
+
+	if typ.Kind() == reflect.Struct {
+		return nil, fmt.Errorf("cannot flatten %s into a collection of objects of unknown type", typ)
+	}
+
+	return nil, fmt.Errorf("unsupported collection element type: %s", typ)
+}
+
+// isStruct returns whether typ is a struct, other than time.Time, or a pointer to one.
+func isStruct(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Struct && typ != timeType
+}
+
+// objectModel returns a new model struct with a field for each of the specified object attributes.
+// Fields are named after their attributes, e.g. "filter_string" becomes FilterString, and are initialized to null values.
+func objectModel(ctx context.Context, attrTypes map[string]attr.Type) (reflect.Value, error) {
+	names := make([]string, 0, len(attrTypes))
+
+	for name := range attrTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	fields := make([]reflect.StructField, len(names))
+	values := make([]attr.Value, len(names))
+
+	for i, name := range names {
+		typ := attrTypes[name]
+		v, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%q: %w", name, err)
+		}
+
+		fields[i] = reflect.StructField{
+			Name: objectModelFieldName(name),
+			Type: reflect.TypeOf(v),
+			Tag:  reflect.StructTag(fmt.Sprintf(`tfsdk:%q`, name)),
+		}
+		values[i] = v
+	}
+
+	model := reflect.New(reflect.StructOf(fields)).Elem()
+
+	for i, v := range values {
+		model.Field(i).Set(reflect.ValueOf(v))
+	}
+
+	return model, nil
+}
+
+// objectModelFieldName returns the model struct field name for the specified object attribute name.
+func objectModelFieldName(name string) string {
+	var sb strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+
+	return sb.String()
+}
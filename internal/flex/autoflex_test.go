@@ -0,0 +1,355 @@
+package flex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testEnum string
+
+type testAPIChild struct {
+	Name  *string
+	Value int32
+}
+
+type testAPIObject struct {
+	_ struct{}
+
+	Child        *testAPIChild
+	Children     []testAPIChild
+	CreatedAt    *time.Time
+	Enabled      *bool
+	Ratio        float64
+	RoleArn      *string
+	Size         *int64
+	State        testEnum
+	SubnetIds    []*string
+	Tags         map[string]string
+	TimeoutInMin *int32
+}
+
+type testChildModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+type testModel struct {
+	Child     []testChildModel `tfsdk:"child"`
+	Children  []testChildModel `tfsdk:"children"`
+	CreatedAt types.String     `tfsdk:"created_at"`
+	Enabled   types.Bool       `tfsdk:"enabled"`
+	ID        types.String     `tfsdk:"id"`
+	Ratio     types.Float64    `tfsdk:"ratio"`
+	RoleARN   types.String     `tfsdk:"role_arn"`
+	Size      types.Int64      `tfsdk:"size"`
+	State     types.String     `tfsdk:"state"`
+	SubnetIDs types.Set        `tfsdk:"subnet_ids"`
+	Tags      types.Map        `tfsdk:"tags"`
+	Timeout   types.Int64      `tfsdk:"timeout" autoflex:"TimeoutInMin"`
+	Skipped   types.String     `tfsdk:"skipped" autoflex:"-"`
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	createdAt := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+
+	type testCase struct {
+		input         any
+		expected      testAPIObject
+		expectedError bool
+	}
+	tests := map[string]testCase{
+		"all fields": {
+			input: &testModel{
+				Child: []testChildModel{
+					{Name: types.StringValue("a"), Value: types.Int64Value(1)},
+				},
+				Children: []testChildModel{
+					{Name: types.StringValue("b"), Value: types.Int64Value(2)},
+					{Name: types.StringNull(), Value: types.Int64Value(3)},
+				},
+				CreatedAt: types.StringValue(createdAt.Format(time.RFC3339)),
+				Enabled:   types.BoolValue(true),
+				ID:        types.StringValue("id"),
+				Ratio:     types.Float64Value(0.5),
+				RoleARN:   types.StringValue("arn:aws:iam::123456789012:role/test"),
+				Size:      types.Int64Value(42),
+				State:     types.StringValue("ACTIVE"),
+				SubnetIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("subnet-1")}),
+				Tags:      types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")}),
+				Timeout:   types.Int64Value(10),
+				Skipped:   types.StringValue("skipped"),
+			},
+			expected: testAPIObject{
+				Child: &testAPIChild{Name: aws.String("a"), Value: 1},
+				Children: []testAPIChild{
+					{Name: aws.String("b"), Value: 2},
+					{Value: 3},
+				},
+				CreatedAt:    &createdAt,
+				Enabled:      aws.Bool(true),
+				Ratio:        0.5,
+				RoleArn:      aws.String("arn:aws:iam::123456789012:role/test"),
+				Size:         aws.Int64(42),
+				State:        "ACTIVE",
+				SubnetIds:    []*string{aws.String("subnet-1")},
+				Tags:         map[string]string{"k": "v"},
+				TimeoutInMin: aws.Int32(10),
+			},
+		},
+		"null and unknown": {
+			input: testModel{
+				CreatedAt: types.StringNull(),
+				Enabled:   types.BoolUnknown(),
+				SubnetIDs: types.SetNull(types.StringType),
+				Tags:      types.MapUnknown(types.StringType),
+			},
+			expected: testAPIObject{},
+		},
+		"overflow": {
+			input: &testModel{
+				Timeout: types.Int64Value(1 << 40),
+			},
+			expectedError: true,
+		},
+		"invalid timestamp": {
+			input: &testModel{
+				CreatedAt: types.StringValue("yesterday"),
+			},
+			expectedError: true,
+		},
+		"not a struct": {
+			input:         "test",
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testAPIObject
+			diags := Expand(ctx, test.input, &got)
+
+			if got, want := diags.HasError(), test.expectedError; got != want {
+				t.Fatalf("got error %t, want %t: %v", got, want, diags)
+			}
+
+			if test.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(got, test.expected, cmp.AllowUnexported(testAPIObject{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	createdAt := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+
+	type testCase struct {
+		input    any
+		expected testModel
+	}
+	tests := map[string]testCase{
+		"all fields": {
+			input: &testAPIObject{
+				Child: &testAPIChild{Name: aws.String("a"), Value: 1},
+				Children: []testAPIChild{
+					{Name: aws.String("b"), Value: 2},
+				},
+				CreatedAt:    &createdAt,
+				Enabled:      aws.Bool(false),
+				Ratio:        0.5,
+				RoleArn:      aws.String("arn:aws:iam::123456789012:role/test"),
+				Size:         aws.Int64(42),
+				State:        "ACTIVE",
+				SubnetIds:    []*string{aws.String("subnet-1"), aws.String("subnet-2")},
+				Tags:         map[string]string{"k": "v"},
+				TimeoutInMin: aws.Int32(10),
+			},
+			expected: testModel{
+				Child: []testChildModel{
+					{Name: types.StringValue("a"), Value: types.Int64Value(1)},
+				},
+				Children: []testChildModel{
+					{Name: types.StringValue("b"), Value: types.Int64Value(2)},
+				},
+				CreatedAt: types.StringValue("2023-04-05T06:07:08Z"),
+				Enabled:   types.BoolValue(false),
+				Ratio:     types.Float64Value(0.5),
+				RoleARN:   types.StringValue("arn:aws:iam::123456789012:role/test"),
+				Size:      types.Int64Value(42),
+				State:     types.StringValue("ACTIVE"),
+				SubnetIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("subnet-1"), types.StringValue("subnet-2")}),
+				Tags:      types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")}),
+				Timeout:   types.Int64Value(10),
+			},
+		},
+		"zero values": {
+			input: testAPIObject{},
+			expected: testModel{
+				CreatedAt: types.StringNull(),
+				Enabled:   types.BoolNull(),
+				Ratio:     types.Float64Value(0),
+				RoleARN:   types.StringNull(),
+				Size:      types.Int64Null(),
+				State:     types.StringNull(),
+				SubnetIDs: types.SetNull(types.StringType),
+				Tags:      types.MapNull(types.StringType),
+				Timeout:   types.Int64Null(),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testModel
+			diags := Flatten(ctx, test.input, &got)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type testObjectsAPIObject struct {
+	Child    *testAPIChild
+	Filters  []testAPIChild
+	Members  []*testAPIChild
+	Settings *testAPIChild
+}
+
+type testObjectsModel struct {
+	Child    types.List   `tfsdk:"child"`
+	Filters  types.List   `tfsdk:"filters"`
+	Members  types.Set    `tfsdk:"members"`
+	Settings types.Object `tfsdk:"settings"`
+}
+
+var testChildAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"value": types.Int64Type,
+}
+
+func testChildObject(name attr.Value, value int64) attr.Value {
+	return types.ObjectValueMust(testChildAttrTypes, map[string]attr.Value{
+		"name":  name,
+		"value": types.Int64Value(value),
+	})
+}
+
+func TestObjectsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objectType := types.ObjectType{AttrTypes: testChildAttrTypes}
+	// Models flattened into hold typed values, as when read from plan or state.
+	nullModel := testObjectsModel{
+		Child:    types.ListNull(objectType),
+		Filters:  types.ListNull(objectType),
+		Members:  types.SetNull(objectType),
+		Settings: types.ObjectNull(testChildAttrTypes),
+	}
+
+	type testCase struct {
+		model    testObjectsModel
+		expected testObjectsAPIObject
+	}
+	tests := map[string]testCase{
+		"list and set of objects": {
+			model: testObjectsModel{
+				Child: types.ListValueMust(objectType, []attr.Value{
+					testChildObject(types.StringValue("a"), 1),
+				}),
+				Filters: types.ListValueMust(objectType, []attr.Value{
+					testChildObject(types.StringValue("b"), 2),
+					testChildObject(types.StringNull(), 3),
+				}),
+				Members: types.SetValueMust(objectType, []attr.Value{
+					testChildObject(types.StringValue("c"), 4),
+					testChildObject(types.StringValue("d"), 5),
+				}),
+				Settings: testChildObject(types.StringValue("e"), 6).(types.Object),
+			},
+			expected: testObjectsAPIObject{
+				Child: &testAPIChild{Name: aws.String("a"), Value: 1},
+				Filters: []testAPIChild{
+					{Name: aws.String("b"), Value: 2},
+					{Value: 3},
+				},
+				Members: []*testAPIChild{
+					{Name: aws.String("c"), Value: 4},
+					{Name: aws.String("d"), Value: 5},
+				},
+				Settings: &testAPIChild{Name: aws.String("e"), Value: 6},
+			},
+		},
+		"null": {
+			model:    nullModel,
+			expected: testObjectsAPIObject{},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var apiObject testObjectsAPIObject
+
+			if diags := Expand(ctx, test.model, &apiObject); diags.HasError() {
+				t.Fatalf("unexpected Expand error: %v", diags)
+			}
+
+			if diff := cmp.Diff(apiObject, test.expected); diff != "" {
+				t.Errorf("unexpected Expand diff (+wanted, -got): %s", diff)
+			}
+
+			got := nullModel
+
+			if diags := Flatten(ctx, apiObject, &got); diags.HasError() {
+				t.Fatalf("unexpected Flatten error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, test.model); diff != "" {
+				t.Errorf("unexpected Flatten diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenObjectsUnknownType(t *testing.T) {
+	t.Parallel()
+
+	var got testObjectsModel
+	apiObject := testObjectsAPIObject{
+		Filters: []testAPIChild{{Name: aws.String("a")}},
+	}
+
+	if diags := Flatten(context.Background(), apiObject, &got); !diags.HasError() {
+		t.Error("expected error flattening into an untyped list of objects")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -73,8 +74,14 @@ func (r *ResourceWithConfigure) FlattenTagsAll(ctx context.Context, apiTags tfta
 
 // SetTagsAll calculates the new value for the `tags_all` attribute.
 func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+	tagPolicyConfig := r.Meta().TagPolicyConfig
 
 	var planTags types.Map
 
@@ -93,6 +100,19 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 				"please de-duplicate and try again")
 		}
 
+		// Tags whose values are not yet known are not checked against the policy's allowed value patterns.
+		planTagData := make(tftags.KeyValueTags)
+		for k, v := range planTags.Elements() {
+			planTagData[k] = &tftags.TagData{}
+			if v, ok := v.(types.String); ok && !v.IsUnknown() {
+				planTagData[k].Value = aws.String(v.ValueString())
+			}
+		}
+
+		if err := tagPolicyConfig.ViolationsError(defaultTagsConfig.MergeTags(planTagData)); err != nil {
+			response.Diagnostics.AddError(err.Error(), "")
+		}
+
 		allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
@@ -116,6 +136,37 @@ func (d *DataSourceWithConfigure) Configure(_ context.Context, request datasourc
 	}
 }
 
+// WithTags is intended to be embedded in resources whose tags are managed by the provider.
+// Taggable resources also embed ResourceWithConfigure and their service package implements intf.ServicePackageWithTags.
+// The provider plans "tags_all", applies tags after Create, updates them before Update and sets
+// the "tags" and "tags_all" attributes from the resource's current tags after Read.
+// Resources whose Create API applies tags call SetTagsOnCreate and pass ExpandTags' result to the API.
+type WithTags struct {
+	tagsIdentifierAttribute string
+	tagsOnCreate            bool
+}
+
+// SetTagsIdentifierAttribute declares the resource taggable.
+// The named attribute's value, typically the ARN, identifies the resource when listing and updating tags.
+func (w *WithTags) SetTagsIdentifierAttribute(name string) {
+	w.tagsIdentifierAttribute = name
+}
+
+// TagsIdentifierAttribute returns the name of the attribute that identifies the resource when listing and updating tags.
+func (w *WithTags) TagsIdentifierAttribute() string {
+	return w.tagsIdentifierAttribute
+}
+
+// SetTagsOnCreate declares that the resource's Create applies the planned tags, so the provider does not apply them after Create.
+func (w *WithTags) SetTagsOnCreate() {
+	w.tagsOnCreate = true
+}
+
+// TagsOnCreate returns whether the resource's Create applies the planned tags.
+func (w *WithTags) TagsOnCreate() bool {
+	return w.tagsOnCreate
+}
+
 // WithTimeouts is intended to be embedded in resource which use the special "timeouts" nested block.
 // See https://developer.hashicorp.com/terraform/language/resources/syntax#operation-timeouts.
 type WithTimeouts struct {
@@ -142,6 +193,23 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 	w.defaultDeleteTimeout = timeout
 }
 
+// OverrideDefaultTimeouts replaces the resource's default timeout values with the specified non-zero values.
+// Only operations for which the resource declares a default timeout are changed.
+func (w *WithTimeouts) OverrideDefaultTimeouts(timeouts conns.ResourceTimeouts) {
+	if timeouts.Create > 0 && w.defaultCreateTimeout > 0 {
+		w.defaultCreateTimeout = timeouts.Create
+	}
+	if timeouts.Read > 0 && w.defaultReadTimeout > 0 {
+		w.defaultReadTimeout = timeouts.Read
+	}
+	if timeouts.Update > 0 && w.defaultUpdateTimeout > 0 {
+		w.defaultUpdateTimeout = timeouts.Update
+	}
+	if timeouts.Delete > 0 && w.defaultDeleteTimeout > 0 {
+		w.defaultDeleteTimeout = timeouts.Delete
+	}
+}
+
 // CreateTimeout returns any configured Create timeout value or the default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
 	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
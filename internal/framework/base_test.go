@@ -0,0 +1,120 @@
+package framework_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestSetTagsAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"tags":     tftypes.Map{ElementType: tftypes.String},
+			"tags_all": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	plan := func(tags map[string]string) tftypes.Value {
+		v := map[string]tftypes.Value{}
+		for k, tag := range tags {
+			v[k] = tftypes.NewValue(tftypes.String, tag)
+		}
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, v),
+			"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+		})
+	}
+
+	testCases := map[string]struct {
+		Plan          tftypes.Value
+		ExpectedError bool
+	}{
+		"destroy": {
+			Plan: tftypes.NewValue(objectType, nil),
+		},
+		"required key missing": {
+			Plan:          plan(map[string]string{"Name": "test"}),
+			ExpectedError: true,
+		},
+		"required key present": {
+			Plan: plan(map[string]string{"Owner": "test"}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var r framework.ResourceWithConfigure
+			r.Configure(ctx, resource.ConfigureRequest{
+				ProviderData: &conns.AWSClient{
+					TagPolicyConfig: &tftags.PolicyConfig{
+						RequiredKeys: tftags.New([]interface{}{"Owner"}),
+					},
+				},
+			}, &resource.ConfigureResponse{})
+
+			request := resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{Schema: s, Raw: testCase.Plan},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			r.SetTagsAll(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.ExpectedError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOverrideDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var w framework.WithTimeouts
+	w.SetDefaultCreateTimeout(30 * time.Minute)
+	w.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	w.OverrideDefaultTimeouts(conns.ResourceTimeouts{Create: 2 * time.Hour, Update: time.Hour})
+
+	for name, testCase := range map[string]struct {
+		Got, Expected time.Duration
+	}{
+		"create": {w.CreateTimeout(ctx, timeouts.Value{}), 2 * time.Hour},
+		"update": {w.UpdateTimeout(ctx, timeouts.Value{}), 0},
+		"delete": {w.DeleteTimeout(ctx, timeouts.Value{}), 10 * time.Minute},
+	} {
+		if testCase.Got != testCase.Expected {
+			t.Errorf("%s timeout = %s, want %s", name, testCase.Got, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+type normalizedJSONType uint8
+
+const (
+	NormalizedJSONType normalizedJSONType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate = NormalizedJSONType
+)
+
+func (t normalizedJSONType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t normalizedJSONType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsUnknown() {
+		return NormalizedJSONUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NormalizedJSONNull(), nil
+	}
+
+	return NormalizedJSONValue(in.ValueString()), nil
+}
+
+func (t normalizedJSONType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return NormalizedJSONUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NormalizedJSONNull(), nil
+	}
+
+	var s string
+	err := in.As(&s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NormalizedJSONValue(s), nil
+}
+
+func (t normalizedJSONType) ValueType(context.Context) attr.Value {
+	return NormalizedJSON{}
+}
+
+// Equal returns true if `o` is also a NormalizedJSONType.
+func (t normalizedJSONType) Equal(o attr.Type) bool {
+	_, ok := o.(normalizedJSONType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t normalizedJSONType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the NormalizedJSONType.
+func (t normalizedJSONType) String() string {
+	return "types.NormalizedJSONType"
+}
+
+// Validate implements type validation.
+func (t normalizedJSONType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"NormalizedJSON Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"NormalizedJSON Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	if _, err := structure.NormalizeJsonString(value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"NormalizedJSON Type Validation Error",
+			fmt.Sprintf("Value %q is not valid JSON: %s", value, err),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t normalizedJSONType) Description() string {
+	return `A JSON document.`
+}
+
+func NormalizedJSONNull() NormalizedJSON {
+	return NormalizedJSON{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NormalizedJSONUnknown() NormalizedJSON {
+	return NormalizedJSON{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NormalizedJSONValue(value string) NormalizedJSON {
+	return NormalizedJSON{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+var (
+	_ StringValuableWithSemanticEquals = NormalizedJSON{}
+)
+
+type NormalizedJSON struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns a NormalizedJSONType.
+func (j NormalizedJSON) Type(_ context.Context) attr.Type {
+	return NormalizedJSONType
+}
+
+func (j NormalizedJSON) ToStringValue(ctx context.Context) (types.String, diag.Diagnostics) {
+	switch j.state {
+	case attr.ValueStateNull:
+		return types.StringNull(), nil
+	case attr.ValueStateUnknown:
+		return types.StringUnknown(), nil
+	default:
+		return types.StringValue(j.value), nil
+	}
+}
+
+// ToTerraformValue returns the data contained in the NormalizedJSON as a string. If
+// Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
+// returns nil.
+func (j NormalizedJSON) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	t := NormalizedJSONType.TerraformType(ctx)
+
+	switch j.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(t, j.value); err != nil {
+			return tftypes.NewValue(t, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(t, j.value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(t, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+	default:
+		return tftypes.NewValue(t, tftypes.UnknownValue), fmt.Errorf("unhandled NormalizedJSON state in ToTerraformValue: %s", j.state)
+	}
+}
+
+// Equal returns true if `other` is a NormalizedJSON and has the same value as `j`.
+func (j NormalizedJSON) Equal(other attr.Value) bool {
+	o, ok := other.(NormalizedJSON)
+
+	if !ok {
+		return false
+	}
+
+	if j.state != o.state {
+		return false
+	}
+
+	if j.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return j.value == o.value
+}
+
+// StringSemanticEquals returns true if `other` is a known NormalizedJSON that is equivalent to `j`,
+// ignoring insignificant whitespace and the order of object keys.
+func (j NormalizedJSON) StringSemanticEquals(_ context.Context, other basetypes.StringValuable) (bool, diag.Diagnostics) {
+	o, ok := other.(NormalizedJSON)
+
+	if !ok || j.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return false, nil
+	}
+
+	return verify.JSONStringsEqual(j.value, o.value), nil
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (j NormalizedJSON) IsNull() bool {
+	return j.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (j NormalizedJSON) IsUnknown() bool {
+	return j.state == attr.ValueStateUnknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (j NormalizedJSON) String() string {
+	if j.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if j.IsNull() {
+		return attr.NullValueString
+	}
+
+	return j.value
+}
+
+// ValueString returns the known string value. If NormalizedJSON is null or unknown, returns "".
+func (j NormalizedJSON) ValueString() string {
+	return j.value
+}
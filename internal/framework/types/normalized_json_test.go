@@ -0,0 +1,150 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestNormalizedJSONTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.NormalizedJSONNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.NormalizedJSONUnknown(),
+		},
+		"valid JSON": {
+			val:      tftypes.NewValue(tftypes.String, `{"a": 1}`),
+			expected: fwtypes.NormalizedJSONValue(`{"a": 1}`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.NormalizedJSONType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedJSONTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"JSON object": {
+			val: tftypes.NewValue(tftypes.String, `{"a": [1, 2]}`),
+		},
+		"JSON array": {
+			val: tftypes.NewValue(tftypes.String, `[1, 2]`),
+		},
+		"invalid JSON": {
+			val:         tftypes.NewValue(tftypes.String, `{"a":`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.NormalizedJSONType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestNormalizedJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.NormalizedJSON
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			val1:   fwtypes.NormalizedJSONValue(`{"a":1,"b":[true]}`),
+			val2:   fwtypes.NormalizedJSONValue(`{"a":1,"b":[true]}`),
+			equals: true,
+		},
+		"reordered keys and whitespace": {
+			val1:   fwtypes.NormalizedJSONValue(`{"a":1,"b":[true]}`),
+			val2:   fwtypes.NormalizedJSONValue("{\n  \"b\": [true],\n  \"a\": 1\n}"),
+			equals: true,
+		},
+		"scalar is not single-element array": {
+			val1: fwtypes.NormalizedJSONValue(`{"a":1,"b":[true]}`),
+			val2: fwtypes.NormalizedJSONValue(`{"a":1,"b":true}`),
+		},
+		"unknown": {
+			val1: fwtypes.NormalizedJSONValue(`{}`),
+			val2: fwtypes.NormalizedJSONUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, diags := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if diags.HasError() {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+type policyDocumentType uint8
+
+const (
+	PolicyDocumentType policyDocumentType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate = PolicyDocumentType
+)
+
+func (t policyDocumentType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t policyDocumentType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsUnknown() {
+		return PolicyDocumentUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return PolicyDocumentNull(), nil
+	}
+
+	return PolicyDocumentValue(in.ValueString()), nil
+}
+
+func (t policyDocumentType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return PolicyDocumentUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return PolicyDocumentNull(), nil
+	}
+
+	var s string
+	err := in.As(&s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return PolicyDocumentValue(s), nil
+}
+
+func (t policyDocumentType) ValueType(context.Context) attr.Value {
+	return PolicyDocument{}
+}
+
+// Equal returns true if `o` is also a PolicyDocumentType.
+func (t policyDocumentType) Equal(o attr.Type) bool {
+	_, ok := o.(policyDocumentType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t policyDocumentType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the PolicyDocumentType.
+func (t policyDocumentType) String() string {
+	return "types.PolicyDocumentType"
+}
+
+// Validate implements type validation.
+func (t policyDocumentType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"PolicyDocument Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"PolicyDocument Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	if err := verify.ValidateIAMPolicyJSON(value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"PolicyDocument Type Validation Error",
+			fmt.Sprintf("Value %q %s", value, err),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t policyDocumentType) Description() string {
+	return `An IAM policy document in JSON format.`
+}
+
+func PolicyDocumentNull() PolicyDocument {
+	return PolicyDocument{
+		state: attr.ValueStateNull,
+	}
+}
+
+func PolicyDocumentUnknown() PolicyDocument {
+	return PolicyDocument{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func PolicyDocumentValue(value string) PolicyDocument {
+	return PolicyDocument{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+var (
+	_ StringValuableWithSemanticEquals = PolicyDocument{}
+)
+
+type PolicyDocument struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns a PolicyDocumentType.
+func (p PolicyDocument) Type(_ context.Context) attr.Type {
+	return PolicyDocumentType
+}
+
+func (p PolicyDocument) ToStringValue(ctx context.Context) (types.String, diag.Diagnostics) {
+	switch p.state {
+	case attr.ValueStateNull:
+		return types.StringNull(), nil
+	case attr.ValueStateUnknown:
+		return types.StringUnknown(), nil
+	default:
+		return types.StringValue(p.value), nil
+	}
+}
+
+// ToTerraformValue returns the data contained in the PolicyDocument as a string. If
+// Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
+// returns nil.
+func (p PolicyDocument) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	t := PolicyDocumentType.TerraformType(ctx)
+
+	switch p.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(t, p.value); err != nil {
+			return tftypes.NewValue(t, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(t, p.value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(t, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+	default:
+		return tftypes.NewValue(t, tftypes.UnknownValue), fmt.Errorf("unhandled PolicyDocument state in ToTerraformValue: %s", p.state)
+	}
+}
+
+// Equal returns true if `other` is a PolicyDocument and has the same value as `p`.
+func (p PolicyDocument) Equal(other attr.Value) bool {
+	o, ok := other.(PolicyDocument)
+
+	if !ok {
+		return false
+	}
+
+	if p.state != o.state {
+		return false
+	}
+
+	if p.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return p.value == o.value
+}
+
+// StringSemanticEquals returns true if `other` is a known PolicyDocument that is equivalent to `p`,
+// ignoring the order of statements and keys and whether single-element arrays are written as scalars.
+func (p PolicyDocument) StringSemanticEquals(_ context.Context, other basetypes.StringValuable) (bool, diag.Diagnostics) {
+	o, ok := other.(PolicyDocument)
+
+	if !ok || p.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return false, nil
+	}
+
+	return verify.PolicyStringsEquivalent(p.value, o.value), nil
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (p PolicyDocument) IsNull() bool {
+	return p.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (p PolicyDocument) IsUnknown() bool {
+	return p.state == attr.ValueStateUnknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (p PolicyDocument) String() string {
+	if p.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if p.IsNull() {
+		return attr.NullValueString
+	}
+
+	return p.value
+}
+
+// ValueString returns the known string value. If PolicyDocument is null or unknown, returns "".
+func (p PolicyDocument) ValueString() string {
+	return p.value
+}
@@ -0,0 +1,201 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+const (
+	testPolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["s3:GetObject"],
+    "Resource": "*"
+  }]
+}`
+	testPolicyDocumentReordered = `{"Statement":{"Resource":["*"],"Action":"s3:GetObject","Effect":"Allow"},"Version":"2012-10-17"}`
+	testPolicyDocumentDifferent = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+)
+
+func TestPolicyDocumentTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.PolicyDocumentNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.PolicyDocumentUnknown(),
+		},
+		"valid policy": {
+			val:      tftypes.NewValue(tftypes.String, testPolicyDocument),
+			expected: fwtypes.PolicyDocumentValue(testPolicyDocument),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.PolicyDocumentType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid policy": {
+			val: tftypes.NewValue(tftypes.String, testPolicyDocument),
+		},
+		"JSON array": {
+			val:         tftypes.NewValue(tftypes.String, `["s3:GetObject"]`),
+			expectError: true,
+		},
+		"invalid JSON": {
+			val:         tftypes.NewValue(tftypes.String, `{"Version":`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.PolicyDocumentType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.PolicyDocument
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			val1:   fwtypes.PolicyDocumentValue(testPolicyDocument),
+			val2:   fwtypes.PolicyDocumentValue(testPolicyDocument),
+			equals: true,
+		},
+		"reordered with scalars": {
+			val1:   fwtypes.PolicyDocumentValue(testPolicyDocument),
+			val2:   fwtypes.PolicyDocumentValue(testPolicyDocumentReordered),
+			equals: true,
+		},
+		"different": {
+			val1: fwtypes.PolicyDocumentValue(testPolicyDocument),
+			val2: fwtypes.PolicyDocumentValue(testPolicyDocumentDifferent),
+		},
+		"empty": {
+			val1:   fwtypes.PolicyDocumentValue(""),
+			val2:   fwtypes.PolicyDocumentValue("{}"),
+			equals: true,
+		},
+		"unknown": {
+			val1: fwtypes.PolicyDocumentValue(testPolicyDocument),
+			val2: fwtypes.PolicyDocumentUnknown(),
+		},
+		"null": {
+			val1: fwtypes.PolicyDocumentNull(),
+			val2: fwtypes.PolicyDocumentValue(testPolicyDocument),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, diags := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if diags.HasError() {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSemanticEqualOrNewValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prior := fwtypes.PolicyDocumentValue(testPolicyDocument)
+
+	got, diags := fwtypes.SemanticEqualOrNewValue(ctx, prior, fwtypes.PolicyDocumentValue(testPolicyDocumentReordered))
+
+	if diags.HasError() {
+		t.Fatalf("got unexpected error: %#v", diags)
+	}
+
+	if diff := cmp.Diff(got, prior); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	new := fwtypes.PolicyDocumentValue(testPolicyDocumentDifferent)
+	got, diags = fwtypes.SemanticEqualOrNewValue(ctx, prior, new)
+
+	if diags.HasError() {
+		t.Fatalf("got unexpected error: %#v", diags)
+	}
+
+	if diff := cmp.Diff(got, new); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// StringValuableWithSemanticEquals is implemented by String values that can be equivalent without being byte-for-byte equal.
+// Its method set matches that of basetypes.StringValuableWithSemanticEquals, introduced in Terraform Plugin Framework v1.3.0.
+type StringValuableWithSemanticEquals interface {
+	basetypes.StringValuable
+
+	StringSemanticEquals(context.Context, basetypes.StringValuable) (bool, diag.Diagnostics)
+}
+
+// SemanticEqualOrNewValue returns priorValue if newValue is semantically equal to it, otherwise newValue.
+// The Plugin Framework doesn't yet check semantic equality itself, so resources call this when setting
+// values read from AWS into state to avoid spurious diffs and inconsistent results after apply.
+func SemanticEqualOrNewValue[T StringValuableWithSemanticEquals](ctx context.Context, priorValue, newValue T) (T, diag.Diagnostics) {
+	equal, diags := priorValue.StringSemanticEquals(ctx, newValue)
+
+	if diags.HasError() || !equal {
+		return newValue, diags
+	}
+
+	return priorValue, diags
+}
@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ARN returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents an ARN with a valid partition, Region and account ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ARN() validator.String {
+	return stringValidator{
+		description: "value must be a valid ARN",
+		validate:    verify.ValidateARN,
+	}
+}
+
+// AccountID returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a 12-digit AWS account ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func AccountID() validator.String {
+	return stringValidator{
+		description: "value must be a valid AWS account ID",
+		validate:    verify.ValidateAccountID,
+	}
+}
+
+// RegionName returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a well-formed AWS Region name.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func RegionName() validator.String {
+	return stringValidator{
+		description: "value must be a valid AWS Region name",
+		validate:    verify.ValidateRegionName,
+	}
+}
@@ -0,0 +1,86 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestARNValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.ARN(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid ARN": {
+			val: types.StringValue("arn:aws:iam::123456789012:user/David"), // lintignore:AWSAT005
+		},
+		"valid ARN without account ID": {
+			val: types.StringValue("arn:aws:s3:::bucket/object"), // lintignore:AWSAT005
+		},
+		"not an ARN": {
+			val:         types.StringValue("123456789012"),
+			expectError: true,
+		},
+		"invalid partition": {
+			val:         types.StringValue("arn:ws:iam::123456789012:user/David"), // lintignore:AWSAT005
+			expectError: true,
+		},
+		"missing resource": {
+			val:         types.StringValue("arn:aws:iam::123456789012:"), // lintignore:AWSAT005
+			expectError: true,
+		},
+	})
+}
+
+func TestAccountIDValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.AccountID(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid account ID": {
+			val: types.StringValue("123456789012"),
+		},
+		"too short": {
+			val:         types.StringValue("12345678901"),
+			expectError: true,
+		},
+		"not digits": {
+			val:         types.StringValue("x123456789012"),
+			expectError: true,
+		},
+	})
+}
+
+func TestRegionNameValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.RegionName(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid Region": {
+			val: types.StringValue("us-west-2"), // lintignore:AWSAT003
+		},
+		"valid GovCloud Region": {
+			val: types.StringValue("us-gov-west-1"), // lintignore:AWSAT003
+		},
+		"invalid Region": {
+			val:         types.StringValue("uswest2"),
+			expectError: true,
+		},
+	})
+}
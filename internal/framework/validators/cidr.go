@@ -86,3 +86,29 @@ func (validator ipv6CIDRNetworkAddressValidator) ValidateString(ctx context.Cont
 func IPv6CIDRNetworkAddress() validator.String {
 	return ipv6CIDRNetworkAddressValidator{}
 }
+
+// CIDRNetworkAddress returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid IPv4 or IPv6 CIDR network address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func CIDRNetworkAddress() validator.String {
+	return stringValidator{
+		description: "value must be a valid CIDR that represents a network address",
+		validate:    verify.ValidateCIDRBlock,
+	}
+}
+
+// MulticastIPAddress returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a multicast IPv4 or IPv6 address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func MulticastIPAddress() validator.String {
+	return stringValidator{
+		description: "value must be a valid multicast IP address",
+		validate:    verify.ValidateMulticastIPAddress,
+	}
+}
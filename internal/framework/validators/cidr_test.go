@@ -119,3 +119,53 @@ func TestIPv6CIDRNetworkAddressValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestCIDRNetworkAddressValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.CIDRNetworkAddress(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid IPv4 CIDR": {
+			val: types.StringValue("10.2.2.0/24"),
+		},
+		"valid IPv6 CIDR": {
+			val: types.StringValue("2001:db8::/122"),
+		},
+		"host address": {
+			val:         types.StringValue("10.2.2.2/24"),
+			expectError: true,
+		},
+		"invalid String": {
+			val:         types.StringValue("test-value"),
+			expectError: true,
+		},
+	})
+}
+
+func TestMulticastIPAddressValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.MulticastIPAddress(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"IPv4 multicast": {
+			val: types.StringValue("224.0.0.1"),
+		},
+		"IPv6 multicast": {
+			val: types.StringValue("ff02::1"),
+		},
+		"unicast": {
+			val:         types.StringValue("10.0.0.1"),
+			expectError: true,
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package validators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// IAMPolicyJSON returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a JSON IAM policy document.
+//
+// A warning is returned for each action and condition key that is not in the bundled IAM catalog.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IAMPolicyJSON() validator.String {
+	return stringValidator{
+		description: "value must be a valid JSON IAM policy document",
+		validate:    verify.ValidateIAMPolicyJSON,
+		warn:        verify.IAMPolicyCatalogWarnings,
+	}
+}
@@ -0,0 +1,43 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestIAMPolicyJSONValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.IAMPolicyJSON(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"empty object": {
+			val: types.StringValue(`{}`),
+		},
+		"valid policy": {
+			val: types.StringValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`),
+		},
+		"unknown action": {
+			val:           types.StringValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}]}`),
+			expectWarning: true,
+		},
+		"empty String": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"leading whitespace": {
+			val:         types.StringValue(`  {"xyz": "foo"}`),
+			expectError: true,
+		},
+		"invalid JSON": {
+			val:         types.StringValue(`{"def":}`),
+			expectError: true,
+		},
+	})
+}
@@ -0,0 +1,24 @@
+package validators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// S3BucketName returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a DNS-compliant S3 bucket name.
+//
+// Legacy bucket names that are only valid in the us-east-1 Region are rejected,
+// as the Region isn't known when configuration is validated.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func S3BucketName() validator.String {
+	return stringValidator{
+		description: "value must be a valid S3 bucket name",
+		validate: func(s string) error {
+			return verify.ValidateS3BucketName(s, "")
+		},
+	}
+}
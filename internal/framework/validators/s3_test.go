@@ -0,0 +1,40 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestS3BucketNameValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.S3BucketName(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid name": {
+			val: types.StringValue("my-bucket.example"),
+		},
+		"too short": {
+			val:         types.StringValue("ab"),
+			expectError: true,
+		},
+		"uppercase": {
+			val:         types.StringValue("My-Bucket"),
+			expectError: true,
+		},
+		"IP address": {
+			val:         types.StringValue("192.168.5.4"),
+			expectError: true,
+		},
+		"consecutive periods": {
+			val:         types.StringValue("my..bucket"),
+			expectError: true,
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringValidator validates that a string Attribute's value passes a check shared with the Plugin SDK validators in the verify package.
+type stringValidator struct {
+	description string
+	validate    func(string) error
+	warn        func(string) []string // Optional.
+}
+
+// Description describes the validation in plain text formatting.
+func (validator stringValidator) Description(_ context.Context) string {
+	return validator.description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator stringValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator stringValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validator.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			err.Error(),
+		))
+
+		return
+	}
+
+	if validator.warn == nil {
+		return
+	}
+
+	for _, warning := range validator.warn(request.ConfigValue.ValueString()) {
+		response.Diagnostics.Append(diag.NewAttributeWarningDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			warning,
+		))
+	}
+}
@@ -0,0 +1,55 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type stringValidatorTestCase struct {
+	val           types.String
+	expectError   bool
+	expectWarning bool
+}
+
+func testStringValidator(t *testing.T, v validator.String, tests map[string]stringValidatorTestCase) {
+	t.Helper()
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributePath := path.Root("test").AtListIndex(0).AtName("value")
+			request := validator.StringRequest{
+				Path:           attributePath,
+				PathExpression: attributePath.Expression(),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			v.ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if got := response.Diagnostics.WarningsCount() > 0; got != test.expectWarning {
+				t.Fatalf("expected warning %t, got: %s", test.expectWarning, response.Diagnostics)
+			}
+
+			for _, d := range response.Diagnostics {
+				if d, ok := d.(diag.DiagnosticWithPath); !ok || !d.Path().Equal(attributePath) {
+					t.Errorf("expected diagnostic with path %s, got %#v", attributePath, d)
+				}
+			}
+		})
+	}
+}
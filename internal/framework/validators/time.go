@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// UTCTimestamp returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a timestamp in RFC3339 format.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func UTCTimestamp() validator.String {
+	return stringValidator{
+		description: "value must be a valid RFC3339 timestamp",
+		validate:    verify.ValidateUTCTimestamp,
+	}
+}
+
+// OnceADayWindowFormat returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a daily time window in "hh24:mi-hh24:mi" format.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func OnceADayWindowFormat() validator.String {
+	return stringValidator{
+		description: `value must be a valid daily time window in "hh24:mi-hh24:mi" format`,
+		validate:    verify.ValidateOnceADayWindowFormat,
+	}
+}
+
+// OnceAWeekWindowFormat returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a weekly time window in "ddd:hh24:mi-ddd:hh24:mi" format.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func OnceAWeekWindowFormat() validator.String {
+	return stringValidator{
+		description: `value must be a valid weekly time window in "ddd:hh24:mi-ddd:hh24:mi" format`,
+		validate:    verify.ValidateOnceAWeekWindowFormat,
+	}
+}
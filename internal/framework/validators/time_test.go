@@ -0,0 +1,82 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestUTCTimestampValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.UTCTimestamp(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid timestamp": {
+			val: types.StringValue("2023-03-04T12:00:00Z"),
+		},
+		"valid timestamp with offset": {
+			val: types.StringValue("2023-03-04T12:00:00+01:00"),
+		},
+		"date only": {
+			val:         types.StringValue("2023-03-04"),
+			expectError: true,
+		},
+	})
+}
+
+func TestOnceADayWindowFormatValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.OnceADayWindowFormat(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid window": {
+			val: types.StringValue("04:00-05:00"),
+		},
+		"invalid hour": {
+			val:         types.StringValue("24:00-25:00"),
+			expectError: true,
+		},
+		"weekly window": {
+			val:         types.StringValue("sun:04:00-sun:05:00"),
+			expectError: true,
+		},
+	})
+}
+
+func TestOnceAWeekWindowFormatValidator(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator(t, fwvalidators.OnceAWeekWindowFormat(), map[string]stringValidatorTestCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid window": {
+			val: types.StringValue("sun:04:00-sun:05:00"),
+		},
+		"mixed case": {
+			val: types.StringValue("Sun:04:00-Mon:05:00"),
+		},
+		"invalid day": {
+			val:         types.StringValue("san:04:00-san:05:00"),
+			expectError: true,
+		},
+		"daily window": {
+			val:         types.StringValue("04:00-05:00"),
+			expectError: true,
+		},
+	})
+}
@@ -0,0 +1,222 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+//go:embed file.tmpl
+var tmpl string
+
+// supplement is the hand-maintained part of the catalog, see supplement.json.
+type supplement struct {
+	// IAM service prefixes of AWS SDK service IDs whose signing name is not the IAM service prefix.
+	// An empty prefix excludes the service from the catalog.
+	ServiceIDs map[string]string `json:"service_ids"`
+	Services   map[string]struct {
+		// Whether the service's actions are derived from its API operations. Defaults to true.
+		DeriveActions *bool `json:"derive_actions"`
+		// Actions which don't correspond to an API operation.
+		Actions []string `json:"actions"`
+		// Service-specific condition keys. A key ending in ':' or '/' matches any key with that prefix.
+		ConditionKeys []string `json:"condition_keys"`
+	} `json:"services"`
+	GlobalConditionKeys []string `json:"global_condition_keys"`
+}
+
+type ServiceDatum struct {
+	Prefix        string
+	Actions       []string
+	ConditionKeys []string
+}
+
+type TemplateData struct {
+	SDKVersion          string
+	Services            []ServiceDatum
+	GlobalConditionKeys []string
+}
+
+func main() {
+	const (
+		filename       = `catalog_gen.go`
+		supplementFile = `../generate/iamcatalog/supplement.json`
+		sdkModule      = "github.com/aws/aws-sdk-go"
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/iamcatalog/%s", filename)
+
+	b, err := os.ReadFile(supplementFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", supplementFile, err)
+	}
+
+	var supp supplement
+
+	if err := json.Unmarshal(b, &supp); err != nil {
+		g.Fatalf("error parsing %s: %s", supplementFile, err)
+	}
+
+	sdkDir, sdkVersion, err := moduleDir(sdkModule)
+
+	if err != nil {
+		g.Fatalf("error locating %s: %s", sdkModule, err)
+	}
+
+	models, err := apiModels(filepath.Join(sdkDir, "models", "apis"))
+
+	if err != nil {
+		g.Fatalf("error reading AWS API models: %s", err)
+	}
+
+	actions := make(map[string]map[string]string) // IAM service prefix -> lowercase action -> action.
+
+	for serviceID, model := range models {
+		prefix, ok := supp.ServiceIDs[serviceID]
+
+		if !ok {
+			prefix = model.Metadata.SigningName
+		}
+		if !ok && prefix == "" {
+			prefix = model.Metadata.EndpointPrefix
+		}
+		if prefix == "" {
+			g.Infof("Skipping service %q: no IAM service prefix", serviceID)
+			continue
+		}
+
+		if v, ok := supp.Services[prefix]; ok && v.DeriveActions != nil && !*v.DeriveActions {
+			continue
+		}
+
+		for name := range model.Operations {
+			addAction(actions, prefix, name)
+		}
+	}
+
+	for prefix, v := range supp.Services {
+		for _, name := range v.Actions {
+			addAction(actions, prefix, name)
+		}
+	}
+
+	td := TemplateData{
+		SDKVersion:          sdkVersion,
+		GlobalConditionKeys: sorted(supp.GlobalConditionKeys),
+	}
+
+	for prefix, v := range actions {
+		s := ServiceDatum{
+			Prefix:        prefix,
+			ConditionKeys: sorted(supp.Services[prefix].ConditionKeys),
+		}
+
+		for _, name := range v {
+			s.Actions = append(s.Actions, name)
+		}
+
+		s.Actions = sorted(s.Actions)
+
+		td.Services = append(td.Services, s)
+	}
+
+	sort.Slice(td.Services, func(i, j int) bool {
+		return td.Services[i].Prefix < td.Services[j].Prefix
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("iamcatalog", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func addAction(actions map[string]map[string]string, prefix, name string) {
+	if _, ok := actions[prefix]; !ok {
+		actions[prefix] = make(map[string]string)
+	}
+
+	actions[prefix][strings.ToLower(name)] = name
+}
+
+func sorted(s []string) []string {
+	s = append([]string{}, s...)
+
+	sort.Slice(s, func(i, j int) bool {
+		return strings.ToLower(s[i]) < strings.ToLower(s[j])
+	})
+
+	return s
+}
+
+// moduleDir returns the directory and version of the specified module in the module cache.
+func moduleDir(path string) (string, string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}} {{.Version}}", path).Output()
+
+	if err != nil {
+		return "", "", err
+	}
+
+	dir, version, _ := strings.Cut(strings.TrimSpace(string(out)), " ")
+
+	return dir, version, nil
+}
+
+type apiModel struct {
+	Metadata struct {
+		APIVersion     string `json:"apiVersion"`
+		EndpointPrefix string `json:"endpointPrefix"`
+		ServiceID      string `json:"serviceId"`
+		SigningName    string `json:"signingName"`
+	} `json:"metadata"`
+	Operations map[string]struct{} `json:"operations"`
+}
+
+// apiModels returns the latest AWS API model of each service, keyed by service ID.
+func apiModels(dir string) (map[string]*apiModel, error) {
+	models := make(map[string]*apiModel)
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*", "*", "api-2.json"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filename := range filenames {
+		b, err := os.ReadFile(filename)
+
+		if err != nil {
+			return nil, err
+		}
+
+		model := &apiModel{}
+
+		if err := json.Unmarshal(b, model); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		if v, ok := models[model.Metadata.ServiceID]; ok && v.Metadata.APIVersion > model.Metadata.APIVersion {
+			continue
+		}
+
+		models[model.Metadata.ServiceID] = model
+	}
+
+	return models, nil
+}
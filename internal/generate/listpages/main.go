@@ -23,6 +23,9 @@ import (
 
 const (
 	defaultFilename = "list_pages_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
 )
 
 var (
@@ -32,6 +35,7 @@ var (
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
 	contextOnly     = flag.Bool("ContextOnly", false, "whether to only generate Context-aware functions")
+	sdkVersion      = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS SDK Go to use i.e. 1 or 2")
 )
 
 func usage() {
@@ -51,6 +55,10 @@ func main() {
 		log.Fatal("both InputPaginator and OutputPaginator must be specified if one is")
 	}
 
+	if *sdkVersion != sdkV1 && *sdkVersion != sdkV2 {
+		log.Fatalf("AWS SDK Go Version %d not supported", *sdkVersion)
+	}
+
 	if *inputPaginator == "" {
 		*inputPaginator = *paginator
 	}
@@ -72,7 +80,7 @@ func main() {
 	servicePackage := filepath.Base(wd)
 	log.SetPrefix(fmt.Sprintf("generate/listpage: %s: ", servicePackage))
 
-	awsService, err := names.AWSGoV1Package(servicePackage)
+	awsService, err := names.AWSGoPackage(servicePackage, *sdkVersion)
 
 	if err != nil {
 		log.Fatalf("encountered: %s", err)
@@ -82,29 +90,53 @@ func main() {
 	sort.Strings(functions)
 
 	g := Generator{
-		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
 		inputPaginator:  *inputPaginator,
 		outputPaginator: *outputPaginator,
 		contextOnly:     *contextOnly,
+		sdkVersion:      *sdkVersion,
+	}
+
+	var sourcePackage, awsUpper, header string
+
+	if *sdkVersion == sdkV1 {
+		g.tmpl = template.Must(template.New("function").Parse(functionTemplate))
+		header = headerTemplate
+		sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+		awsUpper, err = names.AWSGoV1ClientTypeName(servicePackage)
+	} else {
+		g.tmpl = template.Must(template.New("function").Parse(functionV2Template))
+		header = headerV2Template
+		sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", awsService)
+		awsUpper, err = names.ProviderNameUpper(servicePackage)
+	}
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
 	}
 
-	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
 	g.parsePackage(sourcePackage)
 
-	g.printHeader(HeaderInfo{
+	var funcSpecs []FuncSpec
+	needsIterator := false
+
+	for _, functionName := range functions {
+		funcSpec := g.newFuncSpec(functionName, awsUpper, *export)
+		funcSpecs = append(funcSpecs, funcSpec)
+
+		if funcSpec.SDKPaginator == "" {
+			needsIterator = true
+		}
+	}
+
+	g.printHeader(header, HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: servicePackage,
 		SourcePackage:      sourcePackage,
+		NeedsIterator:      needsIterator,
 	})
 
-	awsUpper, err := names.AWSGoV1ClientTypeName(servicePackage)
-
-	if err != nil {
-		log.Fatalf("encountered: %s", err)
-	}
-
-	for _, functionName := range functions {
-		g.generateFunction(functionName, awsUpper, *export)
+	for _, funcSpec := range funcSpecs {
+		g.generateFunction(funcSpec)
 	}
 
 	src := g.format()
@@ -119,6 +151,7 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	NeedsIterator      bool // AWS SDK for Go v2 only
 }
 
 type Generator struct {
@@ -128,6 +161,7 @@ type Generator struct {
 	inputPaginator  string
 	outputPaginator string
 	contextOnly     bool
+	sdkVersion      int
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -143,7 +177,7 @@ type Package struct {
 	files []*PackageFile
 }
 
-func (g *Generator) printHeader(headerInfo HeaderInfo) {
+func (g *Generator) printHeader(headerTemplate string, headerInfo HeaderInfo) {
 	header := template.Must(template.New("header").Parse(headerTemplate))
 	err := header.Execute(&g.buf, headerInfo)
 	if err != nil {
@@ -187,26 +221,17 @@ type FuncSpec struct {
 	InputPaginator  string
 	OutputPaginator string
 	ContextOnly     bool
-}
 
-func (g *Generator) generateFunction(functionName, awsService string, export bool) {
-	var function *ast.FuncDecl
+	// The following are specific to the AWS SDK for Go v2.
+	OptionsType   string // Client options type, e.g. "route53domains.Options"
+	ParamStruct   string // Input type without pointer, e.g. "route53domains.ListDomainsInput"
+	PaginatorFunc string // Constructor for the generated paginator
+	PaginatorType string // Type of the generated paginator
+	SDKPaginator  string // Constructor for the SDK paginator, empty if the SDK does not define one
+}
 
-	for _, file := range g.pkg.files {
-		if file.file != nil {
-			for _, decl := range file.file.Decls {
-				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
-					if funcDecl.Name.Name == functionName {
-						function = funcDecl
-						break
-					}
-				}
-			}
-			if function != nil {
-				break
-			}
-		}
-	}
+func (g *Generator) newFuncSpec(functionName, awsService string, export bool) FuncSpec {
+	function := g.findFunction(functionName)
 
 	if function == nil {
 		log.Fatalf("function \"%s\" not found", functionName)
@@ -222,26 +247,65 @@ func (g *Generator) generateFunction(functionName, awsService string, export boo
 		Name:            fixUpFuncName(funcName, awsService),
 		AWSName:         function.Name.Name,
 		RecvType:        g.expandTypeField(function.Recv),
-		ParamType:       g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
+		ParamType:       g.expandTypeField(function.Type.Params),  // Assumes there is a single pointer input parameter
 		ResultType:      g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
 		InputPaginator:  g.inputPaginator,
 		OutputPaginator: g.outputPaginator,
 		ContextOnly:     g.contextOnly,
 	}
 
+	if g.sdkVersion == sdkV2 {
+		funcSpec.OptionsType = fmt.Sprintf("%s.Options", g.pkg.name)
+		funcSpec.ParamStruct = strings.TrimPrefix(funcSpec.ParamType, "*")
+
+		if sdkPaginator := fmt.Sprintf("New%sPaginator", functionName); g.findFunction(sdkPaginator) != nil {
+			funcSpec.SDKPaginator = fmt.Sprintf("%s.%s", g.pkg.name, sdkPaginator)
+		} else {
+			funcSpec.PaginatorType = fmt.Sprintf("%sPaginator", funcSpec.Name)
+			if export {
+				funcSpec.PaginatorFunc = fmt.Sprintf("New%sPaginator", funcSpec.Name)
+			} else {
+				funcSpec.PaginatorFunc = fmt.Sprintf("new%s%sPaginator", strings.ToUpper(funcSpec.Name[0:1]), funcSpec.Name[1:])
+			}
+		}
+	}
+
+	return funcSpec
+}
+
+func (g *Generator) generateFunction(funcSpec FuncSpec) {
 	err := g.tmpl.Execute(&g.buf, funcSpec)
 	if err != nil {
-		log.Fatalf("error writing function \"%s\": %s", functionName, err)
+		log.Fatalf("error writing function \"%s\": %s", funcSpec.AWSName, err)
+	}
+}
+
+func (g *Generator) findFunction(functionName string) *ast.FuncDecl {
+	for _, file := range g.pkg.files {
+		if file.file != nil {
+			for _, decl := range file.file.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+					if funcDecl.Name.Name == functionName {
+						return funcDecl
+					}
+				}
+			}
+		}
 	}
+
+	return nil
 }
 
+// expandTypeField returns the type of the first pointer-typed field.
+// AWS SDK for Go v2 operations take a leading context.Context parameter.
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
-	typeValue := field.List[0].Type
-	if star, ok := typeValue.(*ast.StarExpr); ok {
-		return fmt.Sprintf("*%s", g.expandTypeExpr(star.X))
+	for _, v := range field.List {
+		if star, ok := v.Type.(*ast.StarExpr); ok {
+			return fmt.Sprintf("*%s", g.expandTypeExpr(star.X))
+		}
 	}
 
-	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", typeValue)
+	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", field.List[0].Type)
 	return ""
 }
 
@@ -264,6 +328,12 @@ var headerTemplate string
 //go:embed function.tmpl
 var functionTemplate string
 
+//go:embed header_v2.tmpl
+var headerV2Template string
+
+//go:embed function_v2.tmpl
+var functionV2Template string
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
@@ -0,0 +1,178 @@
+// Package coverage derives a service's resource types from its AWS API model
+// and reports which of them the provider supports.
+package coverage
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Verbs of AWS API operations which create (or put) a resource and which make the operation's noun a resource type.
+var resourceVerbs = []string{"Create", "Put", "Register"}
+
+// Verbs of AWS API operations which are grouped by noun as evidence for a resource type.
+var verbs = []string{"Create", "Delete", "Deregister", "Describe", "Get", "List", "Modify", "Put", "Register", "Update"}
+
+type ResourceTypeCoverage struct {
+	Name        string   `json:"name"`
+	Operations  []string `json:"operations"`
+	Resources   []string `json:"resources,omitempty"`
+	DataSources []string `json:"data_sources,omitempty"`
+}
+
+func (r ResourceTypeCoverage) Supported() bool {
+	return len(r.Resources) > 0
+}
+
+type ServiceCoverage struct {
+	ProviderPackage string                 `json:"provider_package"`
+	HumanFriendly   string                 `json:"human_friendly"`
+	SDKServiceID    string                 `json:"sdk_service_id"`
+	APIVersion      string                 `json:"api_version"`
+	Operations      int                    `json:"operations"`
+	Resources       []string               `json:"resources"`
+	DataSources     []string               `json:"data_sources"`
+	ResourceTypes   []ResourceTypeCoverage `json:"resource_types"`
+}
+
+func (s ServiceCoverage) SupportedResourceTypes() int {
+	n := 0
+	for _, v := range s.ResourceTypes {
+		if v.Supported() {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s ServiceCoverage) UnsupportedResourceTypes() []ResourceTypeCoverage {
+	var unsupported []ResourceTypeCoverage
+	for _, v := range s.ResourceTypes {
+		if !v.Supported() {
+			unsupported = append(unsupported, v)
+		}
+	}
+
+	return unsupported
+}
+
+// Coverage returns the percentage of the service's resource types that are supported, rounded to the nearest whole number.
+func (s ServiceCoverage) Coverage() string {
+	if len(s.ResourceTypes) == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.0f%%", math.Round(100*float64(s.SupportedResourceTypes())/float64(len(s.ResourceTypes))))
+}
+
+// APIModel is the part of an AWS API model (api-2.json) used to derive the service's resource types.
+type APIModel struct {
+	Metadata struct {
+		APIVersion string `json:"apiVersion"`
+		ServiceID  string `json:"serviceId"`
+	} `json:"metadata"`
+	Operations map[string]struct {
+		Deprecated bool `json:"deprecated"`
+	} `json:"operations"`
+}
+
+// ResourceTypes returns the number of operations and the resource types of the service,
+// i.e. the nouns of its resource-creating operations, and which resources and data sources support them.
+func (m *APIModel) ResourceTypes(resources, dataSources []string) (int, []ResourceTypeCoverage) {
+	operations := make(map[string][]string)
+	creatable := make(map[string]bool)
+	count := 0
+
+	for name, op := range m.Operations {
+		if op.Deprecated {
+			continue
+		}
+
+		count++
+
+		verb, noun := splitOperation(name)
+
+		if noun == "" {
+			continue
+		}
+
+		operations[noun] = append(operations[noun], name)
+
+		for _, v := range resourceVerbs {
+			if verb == v {
+				creatable[noun] = true
+			}
+		}
+	}
+
+	resourceTypes := make([]ResourceTypeCoverage, 0, len(creatable))
+
+	for noun := range creatable {
+		ops := operations[noun]
+		sort.Strings(ops)
+
+		resourceTypes = append(resourceTypes, ResourceTypeCoverage{
+			Name:        noun,
+			Operations:  ops,
+			Resources:   matching(resources, noun),
+			DataSources: matching(dataSources, noun),
+		})
+	}
+
+	sort.Slice(resourceTypes, func(i, j int) bool {
+		return resourceTypes[i].Name < resourceTypes[j].Name
+	})
+
+	return count, resourceTypes
+}
+
+// splitOperation splits an operation name into its verb and singular noun, e.g. "ListScheduleGroups" into "List" and "ScheduleGroup".
+func splitOperation(name string) (string, string) {
+	for _, verb := range verbs {
+		noun := strings.TrimPrefix(name, verb)
+
+		if noun == name || noun == "" {
+			continue
+		}
+
+		if verb == "List" || verb == "Describe" {
+			noun = singular(noun)
+		}
+
+		return verb, noun
+	}
+
+	return "", ""
+}
+
+func singular(noun string) string {
+	switch {
+	case strings.HasSuffix(noun, "ies"):
+		return strings.TrimSuffix(noun, "ies") + "y"
+	case strings.HasSuffix(noun, "sses"), strings.HasSuffix(noun, "xes"):
+		return strings.TrimSuffix(noun, "es")
+	case strings.HasSuffix(noun, "s") && !strings.HasSuffix(noun, "ss"):
+		return strings.TrimSuffix(noun, "s")
+	}
+
+	return noun
+}
+
+// matching returns the Terraform type names which end with the specified noun, ignoring case and underscores.
+// E.g. "aws_scheduler_schedule_group" matches "ScheduleGroup".
+func matching(typeNames []string, noun string) []string {
+	var matches []string
+
+	noun = strings.ToLower(noun)
+
+	for _, typeName := range typeNames {
+		if strings.HasSuffix(strings.ReplaceAll(typeName, "_", ""), noun) {
+			matches = append(matches, typeName)
+		}
+	}
+
+	return matches
+}
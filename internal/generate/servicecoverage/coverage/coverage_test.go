@@ -0,0 +1,128 @@
+package coverage_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/servicecoverage/coverage"
+)
+
+func TestServiceCoverageCoverage(t *testing.T) {
+	t.Parallel()
+
+	supported := coverage.ResourceTypeCoverage{Resources: []string{"aws_example_thing"}}
+	unsupported := coverage.ResourceTypeCoverage{}
+
+	testCases := map[string]struct {
+		resourceTypes []coverage.ResourceTypeCoverage
+		want          string
+	}{
+		"no resource types": {
+			want: "-",
+		},
+		"none supported": {
+			resourceTypes: []coverage.ResourceTypeCoverage{unsupported, unsupported},
+			want:          "0%",
+		},
+		"all supported": {
+			resourceTypes: []coverage.ResourceTypeCoverage{supported, supported},
+			want:          "100%",
+		},
+		"rounded down": {
+			resourceTypes: []coverage.ResourceTypeCoverage{supported, unsupported, unsupported},
+			want:          "33%",
+		},
+		"rounded up": {
+			resourceTypes: []coverage.ResourceTypeCoverage{supported, supported, unsupported},
+			want:          "67%",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := coverage.ServiceCoverage{ResourceTypes: testCase.resourceTypes}
+
+			if got := s.Coverage(); got != testCase.want {
+				t.Errorf("Coverage() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAPIModelResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operations    []string
+		deprecated    []string
+		resources     []string
+		dataSources   []string
+		wantCount     int
+		wantSupported map[string]bool
+	}{
+		"supported by resource": {
+			operations:    []string{"CreateScheduleGroup", "DeleteScheduleGroup", "ListScheduleGroups"},
+			resources:     []string{"aws_scheduler_schedule_group"},
+			wantCount:     3,
+			wantSupported: map[string]bool{"ScheduleGroup": true},
+		},
+		"data source only": {
+			operations:    []string{"CreateWidget", "GetWidget"},
+			dataSources:   []string{"aws_example_widget"},
+			wantCount:     2,
+			wantSupported: map[string]bool{"Widget": false},
+		},
+		"not creatable": {
+			operations:    []string{"DescribeAccountAttributes", "GetAccountSummary"},
+			wantCount:     2,
+			wantSupported: map[string]bool{},
+		},
+		"deprecated operations ignored": {
+			operations:    []string{"PutThing", "RegisterGadget"},
+			deprecated:    []string{"RegisterGadget"},
+			resources:     []string{"aws_example_thing"},
+			wantCount:     1,
+			wantSupported: map[string]bool{"Thing": true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &coverage.APIModel{}
+			m.Operations = make(map[string]struct {
+				Deprecated bool `json:"deprecated"`
+			})
+			for _, v := range testCase.operations {
+				m.Operations[v] = struct {
+					Deprecated bool `json:"deprecated"`
+				}{}
+			}
+			for _, v := range testCase.deprecated {
+				m.Operations[v] = struct {
+					Deprecated bool `json:"deprecated"`
+				}{Deprecated: true}
+			}
+
+			count, resourceTypes := m.ResourceTypes(testCase.resources, testCase.dataSources)
+
+			if count != testCase.wantCount {
+				t.Errorf("operations = %d, want %d", count, testCase.wantCount)
+			}
+
+			got := make(map[string]bool)
+			for _, v := range resourceTypes {
+				got[v.Name] = v.Supported()
+			}
+
+			if !reflect.DeepEqual(got, testCase.wantSupported) {
+				t.Errorf("resource types = %v, want %v", got, testCase.wantSupported)
+			}
+		})
+	}
+}
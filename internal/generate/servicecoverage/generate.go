@@ -0,0 +1,4 @@
+//go:generate go run main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package servicecoverage
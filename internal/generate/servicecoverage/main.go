@@ -0,0 +1,466 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/servicecoverage/coverage"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+//go:embed file.tmpl
+var tmpl string
+
+type TemplateData struct {
+	SDKVersion string
+	Services   []coverage.ServiceCoverage
+}
+
+func main() {
+	const (
+		markdownFile  = "../../../names/coverage.md"
+		jsonFile      = "../../../names/coverage.json"
+		namesDataFile = "../../../names/names_data.csv"
+		providerFile  = "../../provider/provider.go"
+		sdkModule     = "github.com/aws/aws-sdk-go"
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s and %s", strings.TrimPrefix(markdownFile, "../../../"), strings.TrimPrefix(jsonFile, "../../../"))
+
+	data, err := common.ReadAllCSVData(namesDataFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", namesDataFile, err)
+	}
+
+	sdkDir, sdkVersion, err := moduleDir(sdkModule)
+
+	if err != nil {
+		g.Fatalf("error locating %s: %s", sdkModule, err)
+	}
+
+	models, err := apiModels(filepath.Join(sdkDir, "models", "apis"))
+
+	if err != nil {
+		g.Fatalf("error reading AWS API models: %s", err)
+	}
+
+	registrations, err := providerRegistrations(providerFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", providerFile, err)
+	}
+
+	td := TemplateData{
+		SDKVersion: sdkVersion,
+	}
+
+	for i, l := range data {
+		if i < 1 { // no header
+			continue
+		}
+
+		if l[names.ColExclude] != "" {
+			continue
+		}
+
+		if l[names.ColProviderPackageActual] == "" && l[names.ColProviderPackageCorrect] == "" {
+			continue
+		}
+
+		p := l[names.ColProviderPackageCorrect]
+
+		if l[names.ColProviderPackageActual] != "" {
+			p = l[names.ColProviderPackageActual]
+		}
+
+		if l[names.ColGoV1Package] == "" {
+			g.Infof("Skipping service %q: no AWS SDK for Go v1 package", p)
+			continue
+		}
+
+		serviceID, err := sdkServiceID(filepath.Join(sdkDir, "service", l[names.ColGoV1Package], "service.go"))
+
+		if err != nil {
+			g.Fatalf("error reading %s service ID: %s", l[names.ColGoV1Package], err)
+		}
+
+		model, ok := models[serviceID]
+
+		if !ok {
+			g.Infof("Skipping service %q: no AWS API model for %q", p, serviceID)
+			continue
+		}
+
+		s := coverage.ServiceCoverage{
+			ProviderPackage: p,
+			HumanFriendly:   l[names.ColHumanFriendly],
+			SDKServiceID:    serviceID,
+			APIVersion:      model.Metadata.APIVersion,
+		}
+
+		r := registrations[p]
+
+		if dir := fmt.Sprintf("../../service/%s", p); isDir(dir) {
+			if err := r.addServicePackage(dir); err != nil {
+				g.Fatalf("error reading %s: %s", dir, err)
+			}
+		}
+
+		s.Resources, s.DataSources = append([]string{}, r.resources...), append([]string{}, r.dataSources...)
+		sort.Strings(s.Resources)
+		sort.Strings(s.DataSources)
+
+		s.Operations, s.ResourceTypes = model.ResourceTypes(s.Resources, s.DataSources)
+
+		td.Services = append(td.Services, s)
+
+		registrations[p] = r
+	}
+
+	sort.SliceStable(td.Services, func(i, j int) bool {
+		return td.Services[i].ProviderPackage < td.Services[j].ProviderPackage
+	})
+
+	d := g.NewUnformattedFileDestination(markdownFile)
+
+	if err := d.WriteTemplate("servicecoverage", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", markdownFile, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", markdownFile, err)
+	}
+
+	body, err := json.MarshalIndent(td.Services, "", "  ")
+
+	if err != nil {
+		g.Fatalf("generating file (%s): %s", jsonFile, err)
+	}
+
+	d = g.NewUnformattedFileDestination(jsonFile)
+
+	if err := d.WriteBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", jsonFile, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", jsonFile, err)
+	}
+}
+
+// moduleDir returns the directory and version of the specified module in the module cache.
+func moduleDir(path string) (string, string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}} {{.Version}}", path).Output()
+
+	if err != nil {
+		return "", "", err
+	}
+
+	dir, version, _ := strings.Cut(strings.TrimSpace(string(out)), " ")
+
+	return dir, version, nil
+}
+
+func isDir(path string) bool {
+	fi, err := os.Stat(path)
+
+	return err == nil && fi.IsDir()
+}
+
+// apiModels returns the latest AWS API model of each service, keyed by service ID.
+func apiModels(dir string) (map[string]*coverage.APIModel, error) {
+	models := make(map[string]*coverage.APIModel)
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*", "*", "api-2.json"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filename := range filenames {
+		b, err := os.ReadFile(filename)
+
+		if err != nil {
+			return nil, err
+		}
+
+		model := &coverage.APIModel{}
+
+		if err := json.Unmarshal(b, model); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		if v, ok := models[model.Metadata.ServiceID]; ok && v.Metadata.APIVersion > model.Metadata.APIVersion {
+			continue
+		}
+
+		models[model.Metadata.ServiceID] = model
+	}
+
+	return models, nil
+}
+
+// sdkServiceID returns the service ID declared in the specified AWS SDK for Go v1 service.go file.
+func sdkServiceID(filename string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+
+	if err != nil {
+		return "", err
+	}
+
+	if obj := file.Scope.Lookup("ServiceID"); obj != nil {
+		if spec, ok := obj.Decl.(*ast.ValueSpec); ok && len(spec.Values) > 0 {
+			if lit, ok := spec.Values[0].(*ast.BasicLit); ok {
+				return strconv.Unquote(lit.Value)
+			}
+		}
+	}
+
+	return "", fmt.Errorf("ServiceID not found in %s", filename)
+}
+
+type registration struct {
+	resources   []string
+	dataSources []string
+}
+
+// providerRegistrations returns the resources and data sources in the provider's DataSourcesMap and ResourcesMap,
+// keyed by service package.
+func providerRegistrations(filename string) (map[string]registration, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Service package name by import name.
+	packages := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+
+		if !strings.Contains(path, "/internal/service/") {
+			continue
+		}
+
+		p := filepath.Base(path)
+		name := p
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		packages[name] = p
+	}
+
+	registrations := make(map[string]registration)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+
+		if !ok {
+			return true
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+
+		if !ok || (key.Name != "DataSourcesMap" && key.Name != "ResourcesMap") {
+			return true
+		}
+
+		lit, ok := kv.Value.(*ast.CompositeLit)
+
+		if !ok {
+			return true
+		}
+
+		for _, elt := range lit.Elts {
+			elt, ok := elt.(*ast.KeyValueExpr)
+
+			if !ok {
+				continue
+			}
+
+			typeName, ok := stringLit(elt.Key)
+
+			if !ok {
+				continue
+			}
+
+			call, ok := elt.Value.(*ast.CallExpr)
+
+			if !ok {
+				continue
+			}
+
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+
+			if !ok {
+				continue
+			}
+
+			x, ok := sel.X.(*ast.Ident)
+
+			if !ok {
+				continue
+			}
+
+			p, ok := packages[x.Name]
+
+			if !ok {
+				continue
+			}
+
+			r := registrations[p]
+			if key.Name == "DataSourcesMap" {
+				r.dataSources = append(r.dataSources, typeName)
+			} else {
+				r.resources = append(r.resources, typeName)
+			}
+			registrations[p] = r
+		}
+
+		return false
+	})
+
+	return registrations, nil
+}
+
+// addServicePackage adds the resources and data sources which the service package registers itself,
+// either by calling a register factory function or, for Plugin Framework resources and data sources,
+// by setting the type name in their Metadata method.
+func (r *registration) addServicePackage(dir string) error {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Body == nil {
+						continue
+					}
+
+					if decl.Name.Name == "Metadata" && decl.Recv != nil {
+						r.addMetadata(decl)
+
+						continue
+					}
+
+					ast.Inspect(decl.Body, func(n ast.Node) bool {
+						call, ok := n.(*ast.CallExpr)
+
+						if !ok || len(call.Args) == 0 {
+							return true
+						}
+
+						sel, ok := call.Fun.(*ast.SelectorExpr)
+
+						if !ok {
+							return true
+						}
+
+						typeName, ok := stringLit(call.Args[0])
+
+						if !ok {
+							return true
+						}
+
+						switch sel.Sel.Name {
+						case "registerSDKDataSourceFactory":
+							r.dataSources = append(r.dataSources, typeName)
+						case "registerSDKResourceFactory":
+							r.resources = append(r.resources, typeName)
+						}
+
+						return true
+					})
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// addMetadata adds the type name set by a Plugin Framework resource's or data source's Metadata method.
+func (r *registration) addMetadata(decl *ast.FuncDecl) {
+	params := decl.Type.Params.List
+
+	if len(params) < 2 {
+		return
+	}
+
+	sel, ok := params[1].Type.(*ast.SelectorExpr)
+
+	if !ok {
+		return
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+
+	if !ok {
+		return
+	}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		if lhs, ok := assign.Lhs[0].(*ast.SelectorExpr); !ok || lhs.Sel.Name != "TypeName" {
+			return true
+		}
+
+		typeName, ok := stringLit(assign.Rhs[0])
+
+		if !ok {
+			return true
+		}
+
+		switch pkg.Name {
+		case "datasource":
+			r.dataSources = append(r.dataSources, typeName)
+		case "resource":
+			r.resources = append(r.resources, typeName)
+		}
+
+		return false
+	})
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	v, err := strconv.Unquote(lit.Value)
+
+	return v, err == nil
+}
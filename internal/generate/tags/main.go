@@ -26,6 +26,7 @@ var (
 	listTags           = flag.Bool("ListTags", false, "whether to generate ListTags")
 	serviceTagsMap     = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
 	serviceTagsSlice   = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
+	spTags             = flag.Bool("ServicePackageTags", false, "whether to generate service package ListTags and UpdateTags methods")
 	untagInNeedTagType = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
 	updateTags         = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
 	contextOnly        = flag.Bool("ContextOnly", false, "whether to only generate Context-aware functions")
@@ -73,12 +74,13 @@ func usage() {
 }
 
 type TemplateBody struct {
-	getTag           string
-	header           string
-	listTags         string
-	serviceTagsMap   string
-	serviceTagsSlice string
-	updateTags       string
+	getTag             string
+	header             string
+	listTags           string
+	serviceTagsMap     string
+	serviceTagsSlice   string
+	updateTags         string
+	servicePackageTags string
 }
 
 func newTemplateBody(version int, kvtValues bool) *TemplateBody {
@@ -91,6 +93,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			"\n" + v1.ServiceTagsMapBody,
 			"\n" + v1.ServiceTagsSliceBody,
 			"\n" + v1.UpdateTagsBody,
+			"\n" + v1.ServicePackageTagsBody,
 		}
 	case sdkV2:
 		if kvtValues {
@@ -101,6 +104,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 				"\n" + v2.ServiceTagsValueMapBody,
 				"\n" + v2.ServiceTagsSliceBody,
 				"\n" + v2.UpdateTagsBody,
+				"\n" + v2.ServicePackageTagsBody,
 			}
 		}
 		return &TemplateBody{
@@ -110,6 +114,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			"\n" + v2.ServiceTagsMapBody,
 			"\n" + v2.ServiceTagsSliceBody,
 			"\n" + v2.UpdateTagsBody,
+			"\n" + v2.ServicePackageTagsBody,
 		}
 	default:
 		return nil
@@ -120,6 +125,7 @@ type TemplateData struct {
 	AWSService             string
 	AWSServiceIfacePackage string
 	ClientType             string
+	ProviderNameUpper      string
 	ServicePackage         string
 
 	GetTagFunc              string
@@ -158,6 +164,7 @@ type TemplateData struct {
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
+	ConnsPkg        bool
 	ContextPkg      bool
 	FmtPkg          bool
 	HelperSchemaPkg bool
@@ -206,6 +213,16 @@ func main() {
 		clientType = fmt.Sprintf("*%s.%s", awsPkg, clientTypeName)
 	}
 
+	if *spTags && (!*listTags || !*updateTags || *tagResTypeElem != "" || *tagTypeAddBoolElem != "") {
+		g.Fatalf("ServicePackageTags requires ListTags and UpdateTags without TagResTypeElem or TagTypeAddBoolElem")
+	}
+
+	providerNameUpper, err := names.ProviderNameUpper(servicePackage)
+
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
 	tagPackage := awsPkg
 
 	if tagPackage == "wafregional" {
@@ -219,8 +236,10 @@ func main() {
 		AWSService:             awsPkg,
 		AWSServiceIfacePackage: awsIntfPkg,
 		ClientType:             clientType,
+		ProviderNameUpper:      providerNameUpper,
 		ServicePackage:         servicePackage,
 
+		ConnsPkg:        *spTags,
 		ContextPkg:      *sdkVersion == sdkV2 || (*getTag || *listTags || *updateTags),
 		FmtPkg:          *updateTags,
 		HelperSchemaPkg: awsPkg == "autoscaling",
@@ -308,6 +327,12 @@ func main() {
 		}
 	}
 
+	if *spTags {
+		if err := d.WriteTemplate("servicepackagetags", templateBody.servicePackageTags, templateData); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
 	if err := d.Write(); err != nil {
 		g.Fatalf("generating file (%s): %s", filename, err)
 	}
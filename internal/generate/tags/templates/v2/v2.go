@@ -27,3 +27,6 @@ var ServiceTagsSliceBody string
 
 //go:embed update_tags_body.tmpl
 var UpdateTagsBody string
+
+//go:embed service_package_tags_body.tmpl
+var ServicePackageTagsBody string
@@ -0,0 +1,275 @@
+// Package iamcatalog checks the actions and condition keys of IAM policy documents against a bundled catalog
+// of IAM service prefixes, actions and condition keys.
+//
+// The catalog is generated, see internal/generate/iamcatalog.
+package iamcatalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type service struct {
+	actions       []string
+	conditionKeys []string
+}
+
+type catalogIndex struct {
+	actions       map[string]map[string]struct{} // Lowercase IAM service prefix -> lowercase action.
+	conditionKeys map[string][]string            // Lowercase IAM service prefix -> lowercase condition key.
+}
+
+var (
+	index     catalogIndex
+	indexOnce sync.Once
+)
+
+func catalog() catalogIndex {
+	indexOnce.Do(func() {
+		index = catalogIndex{
+			actions:       make(map[string]map[string]struct{}, len(services)),
+			conditionKeys: make(map[string][]string),
+		}
+
+		for prefix, v := range services {
+			actions := make(map[string]struct{}, len(v.actions))
+			for _, action := range v.actions {
+				actions[strings.ToLower(action)] = struct{}{}
+			}
+			index.actions[strings.ToLower(prefix)] = actions
+
+			if len(v.conditionKeys) > 0 {
+				index.conditionKeys[strings.ToLower(prefix)] = lower(v.conditionKeys)
+			}
+		}
+
+		index.conditionKeys["aws"] = lower(globalConditionKeys)
+	})
+
+	return index
+}
+
+// CheckAction returns an error if the specified IAM action is not in the catalog.
+// An action containing wildcards must match at least one action in the catalog.
+// Actions containing policy variables are not checked.
+func CheckAction(action string) error {
+	if action == "*" || strings.Contains(action, "${") {
+		return nil
+	}
+
+	prefix, name, ok := strings.Cut(strings.ToLower(action), ":")
+
+	if !ok || prefix == "" || name == "" {
+		return fmt.Errorf("action %q is not of the form <service>:<action>", action)
+	}
+
+	if strings.ContainsAny(prefix, "*?") {
+		return nil
+	}
+
+	actions, ok := catalog().actions[prefix]
+
+	if !ok {
+		return fmt.Errorf("action %q has an unknown service prefix (%s)", action, prefix)
+	}
+
+	if !strings.ContainsAny(name, "*?") {
+		if _, ok := actions[name]; !ok {
+			return fmt.Errorf("action %q is not a known %s action", action, prefix)
+		}
+
+		return nil
+	}
+
+	for v := range actions {
+		if wildcardMatch(name, v) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("action %q does not match any known %s action", action, prefix)
+}
+
+// CheckConditionKey returns an error if the specified condition key is not in the catalog.
+// Only global condition keys and the keys of services with condition keys in the catalog are checked.
+func CheckConditionKey(key string) error {
+	if strings.Contains(key, "${") {
+		return nil
+	}
+
+	prefix, _, ok := strings.Cut(strings.ToLower(key), ":")
+
+	if !ok {
+		return nil
+	}
+
+	keys, ok := catalog().conditionKeys[prefix]
+
+	if !ok {
+		return nil
+	}
+
+	lowerKey := strings.ToLower(key)
+
+	for _, v := range keys {
+		// Keys ending in ':' or '/' (e.g. "aws:ResourceTag/") take a suffix.
+		if suffix := v[len(v)-1]; suffix == ':' || suffix == '/' {
+			if strings.HasPrefix(lowerKey, v) && len(lowerKey) > len(v) {
+				return nil
+			}
+
+			continue
+		}
+
+		if lowerKey == v {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("condition key %q is not a known %s condition key", key, prefix)
+}
+
+// Warning is an action or condition key in an IAM policy document's statement that is not in the catalog.
+type Warning struct {
+	Statement int // Index of the statement in the policy document.
+	Sid       string
+	Message   string
+}
+
+func (w Warning) String() string {
+	if w.Sid != "" {
+		return fmt.Sprintf("statement %d (Sid %q): %s", w.Statement, w.Sid, w.Message)
+	}
+
+	return fmt.Sprintf("statement %d: %s", w.Statement, w.Message)
+}
+
+type policyDocument struct {
+	Statement json.RawMessage
+}
+
+type policyStatement struct {
+	Sid       string
+	Action    stringOrSlice
+	NotAction stringOrSlice
+	Condition map[string]map[string]json.RawMessage
+}
+
+type stringOrSlice []string
+
+func (s *stringOrSlice) UnmarshalJSON(b []byte) error {
+	var v string
+
+	if err := json.Unmarshal(b, &v); err == nil {
+		*s = []string{v}
+
+		return nil
+	}
+
+	return json.Unmarshal(b, (*[]string)(s))
+}
+
+// CheckPolicy returns a warning for each action and condition key in the specified IAM policy document that is not in the catalog.
+// Documents that cannot be parsed return no warnings.
+func CheckPolicy(policy string) []Warning {
+	var doc policyDocument
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil || len(doc.Statement) == 0 {
+		return nil
+	}
+
+	var statements []policyStatement
+
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement policyStatement
+
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return nil
+		}
+
+		statements = []policyStatement{statement}
+	}
+
+	var warnings []Warning
+
+	for i, v := range statements {
+		actions := append(append([]string{}, v.Action...), v.NotAction...)
+		warnings = append(warnings, CheckStatement(i, v.Sid, actions, conditionKeys(v.Condition))...)
+	}
+
+	return warnings
+}
+
+// CheckStatement returns a warning for each of the specified actions and condition keys of a policy statement that is not in the catalog.
+func CheckStatement(statement int, sid string, actions, conditionKeys []string) []Warning {
+	var warnings []Warning
+
+	for _, action := range actions {
+		if err := CheckAction(action); err != nil {
+			warnings = append(warnings, Warning{Statement: statement, Sid: sid, Message: err.Error()})
+		}
+	}
+
+	for _, key := range conditionKeys {
+		if err := CheckConditionKey(key); err != nil {
+			warnings = append(warnings, Warning{Statement: statement, Sid: sid, Message: err.Error()})
+		}
+	}
+
+	return warnings
+}
+
+func conditionKeys(conditions map[string]map[string]json.RawMessage) []string {
+	var keys []string
+
+	for _, v := range conditions {
+		for key := range v {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// wildcardMatch returns whether s matches pattern, in which '*' matches any sequence of characters and '?' matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	var p, i, star, match = 0, 0, -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, i
+			p++
+		case star != -1:
+			p = star + 1
+			match++
+			i = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+func lower(s []string) []string {
+	out := make([]string, len(s))
+
+	for i, v := range s {
+		out[i] = strings.ToLower(v)
+	}
+
+	return out
+}
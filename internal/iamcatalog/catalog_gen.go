@@ -0,0 +1,13799 @@
+// Code generated by internal/generate/iamcatalog/main.go; DO NOT EDIT.
+
+// Actions are derived from the API models of github.com/aws/aws-sdk-go v1.44.182.
+
+package iamcatalog
+
+var services = map[string]service{
+	"a4b": {
+		actions: []string{
+			"ApproveSkill",
+			"AssociateContactWithAddressBook",
+			"AssociateDeviceWithNetworkProfile",
+			"AssociateDeviceWithRoom",
+			"AssociateSkillGroupWithRoom",
+			"AssociateSkillWithSkillGroup",
+			"AssociateSkillWithUsers",
+			"CreateAddressBook",
+			"CreateBusinessReportSchedule",
+			"CreateConferenceProvider",
+			"CreateContact",
+			"CreateGatewayGroup",
+			"CreateNetworkProfile",
+			"CreateProfile",
+			"CreateRoom",
+			"CreateSkillGroup",
+			"CreateUser",
+			"DeleteAddressBook",
+			"DeleteBusinessReportSchedule",
+			"DeleteConferenceProvider",
+			"DeleteContact",
+			"DeleteDevice",
+			"DeleteDeviceUsageData",
+			"DeleteGatewayGroup",
+			"DeleteNetworkProfile",
+			"DeleteProfile",
+			"DeleteRoom",
+			"DeleteRoomSkillParameter",
+			"DeleteSkillAuthorization",
+			"DeleteSkillGroup",
+			"DeleteUser",
+			"DisassociateContactFromAddressBook",
+			"DisassociateDeviceFromRoom",
+			"DisassociateSkillFromSkillGroup",
+			"DisassociateSkillFromUsers",
+			"DisassociateSkillGroupFromRoom",
+			"ForgetSmartHomeAppliances",
+			"GetAddressBook",
+			"GetConferencePreference",
+			"GetConferenceProvider",
+			"GetContact",
+			"GetDevice",
+			"GetGateway",
+			"GetGatewayGroup",
+			"GetInvitationConfiguration",
+			"GetNetworkProfile",
+			"GetProfile",
+			"GetRoom",
+			"GetRoomSkillParameter",
+			"GetSkillGroup",
+			"ListBusinessReportSchedules",
+			"ListConferenceProviders",
+			"ListDeviceEvents",
+			"ListGatewayGroups",
+			"ListGateways",
+			"ListSkills",
+			"ListSkillsStoreCategories",
+			"ListSkillsStoreSkillsByCategory",
+			"ListSmartHomeAppliances",
+			"ListTags",
+			"PutConferencePreference",
+			"PutInvitationConfiguration",
+			"PutRoomSkillParameter",
+			"PutSkillAuthorization",
+			"RegisterAVSDevice",
+			"RejectSkill",
+			"ResolveRoom",
+			"RevokeInvitation",
+			"SearchAddressBooks",
+			"SearchContacts",
+			"SearchDevices",
+			"SearchNetworkProfiles",
+			"SearchProfiles",
+			"SearchRooms",
+			"SearchSkillGroups",
+			"SearchUsers",
+			"SendAnnouncement",
+			"SendInvitation",
+			"StartDeviceSync",
+			"StartSmartHomeApplianceDiscovery",
+			"TagResource",
+			"UntagResource",
+			"UpdateAddressBook",
+			"UpdateBusinessReportSchedule",
+			"UpdateConferenceProvider",
+			"UpdateContact",
+			"UpdateDevice",
+			"UpdateGateway",
+			"UpdateGatewayGroup",
+			"UpdateNetworkProfile",
+			"UpdateProfile",
+			"UpdateRoom",
+			"UpdateSkillGroup",
+		},
+	},
+	"access-analyzer": {
+		actions: []string{
+			"ApplyArchiveRule",
+			"CancelPolicyGeneration",
+			"CreateAccessPreview",
+			"CreateAnalyzer",
+			"CreateArchiveRule",
+			"DeleteAnalyzer",
+			"DeleteArchiveRule",
+			"GetAccessPreview",
+			"GetAnalyzedResource",
+			"GetAnalyzer",
+			"GetArchiveRule",
+			"GetFinding",
+			"GetGeneratedPolicy",
+			"ListAccessPreviewFindings",
+			"ListAccessPreviews",
+			"ListAnalyzedResources",
+			"ListAnalyzers",
+			"ListArchiveRules",
+			"ListFindings",
+			"ListPolicyGenerations",
+			"ListTagsForResource",
+			"StartPolicyGeneration",
+			"StartResourceScan",
+			"TagResource",
+			"UntagResource",
+			"UpdateArchiveRule",
+			"UpdateFindings",
+			"ValidatePolicy",
+		},
+	},
+	"account": {
+		actions: []string{
+			"DeleteAlternateContact",
+			"GetAlternateContact",
+			"GetContactInformation",
+			"PutAlternateContact",
+			"PutContactInformation",
+		},
+	},
+	"acm": {
+		actions: []string{
+			"AddTagsToCertificate",
+			"DeleteCertificate",
+			"DescribeCertificate",
+			"ExportCertificate",
+			"GetAccountConfiguration",
+			"GetCertificate",
+			"ImportCertificate",
+			"ListCertificates",
+			"ListTagsForCertificate",
+			"PutAccountConfiguration",
+			"RemoveTagsFromCertificate",
+			"RenewCertificate",
+			"RequestCertificate",
+			"ResendValidationEmail",
+			"UpdateCertificateOptions",
+		},
+	},
+	"acm-pca": {
+		actions: []string{
+			"CreateCertificateAuthority",
+			"CreateCertificateAuthorityAuditReport",
+			"CreatePermission",
+			"DeleteCertificateAuthority",
+			"DeletePermission",
+			"DeletePolicy",
+			"DescribeCertificateAuthority",
+			"DescribeCertificateAuthorityAuditReport",
+			"GetCertificate",
+			"GetCertificateAuthorityCertificate",
+			"GetCertificateAuthorityCsr",
+			"GetPolicy",
+			"ImportCertificateAuthorityCertificate",
+			"IssueCertificate",
+			"ListCertificateAuthorities",
+			"ListPermissions",
+			"ListTags",
+			"PutPolicy",
+			"RestoreCertificateAuthority",
+			"RevokeCertificate",
+			"TagCertificateAuthority",
+			"UntagCertificateAuthority",
+			"UpdateCertificateAuthority",
+		},
+	},
+	"airflow": {
+		actions: []string{
+			"CreateCliToken",
+			"CreateEnvironment",
+			"CreateWebLoginToken",
+			"DeleteEnvironment",
+			"GetEnvironment",
+			"ListEnvironments",
+			"ListTagsForResource",
+			"PublishMetrics",
+			"TagResource",
+			"UntagResource",
+			"UpdateEnvironment",
+		},
+	},
+	"amplify": {
+		actions: []string{
+			"CreateApp",
+			"CreateBackendEnvironment",
+			"CreateBranch",
+			"CreateDeployment",
+			"CreateDomainAssociation",
+			"CreateWebhook",
+			"DeleteApp",
+			"DeleteBackendEnvironment",
+			"DeleteBranch",
+			"DeleteDomainAssociation",
+			"DeleteJob",
+			"DeleteWebhook",
+			"GenerateAccessLogs",
+			"GetApp",
+			"GetArtifactUrl",
+			"GetBackendEnvironment",
+			"GetBranch",
+			"GetDomainAssociation",
+			"GetJob",
+			"GetWebhook",
+			"ListApps",
+			"ListArtifacts",
+			"ListBackendEnvironments",
+			"ListBranches",
+			"ListDomainAssociations",
+			"ListJobs",
+			"ListTagsForResource",
+			"ListWebhooks",
+			"StartDeployment",
+			"StartJob",
+			"StopJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateApp",
+			"UpdateBranch",
+			"UpdateDomainAssociation",
+			"UpdateWebhook",
+		},
+	},
+	"amplifybackend": {
+		actions: []string{
+			"CloneBackend",
+			"CreateBackend",
+			"CreateBackendAPI",
+			"CreateBackendAuth",
+			"CreateBackendConfig",
+			"CreateBackendStorage",
+			"CreateToken",
+			"DeleteBackend",
+			"DeleteBackendAPI",
+			"DeleteBackendAuth",
+			"DeleteBackendStorage",
+			"DeleteToken",
+			"GenerateBackendAPIModels",
+			"GetBackend",
+			"GetBackendAPI",
+			"GetBackendAPIModels",
+			"GetBackendAuth",
+			"GetBackendJob",
+			"GetBackendStorage",
+			"GetToken",
+			"ImportBackendAuth",
+			"ImportBackendStorage",
+			"ListBackendJobs",
+			"ListS3Buckets",
+			"RemoveAllBackends",
+			"RemoveBackendConfig",
+			"UpdateBackendAPI",
+			"UpdateBackendAuth",
+			"UpdateBackendConfig",
+			"UpdateBackendJob",
+			"UpdateBackendStorage",
+		},
+	},
+	"amplifyuibuilder": {
+		actions: []string{
+			"CreateComponent",
+			"CreateForm",
+			"CreateTheme",
+			"DeleteComponent",
+			"DeleteForm",
+			"DeleteTheme",
+			"ExchangeCodeForToken",
+			"ExportComponents",
+			"ExportForms",
+			"ExportThemes",
+			"GetComponent",
+			"GetForm",
+			"GetMetadata",
+			"GetTheme",
+			"ListComponents",
+			"ListForms",
+			"ListThemes",
+			"PutMetadataFlag",
+			"RefreshToken",
+			"UpdateComponent",
+			"UpdateForm",
+			"UpdateTheme",
+		},
+	},
+	"aoss": {
+		actions: []string{
+			"APIAccessAll",
+			"BatchGetCollection",
+			"BatchGetVpcEndpoint",
+			"CreateAccessPolicy",
+			"CreateCollection",
+			"CreateSecurityConfig",
+			"CreateSecurityPolicy",
+			"CreateVpcEndpoint",
+			"DashboardsAccessAll",
+			"DeleteAccessPolicy",
+			"DeleteCollection",
+			"DeleteSecurityConfig",
+			"DeleteSecurityPolicy",
+			"DeleteVpcEndpoint",
+			"GetAccessPolicy",
+			"GetAccountSettings",
+			"GetPoliciesStats",
+			"GetSecurityConfig",
+			"GetSecurityPolicy",
+			"ListAccessPolicies",
+			"ListCollections",
+			"ListSecurityConfigs",
+			"ListSecurityPolicies",
+			"ListTagsForResource",
+			"ListVpcEndpoints",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccessPolicy",
+			"UpdateAccountSettings",
+			"UpdateCollection",
+			"UpdateSecurityConfig",
+			"UpdateSecurityPolicy",
+			"UpdateVpcEndpoint",
+		},
+	},
+	"apigateway": {
+		actions: []string{
+			"AddCertificateToDomain",
+			"CreateAccessAssociation",
+			"DELETE",
+			"GET",
+			"PATCH",
+			"POST",
+			"PUT",
+			"RemoveCertificateFromDomain",
+			"SetWebACL",
+			"UpdateDomainNameManagementPolicy",
+			"UpdateRestApiPolicy",
+		},
+	},
+	"app-integrations": {
+		actions: []string{
+			"CreateDataIntegration",
+			"CreateEventIntegration",
+			"DeleteDataIntegration",
+			"DeleteEventIntegration",
+			"GetDataIntegration",
+			"GetEventIntegration",
+			"ListDataIntegrationAssociations",
+			"ListDataIntegrations",
+			"ListEventIntegrationAssociations",
+			"ListEventIntegrations",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateDataIntegration",
+			"UpdateEventIntegration",
+		},
+	},
+	"appconfig": {
+		actions: []string{
+			"CreateApplication",
+			"CreateConfigurationProfile",
+			"CreateDeploymentStrategy",
+			"CreateEnvironment",
+			"CreateExtension",
+			"CreateExtensionAssociation",
+			"CreateHostedConfigurationVersion",
+			"DeleteApplication",
+			"DeleteConfigurationProfile",
+			"DeleteDeploymentStrategy",
+			"DeleteEnvironment",
+			"DeleteExtension",
+			"DeleteExtensionAssociation",
+			"DeleteHostedConfigurationVersion",
+			"GetApplication",
+			"GetConfiguration",
+			"GetConfigurationProfile",
+			"GetDeployment",
+			"GetDeploymentStrategy",
+			"GetEnvironment",
+			"GetExtension",
+			"GetExtensionAssociation",
+			"GetHostedConfigurationVersion",
+			"GetLatestConfiguration",
+			"ListApplications",
+			"ListConfigurationProfiles",
+			"ListDeployments",
+			"ListDeploymentStrategies",
+			"ListEnvironments",
+			"ListExtensionAssociations",
+			"ListExtensions",
+			"ListHostedConfigurationVersions",
+			"ListTagsForResource",
+			"StartConfigurationSession",
+			"StartDeployment",
+			"StopDeployment",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateConfigurationProfile",
+			"UpdateDeploymentStrategy",
+			"UpdateEnvironment",
+			"UpdateExtension",
+			"UpdateExtensionAssociation",
+			"ValidateConfiguration",
+		},
+	},
+	"appflow": {
+		actions: []string{
+			"CreateConnectorProfile",
+			"CreateFlow",
+			"DeleteConnectorProfile",
+			"DeleteFlow",
+			"DescribeConnector",
+			"DescribeConnectorEntity",
+			"DescribeConnectorProfiles",
+			"DescribeConnectors",
+			"DescribeFlow",
+			"DescribeFlowExecutionRecords",
+			"ListConnectorEntities",
+			"ListConnectors",
+			"ListFlows",
+			"ListTagsForResource",
+			"RegisterConnector",
+			"StartFlow",
+			"StopFlow",
+			"TagResource",
+			"UnregisterConnector",
+			"UntagResource",
+			"UpdateConnectorProfile",
+			"UpdateConnectorRegistration",
+			"UpdateFlow",
+		},
+	},
+	"application-autoscaling": {
+		actions: []string{
+			"DeleteScalingPolicy",
+			"DeleteScheduledAction",
+			"DeregisterScalableTarget",
+			"DescribeScalableTargets",
+			"DescribeScalingActivities",
+			"DescribeScalingPolicies",
+			"DescribeScheduledActions",
+			"PutScalingPolicy",
+			"PutScheduledAction",
+			"RegisterScalableTarget",
+		},
+	},
+	"application-cost-profiler": {
+		actions: []string{
+			"DeleteReportDefinition",
+			"GetReportDefinition",
+			"ImportApplicationUsage",
+			"ListReportDefinitions",
+			"PutReportDefinition",
+			"UpdateReportDefinition",
+		},
+	},
+	"applicationinsights": {
+		actions: []string{
+			"CreateApplication",
+			"CreateComponent",
+			"CreateLogPattern",
+			"DeleteApplication",
+			"DeleteComponent",
+			"DeleteLogPattern",
+			"DescribeApplication",
+			"DescribeComponent",
+			"DescribeComponentConfiguration",
+			"DescribeComponentConfigurationRecommendation",
+			"DescribeLogPattern",
+			"DescribeObservation",
+			"DescribeProblem",
+			"DescribeProblemObservations",
+			"ListApplications",
+			"ListComponents",
+			"ListConfigurationHistory",
+			"ListLogPatterns",
+			"ListLogPatternSets",
+			"ListProblems",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateComponent",
+			"UpdateComponentConfiguration",
+			"UpdateLogPattern",
+		},
+	},
+	"appmesh": {
+		actions: []string{
+			"CreateGatewayRoute",
+			"CreateMesh",
+			"CreateRoute",
+			"CreateVirtualGateway",
+			"CreateVirtualNode",
+			"CreateVirtualRouter",
+			"CreateVirtualService",
+			"DeleteGatewayRoute",
+			"DeleteMesh",
+			"DeleteRoute",
+			"DeleteVirtualGateway",
+			"DeleteVirtualNode",
+			"DeleteVirtualRouter",
+			"DeleteVirtualService",
+			"DescribeGatewayRoute",
+			"DescribeMesh",
+			"DescribeRoute",
+			"DescribeVirtualGateway",
+			"DescribeVirtualNode",
+			"DescribeVirtualRouter",
+			"DescribeVirtualService",
+			"ListGatewayRoutes",
+			"ListMeshes",
+			"ListRoutes",
+			"ListTagsForResource",
+			"ListVirtualGateways",
+			"ListVirtualNodes",
+			"ListVirtualRouters",
+			"ListVirtualServices",
+			"TagResource",
+			"UntagResource",
+			"UpdateGatewayRoute",
+			"UpdateMesh",
+			"UpdateRoute",
+			"UpdateVirtualGateway",
+			"UpdateVirtualNode",
+			"UpdateVirtualRouter",
+			"UpdateVirtualService",
+		},
+	},
+	"apprunner": {
+		actions: []string{
+			"AssociateCustomDomain",
+			"CreateAutoScalingConfiguration",
+			"CreateConnection",
+			"CreateObservabilityConfiguration",
+			"CreateService",
+			"CreateVpcConnector",
+			"CreateVpcIngressConnection",
+			"DeleteAutoScalingConfiguration",
+			"DeleteConnection",
+			"DeleteObservabilityConfiguration",
+			"DeleteService",
+			"DeleteVpcConnector",
+			"DeleteVpcIngressConnection",
+			"DescribeAutoScalingConfiguration",
+			"DescribeCustomDomains",
+			"DescribeObservabilityConfiguration",
+			"DescribeService",
+			"DescribeVpcConnector",
+			"DescribeVpcIngressConnection",
+			"DisassociateCustomDomain",
+			"ListAutoScalingConfigurations",
+			"ListConnections",
+			"ListObservabilityConfigurations",
+			"ListOperations",
+			"ListServices",
+			"ListTagsForResource",
+			"ListVpcConnectors",
+			"ListVpcIngressConnections",
+			"PauseService",
+			"ResumeService",
+			"StartDeployment",
+			"TagResource",
+			"UntagResource",
+			"UpdateService",
+			"UpdateVpcIngressConnection",
+		},
+	},
+	"appstream": {
+		actions: []string{
+			"AssociateApplicationFleet",
+			"AssociateApplicationToEntitlement",
+			"AssociateFleet",
+			"BatchAssociateUserStack",
+			"BatchDisassociateUserStack",
+			"CopyImage",
+			"CreateAppBlock",
+			"CreateApplication",
+			"CreateDirectoryConfig",
+			"CreateEntitlement",
+			"CreateFleet",
+			"CreateImageBuilder",
+			"CreateImageBuilderStreamingURL",
+			"CreateStack",
+			"CreateStreamingURL",
+			"CreateUpdatedImage",
+			"CreateUsageReportSubscription",
+			"CreateUser",
+			"DeleteAppBlock",
+			"DeleteApplication",
+			"DeleteDirectoryConfig",
+			"DeleteEntitlement",
+			"DeleteFleet",
+			"DeleteImage",
+			"DeleteImageBuilder",
+			"DeleteImagePermissions",
+			"DeleteStack",
+			"DeleteUsageReportSubscription",
+			"DeleteUser",
+			"DescribeAppBlocks",
+			"DescribeApplicationFleetAssociations",
+			"DescribeApplications",
+			"DescribeDirectoryConfigs",
+			"DescribeEntitlements",
+			"DescribeFleets",
+			"DescribeImageBuilders",
+			"DescribeImagePermissions",
+			"DescribeImages",
+			"DescribeSessions",
+			"DescribeStacks",
+			"DescribeUsageReportSubscriptions",
+			"DescribeUsers",
+			"DescribeUserStackAssociations",
+			"DisableUser",
+			"DisassociateApplicationFleet",
+			"DisassociateApplicationFromEntitlement",
+			"DisassociateFleet",
+			"EnableUser",
+			"ExpireSession",
+			"ListAssociatedFleets",
+			"ListAssociatedStacks",
+			"ListEntitledApplications",
+			"ListTagsForResource",
+			"StartFleet",
+			"StartImageBuilder",
+			"StopFleet",
+			"StopImageBuilder",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateDirectoryConfig",
+			"UpdateEntitlement",
+			"UpdateFleet",
+			"UpdateImagePermissions",
+			"UpdateStack",
+		},
+	},
+	"appsync": {
+		actions: []string{
+			"AssociateApi",
+			"CreateApiCache",
+			"CreateApiKey",
+			"CreateDataSource",
+			"CreateDomainName",
+			"CreateFunction",
+			"CreateGraphqlApi",
+			"CreateResolver",
+			"CreateType",
+			"DeleteApiCache",
+			"DeleteApiKey",
+			"DeleteDataSource",
+			"DeleteDomainName",
+			"DeleteFunction",
+			"DeleteGraphqlApi",
+			"DeleteResolver",
+			"DeleteType",
+			"DisassociateApi",
+			"EvaluateCode",
+			"EvaluateMappingTemplate",
+			"EventConnect",
+			"EventPublish",
+			"EventSubscribe",
+			"FlushApiCache",
+			"GetApiAssociation",
+			"GetApiCache",
+			"GetDataSource",
+			"GetDomainName",
+			"GetFunction",
+			"GetGraphqlApi",
+			"GetIntrospectionSchema",
+			"GetResolver",
+			"GetSchemaCreationStatus",
+			"GetType",
+			"GraphQL",
+			"ListApiKeys",
+			"ListDataSources",
+			"ListDomainNames",
+			"ListFunctions",
+			"ListGraphqlApis",
+			"ListResolvers",
+			"ListResolversByFunction",
+			"ListTagsForResource",
+			"ListTypes",
+			"SourceGraphQL",
+			"StartSchemaCreation",
+			"TagResource",
+			"UntagResource",
+			"UpdateApiCache",
+			"UpdateApiKey",
+			"UpdateDataSource",
+			"UpdateDomainName",
+			"UpdateFunction",
+			"UpdateGraphqlApi",
+			"UpdateResolver",
+			"UpdateType",
+		},
+	},
+	"aps": {
+		actions: []string{
+			"CreateAlertManagerDefinition",
+			"CreateLoggingConfiguration",
+			"CreateRuleGroupsNamespace",
+			"CreateWorkspace",
+			"DeleteAlertManagerDefinition",
+			"DeleteLoggingConfiguration",
+			"DeleteRuleGroupsNamespace",
+			"DeleteWorkspace",
+			"DescribeAlertManagerDefinition",
+			"DescribeLoggingConfiguration",
+			"DescribeRuleGroupsNamespace",
+			"DescribeWorkspace",
+			"ListRuleGroupsNamespaces",
+			"ListTagsForResource",
+			"ListWorkspaces",
+			"PutAlertManagerDefinition",
+			"PutRuleGroupsNamespace",
+			"TagResource",
+			"UntagResource",
+			"UpdateLoggingConfiguration",
+			"UpdateWorkspaceAlias",
+		},
+	},
+	"arc-zonal-shift": {
+		actions: []string{
+			"CancelZonalShift",
+			"GetManagedResource",
+			"ListManagedResources",
+			"ListZonalShifts",
+			"StartZonalShift",
+			"UpdateZonalShift",
+		},
+	},
+	"athena": {
+		actions: []string{
+			"BatchGetNamedQuery",
+			"BatchGetPreparedStatement",
+			"BatchGetQueryExecution",
+			"CreateDataCatalog",
+			"CreateNamedQuery",
+			"CreateNotebook",
+			"CreatePreparedStatement",
+			"CreatePresignedNotebookUrl",
+			"CreateWorkGroup",
+			"DeleteDataCatalog",
+			"DeleteNamedQuery",
+			"DeleteNotebook",
+			"DeletePreparedStatement",
+			"DeleteWorkGroup",
+			"ExportNotebook",
+			"GetCalculationExecution",
+			"GetCalculationExecutionCode",
+			"GetCalculationExecutionStatus",
+			"GetDatabase",
+			"GetDataCatalog",
+			"GetNamedQuery",
+			"GetNotebookMetadata",
+			"GetPreparedStatement",
+			"GetQueryExecution",
+			"GetQueryResults",
+			"GetQueryRuntimeStatistics",
+			"GetSession",
+			"GetSessionStatus",
+			"GetTableMetadata",
+			"GetWorkGroup",
+			"ImportNotebook",
+			"ListApplicationDPUSizes",
+			"ListCalculationExecutions",
+			"ListDatabases",
+			"ListDataCatalogs",
+			"ListEngineVersions",
+			"ListExecutors",
+			"ListNamedQueries",
+			"ListNotebookMetadata",
+			"ListNotebookSessions",
+			"ListPreparedStatements",
+			"ListQueryExecutions",
+			"ListSessions",
+			"ListTableMetadata",
+			"ListTagsForResource",
+			"ListWorkGroups",
+			"StartCalculationExecution",
+			"StartQueryExecution",
+			"StartSession",
+			"StopCalculationExecution",
+			"StopQueryExecution",
+			"TagResource",
+			"TerminateSession",
+			"UntagResource",
+			"UpdateDataCatalog",
+			"UpdateNamedQuery",
+			"UpdateNotebook",
+			"UpdateNotebookMetadata",
+			"UpdatePreparedStatement",
+			"UpdateWorkGroup",
+		},
+	},
+	"auditmanager": {
+		actions: []string{
+			"AssociateAssessmentReportEvidenceFolder",
+			"BatchAssociateAssessmentReportEvidence",
+			"BatchCreateDelegationByAssessment",
+			"BatchDeleteDelegationByAssessment",
+			"BatchDisassociateAssessmentReportEvidence",
+			"BatchImportEvidenceToAssessmentControl",
+			"CreateAssessment",
+			"CreateAssessmentFramework",
+			"CreateAssessmentReport",
+			"CreateControl",
+			"DeleteAssessment",
+			"DeleteAssessmentFramework",
+			"DeleteAssessmentFrameworkShare",
+			"DeleteAssessmentReport",
+			"DeleteControl",
+			"DeregisterAccount",
+			"DeregisterOrganizationAdminAccount",
+			"DisassociateAssessmentReportEvidenceFolder",
+			"GetAccountStatus",
+			"GetAssessment",
+			"GetAssessmentFramework",
+			"GetAssessmentReportUrl",
+			"GetChangeLogs",
+			"GetControl",
+			"GetDelegations",
+			"GetEvidence",
+			"GetEvidenceByEvidenceFolder",
+			"GetEvidenceFolder",
+			"GetEvidenceFoldersByAssessment",
+			"GetEvidenceFoldersByAssessmentControl",
+			"GetInsights",
+			"GetInsightsByAssessment",
+			"GetOrganizationAdminAccount",
+			"GetServicesInScope",
+			"GetSettings",
+			"ListAssessmentControlInsightsByControlDomain",
+			"ListAssessmentFrameworks",
+			"ListAssessmentFrameworkShareRequests",
+			"ListAssessmentReports",
+			"ListAssessments",
+			"ListControlDomainInsights",
+			"ListControlDomainInsightsByAssessment",
+			"ListControlInsightsByControlDomain",
+			"ListControls",
+			"ListKeywordsForDataSource",
+			"ListNotifications",
+			"ListTagsForResource",
+			"RegisterAccount",
+			"RegisterOrganizationAdminAccount",
+			"StartAssessmentFrameworkShare",
+			"TagResource",
+			"UntagResource",
+			"UpdateAssessment",
+			"UpdateAssessmentControl",
+			"UpdateAssessmentControlSetStatus",
+			"UpdateAssessmentFramework",
+			"UpdateAssessmentFrameworkShare",
+			"UpdateAssessmentStatus",
+			"UpdateControl",
+			"UpdateSettings",
+			"ValidateAssessmentReportIntegrity",
+		},
+	},
+	"autoscaling": {
+		actions: []string{
+			"AttachInstances",
+			"AttachLoadBalancers",
+			"AttachLoadBalancerTargetGroups",
+			"AttachTrafficSources",
+			"BatchDeleteScheduledAction",
+			"BatchPutScheduledUpdateGroupAction",
+			"CancelInstanceRefresh",
+			"CompleteLifecycleAction",
+			"CreateAutoScalingGroup",
+			"CreateLaunchConfiguration",
+			"CreateOrUpdateTags",
+			"DeleteAutoScalingGroup",
+			"DeleteLaunchConfiguration",
+			"DeleteLifecycleHook",
+			"DeleteNotificationConfiguration",
+			"DeletePolicy",
+			"DeleteScheduledAction",
+			"DeleteTags",
+			"DeleteWarmPool",
+			"DescribeAccountLimits",
+			"DescribeAdjustmentTypes",
+			"DescribeAutoScalingGroups",
+			"DescribeAutoScalingInstances",
+			"DescribeAutoScalingNotificationTypes",
+			"DescribeInstanceRefreshes",
+			"DescribeLaunchConfigurations",
+			"DescribeLifecycleHooks",
+			"DescribeLifecycleHookTypes",
+			"DescribeLoadBalancers",
+			"DescribeLoadBalancerTargetGroups",
+			"DescribeMetricCollectionTypes",
+			"DescribeNotificationConfigurations",
+			"DescribePolicies",
+			"DescribeScalingActivities",
+			"DescribeScalingProcessTypes",
+			"DescribeScheduledActions",
+			"DescribeTags",
+			"DescribeTerminationPolicyTypes",
+			"DescribeTrafficSources",
+			"DescribeWarmPool",
+			"DetachInstances",
+			"DetachLoadBalancers",
+			"DetachLoadBalancerTargetGroups",
+			"DetachTrafficSources",
+			"DisableMetricsCollection",
+			"EnableMetricsCollection",
+			"EnterStandby",
+			"ExecutePolicy",
+			"ExitStandby",
+			"GetPredictiveScalingForecast",
+			"PutLifecycleHook",
+			"PutNotificationConfiguration",
+			"PutScalingPolicy",
+			"PutScheduledUpdateGroupAction",
+			"PutWarmPool",
+			"RecordLifecycleActionHeartbeat",
+			"ResumeProcesses",
+			"SetDesiredCapacity",
+			"SetInstanceHealth",
+			"SetInstanceProtection",
+			"StartInstanceRefresh",
+			"SuspendProcesses",
+			"TerminateInstanceInAutoScalingGroup",
+			"UpdateAutoScalingGroup",
+		},
+	},
+	"autoscaling-plans": {
+		actions: []string{
+			"CreateScalingPlan",
+			"DeleteScalingPlan",
+			"DescribeScalingPlanResources",
+			"DescribeScalingPlans",
+			"GetScalingPlanResourceForecastData",
+			"UpdateScalingPlan",
+		},
+	},
+	"aws-marketplace": {
+		actions: []string{
+			"BatchMeterUsage",
+			"CancelChangeSet",
+			"DescribeChangeSet",
+			"DescribeEntity",
+			"GetEntitlements",
+			"ListChangeSets",
+			"ListEntities",
+			"ListTagsForResource",
+			"MeterUsage",
+			"RegisterUsage",
+			"ResolveCustomer",
+			"StartChangeSet",
+			"Subscribe",
+			"TagResource",
+			"Unsubscribe",
+			"UntagResource",
+			"ViewSubscriptions",
+		},
+	},
+	"aws-portal": {
+		actions: []string{
+			"ModifyAccount",
+			"ModifyBilling",
+			"ModifyPaymentMethods",
+			"ViewAccount",
+			"ViewBilling",
+			"ViewPaymentMethods",
+			"ViewUsage",
+		},
+	},
+	"backup": {
+		actions: []string{
+			"CancelLegalHold",
+			"CreateBackupPlan",
+			"CreateBackupSelection",
+			"CreateBackupVault",
+			"CreateFramework",
+			"CreateLegalHold",
+			"CreateReportPlan",
+			"DeleteBackupPlan",
+			"DeleteBackupSelection",
+			"DeleteBackupVault",
+			"DeleteBackupVaultAccessPolicy",
+			"DeleteBackupVaultLockConfiguration",
+			"DeleteBackupVaultNotifications",
+			"DeleteFramework",
+			"DeleteRecoveryPoint",
+			"DeleteReportPlan",
+			"DescribeBackupJob",
+			"DescribeBackupVault",
+			"DescribeCopyJob",
+			"DescribeFramework",
+			"DescribeGlobalSettings",
+			"DescribeProtectedResource",
+			"DescribeRecoveryPoint",
+			"DescribeRegionSettings",
+			"DescribeReportJob",
+			"DescribeReportPlan",
+			"DescribeRestoreJob",
+			"DisassociateRecoveryPoint",
+			"DisassociateRecoveryPointFromParent",
+			"ExportBackupPlanTemplate",
+			"GetBackupPlan",
+			"GetBackupPlanFromJSON",
+			"GetBackupPlanFromTemplate",
+			"GetBackupSelection",
+			"GetBackupVaultAccessPolicy",
+			"GetBackupVaultNotifications",
+			"GetLegalHold",
+			"GetRecoveryPointRestoreMetadata",
+			"GetSupportedResourceTypes",
+			"ListBackupJobs",
+			"ListBackupPlans",
+			"ListBackupPlanTemplates",
+			"ListBackupPlanVersions",
+			"ListBackupSelections",
+			"ListBackupVaults",
+			"ListCopyJobs",
+			"ListFrameworks",
+			"ListLegalHolds",
+			"ListProtectedResources",
+			"ListRecoveryPointsByBackupVault",
+			"ListRecoveryPointsByLegalHold",
+			"ListRecoveryPointsByResource",
+			"ListReportJobs",
+			"ListReportPlans",
+			"ListRestoreJobs",
+			"ListTags",
+			"PutBackupVaultAccessPolicy",
+			"PutBackupVaultLockConfiguration",
+			"PutBackupVaultNotifications",
+			"StartBackupJob",
+			"StartCopyJob",
+			"StartReportJob",
+			"StartRestoreJob",
+			"StopBackupJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateBackupPlan",
+			"UpdateFramework",
+			"UpdateGlobalSettings",
+			"UpdateRecoveryPointLifecycle",
+			"UpdateRegionSettings",
+			"UpdateReportPlan",
+		},
+	},
+	"backup-gateway": {
+		actions: []string{
+			"AssociateGatewayToServer",
+			"CreateGateway",
+			"DeleteGateway",
+			"DeleteHypervisor",
+			"DisassociateGatewayFromServer",
+			"GetBandwidthRateLimitSchedule",
+			"GetGateway",
+			"GetHypervisor",
+			"GetHypervisorPropertyMappings",
+			"GetVirtualMachine",
+			"ImportHypervisorConfiguration",
+			"ListGateways",
+			"ListHypervisors",
+			"ListTagsForResource",
+			"ListVirtualMachines",
+			"PutBandwidthRateLimitSchedule",
+			"PutHypervisorPropertyMappings",
+			"PutMaintenanceStartTime",
+			"StartVirtualMachinesMetadataSync",
+			"TagResource",
+			"TestHypervisorConfiguration",
+			"UntagResource",
+			"UpdateGatewayInformation",
+			"UpdateGatewaySoftwareNow",
+			"UpdateHypervisor",
+		},
+	},
+	"backup-storage": {
+		actions: []string{
+			"DeleteObject",
+			"GetChunk",
+			"GetObjectMetadata",
+			"ListChunks",
+			"ListObjects",
+			"NotifyObjectComplete",
+			"PutChunk",
+			"PutObject",
+			"StartObject",
+		},
+	},
+	"batch": {
+		actions: []string{
+			"CancelJob",
+			"CreateComputeEnvironment",
+			"CreateJobQueue",
+			"CreateSchedulingPolicy",
+			"DeleteComputeEnvironment",
+			"DeleteJobQueue",
+			"DeleteSchedulingPolicy",
+			"DeregisterJobDefinition",
+			"DescribeComputeEnvironments",
+			"DescribeJobDefinitions",
+			"DescribeJobQueues",
+			"DescribeJobs",
+			"DescribeSchedulingPolicies",
+			"ListJobs",
+			"ListSchedulingPolicies",
+			"ListTagsForResource",
+			"RegisterJobDefinition",
+			"SubmitJob",
+			"TagResource",
+			"TerminateJob",
+			"UntagResource",
+			"UpdateComputeEnvironment",
+			"UpdateJobQueue",
+			"UpdateSchedulingPolicy",
+		},
+	},
+	"billingconductor": {
+		actions: []string{
+			"AssociateAccounts",
+			"AssociatePricingRules",
+			"BatchAssociateResourcesToCustomLineItem",
+			"BatchDisassociateResourcesFromCustomLineItem",
+			"CreateBillingGroup",
+			"CreateCustomLineItem",
+			"CreatePricingPlan",
+			"CreatePricingRule",
+			"DeleteBillingGroup",
+			"DeleteCustomLineItem",
+			"DeletePricingPlan",
+			"DeletePricingRule",
+			"DisassociateAccounts",
+			"DisassociatePricingRules",
+			"ListAccountAssociations",
+			"ListBillingGroupCostReports",
+			"ListBillingGroups",
+			"ListCustomLineItems",
+			"ListCustomLineItemVersions",
+			"ListPricingPlans",
+			"ListPricingPlansAssociatedWithPricingRule",
+			"ListPricingRules",
+			"ListPricingRulesAssociatedToPricingPlan",
+			"ListResourcesAssociatedToCustomLineItem",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateBillingGroup",
+			"UpdateCustomLineItem",
+			"UpdatePricingPlan",
+			"UpdatePricingRule",
+		},
+	},
+	"braket": {
+		actions: []string{
+			"CancelJob",
+			"CancelQuantumTask",
+			"CreateJob",
+			"CreateQuantumTask",
+			"GetDevice",
+			"GetJob",
+			"GetQuantumTask",
+			"ListTagsForResource",
+			"SearchDevices",
+			"SearchJobs",
+			"SearchQuantumTasks",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"budgets": {
+		actions: []string{
+			"CreateBudget",
+			"CreateBudgetAction",
+			"CreateNotification",
+			"CreateSubscriber",
+			"DeleteBudget",
+			"DeleteBudgetAction",
+			"DeleteNotification",
+			"DeleteSubscriber",
+			"DescribeBudget",
+			"DescribeBudgetAction",
+			"DescribeBudgetActionHistories",
+			"DescribeBudgetActionsForAccount",
+			"DescribeBudgetActionsForBudget",
+			"DescribeBudgetNotificationsForAccount",
+			"DescribeBudgetPerformanceHistory",
+			"DescribeBudgets",
+			"DescribeNotificationsForBudget",
+			"DescribeSubscribersForNotification",
+			"ExecuteBudgetAction",
+			"UpdateBudget",
+			"UpdateBudgetAction",
+			"UpdateNotification",
+			"UpdateSubscriber",
+		},
+	},
+	"cases": {
+		actions: []string{
+			"BatchGetField",
+			"BatchPutFieldOptions",
+			"CreateCase",
+			"CreateDomain",
+			"CreateField",
+			"CreateLayout",
+			"CreateRelatedItem",
+			"CreateTemplate",
+			"GetCase",
+			"GetCaseEventConfiguration",
+			"GetDomain",
+			"GetLayout",
+			"GetTemplate",
+			"ListCasesForContact",
+			"ListDomains",
+			"ListFieldOptions",
+			"ListFields",
+			"ListLayouts",
+			"ListTagsForResource",
+			"ListTemplates",
+			"PutCaseEventConfiguration",
+			"SearchCases",
+			"SearchRelatedItems",
+			"TagResource",
+			"UntagResource",
+			"UpdateCase",
+			"UpdateField",
+			"UpdateLayout",
+			"UpdateTemplate",
+		},
+	},
+	"cassandra": {
+		actions: []string{
+			"Alter",
+			"Create",
+			"CreateKeyspace",
+			"CreateTable",
+			"DeleteKeyspace",
+			"DeleteTable",
+			"Drop",
+			"GetKeyspace",
+			"GetTable",
+			"ListKeyspaces",
+			"ListTables",
+			"ListTagsForResource",
+			"Modify",
+			"Restore",
+			"RestoreTable",
+			"Select",
+			"TagResource",
+			"UntagResource",
+			"UpdatePartitioner",
+			"UpdateTable",
+		},
+	},
+	"ce": {
+		actions: []string{
+			"CreateAnomalyMonitor",
+			"CreateAnomalySubscription",
+			"CreateCostCategoryDefinition",
+			"DeleteAnomalyMonitor",
+			"DeleteAnomalySubscription",
+			"DeleteCostCategoryDefinition",
+			"DescribeCostCategoryDefinition",
+			"GetAnomalies",
+			"GetAnomalyMonitors",
+			"GetAnomalySubscriptions",
+			"GetCostAndUsage",
+			"GetCostAndUsageWithResources",
+			"GetCostCategories",
+			"GetCostForecast",
+			"GetDimensionValues",
+			"GetReservationCoverage",
+			"GetReservationPurchaseRecommendation",
+			"GetReservationUtilization",
+			"GetRightsizingRecommendation",
+			"GetSavingsPlansCoverage",
+			"GetSavingsPlansPurchaseRecommendation",
+			"GetSavingsPlansUtilization",
+			"GetSavingsPlansUtilizationDetails",
+			"GetTags",
+			"GetUsageForecast",
+			"ListCostAllocationTags",
+			"ListCostCategoryDefinitions",
+			"ListSavingsPlansPurchaseRecommendationGeneration",
+			"ListTagsForResource",
+			"ProvideAnomalyFeedback",
+			"StartSavingsPlansPurchaseRecommendationGeneration",
+			"TagResource",
+			"UntagResource",
+			"UpdateAnomalyMonitor",
+			"UpdateAnomalySubscription",
+			"UpdateCostAllocationTagsStatus",
+			"UpdateCostCategoryDefinition",
+		},
+	},
+	"chime": {
+		actions: []string{
+			"AssociateChannelFlow",
+			"AssociatePhoneNumbersWithVoiceConnector",
+			"AssociatePhoneNumbersWithVoiceConnectorGroup",
+			"AssociatePhoneNumberWithUser",
+			"AssociateSigninDelegateGroupsWithAccount",
+			"BatchCreateAttendee",
+			"BatchCreateChannelMembership",
+			"BatchCreateRoomMembership",
+			"BatchDeletePhoneNumber",
+			"BatchSuspendUser",
+			"BatchUnsuspendUser",
+			"BatchUpdateAttendeeCapabilitiesExcept",
+			"BatchUpdatePhoneNumber",
+			"BatchUpdateUser",
+			"ChannelFlowCallback",
+			"CreateAccount",
+			"CreateAppInstance",
+			"CreateAppInstanceAdmin",
+			"CreateAppInstanceUser",
+			"CreateAttendee",
+			"CreateBot",
+			"CreateChannel",
+			"CreateChannelBan",
+			"CreateChannelFlow",
+			"CreateChannelMembership",
+			"CreateChannelModerator",
+			"CreateMediaCapturePipeline",
+			"CreateMediaConcatenationPipeline",
+			"CreateMediaLiveConnectorPipeline",
+			"CreateMeeting",
+			"CreateMeetingDialOut",
+			"CreateMeetingWithAttendees",
+			"CreatePhoneNumberOrder",
+			"CreateProxySession",
+			"CreateRoom",
+			"CreateRoomMembership",
+			"CreateSipMediaApplication",
+			"CreateSipMediaApplicationCall",
+			"CreateSipRule",
+			"CreateUser",
+			"CreateVoiceConnector",
+			"CreateVoiceConnectorGroup",
+			"DeleteAccount",
+			"DeleteAppInstance",
+			"DeleteAppInstanceAdmin",
+			"DeleteAppInstanceStreamingConfigurations",
+			"DeleteAppInstanceUser",
+			"DeleteAttendee",
+			"DeleteChannel",
+			"DeleteChannelBan",
+			"DeleteChannelFlow",
+			"DeleteChannelMembership",
+			"DeleteChannelMessage",
+			"DeleteChannelModerator",
+			"DeleteEventsConfiguration",
+			"DeleteMediaCapturePipeline",
+			"DeleteMediaPipeline",
+			"DeleteMeeting",
+			"DeletePhoneNumber",
+			"DeleteProxySession",
+			"DeleteRoom",
+			"DeleteRoomMembership",
+			"DeleteSipMediaApplication",
+			"DeleteSipRule",
+			"DeleteVoiceConnector",
+			"DeleteVoiceConnectorEmergencyCallingConfiguration",
+			"DeleteVoiceConnectorGroup",
+			"DeleteVoiceConnectorOrigination",
+			"DeleteVoiceConnectorProxy",
+			"DeleteVoiceConnectorStreamingConfiguration",
+			"DeleteVoiceConnectorTermination",
+			"DeleteVoiceConnectorTerminationCredentials",
+			"DeregisterAppInstanceUserEndpoint",
+			"DescribeAppInstance",
+			"DescribeAppInstanceAdmin",
+			"DescribeAppInstanceUser",
+			"DescribeAppInstanceUserEndpoint",
+			"DescribeChannel",
+			"DescribeChannelBan",
+			"DescribeChannelFlow",
+			"DescribeChannelMembership",
+			"DescribeChannelMembershipForAppInstanceUser",
+			"DescribeChannelModeratedByAppInstanceUser",
+			"DescribeChannelModerator",
+			"DisassociateChannelFlow",
+			"DisassociatePhoneNumberFromUser",
+			"DisassociatePhoneNumbersFromVoiceConnector",
+			"DisassociatePhoneNumbersFromVoiceConnectorGroup",
+			"DisassociateSigninDelegateGroupsFromAccount",
+			"GetAccount",
+			"GetAccountSettings",
+			"GetAppInstanceRetentionSettings",
+			"GetAppInstanceStreamingConfigurations",
+			"GetAttendee",
+			"GetBot",
+			"GetChannelMembershipPreferences",
+			"GetChannelMessage",
+			"GetChannelMessageStatus",
+			"GetEventsConfiguration",
+			"GetGlobalSettings",
+			"GetMediaCapturePipeline",
+			"GetMediaPipeline",
+			"GetMeeting",
+			"GetMessagingSessionEndpoint",
+			"GetPhoneNumber",
+			"GetPhoneNumberOrder",
+			"GetPhoneNumberSettings",
+			"GetProxySession",
+			"GetRetentionSettings",
+			"GetRoom",
+			"GetSipMediaApplication",
+			"GetSipMediaApplicationAlexaSkillConfiguration",
+			"GetSipMediaApplicationLoggingConfiguration",
+			"GetSipRule",
+			"GetUser",
+			"GetUserSettings",
+			"GetVoiceConnector",
+			"GetVoiceConnectorEmergencyCallingConfiguration",
+			"GetVoiceConnectorGroup",
+			"GetVoiceConnectorLoggingConfiguration",
+			"GetVoiceConnectorOrigination",
+			"GetVoiceConnectorProxy",
+			"GetVoiceConnectorStreamingConfiguration",
+			"GetVoiceConnectorTermination",
+			"GetVoiceConnectorTerminationHealth",
+			"InviteUsers",
+			"ListAccounts",
+			"ListAppInstanceAdmins",
+			"ListAppInstances",
+			"ListAppInstanceUserEndpoints",
+			"ListAppInstanceUsers",
+			"ListAttendees",
+			"ListAttendeeTags",
+			"ListAvailableVoiceConnectorRegions",
+			"ListBots",
+			"ListChannelBans",
+			"ListChannelFlows",
+			"ListChannelMemberships",
+			"ListChannelMembershipsForAppInstanceUser",
+			"ListChannelMessages",
+			"ListChannelModerators",
+			"ListChannels",
+			"ListChannelsAssociatedWithChannelFlow",
+			"ListChannelsModeratedByAppInstanceUser",
+			"ListMediaCapturePipelines",
+			"ListMediaPipelines",
+			"ListMeetings",
+			"ListMeetingTags",
+			"ListPhoneNumberOrders",
+			"ListPhoneNumbers",
+			"ListProxySessions",
+			"ListRoomMemberships",
+			"ListRooms",
+			"ListSipMediaApplications",
+			"ListSipRules",
+			"ListSubChannels",
+			"ListSupportedPhoneNumberCountries",
+			"ListTagsForResource",
+			"ListUsers",
+			"ListVoiceConnectorGroups",
+			"ListVoiceConnectors",
+			"ListVoiceConnectorTerminationCredentials",
+			"LogoutUser",
+			"PutAppInstanceRetentionSettings",
+			"PutAppInstanceStreamingConfigurations",
+			"PutChannelMembershipPreferences",
+			"PutEventsConfiguration",
+			"PutRetentionSettings",
+			"PutSipMediaApplicationAlexaSkillConfiguration",
+			"PutSipMediaApplicationLoggingConfiguration",
+			"PutVoiceConnectorEmergencyCallingConfiguration",
+			"PutVoiceConnectorLoggingConfiguration",
+			"PutVoiceConnectorOrigination",
+			"PutVoiceConnectorProxy",
+			"PutVoiceConnectorStreamingConfiguration",
+			"PutVoiceConnectorTermination",
+			"PutVoiceConnectorTerminationCredentials",
+			"RedactChannelMessage",
+			"RedactConversationMessage",
+			"RedactRoomMessage",
+			"RegenerateSecurityToken",
+			"RegisterAppInstanceUserEndpoint",
+			"ResetPersonalPIN",
+			"RestorePhoneNumber",
+			"SearchAvailablePhoneNumbers",
+			"SearchChannels",
+			"SendChannelMessage",
+			"StartMeetingTranscription",
+			"StopMeetingTranscription",
+			"TagAttendee",
+			"TagMeeting",
+			"TagResource",
+			"UntagAttendee",
+			"UntagMeeting",
+			"UntagResource",
+			"UpdateAccount",
+			"UpdateAccountSettings",
+			"UpdateAppInstance",
+			"UpdateAppInstanceUser",
+			"UpdateAppInstanceUserEndpoint",
+			"UpdateAttendeeCapabilities",
+			"UpdateBot",
+			"UpdateChannel",
+			"UpdateChannelFlow",
+			"UpdateChannelMessage",
+			"UpdateChannelReadMarker",
+			"UpdateGlobalSettings",
+			"UpdatePhoneNumber",
+			"UpdatePhoneNumberSettings",
+			"UpdateProxySession",
+			"UpdateRoom",
+			"UpdateRoomMembership",
+			"UpdateSipMediaApplication",
+			"UpdateSipMediaApplicationCall",
+			"UpdateSipRule",
+			"UpdateUser",
+			"UpdateUserSettings",
+			"UpdateVoiceConnector",
+			"UpdateVoiceConnectorGroup",
+			"ValidateE911Address",
+		},
+	},
+	"cleanrooms": {
+		actions: []string{
+			"BatchGetSchema",
+			"CreateCollaboration",
+			"CreateConfiguredTable",
+			"CreateConfiguredTableAnalysisRule",
+			"CreateConfiguredTableAssociation",
+			"CreateMembership",
+			"DeleteCollaboration",
+			"DeleteConfiguredTable",
+			"DeleteConfiguredTableAnalysisRule",
+			"DeleteConfiguredTableAssociation",
+			"DeleteMember",
+			"DeleteMembership",
+			"GetCollaboration",
+			"GetConfiguredTable",
+			"GetConfiguredTableAnalysisRule",
+			"GetConfiguredTableAssociation",
+			"GetMembership",
+			"GetProtectedQuery",
+			"GetSchema",
+			"GetSchemaAnalysisRule",
+			"ListCollaborations",
+			"ListConfiguredTableAssociations",
+			"ListConfiguredTables",
+			"ListMembers",
+			"ListMemberships",
+			"ListProtectedQueries",
+			"ListSchemas",
+			"StartProtectedQuery",
+			"UpdateCollaboration",
+			"UpdateConfiguredTable",
+			"UpdateConfiguredTableAnalysisRule",
+			"UpdateConfiguredTableAssociation",
+			"UpdateMembership",
+			"UpdateProtectedQuery",
+		},
+	},
+	"cloud9": {
+		actions: []string{
+			"CreateEnvironmentEC2",
+			"CreateEnvironmentMembership",
+			"DeleteEnvironment",
+			"DeleteEnvironmentMembership",
+			"DescribeEnvironmentMemberships",
+			"DescribeEnvironments",
+			"DescribeEnvironmentStatus",
+			"ListEnvironments",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateEnvironment",
+			"UpdateEnvironmentMembership",
+		},
+	},
+	"cloudcontrolapi": {
+		actions: []string{
+			"CancelResourceRequest",
+			"CreateResource",
+			"DeleteResource",
+			"GetResource",
+			"GetResourceRequestStatus",
+			"ListResourceRequests",
+			"ListResources",
+			"UpdateResource",
+		},
+	},
+	"clouddirectory": {
+		actions: []string{
+			"AddFacetToObject",
+			"ApplySchema",
+			"AttachObject",
+			"AttachPolicy",
+			"AttachToIndex",
+			"AttachTypedLink",
+			"BatchRead",
+			"BatchWrite",
+			"CreateDirectory",
+			"CreateFacet",
+			"CreateIndex",
+			"CreateObject",
+			"CreateSchema",
+			"CreateTypedLinkFacet",
+			"DeleteDirectory",
+			"DeleteFacet",
+			"DeleteObject",
+			"DeleteSchema",
+			"DeleteTypedLinkFacet",
+			"DetachFromIndex",
+			"DetachObject",
+			"DetachPolicy",
+			"DetachTypedLink",
+			"DisableDirectory",
+			"EnableDirectory",
+			"GetAppliedSchemaVersion",
+			"GetDirectory",
+			"GetFacet",
+			"GetLinkAttributes",
+			"GetObjectAttributes",
+			"GetObjectInformation",
+			"GetSchemaAsJson",
+			"GetTypedLinkFacetInformation",
+			"ListAppliedSchemaArns",
+			"ListAttachedIndices",
+			"ListDevelopmentSchemaArns",
+			"ListDirectories",
+			"ListFacetAttributes",
+			"ListFacetNames",
+			"ListIncomingTypedLinks",
+			"ListIndex",
+			"ListManagedSchemaArns",
+			"ListObjectAttributes",
+			"ListObjectChildren",
+			"ListObjectParentPaths",
+			"ListObjectParents",
+			"ListObjectPolicies",
+			"ListOutgoingTypedLinks",
+			"ListPolicyAttachments",
+			"ListPublishedSchemaArns",
+			"ListTagsForResource",
+			"ListTypedLinkFacetAttributes",
+			"ListTypedLinkFacetNames",
+			"LookupPolicy",
+			"PublishSchema",
+			"PutSchemaFromJson",
+			"RemoveFacetFromObject",
+			"TagResource",
+			"UntagResource",
+			"UpdateFacet",
+			"UpdateLinkAttributes",
+			"UpdateObjectAttributes",
+			"UpdateSchema",
+			"UpdateTypedLinkFacet",
+			"UpgradeAppliedSchema",
+			"UpgradePublishedSchema",
+		},
+	},
+	"cloudformation": {
+		actions: []string{
+			"ActivateType",
+			"BatchDescribeTypeConfigurations",
+			"CancelUpdateStack",
+			"ContinueUpdateRollback",
+			"CreateChangeSet",
+			"CreateStack",
+			"CreateStackInstances",
+			"CreateStackSet",
+			"DeactivateType",
+			"DeleteChangeSet",
+			"DeleteStack",
+			"DeleteStackInstances",
+			"DeleteStackSet",
+			"DeregisterType",
+			"DescribeAccountLimits",
+			"DescribeChangeSet",
+			"DescribeChangeSetHooks",
+			"DescribePublisher",
+			"DescribeStackDriftDetectionStatus",
+			"DescribeStackEvents",
+			"DescribeStackInstance",
+			"DescribeStackResource",
+			"DescribeStackResourceDrifts",
+			"DescribeStackResources",
+			"DescribeStacks",
+			"DescribeStackSet",
+			"DescribeStackSetOperation",
+			"DescribeType",
+			"DescribeTypeRegistration",
+			"DetectStackDrift",
+			"DetectStackResourceDrift",
+			"DetectStackSetDrift",
+			"EstimateTemplateCost",
+			"ExecuteChangeSet",
+			"GetStackPolicy",
+			"GetTemplate",
+			"GetTemplateSummary",
+			"ImportStacksToStackSet",
+			"ListChangeSets",
+			"ListExports",
+			"ListImports",
+			"ListStackInstances",
+			"ListStackResources",
+			"ListStacks",
+			"ListStackSetOperationResults",
+			"ListStackSetOperations",
+			"ListStackSets",
+			"ListTypeRegistrations",
+			"ListTypes",
+			"ListTypeVersions",
+			"PublishType",
+			"RecordHandlerProgress",
+			"RegisterPublisher",
+			"RegisterType",
+			"RollbackStack",
+			"SetStackPolicy",
+			"SetTypeConfiguration",
+			"SetTypeDefaultVersion",
+			"SignalResource",
+			"StopStackSetOperation",
+			"TestType",
+			"UpdateStack",
+			"UpdateStackInstances",
+			"UpdateStackSet",
+			"UpdateTerminationProtection",
+			"ValidateTemplate",
+		},
+	},
+	"cloudfront": {
+		actions: []string{
+			"AssociateAlias",
+			"CopyDistribution",
+			"CreateCachePolicy",
+			"CreateCloudFrontOriginAccessIdentity",
+			"CreateContinuousDeploymentPolicy",
+			"CreateDistribution",
+			"CreateDistributionWithTags",
+			"CreateFieldLevelEncryptionConfig",
+			"CreateFieldLevelEncryptionProfile",
+			"CreateFunction",
+			"CreateInvalidation",
+			"CreateKeyGroup",
+			"CreateMonitoringSubscription",
+			"CreateOriginAccessControl",
+			"CreateOriginRequestPolicy",
+			"CreatePublicKey",
+			"CreateRealtimeLogConfig",
+			"CreateResponseHeadersPolicy",
+			"CreateStreamingDistribution",
+			"CreateStreamingDistributionWithTags",
+			"DeleteCachePolicy",
+			"DeleteCloudFrontOriginAccessIdentity",
+			"DeleteContinuousDeploymentPolicy",
+			"DeleteDistribution",
+			"DeleteFieldLevelEncryptionConfig",
+			"DeleteFieldLevelEncryptionProfile",
+			"DeleteFunction",
+			"DeleteKeyGroup",
+			"DeleteMonitoringSubscription",
+			"DeleteOriginAccessControl",
+			"DeleteOriginRequestPolicy",
+			"DeletePublicKey",
+			"DeleteRealtimeLogConfig",
+			"DeleteResponseHeadersPolicy",
+			"DeleteStreamingDistribution",
+			"DescribeFunction",
+			"GetCachePolicy",
+			"GetCachePolicyConfig",
+			"GetCloudFrontOriginAccessIdentity",
+			"GetCloudFrontOriginAccessIdentityConfig",
+			"GetContinuousDeploymentPolicy",
+			"GetContinuousDeploymentPolicyConfig",
+			"GetDistribution",
+			"GetDistributionConfig",
+			"GetFieldLevelEncryption",
+			"GetFieldLevelEncryptionConfig",
+			"GetFieldLevelEncryptionProfile",
+			"GetFieldLevelEncryptionProfileConfig",
+			"GetFunction",
+			"GetInvalidation",
+			"GetKeyGroup",
+			"GetKeyGroupConfig",
+			"GetMonitoringSubscription",
+			"GetOriginAccessControl",
+			"GetOriginAccessControlConfig",
+			"GetOriginRequestPolicy",
+			"GetOriginRequestPolicyConfig",
+			"GetPublicKey",
+			"GetPublicKeyConfig",
+			"GetRealtimeLogConfig",
+			"GetResponseHeadersPolicy",
+			"GetResponseHeadersPolicyConfig",
+			"GetStreamingDistribution",
+			"GetStreamingDistributionConfig",
+			"ListCachePolicies",
+			"ListCloudFrontOriginAccessIdentities",
+			"ListConflictingAliases",
+			"ListContinuousDeploymentPolicies",
+			"ListDistributions",
+			"ListDistributionsByCachePolicyId",
+			"ListDistributionsByKeyGroup",
+			"ListDistributionsByOriginRequestPolicyId",
+			"ListDistributionsByRealtimeLogConfig",
+			"ListDistributionsByResponseHeadersPolicyId",
+			"ListDistributionsByWebACLId",
+			"ListFieldLevelEncryptionConfigs",
+			"ListFieldLevelEncryptionProfiles",
+			"ListFunctions",
+			"ListInvalidations",
+			"ListKeyGroups",
+			"ListOriginAccessControls",
+			"ListOriginRequestPolicies",
+			"ListPublicKeys",
+			"ListRealtimeLogConfigs",
+			"ListResponseHeadersPolicies",
+			"ListStreamingDistributions",
+			"ListTagsForResource",
+			"PublishFunction",
+			"TagResource",
+			"TestFunction",
+			"UntagResource",
+			"UpdateCachePolicy",
+			"UpdateCloudFrontOriginAccessIdentity",
+			"UpdateContinuousDeploymentPolicy",
+			"UpdateDistribution",
+			"UpdateDistributionWithStagingConfig",
+			"UpdateFieldLevelEncryptionConfig",
+			"UpdateFieldLevelEncryptionProfile",
+			"UpdateFunction",
+			"UpdateKeyGroup",
+			"UpdateOriginAccessControl",
+			"UpdateOriginRequestPolicy",
+			"UpdatePublicKey",
+			"UpdateRealtimeLogConfig",
+			"UpdateResponseHeadersPolicy",
+			"UpdateStreamingDistribution",
+		},
+	},
+	"cloudhsm": {
+		actions: []string{
+			"AddTagsToResource",
+			"CopyBackupToRegion",
+			"CreateCluster",
+			"CreateHapg",
+			"CreateHsm",
+			"CreateLunaClient",
+			"DeleteBackup",
+			"DeleteCluster",
+			"DeleteHapg",
+			"DeleteHsm",
+			"DeleteLunaClient",
+			"DescribeBackups",
+			"DescribeClusters",
+			"DescribeHapg",
+			"DescribeHsm",
+			"DescribeLunaClient",
+			"GetConfig",
+			"InitializeCluster",
+			"ListAvailableZones",
+			"ListHapgs",
+			"ListHsms",
+			"ListLunaClients",
+			"ListTags",
+			"ListTagsForResource",
+			"ModifyBackupAttributes",
+			"ModifyCluster",
+			"ModifyHapg",
+			"ModifyHsm",
+			"ModifyLunaClient",
+			"RemoveTagsFromResource",
+			"RestoreBackup",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"cloudsearch": {
+		actions: []string{
+			"BuildSuggesters",
+			"CreateDomain",
+			"DefineAnalysisScheme",
+			"DefineExpression",
+			"DefineIndexField",
+			"DefineSuggester",
+			"DeleteAnalysisScheme",
+			"DeleteDomain",
+			"DeleteExpression",
+			"DeleteIndexField",
+			"DeleteSuggester",
+			"DescribeAnalysisSchemes",
+			"DescribeAvailabilityOptions",
+			"DescribeDomainEndpointOptions",
+			"DescribeDomains",
+			"DescribeExpressions",
+			"DescribeIndexFields",
+			"DescribeScalingParameters",
+			"DescribeServiceAccessPolicies",
+			"DescribeSuggesters",
+			"IndexDocuments",
+			"ListDomainNames",
+			"Search",
+			"Suggest",
+			"UpdateAvailabilityOptions",
+			"UpdateDomainEndpointOptions",
+			"UpdateScalingParameters",
+			"UpdateServiceAccessPolicies",
+			"UploadDocuments",
+		},
+	},
+	"cloudshell": {
+		actions: []string{
+			"CreateEnvironment",
+			"CreateSession",
+			"DeleteEnvironment",
+			"GetEnvironmentStatus",
+			"GetFileDownloadUrls",
+			"GetFileUploadUrls",
+			"PutCredentials",
+			"StartEnvironment",
+			"StopEnvironment",
+		},
+	},
+	"cloudtrail": {
+		actions: []string{
+			"AddTags",
+			"CancelQuery",
+			"CreateEventDataStore",
+			"CreateTrail",
+			"DeleteEventDataStore",
+			"DeleteTrail",
+			"DeregisterOrganizationDelegatedAdmin",
+			"DescribeQuery",
+			"DescribeTrails",
+			"GetChannel",
+			"GetEventDataStore",
+			"GetEventSelectors",
+			"GetImport",
+			"GetInsightSelectors",
+			"GetQueryResults",
+			"GetTrail",
+			"GetTrailStatus",
+			"ListChannels",
+			"ListEventDataStores",
+			"ListImportFailures",
+			"ListImports",
+			"ListPublicKeys",
+			"ListQueries",
+			"ListTags",
+			"ListTrails",
+			"LookupEvents",
+			"PutEventSelectors",
+			"PutInsightSelectors",
+			"RegisterOrganizationDelegatedAdmin",
+			"RemoveTags",
+			"RestoreEventDataStore",
+			"StartImport",
+			"StartLogging",
+			"StartQuery",
+			"StopImport",
+			"StopLogging",
+			"UpdateEventDataStore",
+			"UpdateTrail",
+		},
+	},
+	"cloudwatch": {
+		actions: []string{
+			"DeleteAlarms",
+			"DeleteAnomalyDetector",
+			"DeleteDashboards",
+			"DeleteInsightRules",
+			"DeleteMetricStream",
+			"DescribeAlarmHistory",
+			"DescribeAlarms",
+			"DescribeAlarmsForMetric",
+			"DescribeAnomalyDetectors",
+			"DescribeInsightRules",
+			"DisableAlarmActions",
+			"DisableInsightRules",
+			"EnableAlarmActions",
+			"EnableInsightRules",
+			"GetDashboard",
+			"GetInsightRuleReport",
+			"GetMetricData",
+			"GetMetricStatistics",
+			"GetMetricStream",
+			"GetMetricWidgetImage",
+			"Link",
+			"ListDashboards",
+			"ListManagedInsightRules",
+			"ListMetrics",
+			"ListMetricStreams",
+			"ListTagsForResource",
+			"PutAnomalyDetector",
+			"PutCompositeAlarm",
+			"PutDashboard",
+			"PutInsightRule",
+			"PutManagedInsightRules",
+			"PutMetricAlarm",
+			"PutMetricData",
+			"PutMetricStream",
+			"SetAlarmState",
+			"StartMetricStreams",
+			"StopMetricStreams",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"codeartifact": {
+		actions: []string{
+			"AssociateExternalConnection",
+			"CopyPackageVersions",
+			"CreateDomain",
+			"CreateRepository",
+			"DeleteDomain",
+			"DeleteDomainPermissionsPolicy",
+			"DeletePackageVersions",
+			"DeleteRepository",
+			"DeleteRepositoryPermissionsPolicy",
+			"DescribeDomain",
+			"DescribePackage",
+			"DescribePackageVersion",
+			"DescribeRepository",
+			"DisassociateExternalConnection",
+			"DisposePackageVersions",
+			"GetAuthorizationToken",
+			"GetDomainPermissionsPolicy",
+			"GetPackageVersionAsset",
+			"GetPackageVersionReadme",
+			"GetRepositoryEndpoint",
+			"GetRepositoryPermissionsPolicy",
+			"ListDomains",
+			"ListPackages",
+			"ListPackageVersionAssets",
+			"ListPackageVersionDependencies",
+			"ListPackageVersions",
+			"ListRepositories",
+			"ListRepositoriesInDomain",
+			"ListTagsForResource",
+			"PutDomainPermissionsPolicy",
+			"PutPackageOriginConfiguration",
+			"PutRepositoryPermissionsPolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdatePackageVersionsStatus",
+			"UpdateRepository",
+		},
+	},
+	"codebuild": {
+		actions: []string{
+			"BatchDeleteBuilds",
+			"BatchGetBuildBatches",
+			"BatchGetBuilds",
+			"BatchGetProjects",
+			"BatchGetReportGroups",
+			"BatchGetReports",
+			"BatchPutCodeCoverages",
+			"BatchPutTestCases",
+			"CreateProject",
+			"CreateReport",
+			"CreateReportGroup",
+			"CreateWebhook",
+			"DeleteBuildBatch",
+			"DeleteProject",
+			"DeleteReport",
+			"DeleteReportGroup",
+			"DeleteResourcePolicy",
+			"DeleteSourceCredentials",
+			"DeleteWebhook",
+			"DescribeCodeCoverages",
+			"DescribeTestCases",
+			"GetReportGroupTrend",
+			"GetResourcePolicy",
+			"ImportSourceCredentials",
+			"InvalidateProjectCache",
+			"ListBuildBatches",
+			"ListBuildBatchesForProject",
+			"ListBuilds",
+			"ListBuildsForProject",
+			"ListCuratedEnvironmentImages",
+			"ListProjects",
+			"ListReportGroups",
+			"ListReports",
+			"ListReportsForReportGroup",
+			"ListSharedProjects",
+			"ListSharedReportGroups",
+			"ListSourceCredentials",
+			"PutResourcePolicy",
+			"RetryBuild",
+			"RetryBuildBatch",
+			"StartBuild",
+			"StartBuildBatch",
+			"StopBuild",
+			"StopBuildBatch",
+			"UpdateProject",
+			"UpdateProjectVisibility",
+			"UpdateReport",
+			"UpdateReportGroup",
+			"UpdateWebhook",
+		},
+	},
+	"codecommit": {
+		actions: []string{
+			"AssociateApprovalRuleTemplateWithRepository",
+			"BatchAssociateApprovalRuleTemplateWithRepositories",
+			"BatchDescribeMergeConflicts",
+			"BatchDisassociateApprovalRuleTemplateFromRepositories",
+			"BatchGetCommits",
+			"BatchGetPullRequests",
+			"BatchGetRepositories",
+			"CancelUploadArchive",
+			"CreateApprovalRuleTemplate",
+			"CreateBranch",
+			"CreateCommit",
+			"CreatePullRequest",
+			"CreatePullRequestApprovalRule",
+			"CreateRepository",
+			"CreateUnreferencedMergeCommit",
+			"DeleteApprovalRuleTemplate",
+			"DeleteBranch",
+			"DeleteCommentContent",
+			"DeleteFile",
+			"DeletePullRequestApprovalRule",
+			"DeleteRepository",
+			"DescribeMergeConflicts",
+			"DescribePullRequestEvents",
+			"DisassociateApprovalRuleTemplateFromRepository",
+			"EvaluatePullRequestApprovalRules",
+			"GetApprovalRuleTemplate",
+			"GetBlob",
+			"GetBranch",
+			"GetComment",
+			"GetCommentReactions",
+			"GetCommentsForComparedCommit",
+			"GetCommentsForPullRequest",
+			"GetCommit",
+			"GetCommitHistory",
+			"GetCommitsFromMergeBase",
+			"GetDifferences",
+			"GetFile",
+			"GetFolder",
+			"GetMergeCommit",
+			"GetMergeConflicts",
+			"GetMergeOptions",
+			"GetObjectIdentifier",
+			"GetPullRequest",
+			"GetPullRequestApprovalStates",
+			"GetPullRequestOverrideState",
+			"GetReferences",
+			"GetRepository",
+			"GetRepositoryTriggers",
+			"GetTree",
+			"GetUploadArchiveStatus",
+			"GitPull",
+			"GitPush",
+			"ListApprovalRuleTemplates",
+			"ListAssociatedApprovalRuleTemplatesForRepository",
+			"ListBranches",
+			"ListPullRequests",
+			"ListRepositories",
+			"ListRepositoriesForApprovalRuleTemplate",
+			"ListTagsForResource",
+			"MergeBranchesByFastForward",
+			"MergeBranchesBySquash",
+			"MergeBranchesByThreeWay",
+			"MergePullRequestByFastForward",
+			"MergePullRequestBySquash",
+			"MergePullRequestByThreeWay",
+			"OverridePullRequestApprovalRules",
+			"PostCommentForComparedCommit",
+			"PostCommentForPullRequest",
+			"PostCommentReply",
+			"PutCommentReaction",
+			"PutFile",
+			"PutRepositoryTriggers",
+			"TagResource",
+			"TestRepositoryTriggers",
+			"UntagResource",
+			"UpdateApprovalRuleTemplateContent",
+			"UpdateApprovalRuleTemplateDescription",
+			"UpdateApprovalRuleTemplateName",
+			"UpdateComment",
+			"UpdateDefaultBranch",
+			"UpdatePullRequestApprovalRuleContent",
+			"UpdatePullRequestApprovalState",
+			"UpdatePullRequestDescription",
+			"UpdatePullRequestStatus",
+			"UpdatePullRequestTitle",
+			"UpdateRepositoryDescription",
+			"UpdateRepositoryName",
+			"UploadArchive",
+		},
+	},
+	"codedeploy": {
+		actions: []string{
+			"AddTagsToOnPremisesInstances",
+			"BatchGetApplicationRevisions",
+			"BatchGetApplications",
+			"BatchGetDeploymentGroups",
+			"BatchGetDeploymentInstances",
+			"BatchGetDeployments",
+			"BatchGetDeploymentTargets",
+			"BatchGetOnPremisesInstances",
+			"ContinueDeployment",
+			"CreateApplication",
+			"CreateDeployment",
+			"CreateDeploymentConfig",
+			"CreateDeploymentGroup",
+			"DeleteApplication",
+			"DeleteDeploymentConfig",
+			"DeleteDeploymentGroup",
+			"DeleteGitHubAccountToken",
+			"DeleteResourcesByExternalId",
+			"DeregisterOnPremisesInstance",
+			"GetApplication",
+			"GetApplicationRevision",
+			"GetDeployment",
+			"GetDeploymentConfig",
+			"GetDeploymentGroup",
+			"GetDeploymentInstance",
+			"GetDeploymentTarget",
+			"GetOnPremisesInstance",
+			"ListApplicationRevisions",
+			"ListApplications",
+			"ListDeploymentConfigs",
+			"ListDeploymentGroups",
+			"ListDeploymentInstances",
+			"ListDeployments",
+			"ListDeploymentTargets",
+			"ListGitHubAccountTokenNames",
+			"ListOnPremisesInstances",
+			"ListTagsForResource",
+			"PutLifecycleEventHookExecutionStatus",
+			"RegisterApplicationRevision",
+			"RegisterOnPremisesInstance",
+			"RemoveTagsFromOnPremisesInstances",
+			"SkipWaitTimeForInstanceTermination",
+			"StopDeployment",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateDeploymentGroup",
+		},
+	},
+	"codeguru-profiler": {
+		actions: []string{
+			"AddNotificationChannels",
+			"BatchGetFrameMetricData",
+			"ConfigureAgent",
+			"CreateProfilingGroup",
+			"DeleteProfilingGroup",
+			"DescribeProfilingGroup",
+			"GetFindingsReportAccountSummary",
+			"GetNotificationConfiguration",
+			"GetPolicy",
+			"GetProfile",
+			"GetRecommendations",
+			"ListFindingsReports",
+			"ListProfileTimes",
+			"ListProfilingGroups",
+			"ListTagsForResource",
+			"PostAgentProfile",
+			"PutPermission",
+			"RemoveNotificationChannel",
+			"RemovePermission",
+			"SubmitFeedback",
+			"TagResource",
+			"UntagResource",
+			"UpdateProfilingGroup",
+		},
+	},
+	"codeguru-reviewer": {
+		actions: []string{
+			"AssociateRepository",
+			"CreateCodeReview",
+			"DescribeCodeReview",
+			"DescribeRecommendationFeedback",
+			"DescribeRepositoryAssociation",
+			"DisassociateRepository",
+			"ListCodeReviews",
+			"ListRecommendationFeedback",
+			"ListRecommendations",
+			"ListRepositoryAssociations",
+			"ListTagsForResource",
+			"PutRecommendationFeedback",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"codepipeline": {
+		actions: []string{
+			"AcknowledgeJob",
+			"AcknowledgeThirdPartyJob",
+			"CreateCustomActionType",
+			"CreatePipeline",
+			"DeleteCustomActionType",
+			"DeletePipeline",
+			"DeleteWebhook",
+			"DeregisterWebhookWithThirdParty",
+			"DisableStageTransition",
+			"EnableStageTransition",
+			"GetActionType",
+			"GetJobDetails",
+			"GetPipeline",
+			"GetPipelineExecution",
+			"GetPipelineState",
+			"GetThirdPartyJobDetails",
+			"ListActionExecutions",
+			"ListActionTypes",
+			"ListPipelineExecutions",
+			"ListPipelines",
+			"ListTagsForResource",
+			"ListWebhooks",
+			"PollForJobs",
+			"PollForThirdPartyJobs",
+			"PutActionRevision",
+			"PutApprovalResult",
+			"PutJobFailureResult",
+			"PutJobSuccessResult",
+			"PutThirdPartyJobFailureResult",
+			"PutThirdPartyJobSuccessResult",
+			"PutWebhook",
+			"RegisterWebhookWithThirdParty",
+			"RetryStageExecution",
+			"StartPipelineExecution",
+			"StopPipelineExecution",
+			"TagResource",
+			"UntagResource",
+			"UpdateActionType",
+			"UpdatePipeline",
+		},
+	},
+	"codestar": {
+		actions: []string{
+			"AssociateTeamMember",
+			"CreateProject",
+			"CreateUserProfile",
+			"DeleteProject",
+			"DeleteUserProfile",
+			"DescribeProject",
+			"DescribeUserProfile",
+			"DisassociateTeamMember",
+			"ListProjects",
+			"ListResources",
+			"ListTagsForProject",
+			"ListTeamMembers",
+			"ListUserProfiles",
+			"TagProject",
+			"UntagProject",
+			"UpdateProject",
+			"UpdateTeamMember",
+			"UpdateUserProfile",
+		},
+	},
+	"codestar-connections": {
+		actions: []string{
+			"CreateConnection",
+			"CreateHost",
+			"DeleteConnection",
+			"DeleteHost",
+			"GetConnection",
+			"GetHost",
+			"GetIndividualAccessToken",
+			"GetInstallationUrl",
+			"ListConnections",
+			"ListHosts",
+			"ListInstallationTargets",
+			"ListTagsForResource",
+			"PassConnection",
+			"RegisterAppCode",
+			"StartAppRegistrationHandshake",
+			"StartOAuthHandshake",
+			"TagResource",
+			"UntagResource",
+			"UpdateConnectionInstallation",
+			"UpdateHost",
+			"UseConnection",
+		},
+	},
+	"codestar-notifications": {
+		actions: []string{
+			"CreateNotificationRule",
+			"DeleteNotificationRule",
+			"DeleteTarget",
+			"DescribeNotificationRule",
+			"ListEventTypes",
+			"ListNotificationRules",
+			"ListTagsForResource",
+			"ListTargets",
+			"Subscribe",
+			"TagResource",
+			"Unsubscribe",
+			"UntagResource",
+			"UpdateNotificationRule",
+		},
+	},
+	"cognito-identity": {
+		actions: []string{
+			"CreateIdentityPool",
+			"DeleteIdentities",
+			"DeleteIdentityPool",
+			"DescribeIdentity",
+			"DescribeIdentityPool",
+			"GetCredentialsForIdentity",
+			"GetId",
+			"GetIdentityPoolRoles",
+			"GetOpenIdToken",
+			"GetOpenIdTokenForDeveloperIdentity",
+			"GetPrincipalTagAttributeMap",
+			"ListIdentities",
+			"ListIdentityPools",
+			"ListTagsForResource",
+			"LookupDeveloperIdentity",
+			"MergeDeveloperIdentities",
+			"SetIdentityPoolRoles",
+			"SetPrincipalTagAttributeMap",
+			"TagResource",
+			"UnlinkDeveloperIdentity",
+			"UnlinkIdentity",
+			"UntagResource",
+			"UpdateIdentityPool",
+		},
+	},
+	"cognito-idp": {
+		actions: []string{
+			"AddCustomAttributes",
+			"AdminAddUserToGroup",
+			"AdminConfirmSignUp",
+			"AdminCreateUser",
+			"AdminDeleteUser",
+			"AdminDeleteUserAttributes",
+			"AdminDisableProviderForUser",
+			"AdminDisableUser",
+			"AdminEnableUser",
+			"AdminForgetDevice",
+			"AdminGetDevice",
+			"AdminGetUser",
+			"AdminInitiateAuth",
+			"AdminLinkProviderForUser",
+			"AdminListDevices",
+			"AdminListGroupsForUser",
+			"AdminListUserAuthEvents",
+			"AdminRemoveUserFromGroup",
+			"AdminResetUserPassword",
+			"AdminRespondToAuthChallenge",
+			"AdminSetUserMFAPreference",
+			"AdminSetUserPassword",
+			"AdminSetUserSettings",
+			"AdminUpdateAuthEventFeedback",
+			"AdminUpdateDeviceStatus",
+			"AdminUpdateUserAttributes",
+			"AdminUserGlobalSignOut",
+			"AssociateSoftwareToken",
+			"ChangePassword",
+			"ConfirmDevice",
+			"ConfirmForgotPassword",
+			"ConfirmSignUp",
+			"CreateGroup",
+			"CreateIdentityProvider",
+			"CreateResourceServer",
+			"CreateUserImportJob",
+			"CreateUserPool",
+			"CreateUserPoolClient",
+			"CreateUserPoolDomain",
+			"DeleteGroup",
+			"DeleteIdentityProvider",
+			"DeleteResourceServer",
+			"DeleteUser",
+			"DeleteUserAttributes",
+			"DeleteUserPool",
+			"DeleteUserPoolClient",
+			"DeleteUserPoolDomain",
+			"DescribeIdentityProvider",
+			"DescribeResourceServer",
+			"DescribeRiskConfiguration",
+			"DescribeUserImportJob",
+			"DescribeUserPool",
+			"DescribeUserPoolClient",
+			"DescribeUserPoolDomain",
+			"ForgetDevice",
+			"ForgotPassword",
+			"GetCSVHeader",
+			"GetDevice",
+			"GetGroup",
+			"GetIdentityProviderByIdentifier",
+			"GetSigningCertificate",
+			"GetUICustomization",
+			"GetUser",
+			"GetUserAttributeVerificationCode",
+			"GetUserPoolMfaConfig",
+			"GlobalSignOut",
+			"InitiateAuth",
+			"ListDevices",
+			"ListGroups",
+			"ListIdentityProviders",
+			"ListResourceServers",
+			"ListTagsForResource",
+			"ListUserImportJobs",
+			"ListUserPoolClients",
+			"ListUserPools",
+			"ListUsers",
+			"ListUsersInGroup",
+			"ResendConfirmationCode",
+			"RespondToAuthChallenge",
+			"RevokeToken",
+			"SetRiskConfiguration",
+			"SetUICustomization",
+			"SetUserMFAPreference",
+			"SetUserPoolMfaConfig",
+			"SetUserSettings",
+			"SignUp",
+			"StartUserImportJob",
+			"StopUserImportJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateAuthEventFeedback",
+			"UpdateDeviceStatus",
+			"UpdateGroup",
+			"UpdateIdentityProvider",
+			"UpdateResourceServer",
+			"UpdateUserAttributes",
+			"UpdateUserPool",
+			"UpdateUserPoolClient",
+			"UpdateUserPoolDomain",
+			"VerifySoftwareToken",
+			"VerifyUserAttribute",
+		},
+	},
+	"cognito-sync": {
+		actions: []string{
+			"BulkPublish",
+			"DeleteDataset",
+			"DescribeDataset",
+			"DescribeIdentityPoolUsage",
+			"DescribeIdentityUsage",
+			"GetBulkPublishDetails",
+			"GetCognitoEvents",
+			"GetIdentityPoolConfiguration",
+			"ListDatasets",
+			"ListIdentityPoolUsage",
+			"ListRecords",
+			"RegisterDevice",
+			"SetCognitoEvents",
+			"SetIdentityPoolConfiguration",
+			"SubscribeToDataset",
+			"UnsubscribeFromDataset",
+			"UpdateRecords",
+		},
+	},
+	"comprehend": {
+		actions: []string{
+			"BatchDetectDominantLanguage",
+			"BatchDetectEntities",
+			"BatchDetectKeyPhrases",
+			"BatchDetectSentiment",
+			"BatchDetectSyntax",
+			"BatchDetectTargetedSentiment",
+			"ClassifyDocument",
+			"ContainsPiiEntities",
+			"CreateDocumentClassifier",
+			"CreateEndpoint",
+			"CreateEntityRecognizer",
+			"DeleteDocumentClassifier",
+			"DeleteEndpoint",
+			"DeleteEntityRecognizer",
+			"DeleteResourcePolicy",
+			"DescribeDocumentClassificationJob",
+			"DescribeDocumentClassifier",
+			"DescribeDominantLanguageDetectionJob",
+			"DescribeEndpoint",
+			"DescribeEntitiesDetectionJob",
+			"DescribeEntityRecognizer",
+			"DescribeEventsDetectionJob",
+			"DescribeKeyPhrasesDetectionJob",
+			"DescribePiiEntitiesDetectionJob",
+			"DescribeResourcePolicy",
+			"DescribeSentimentDetectionJob",
+			"DescribeTargetedSentimentDetectionJob",
+			"DescribeTopicsDetectionJob",
+			"DetectDominantLanguage",
+			"DetectEntities",
+			"DetectKeyPhrases",
+			"DetectPiiEntities",
+			"DetectSentiment",
+			"DetectSyntax",
+			"DetectTargetedSentiment",
+			"ImportModel",
+			"ListDocumentClassificationJobs",
+			"ListDocumentClassifiers",
+			"ListDocumentClassifierSummaries",
+			"ListDominantLanguageDetectionJobs",
+			"ListEndpoints",
+			"ListEntitiesDetectionJobs",
+			"ListEntityRecognizers",
+			"ListEntityRecognizerSummaries",
+			"ListEventsDetectionJobs",
+			"ListKeyPhrasesDetectionJobs",
+			"ListPiiEntitiesDetectionJobs",
+			"ListSentimentDetectionJobs",
+			"ListTagsForResource",
+			"ListTargetedSentimentDetectionJobs",
+			"ListTopicsDetectionJobs",
+			"PutResourcePolicy",
+			"StartDocumentClassificationJob",
+			"StartDominantLanguageDetectionJob",
+			"StartEntitiesDetectionJob",
+			"StartEventsDetectionJob",
+			"StartKeyPhrasesDetectionJob",
+			"StartPiiEntitiesDetectionJob",
+			"StartSentimentDetectionJob",
+			"StartTargetedSentimentDetectionJob",
+			"StartTopicsDetectionJob",
+			"StopDominantLanguageDetectionJob",
+			"StopEntitiesDetectionJob",
+			"StopEventsDetectionJob",
+			"StopKeyPhrasesDetectionJob",
+			"StopPiiEntitiesDetectionJob",
+			"StopSentimentDetectionJob",
+			"StopTargetedSentimentDetectionJob",
+			"StopTrainingDocumentClassifier",
+			"StopTrainingEntityRecognizer",
+			"TagResource",
+			"UntagResource",
+			"UpdateEndpoint",
+		},
+	},
+	"comprehendmedical": {
+		actions: []string{
+			"DescribeEntitiesDetectionV2Job",
+			"DescribeICD10CMInferenceJob",
+			"DescribePHIDetectionJob",
+			"DescribeRxNormInferenceJob",
+			"DescribeSNOMEDCTInferenceJob",
+			"DetectEntities",
+			"DetectEntitiesV2",
+			"DetectPHI",
+			"InferICD10CM",
+			"InferRxNorm",
+			"InferSNOMEDCT",
+			"ListEntitiesDetectionV2Jobs",
+			"ListICD10CMInferenceJobs",
+			"ListPHIDetectionJobs",
+			"ListRxNormInferenceJobs",
+			"ListSNOMEDCTInferenceJobs",
+			"StartEntitiesDetectionV2Job",
+			"StartICD10CMInferenceJob",
+			"StartPHIDetectionJob",
+			"StartRxNormInferenceJob",
+			"StartSNOMEDCTInferenceJob",
+			"StopEntitiesDetectionV2Job",
+			"StopICD10CMInferenceJob",
+			"StopPHIDetectionJob",
+			"StopRxNormInferenceJob",
+			"StopSNOMEDCTInferenceJob",
+		},
+	},
+	"compute-optimizer": {
+		actions: []string{
+			"DeleteRecommendationPreferences",
+			"DescribeRecommendationExportJobs",
+			"ExportAutoScalingGroupRecommendations",
+			"ExportEBSVolumeRecommendations",
+			"ExportEC2InstanceRecommendations",
+			"ExportECSServiceRecommendations",
+			"ExportLambdaFunctionRecommendations",
+			"GetAutoScalingGroupRecommendations",
+			"GetEBSVolumeRecommendations",
+			"GetEC2InstanceRecommendations",
+			"GetEC2RecommendationProjectedMetrics",
+			"GetECSServiceRecommendationProjectedMetrics",
+			"GetECSServiceRecommendations",
+			"GetEffectiveRecommendationPreferences",
+			"GetEnrollmentStatus",
+			"GetEnrollmentStatusesForOrganization",
+			"GetLambdaFunctionRecommendations",
+			"GetRecommendationPreferences",
+			"GetRecommendationSummaries",
+			"PutRecommendationPreferences",
+			"UpdateEnrollmentStatus",
+		},
+	},
+	"config": {
+		actions: []string{
+			"BatchGetAggregateResourceConfig",
+			"BatchGetResourceConfig",
+			"DeleteAggregationAuthorization",
+			"DeleteConfigRule",
+			"DeleteConfigurationAggregator",
+			"DeleteConfigurationRecorder",
+			"DeleteConformancePack",
+			"DeleteDeliveryChannel",
+			"DeleteEvaluationResults",
+			"DeleteOrganizationConfigRule",
+			"DeleteOrganizationConformancePack",
+			"DeletePendingAggregationRequest",
+			"DeleteRemediationConfiguration",
+			"DeleteRemediationExceptions",
+			"DeleteResourceConfig",
+			"DeleteRetentionConfiguration",
+			"DeleteStoredQuery",
+			"DeliverConfigSnapshot",
+			"DescribeAggregateComplianceByConfigRules",
+			"DescribeAggregateComplianceByConformancePacks",
+			"DescribeAggregationAuthorizations",
+			"DescribeComplianceByConfigRule",
+			"DescribeComplianceByResource",
+			"DescribeConfigRuleEvaluationStatus",
+			"DescribeConfigRules",
+			"DescribeConfigurationAggregators",
+			"DescribeConfigurationAggregatorSourcesStatus",
+			"DescribeConfigurationRecorders",
+			"DescribeConfigurationRecorderStatus",
+			"DescribeConformancePackCompliance",
+			"DescribeConformancePacks",
+			"DescribeConformancePackStatus",
+			"DescribeDeliveryChannels",
+			"DescribeDeliveryChannelStatus",
+			"DescribeOrganizationConfigRules",
+			"DescribeOrganizationConfigRuleStatuses",
+			"DescribeOrganizationConformancePacks",
+			"DescribeOrganizationConformancePackStatuses",
+			"DescribePendingAggregationRequests",
+			"DescribeRemediationConfigurations",
+			"DescribeRemediationExceptions",
+			"DescribeRemediationExecutionStatus",
+			"DescribeRetentionConfigurations",
+			"GetAggregateComplianceDetailsByConfigRule",
+			"GetAggregateConfigRuleComplianceSummary",
+			"GetAggregateConformancePackComplianceSummary",
+			"GetAggregateDiscoveredResourceCounts",
+			"GetAggregateResourceConfig",
+			"GetComplianceDetailsByConfigRule",
+			"GetComplianceDetailsByResource",
+			"GetComplianceSummaryByConfigRule",
+			"GetComplianceSummaryByResourceType",
+			"GetConformancePackComplianceDetails",
+			"GetConformancePackComplianceSummary",
+			"GetCustomRulePolicy",
+			"GetDiscoveredResourceCounts",
+			"GetOrganizationConfigRuleDetailedStatus",
+			"GetOrganizationConformancePackDetailedStatus",
+			"GetOrganizationCustomRulePolicy",
+			"GetResourceConfigHistory",
+			"GetResourceEvaluationSummary",
+			"GetStoredQuery",
+			"ListAggregateDiscoveredResources",
+			"ListConformancePackComplianceScores",
+			"ListDiscoveredResources",
+			"ListResourceEvaluations",
+			"ListStoredQueries",
+			"ListTagsForResource",
+			"PutAggregationAuthorization",
+			"PutConfigRule",
+			"PutConfigurationAggregator",
+			"PutConfigurationRecorder",
+			"PutConformancePack",
+			"PutDeliveryChannel",
+			"PutEvaluations",
+			"PutExternalEvaluation",
+			"PutOrganizationConfigRule",
+			"PutOrganizationConformancePack",
+			"PutRemediationConfigurations",
+			"PutRemediationExceptions",
+			"PutResourceConfig",
+			"PutRetentionConfiguration",
+			"PutStoredQuery",
+			"SelectAggregateResourceConfig",
+			"SelectResourceConfig",
+			"StartConfigRulesEvaluation",
+			"StartConfigurationRecorder",
+			"StartRemediationExecution",
+			"StartResourceEvaluation",
+			"StopConfigurationRecorder",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"connect": {
+		actions: []string{
+			"AssociateApprovedOrigin",
+			"AssociateBot",
+			"AssociateDefaultVocabulary",
+			"AssociateInstanceStorageConfig",
+			"AssociateLambdaFunction",
+			"AssociateLexBot",
+			"AssociatePhoneNumberContactFlow",
+			"AssociateQueueQuickConnects",
+			"AssociateRoutingProfileQueues",
+			"AssociateSecurityKey",
+			"ClaimPhoneNumber",
+			"CreateAgentStatus",
+			"CreateContactFlow",
+			"CreateContactFlowModule",
+			"CreateHoursOfOperation",
+			"CreateInstance",
+			"CreateIntegrationAssociation",
+			"CreateQueue",
+			"CreateQuickConnect",
+			"CreateRoutingProfile",
+			"CreateRule",
+			"CreateSecurityProfile",
+			"CreateTaskTemplate",
+			"CreateTrafficDistributionGroup",
+			"CreateUseCase",
+			"CreateUser",
+			"CreateUserHierarchyGroup",
+			"CreateVocabulary",
+			"DeleteContactFlow",
+			"DeleteContactFlowModule",
+			"DeleteHoursOfOperation",
+			"DeleteInstance",
+			"DeleteIntegrationAssociation",
+			"DeleteQuickConnect",
+			"DeleteRule",
+			"DeleteSecurityProfile",
+			"DeleteTaskTemplate",
+			"DeleteTrafficDistributionGroup",
+			"DeleteUseCase",
+			"DeleteUser",
+			"DeleteUserHierarchyGroup",
+			"DeleteVocabulary",
+			"DescribeAgentStatus",
+			"DescribeContact",
+			"DescribeContactFlow",
+			"DescribeContactFlowModule",
+			"DescribeHoursOfOperation",
+			"DescribeInstance",
+			"DescribeInstanceAttribute",
+			"DescribeInstanceStorageConfig",
+			"DescribePhoneNumber",
+			"DescribeQueue",
+			"DescribeQuickConnect",
+			"DescribeRoutingProfile",
+			"DescribeRule",
+			"DescribeSecurityProfile",
+			"DescribeTrafficDistributionGroup",
+			"DescribeUser",
+			"DescribeUserHierarchyGroup",
+			"DescribeUserHierarchyStructure",
+			"DescribeVocabulary",
+			"DisassociateApprovedOrigin",
+			"DisassociateBot",
+			"DisassociateInstanceStorageConfig",
+			"DisassociateLambdaFunction",
+			"DisassociateLexBot",
+			"DisassociatePhoneNumberContactFlow",
+			"DisassociateQueueQuickConnects",
+			"DisassociateRoutingProfileQueues",
+			"DisassociateSecurityKey",
+			"DismissUserContact",
+			"GetContactAttributes",
+			"GetCurrentMetricData",
+			"GetCurrentUserData",
+			"GetFederationToken",
+			"GetMetricData",
+			"GetTaskTemplate",
+			"GetTrafficDistribution",
+			"ListAgentStatuses",
+			"ListApprovedOrigins",
+			"ListBots",
+			"ListContactFlowModules",
+			"ListContactFlows",
+			"ListContactReferences",
+			"ListDefaultVocabularies",
+			"ListHoursOfOperations",
+			"ListInstanceAttributes",
+			"ListInstances",
+			"ListInstanceStorageConfigs",
+			"ListIntegrationAssociations",
+			"ListLambdaFunctions",
+			"ListLexBots",
+			"ListPhoneNumbers",
+			"ListPhoneNumbersV2",
+			"ListPrompts",
+			"ListQueueQuickConnects",
+			"ListQueues",
+			"ListQuickConnects",
+			"ListRealtimeContactAnalysisSegments",
+			"ListRoutingProfileQueues",
+			"ListRoutingProfiles",
+			"ListRules",
+			"ListSecurityKeys",
+			"ListSecurityProfilePermissions",
+			"ListSecurityProfiles",
+			"ListTagsForResource",
+			"ListTaskTemplates",
+			"ListTrafficDistributionGroups",
+			"ListUseCases",
+			"ListUserHierarchyGroups",
+			"ListUsers",
+			"MonitorContact",
+			"PutUserStatus",
+			"ReleasePhoneNumber",
+			"ReplicateInstance",
+			"ResumeContactRecording",
+			"SearchAvailablePhoneNumbers",
+			"SearchQueues",
+			"SearchRoutingProfiles",
+			"SearchSecurityProfiles",
+			"SearchUsers",
+			"SearchVocabularies",
+			"StartChatContact",
+			"StartContactRecording",
+			"StartContactStreaming",
+			"StartOutboundVoiceContact",
+			"StartTaskContact",
+			"StopContact",
+			"StopContactRecording",
+			"StopContactStreaming",
+			"SuspendContactRecording",
+			"TagResource",
+			"TransferContact",
+			"UntagResource",
+			"UpdateAgentStatus",
+			"UpdateContact",
+			"UpdateContactAttributes",
+			"UpdateContactFlowContent",
+			"UpdateContactFlowMetadata",
+			"UpdateContactFlowModuleContent",
+			"UpdateContactFlowModuleMetadata",
+			"UpdateContactFlowName",
+			"UpdateContactSchedule",
+			"UpdateHoursOfOperation",
+			"UpdateInstanceAttribute",
+			"UpdateInstanceStorageConfig",
+			"UpdateParticipantRoleConfig",
+			"UpdatePhoneNumber",
+			"UpdateQueueHoursOfOperation",
+			"UpdateQueueMaxContacts",
+			"UpdateQueueName",
+			"UpdateQueueOutboundCallerConfig",
+			"UpdateQueueStatus",
+			"UpdateQuickConnectConfig",
+			"UpdateQuickConnectName",
+			"UpdateRoutingProfileConcurrency",
+			"UpdateRoutingProfileDefaultOutboundQueue",
+			"UpdateRoutingProfileName",
+			"UpdateRoutingProfileQueues",
+			"UpdateRule",
+			"UpdateSecurityProfile",
+			"UpdateTaskTemplate",
+			"UpdateTrafficDistribution",
+			"UpdateUserHierarchy",
+			"UpdateUserHierarchyGroupName",
+			"UpdateUserHierarchyStructure",
+			"UpdateUserIdentityInfo",
+			"UpdateUserPhoneConfig",
+			"UpdateUserRoutingProfile",
+			"UpdateUserSecurityProfiles",
+		},
+	},
+	"connect-campaigns": {
+		actions: []string{
+			"CreateCampaign",
+			"DeleteCampaign",
+			"DeleteConnectInstanceConfig",
+			"DeleteInstanceOnboardingJob",
+			"DescribeCampaign",
+			"GetCampaignState",
+			"GetCampaignStateBatch",
+			"GetConnectInstanceConfig",
+			"GetInstanceOnboardingJobStatus",
+			"ListCampaigns",
+			"ListTagsForResource",
+			"PauseCampaign",
+			"PutDialRequestBatch",
+			"ResumeCampaign",
+			"StartCampaign",
+			"StartInstanceOnboardingJob",
+			"StopCampaign",
+			"TagResource",
+			"UntagResource",
+			"UpdateCampaignDialerConfig",
+			"UpdateCampaignName",
+			"UpdateCampaignOutboundCallConfig",
+		},
+	},
+	"controltower": {
+		actions: []string{
+			"DisableControl",
+			"EnableControl",
+			"GetControlOperation",
+			"ListEnabledControls",
+		},
+	},
+	"cur": {
+		actions: []string{
+			"DeleteReportDefinition",
+			"DescribeReportDefinitions",
+			"ModifyReportDefinition",
+			"PutReportDefinition",
+		},
+	},
+	"databrew": {
+		actions: []string{
+			"BatchDeleteRecipeVersion",
+			"CreateDataset",
+			"CreateProfileJob",
+			"CreateProject",
+			"CreateRecipe",
+			"CreateRecipeJob",
+			"CreateRuleset",
+			"CreateSchedule",
+			"DeleteDataset",
+			"DeleteJob",
+			"DeleteProject",
+			"DeleteRecipeVersion",
+			"DeleteRuleset",
+			"DeleteSchedule",
+			"DescribeDataset",
+			"DescribeJob",
+			"DescribeJobRun",
+			"DescribeProject",
+			"DescribeRecipe",
+			"DescribeRuleset",
+			"DescribeSchedule",
+			"ListDatasets",
+			"ListJobRuns",
+			"ListJobs",
+			"ListProjects",
+			"ListRecipes",
+			"ListRecipeVersions",
+			"ListRulesets",
+			"ListSchedules",
+			"ListTagsForResource",
+			"PublishRecipe",
+			"SendProjectSessionAction",
+			"StartJobRun",
+			"StartProjectSession",
+			"StopJobRun",
+			"TagResource",
+			"UntagResource",
+			"UpdateDataset",
+			"UpdateProfileJob",
+			"UpdateProject",
+			"UpdateRecipe",
+			"UpdateRecipeJob",
+			"UpdateRuleset",
+			"UpdateSchedule",
+		},
+	},
+	"dataexchange": {
+		actions: []string{
+			"CancelJob",
+			"CreateDataSet",
+			"CreateEventAction",
+			"CreateJob",
+			"CreateRevision",
+			"DeleteAsset",
+			"DeleteDataSet",
+			"DeleteEventAction",
+			"DeleteRevision",
+			"GetAsset",
+			"GetDataSet",
+			"GetEventAction",
+			"GetJob",
+			"GetRevision",
+			"ListDataSetRevisions",
+			"ListDataSets",
+			"ListEventActions",
+			"ListJobs",
+			"ListRevisionAssets",
+			"ListTagsForResource",
+			"RevokeRevision",
+			"SendApiAsset",
+			"StartJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateAsset",
+			"UpdateDataSet",
+			"UpdateEventAction",
+			"UpdateRevision",
+		},
+	},
+	"datapipeline": {
+		actions: []string{
+			"ActivatePipeline",
+			"AddTags",
+			"CreatePipeline",
+			"DeactivatePipeline",
+			"DeletePipeline",
+			"DescribeObjects",
+			"DescribePipelines",
+			"EvaluateExpression",
+			"GetPipelineDefinition",
+			"ListPipelines",
+			"PollForTask",
+			"PutPipelineDefinition",
+			"QueryObjects",
+			"RemoveTags",
+			"ReportTaskProgress",
+			"ReportTaskRunnerHeartbeat",
+			"SetStatus",
+			"SetTaskStatus",
+			"ValidatePipelineDefinition",
+		},
+	},
+	"datasync": {
+		actions: []string{
+			"CancelTaskExecution",
+			"CreateAgent",
+			"CreateLocationEfs",
+			"CreateLocationFsxLustre",
+			"CreateLocationFsxOntap",
+			"CreateLocationFsxOpenZfs",
+			"CreateLocationFsxWindows",
+			"CreateLocationHdfs",
+			"CreateLocationNfs",
+			"CreateLocationObjectStorage",
+			"CreateLocationS3",
+			"CreateLocationSmb",
+			"CreateTask",
+			"DeleteAgent",
+			"DeleteLocation",
+			"DeleteTask",
+			"DescribeAgent",
+			"DescribeLocationEfs",
+			"DescribeLocationFsxLustre",
+			"DescribeLocationFsxOntap",
+			"DescribeLocationFsxOpenZfs",
+			"DescribeLocationFsxWindows",
+			"DescribeLocationHdfs",
+			"DescribeLocationNfs",
+			"DescribeLocationObjectStorage",
+			"DescribeLocationS3",
+			"DescribeLocationSmb",
+			"DescribeTask",
+			"DescribeTaskExecution",
+			"ListAgents",
+			"ListLocations",
+			"ListTagsForResource",
+			"ListTaskExecutions",
+			"ListTasks",
+			"StartTaskExecution",
+			"TagResource",
+			"UntagResource",
+			"UpdateAgent",
+			"UpdateLocationHdfs",
+			"UpdateLocationNfs",
+			"UpdateLocationObjectStorage",
+			"UpdateLocationSmb",
+			"UpdateTask",
+			"UpdateTaskExecution",
+		},
+	},
+	"dax": {
+		actions: []string{
+			"CreateCluster",
+			"CreateParameterGroup",
+			"CreateSubnetGroup",
+			"DecreaseReplicationFactor",
+			"DeleteCluster",
+			"DeleteParameterGroup",
+			"DeleteSubnetGroup",
+			"DescribeClusters",
+			"DescribeDefaultParameters",
+			"DescribeEvents",
+			"DescribeParameterGroups",
+			"DescribeParameters",
+			"DescribeSubnetGroups",
+			"IncreaseReplicationFactor",
+			"ListTags",
+			"RebootNode",
+			"TagResource",
+			"UntagResource",
+			"UpdateCluster",
+			"UpdateParameterGroup",
+			"UpdateSubnetGroup",
+		},
+	},
+	"detective": {
+		actions: []string{
+			"AcceptInvitation",
+			"BatchGetGraphMemberDatasources",
+			"BatchGetMembershipDatasources",
+			"CreateGraph",
+			"CreateMembers",
+			"DeleteGraph",
+			"DeleteMembers",
+			"DescribeOrganizationConfiguration",
+			"DisableOrganizationAdminAccount",
+			"DisassociateMembership",
+			"EnableOrganizationAdminAccount",
+			"GetMembers",
+			"ListDatasourcePackages",
+			"ListGraphs",
+			"ListInvitations",
+			"ListMembers",
+			"ListOrganizationAdminAccounts",
+			"ListTagsForResource",
+			"RejectInvitation",
+			"StartMonitoringMember",
+			"TagResource",
+			"UntagResource",
+			"UpdateDatasourcePackages",
+			"UpdateOrganizationConfiguration",
+		},
+	},
+	"devicefarm": {
+		actions: []string{
+			"CreateDevicePool",
+			"CreateInstanceProfile",
+			"CreateNetworkProfile",
+			"CreateProject",
+			"CreateRemoteAccessSession",
+			"CreateTestGridProject",
+			"CreateTestGridUrl",
+			"CreateUpload",
+			"CreateVPCEConfiguration",
+			"DeleteDevicePool",
+			"DeleteInstanceProfile",
+			"DeleteNetworkProfile",
+			"DeleteProject",
+			"DeleteRemoteAccessSession",
+			"DeleteRun",
+			"DeleteTestGridProject",
+			"DeleteUpload",
+			"DeleteVPCEConfiguration",
+			"GetAccountSettings",
+			"GetDevice",
+			"GetDeviceInstance",
+			"GetDevicePool",
+			"GetDevicePoolCompatibility",
+			"GetInstanceProfile",
+			"GetJob",
+			"GetNetworkProfile",
+			"GetOfferingStatus",
+			"GetProject",
+			"GetRemoteAccessSession",
+			"GetRun",
+			"GetSuite",
+			"GetTest",
+			"GetTestGridProject",
+			"GetTestGridSession",
+			"GetUpload",
+			"GetVPCEConfiguration",
+			"InstallToRemoteAccessSession",
+			"ListArtifacts",
+			"ListDeviceInstances",
+			"ListDevicePools",
+			"ListDevices",
+			"ListInstanceProfiles",
+			"ListJobs",
+			"ListNetworkProfiles",
+			"ListOfferingPromotions",
+			"ListOfferings",
+			"ListOfferingTransactions",
+			"ListProjects",
+			"ListRemoteAccessSessions",
+			"ListRuns",
+			"ListSamples",
+			"ListSuites",
+			"ListTagsForResource",
+			"ListTestGridProjects",
+			"ListTestGridSessionActions",
+			"ListTestGridSessionArtifacts",
+			"ListTestGridSessions",
+			"ListTests",
+			"ListUniqueProblems",
+			"ListUploads",
+			"ListVPCEConfigurations",
+			"PurchaseOffering",
+			"RenewOffering",
+			"ScheduleRun",
+			"StopJob",
+			"StopRemoteAccessSession",
+			"StopRun",
+			"TagResource",
+			"UntagResource",
+			"UpdateDeviceInstance",
+			"UpdateDevicePool",
+			"UpdateInstanceProfile",
+			"UpdateNetworkProfile",
+			"UpdateProject",
+			"UpdateTestGridProject",
+			"UpdateUpload",
+			"UpdateVPCEConfiguration",
+		},
+	},
+	"devops-guru": {
+		actions: []string{
+			"AddNotificationChannel",
+			"DeleteInsight",
+			"DescribeAccountHealth",
+			"DescribeAccountOverview",
+			"DescribeAnomaly",
+			"DescribeEventSourcesConfig",
+			"DescribeFeedback",
+			"DescribeInsight",
+			"DescribeOrganizationHealth",
+			"DescribeOrganizationOverview",
+			"DescribeOrganizationResourceCollectionHealth",
+			"DescribeResourceCollectionHealth",
+			"DescribeServiceIntegration",
+			"GetCostEstimation",
+			"GetResourceCollection",
+			"ListAnomaliesForInsight",
+			"ListAnomalousLogGroups",
+			"ListEvents",
+			"ListInsights",
+			"ListMonitoredResources",
+			"ListNotificationChannels",
+			"ListOrganizationInsights",
+			"ListRecommendations",
+			"PutFeedback",
+			"RemoveNotificationChannel",
+			"SearchInsights",
+			"SearchOrganizationInsights",
+			"StartCostEstimation",
+			"UpdateEventSourcesConfig",
+			"UpdateResourceCollection",
+			"UpdateServiceIntegration",
+		},
+	},
+	"directconnect": {
+		actions: []string{
+			"AcceptDirectConnectGatewayAssociationProposal",
+			"AllocateConnectionOnInterconnect",
+			"AllocateHostedConnection",
+			"AllocatePrivateVirtualInterface",
+			"AllocatePublicVirtualInterface",
+			"AllocateTransitVirtualInterface",
+			"AssociateConnectionWithLag",
+			"AssociateHostedConnection",
+			"AssociateMacSecKey",
+			"AssociateVirtualInterface",
+			"ConfirmConnection",
+			"ConfirmCustomerAgreement",
+			"ConfirmPrivateVirtualInterface",
+			"ConfirmPublicVirtualInterface",
+			"ConfirmTransitVirtualInterface",
+			"CreateBGPPeer",
+			"CreateConnection",
+			"CreateDirectConnectGateway",
+			"CreateDirectConnectGatewayAssociation",
+			"CreateDirectConnectGatewayAssociationProposal",
+			"CreateInterconnect",
+			"CreateLag",
+			"CreatePrivateVirtualInterface",
+			"CreatePublicVirtualInterface",
+			"CreateTransitVirtualInterface",
+			"DeleteBGPPeer",
+			"DeleteConnection",
+			"DeleteDirectConnectGateway",
+			"DeleteDirectConnectGatewayAssociation",
+			"DeleteDirectConnectGatewayAssociationProposal",
+			"DeleteInterconnect",
+			"DeleteLag",
+			"DeleteVirtualInterface",
+			"DescribeConnectionLoa",
+			"DescribeConnections",
+			"DescribeConnectionsOnInterconnect",
+			"DescribeCustomerMetadata",
+			"DescribeDirectConnectGatewayAssociationProposals",
+			"DescribeDirectConnectGatewayAssociations",
+			"DescribeDirectConnectGatewayAttachments",
+			"DescribeDirectConnectGateways",
+			"DescribeHostedConnections",
+			"DescribeInterconnectLoa",
+			"DescribeInterconnects",
+			"DescribeLags",
+			"DescribeLoa",
+			"DescribeLocations",
+			"DescribeRouterConfiguration",
+			"DescribeTags",
+			"DescribeVirtualGateways",
+			"DescribeVirtualInterfaces",
+			"DisassociateConnectionFromLag",
+			"DisassociateMacSecKey",
+			"ListVirtualInterfaceTestHistory",
+			"StartBgpFailoverTest",
+			"StopBgpFailoverTest",
+			"TagResource",
+			"UntagResource",
+			"UpdateConnection",
+			"UpdateDirectConnectGateway",
+			"UpdateDirectConnectGatewayAssociation",
+			"UpdateLag",
+			"UpdateVirtualInterfaceAttributes",
+		},
+	},
+	"discovery": {
+		actions: []string{
+			"AssociateConfigurationItemsToApplication",
+			"BatchDeleteImportData",
+			"CreateApplication",
+			"CreateTags",
+			"DeleteApplications",
+			"DeleteTags",
+			"DescribeAgents",
+			"DescribeConfigurations",
+			"DescribeContinuousExports",
+			"DescribeExportConfigurations",
+			"DescribeExportTasks",
+			"DescribeImportTasks",
+			"DescribeTags",
+			"DisassociateConfigurationItemsFromApplication",
+			"ExportConfigurations",
+			"GetDiscoverySummary",
+			"ListConfigurations",
+			"ListServerNeighbors",
+			"StartContinuousExport",
+			"StartDataCollectionByAgentIds",
+			"StartExportTask",
+			"StartImportTask",
+			"StopContinuousExport",
+			"StopDataCollectionByAgentIds",
+			"UpdateApplication",
+		},
+	},
+	"dlm": {
+		actions: []string{
+			"CreateLifecyclePolicy",
+			"DeleteLifecyclePolicy",
+			"GetLifecyclePolicies",
+			"GetLifecyclePolicy",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateLifecyclePolicy",
+		},
+	},
+	"dms": {
+		actions: []string{
+			"AddTagsToResource",
+			"ApplyPendingMaintenanceAction",
+			"CancelReplicationTaskAssessmentRun",
+			"CreateEndpoint",
+			"CreateEventSubscription",
+			"CreateFleetAdvisorCollector",
+			"CreateReplicationInstance",
+			"CreateReplicationSubnetGroup",
+			"CreateReplicationTask",
+			"DeleteCertificate",
+			"DeleteConnection",
+			"DeleteEndpoint",
+			"DeleteEventSubscription",
+			"DeleteFleetAdvisorCollector",
+			"DeleteFleetAdvisorDatabases",
+			"DeleteReplicationInstance",
+			"DeleteReplicationSubnetGroup",
+			"DeleteReplicationTask",
+			"DeleteReplicationTaskAssessmentRun",
+			"DescribeAccountAttributes",
+			"DescribeApplicableIndividualAssessments",
+			"DescribeCertificates",
+			"DescribeConnections",
+			"DescribeEndpoints",
+			"DescribeEndpointSettings",
+			"DescribeEndpointTypes",
+			"DescribeEventCategories",
+			"DescribeEvents",
+			"DescribeEventSubscriptions",
+			"DescribeFleetAdvisorCollectors",
+			"DescribeFleetAdvisorDatabases",
+			"DescribeFleetAdvisorLsaAnalysis",
+			"DescribeFleetAdvisorSchemaObjectSummary",
+			"DescribeFleetAdvisorSchemas",
+			"DescribeOrderableReplicationInstances",
+			"DescribePendingMaintenanceActions",
+			"DescribeRefreshSchemasStatus",
+			"DescribeReplicationInstances",
+			"DescribeReplicationInstanceTaskLogs",
+			"DescribeReplicationSubnetGroups",
+			"DescribeReplicationTaskAssessmentResults",
+			"DescribeReplicationTaskAssessmentRuns",
+			"DescribeReplicationTaskIndividualAssessments",
+			"DescribeReplicationTasks",
+			"DescribeSchemas",
+			"DescribeTableStatistics",
+			"ImportCertificate",
+			"ListTagsForResource",
+			"ModifyEndpoint",
+			"ModifyEventSubscription",
+			"ModifyReplicationInstance",
+			"ModifyReplicationSubnetGroup",
+			"ModifyReplicationTask",
+			"MoveReplicationTask",
+			"RebootReplicationInstance",
+			"RefreshSchemas",
+			"ReloadTables",
+			"RemoveTagsFromResource",
+			"RunFleetAdvisorLsaAnalysis",
+			"StartReplicationTask",
+			"StartReplicationTaskAssessment",
+			"StartReplicationTaskAssessmentRun",
+			"StopReplicationTask",
+			"TestConnection",
+			"UpdateSubscriptionsToEventBridge",
+		},
+	},
+	"docdb-elastic": {
+		actions: []string{
+			"CreateCluster",
+			"CreateClusterSnapshot",
+			"DeleteCluster",
+			"DeleteClusterSnapshot",
+			"GetCluster",
+			"GetClusterSnapshot",
+			"ListClusters",
+			"ListClusterSnapshots",
+			"ListTagsForResource",
+			"RestoreClusterFromSnapshot",
+			"TagResource",
+			"UntagResource",
+			"UpdateCluster",
+		},
+	},
+	"drs": {
+		actions: []string{
+			"CreateExtendedSourceServer",
+			"CreateReplicationConfigurationTemplate",
+			"DeleteJob",
+			"DeleteRecoveryInstance",
+			"DeleteReplicationConfigurationTemplate",
+			"DeleteSourceServer",
+			"DescribeJobLogItems",
+			"DescribeJobs",
+			"DescribeRecoveryInstances",
+			"DescribeRecoverySnapshots",
+			"DescribeReplicationConfigurationTemplates",
+			"DescribeSourceServers",
+			"DisconnectRecoveryInstance",
+			"DisconnectSourceServer",
+			"GetFailbackReplicationConfiguration",
+			"GetLaunchConfiguration",
+			"GetReplicationConfiguration",
+			"InitializeService",
+			"ListExtensibleSourceServers",
+			"ListStagingAccounts",
+			"ListTagsForResource",
+			"RetryDataReplication",
+			"ReverseReplication",
+			"StartFailbackLaunch",
+			"StartRecovery",
+			"StartReplication",
+			"StopFailback",
+			"StopReplication",
+			"TagResource",
+			"TerminateRecoveryInstances",
+			"UntagResource",
+			"UpdateFailbackReplicationConfiguration",
+			"UpdateLaunchConfiguration",
+			"UpdateReplicationConfiguration",
+			"UpdateReplicationConfigurationTemplate",
+		},
+	},
+	"ds": {
+		actions: []string{
+			"AcceptSharedDirectory",
+			"AddIpRoutes",
+			"AddRegion",
+			"AddTagsToResource",
+			"CancelSchemaExtension",
+			"ConnectDirectory",
+			"CreateAlias",
+			"CreateComputer",
+			"CreateConditionalForwarder",
+			"CreateDirectory",
+			"CreateLogSubscription",
+			"CreateMicrosoftAD",
+			"CreateSnapshot",
+			"CreateTrust",
+			"DeleteConditionalForwarder",
+			"DeleteDirectory",
+			"DeleteLogSubscription",
+			"DeleteSnapshot",
+			"DeleteTrust",
+			"DeregisterCertificate",
+			"DeregisterEventTopic",
+			"DescribeCertificate",
+			"DescribeClientAuthenticationSettings",
+			"DescribeConditionalForwarders",
+			"DescribeDirectories",
+			"DescribeDomainControllers",
+			"DescribeEventTopics",
+			"DescribeLDAPSSettings",
+			"DescribeRegions",
+			"DescribeSettings",
+			"DescribeSharedDirectories",
+			"DescribeSnapshots",
+			"DescribeTrusts",
+			"DescribeUpdateDirectory",
+			"DisableClientAuthentication",
+			"DisableLDAPS",
+			"DisableRadius",
+			"DisableSso",
+			"EnableClientAuthentication",
+			"EnableLDAPS",
+			"EnableRadius",
+			"EnableSso",
+			"GetDirectoryLimits",
+			"GetSnapshotLimits",
+			"ListCertificates",
+			"ListIpRoutes",
+			"ListLogSubscriptions",
+			"ListSchemaExtensions",
+			"ListTagsForResource",
+			"RegisterCertificate",
+			"RegisterEventTopic",
+			"RejectSharedDirectory",
+			"RemoveIpRoutes",
+			"RemoveRegion",
+			"RemoveTagsFromResource",
+			"ResetUserPassword",
+			"RestoreFromSnapshot",
+			"ShareDirectory",
+			"StartSchemaExtension",
+			"UnshareDirectory",
+			"UpdateConditionalForwarder",
+			"UpdateDirectorySetup",
+			"UpdateNumberOfDomainControllers",
+			"UpdateRadius",
+			"UpdateSettings",
+			"UpdateTrust",
+			"VerifyTrust",
+		},
+	},
+	"dynamodb": {
+		actions: []string{
+			"BatchExecuteStatement",
+			"BatchGetItem",
+			"BatchWriteItem",
+			"ConditionCheckItem",
+			"CreateBackup",
+			"CreateGlobalTable",
+			"CreateTable",
+			"CreateTableReplica",
+			"DeleteBackup",
+			"DeleteItem",
+			"DeleteTable",
+			"DeleteTableReplica",
+			"DescribeBackup",
+			"DescribeContinuousBackups",
+			"DescribeContributorInsights",
+			"DescribeEndpoints",
+			"DescribeExport",
+			"DescribeGlobalTable",
+			"DescribeGlobalTableSettings",
+			"DescribeImport",
+			"DescribeKinesisStreamingDestination",
+			"DescribeLimits",
+			"DescribeStream",
+			"DescribeTable",
+			"DescribeTableReplicaAutoScaling",
+			"DescribeTimeToLive",
+			"DisableKinesisStreamingDestination",
+			"EnableKinesisStreamingDestination",
+			"ExecuteStatement",
+			"ExecuteTransaction",
+			"ExportTableToPointInTime",
+			"GetItem",
+			"GetRecords",
+			"GetShardIterator",
+			"ImportTable",
+			"ListBackups",
+			"ListContributorInsights",
+			"ListExports",
+			"ListGlobalTables",
+			"ListImports",
+			"ListStreams",
+			"ListTables",
+			"ListTagsOfResource",
+			"PartiQLDelete",
+			"PartiQLInsert",
+			"PartiQLSelect",
+			"PartiQLUpdate",
+			"PutItem",
+			"Query",
+			"ReplicateSettings",
+			"RestoreTableFromAwsBackup",
+			"RestoreTableFromBackup",
+			"RestoreTableToPointInTime",
+			"Scan",
+			"StartAwsBackupJob",
+			"TagResource",
+			"TransactGetItems",
+			"TransactWriteItems",
+			"UntagResource",
+			"UpdateContinuousBackups",
+			"UpdateContributorInsights",
+			"UpdateGlobalTable",
+			"UpdateGlobalTableSettings",
+			"UpdateItem",
+			"UpdateTable",
+			"UpdateTableReplicaAutoScaling",
+			"UpdateTimeToLive",
+		},
+	},
+	"ebs": {
+		actions: []string{
+			"CompleteSnapshot",
+			"GetSnapshotBlock",
+			"ListChangedBlocks",
+			"ListSnapshotBlocks",
+			"PutSnapshotBlock",
+			"StartSnapshot",
+		},
+	},
+	"ec2": {
+		actions: []string{
+			"AcceptAddressTransfer",
+			"AcceptReservedInstancesExchangeQuote",
+			"AcceptTransitGatewayMulticastDomainAssociations",
+			"AcceptTransitGatewayPeeringAttachment",
+			"AcceptTransitGatewayVpcAttachment",
+			"AcceptVpcEndpointConnections",
+			"AcceptVpcPeeringConnection",
+			"AdvertiseByoipCidr",
+			"AllocateAddress",
+			"AllocateHosts",
+			"AllocateIpamPoolCidr",
+			"ApplySecurityGroupsToClientVpnTargetNetwork",
+			"AssignIpv6Addresses",
+			"AssignPrivateIpAddresses",
+			"AssociateAddress",
+			"AssociateClientVpnTargetNetwork",
+			"AssociateDhcpOptions",
+			"AssociateEnclaveCertificateIamRole",
+			"AssociateIamInstanceProfile",
+			"AssociateInstanceEventWindow",
+			"AssociateRouteTable",
+			"AssociateSubnetCidrBlock",
+			"AssociateTransitGatewayMulticastDomain",
+			"AssociateTransitGatewayPolicyTable",
+			"AssociateTransitGatewayRouteTable",
+			"AssociateTrunkInterface",
+			"AssociateVpcCidrBlock",
+			"AttachClassicLinkVpc",
+			"AttachInternetGateway",
+			"AttachNetworkInterface",
+			"AttachVerifiedAccessTrustProvider",
+			"AttachVolume",
+			"AttachVpnGateway",
+			"AuthorizeClientVpnIngress",
+			"AuthorizeSecurityGroupEgress",
+			"AuthorizeSecurityGroupIngress",
+			"BundleInstance",
+			"CancelBundleTask",
+			"CancelCapacityReservation",
+			"CancelCapacityReservationFleets",
+			"CancelConversionTask",
+			"CancelExportTask",
+			"CancelImageLaunchPermission",
+			"CancelImportTask",
+			"CancelReservedInstancesListing",
+			"CancelSpotFleetRequests",
+			"CancelSpotInstanceRequests",
+			"ConfirmProductInstance",
+			"CopyFpgaImage",
+			"CopyImage",
+			"CopySnapshot",
+			"CreateCapacityReservation",
+			"CreateCapacityReservationFleet",
+			"CreateCarrierGateway",
+			"CreateClientVpnEndpoint",
+			"CreateClientVpnRoute",
+			"CreateCoipCidr",
+			"CreateCoipPool",
+			"CreateCustomerGateway",
+			"CreateDefaultSubnet",
+			"CreateDefaultVpc",
+			"CreateDhcpOptions",
+			"CreateEgressOnlyInternetGateway",
+			"CreateFleet",
+			"CreateFlowLogs",
+			"CreateFpgaImage",
+			"CreateImage",
+			"CreateInstanceEventWindow",
+			"CreateInstanceExportTask",
+			"CreateInternetGateway",
+			"CreateIpam",
+			"CreateIpamPool",
+			"CreateIpamScope",
+			"CreateKeyPair",
+			"CreateLaunchTemplate",
+			"CreateLaunchTemplateVersion",
+			"CreateLocalGatewayRoute",
+			"CreateLocalGatewayRouteTable",
+			"CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation",
+			"CreateLocalGatewayRouteTableVpcAssociation",
+			"CreateManagedPrefixList",
+			"CreateNatGateway",
+			"CreateNetworkAcl",
+			"CreateNetworkAclEntry",
+			"CreateNetworkInsightsAccessScope",
+			"CreateNetworkInsightsPath",
+			"CreateNetworkInterface",
+			"CreateNetworkInterfacePermission",
+			"CreatePlacementGroup",
+			"CreatePublicIpv4Pool",
+			"CreateReplaceRootVolumeTask",
+			"CreateReservedInstancesListing",
+			"CreateRestoreImageTask",
+			"CreateRoute",
+			"CreateRouteTable",
+			"CreateSecurityGroup",
+			"CreateSnapshot",
+			"CreateSnapshots",
+			"CreateSpotDatafeedSubscription",
+			"CreateStoreImageTask",
+			"CreateSubnet",
+			"CreateSubnetCidrReservation",
+			"CreateTags",
+			"CreateTrafficMirrorFilter",
+			"CreateTrafficMirrorFilterRule",
+			"CreateTrafficMirrorSession",
+			"CreateTrafficMirrorTarget",
+			"CreateTransitGateway",
+			"CreateTransitGatewayConnect",
+			"CreateTransitGatewayConnectPeer",
+			"CreateTransitGatewayMulticastDomain",
+			"CreateTransitGatewayPeeringAttachment",
+			"CreateTransitGatewayPolicyTable",
+			"CreateTransitGatewayPrefixListReference",
+			"CreateTransitGatewayRoute",
+			"CreateTransitGatewayRouteTable",
+			"CreateTransitGatewayRouteTableAnnouncement",
+			"CreateTransitGatewayVpcAttachment",
+			"CreateVerifiedAccessEndpoint",
+			"CreateVerifiedAccessGroup",
+			"CreateVerifiedAccessInstance",
+			"CreateVerifiedAccessTrustProvider",
+			"CreateVolume",
+			"CreateVpc",
+			"CreateVpcEndpoint",
+			"CreateVpcEndpointConnectionNotification",
+			"CreateVpcEndpointServiceConfiguration",
+			"CreateVpcPeeringConnection",
+			"CreateVpnConnection",
+			"CreateVpnConnectionRoute",
+			"CreateVpnGateway",
+			"DeleteCarrierGateway",
+			"DeleteClientVpnEndpoint",
+			"DeleteClientVpnRoute",
+			"DeleteCoipCidr",
+			"DeleteCoipPool",
+			"DeleteCustomerGateway",
+			"DeleteDhcpOptions",
+			"DeleteEgressOnlyInternetGateway",
+			"DeleteFleets",
+			"DeleteFlowLogs",
+			"DeleteFpgaImage",
+			"DeleteInstanceEventWindow",
+			"DeleteInternetGateway",
+			"DeleteIpam",
+			"DeleteIpamPool",
+			"DeleteIpamScope",
+			"DeleteKeyPair",
+			"DeleteLaunchTemplate",
+			"DeleteLaunchTemplateVersions",
+			"DeleteLocalGatewayRoute",
+			"DeleteLocalGatewayRouteTable",
+			"DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation",
+			"DeleteLocalGatewayRouteTableVpcAssociation",
+			"DeleteManagedPrefixList",
+			"DeleteNatGateway",
+			"DeleteNetworkAcl",
+			"DeleteNetworkAclEntry",
+			"DeleteNetworkInsightsAccessScope",
+			"DeleteNetworkInsightsAccessScopeAnalysis",
+			"DeleteNetworkInsightsAnalysis",
+			"DeleteNetworkInsightsPath",
+			"DeleteNetworkInterface",
+			"DeleteNetworkInterfacePermission",
+			"DeletePlacementGroup",
+			"DeletePublicIpv4Pool",
+			"DeleteQueuedReservedInstances",
+			"DeleteRoute",
+			"DeleteRouteTable",
+			"DeleteSecurityGroup",
+			"DeleteSnapshot",
+			"DeleteSpotDatafeedSubscription",
+			"DeleteSubnet",
+			"DeleteSubnetCidrReservation",
+			"DeleteTags",
+			"DeleteTrafficMirrorFilter",
+			"DeleteTrafficMirrorFilterRule",
+			"DeleteTrafficMirrorSession",
+			"DeleteTrafficMirrorTarget",
+			"DeleteTransitGateway",
+			"DeleteTransitGatewayConnect",
+			"DeleteTransitGatewayConnectPeer",
+			"DeleteTransitGatewayMulticastDomain",
+			"DeleteTransitGatewayPeeringAttachment",
+			"DeleteTransitGatewayPolicyTable",
+			"DeleteTransitGatewayPrefixListReference",
+			"DeleteTransitGatewayRoute",
+			"DeleteTransitGatewayRouteTable",
+			"DeleteTransitGatewayRouteTableAnnouncement",
+			"DeleteTransitGatewayVpcAttachment",
+			"DeleteVerifiedAccessEndpoint",
+			"DeleteVerifiedAccessGroup",
+			"DeleteVerifiedAccessInstance",
+			"DeleteVerifiedAccessTrustProvider",
+			"DeleteVolume",
+			"DeleteVpc",
+			"DeleteVpcEndpointConnectionNotifications",
+			"DeleteVpcEndpoints",
+			"DeleteVpcEndpointServiceConfigurations",
+			"DeleteVpcPeeringConnection",
+			"DeleteVpnConnection",
+			"DeleteVpnConnectionRoute",
+			"DeleteVpnGateway",
+			"DeprovisionByoipCidr",
+			"DeprovisionIpamPoolCidr",
+			"DeprovisionPublicIpv4PoolCidr",
+			"DeregisterImage",
+			"DeregisterInstanceEventNotificationAttributes",
+			"DeregisterTransitGatewayMulticastGroupMembers",
+			"DeregisterTransitGatewayMulticastGroupSources",
+			"DescribeAccountAttributes",
+			"DescribeAddresses",
+			"DescribeAddressesAttribute",
+			"DescribeAddressTransfers",
+			"DescribeAggregateIdFormat",
+			"DescribeAvailabilityZones",
+			"DescribeAwsNetworkPerformanceMetricSubscriptions",
+			"DescribeBundleTasks",
+			"DescribeByoipCidrs",
+			"DescribeCapacityReservationFleets",
+			"DescribeCapacityReservations",
+			"DescribeCarrierGateways",
+			"DescribeClassicLinkInstances",
+			"DescribeClientVpnAuthorizationRules",
+			"DescribeClientVpnConnections",
+			"DescribeClientVpnEndpoints",
+			"DescribeClientVpnRoutes",
+			"DescribeClientVpnTargetNetworks",
+			"DescribeCoipPools",
+			"DescribeConversionTasks",
+			"DescribeCustomerGateways",
+			"DescribeDhcpOptions",
+			"DescribeEgressOnlyInternetGateways",
+			"DescribeElasticGpus",
+			"DescribeExportImageTasks",
+			"DescribeExportTasks",
+			"DescribeFastLaunchImages",
+			"DescribeFastSnapshotRestores",
+			"DescribeFleetHistory",
+			"DescribeFleetInstances",
+			"DescribeFleets",
+			"DescribeFlowLogs",
+			"DescribeFpgaImageAttribute",
+			"DescribeFpgaImages",
+			"DescribeHostReservationOfferings",
+			"DescribeHostReservations",
+			"DescribeHosts",
+			"DescribeIamInstanceProfileAssociations",
+			"DescribeIdentityIdFormat",
+			"DescribeIdFormat",
+			"DescribeImageAttribute",
+			"DescribeImages",
+			"DescribeImportImageTasks",
+			"DescribeImportSnapshotTasks",
+			"DescribeInstanceAttribute",
+			"DescribeInstanceCreditSpecifications",
+			"DescribeInstanceEventNotificationAttributes",
+			"DescribeInstanceEventWindows",
+			"DescribeInstances",
+			"DescribeInstanceStatus",
+			"DescribeInstanceTypeOfferings",
+			"DescribeInstanceTypes",
+			"DescribeInternetGateways",
+			"DescribeIpamPools",
+			"DescribeIpams",
+			"DescribeIpamScopes",
+			"DescribeIpv6Pools",
+			"DescribeKeyPairs",
+			"DescribeLaunchTemplates",
+			"DescribeLaunchTemplateVersions",
+			"DescribeLocalGatewayRouteTables",
+			"DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations",
+			"DescribeLocalGatewayRouteTableVpcAssociations",
+			"DescribeLocalGateways",
+			"DescribeLocalGatewayVirtualInterfaceGroups",
+			"DescribeLocalGatewayVirtualInterfaces",
+			"DescribeManagedPrefixLists",
+			"DescribeMovingAddresses",
+			"DescribeNatGateways",
+			"DescribeNetworkAcls",
+			"DescribeNetworkInsightsAccessScopeAnalyses",
+			"DescribeNetworkInsightsAccessScopes",
+			"DescribeNetworkInsightsAnalyses",
+			"DescribeNetworkInsightsPaths",
+			"DescribeNetworkInterfaceAttribute",
+			"DescribeNetworkInterfacePermissions",
+			"DescribeNetworkInterfaces",
+			"DescribePlacementGroups",
+			"DescribePrefixLists",
+			"DescribePrincipalIdFormat",
+			"DescribePublicIpv4Pools",
+			"DescribeRegions",
+			"DescribeReplaceRootVolumeTasks",
+			"DescribeReservedInstances",
+			"DescribeReservedInstancesListings",
+			"DescribeReservedInstancesModifications",
+			"DescribeReservedInstancesOfferings",
+			"DescribeRouteTables",
+			"DescribeScheduledInstanceAvailability",
+			"DescribeScheduledInstances",
+			"DescribeSecurityGroupReferences",
+			"DescribeSecurityGroupRules",
+			"DescribeSecurityGroups",
+			"DescribeSnapshotAttribute",
+			"DescribeSnapshots",
+			"DescribeSnapshotTierStatus",
+			"DescribeSpotDatafeedSubscription",
+			"DescribeSpotFleetInstances",
+			"DescribeSpotFleetRequestHistory",
+			"DescribeSpotFleetRequests",
+			"DescribeSpotInstanceRequests",
+			"DescribeSpotPriceHistory",
+			"DescribeStaleSecurityGroups",
+			"DescribeStoreImageTasks",
+			"DescribeSubnets",
+			"DescribeTags",
+			"DescribeTrafficMirrorFilters",
+			"DescribeTrafficMirrorSessions",
+			"DescribeTrafficMirrorTargets",
+			"DescribeTransitGatewayAttachments",
+			"DescribeTransitGatewayConnectPeers",
+			"DescribeTransitGatewayConnects",
+			"DescribeTransitGatewayMulticastDomains",
+			"DescribeTransitGatewayPeeringAttachments",
+			"DescribeTransitGatewayPolicyTables",
+			"DescribeTransitGatewayRouteTableAnnouncements",
+			"DescribeTransitGatewayRouteTables",
+			"DescribeTransitGateways",
+			"DescribeTransitGatewayVpcAttachments",
+			"DescribeTrunkInterfaceAssociations",
+			"DescribeVerifiedAccessEndpoints",
+			"DescribeVerifiedAccessGroups",
+			"DescribeVerifiedAccessInstanceLoggingConfigurations",
+			"DescribeVerifiedAccessInstances",
+			"DescribeVerifiedAccessTrustProviders",
+			"DescribeVolumeAttribute",
+			"DescribeVolumes",
+			"DescribeVolumesModifications",
+			"DescribeVolumeStatus",
+			"DescribeVpcAttribute",
+			"DescribeVpcClassicLink",
+			"DescribeVpcClassicLinkDnsSupport",
+			"DescribeVpcEndpointConnectionNotifications",
+			"DescribeVpcEndpointConnections",
+			"DescribeVpcEndpoints",
+			"DescribeVpcEndpointServiceConfigurations",
+			"DescribeVpcEndpointServicePermissions",
+			"DescribeVpcEndpointServices",
+			"DescribeVpcPeeringConnections",
+			"DescribeVpcs",
+			"DescribeVpnConnections",
+			"DescribeVpnGateways",
+			"DetachClassicLinkVpc",
+			"DetachInternetGateway",
+			"DetachNetworkInterface",
+			"DetachVerifiedAccessTrustProvider",
+			"DetachVolume",
+			"DetachVpnGateway",
+			"DisableAddressTransfer",
+			"DisableAwsNetworkPerformanceMetricSubscription",
+			"DisableEbsEncryptionByDefault",
+			"DisableFastLaunch",
+			"DisableFastSnapshotRestores",
+			"DisableImageDeprecation",
+			"DisableIpamOrganizationAdminAccount",
+			"DisableSerialConsoleAccess",
+			"DisableTransitGatewayRouteTablePropagation",
+			"DisableVgwRoutePropagation",
+			"DisableVpcClassicLink",
+			"DisableVpcClassicLinkDnsSupport",
+			"DisassociateAddress",
+			"DisassociateClientVpnTargetNetwork",
+			"DisassociateEnclaveCertificateIamRole",
+			"DisassociateIamInstanceProfile",
+			"DisassociateInstanceEventWindow",
+			"DisassociateRouteTable",
+			"DisassociateSubnetCidrBlock",
+			"DisassociateTransitGatewayMulticastDomain",
+			"DisassociateTransitGatewayPolicyTable",
+			"DisassociateTransitGatewayRouteTable",
+			"DisassociateTrunkInterface",
+			"DisassociateVpcCidrBlock",
+			"EnableAddressTransfer",
+			"EnableAwsNetworkPerformanceMetricSubscription",
+			"EnableEbsEncryptionByDefault",
+			"EnableFastLaunch",
+			"EnableFastSnapshotRestores",
+			"EnableImageDeprecation",
+			"EnableIpamOrganizationAdminAccount",
+			"EnableReachabilityAnalyzerOrganizationSharing",
+			"EnableSerialConsoleAccess",
+			"EnableTransitGatewayRouteTablePropagation",
+			"EnableVgwRoutePropagation",
+			"EnableVolumeIO",
+			"EnableVpcClassicLink",
+			"EnableVpcClassicLinkDnsSupport",
+			"ExportClientVpnClientCertificateRevocationList",
+			"ExportClientVpnClientConfiguration",
+			"ExportImage",
+			"ExportTransitGatewayRoutes",
+			"GetAssociatedEnclaveCertificateIamRoles",
+			"GetAssociatedIpv6PoolCidrs",
+			"GetAwsNetworkPerformanceData",
+			"GetCapacityReservationUsage",
+			"GetCoipPoolUsage",
+			"GetConsoleOutput",
+			"GetConsoleScreenshot",
+			"GetDefaultCreditSpecification",
+			"GetEbsDefaultKmsKeyId",
+			"GetEbsEncryptionByDefault",
+			"GetFlowLogsIntegrationTemplate",
+			"GetGroupsForCapacityReservation",
+			"GetHostReservationPurchasePreview",
+			"GetInstanceTypesFromInstanceRequirements",
+			"GetInstanceUefiData",
+			"GetIpamAddressHistory",
+			"GetIpamPoolAllocations",
+			"GetIpamPoolCidrs",
+			"GetIpamResourceCidrs",
+			"GetLaunchTemplateData",
+			"GetManagedPrefixListAssociations",
+			"GetManagedPrefixListEntries",
+			"GetNetworkInsightsAccessScopeAnalysisFindings",
+			"GetNetworkInsightsAccessScopeContent",
+			"GetPasswordData",
+			"GetReservedInstancesExchangeQuote",
+			"GetSerialConsoleAccessStatus",
+			"GetSpotPlacementScores",
+			"GetSubnetCidrReservations",
+			"GetTransitGatewayAttachmentPropagations",
+			"GetTransitGatewayMulticastDomainAssociations",
+			"GetTransitGatewayPolicyTableAssociations",
+			"GetTransitGatewayPolicyTableEntries",
+			"GetTransitGatewayPrefixListReferences",
+			"GetTransitGatewayRouteTableAssociations",
+			"GetTransitGatewayRouteTablePropagations",
+			"GetVerifiedAccessEndpointPolicy",
+			"GetVerifiedAccessGroupPolicy",
+			"GetVpnConnectionDeviceSampleConfiguration",
+			"GetVpnConnectionDeviceTypes",
+			"ImportClientVpnClientCertificateRevocationList",
+			"ImportImage",
+			"ImportInstance",
+			"ImportKeyPair",
+			"ImportSnapshot",
+			"ImportVolume",
+			"ListImagesInRecycleBin",
+			"ListSnapshotsInRecycleBin",
+			"ModifyAddressAttribute",
+			"ModifyAvailabilityZoneGroup",
+			"ModifyCapacityReservation",
+			"ModifyCapacityReservationFleet",
+			"ModifyClientVpnEndpoint",
+			"ModifyDefaultCreditSpecification",
+			"ModifyEbsDefaultKmsKeyId",
+			"ModifyFleet",
+			"ModifyFpgaImageAttribute",
+			"ModifyHosts",
+			"ModifyIdentityIdFormat",
+			"ModifyIdFormat",
+			"ModifyImageAttribute",
+			"ModifyInstanceAttribute",
+			"ModifyInstanceCapacityReservationAttributes",
+			"ModifyInstanceCreditSpecification",
+			"ModifyInstanceEventStartTime",
+			"ModifyInstanceEventWindow",
+			"ModifyInstanceMaintenanceOptions",
+			"ModifyInstanceMetadataOptions",
+			"ModifyInstancePlacement",
+			"ModifyIpam",
+			"ModifyIpamPool",
+			"ModifyIpamResourceCidr",
+			"ModifyIpamScope",
+			"ModifyLaunchTemplate",
+			"ModifyLocalGatewayRoute",
+			"ModifyManagedPrefixList",
+			"ModifyNetworkInterfaceAttribute",
+			"ModifyPrivateDnsNameOptions",
+			"ModifyReservedInstances",
+			"ModifySecurityGroupRules",
+			"ModifySnapshotAttribute",
+			"ModifySnapshotTier",
+			"ModifySpotFleetRequest",
+			"ModifySubnetAttribute",
+			"ModifyTrafficMirrorFilterNetworkServices",
+			"ModifyTrafficMirrorFilterRule",
+			"ModifyTrafficMirrorSession",
+			"ModifyTransitGateway",
+			"ModifyTransitGatewayPrefixListReference",
+			"ModifyTransitGatewayVpcAttachment",
+			"ModifyVerifiedAccessEndpoint",
+			"ModifyVerifiedAccessEndpointPolicy",
+			"ModifyVerifiedAccessGroup",
+			"ModifyVerifiedAccessGroupPolicy",
+			"ModifyVerifiedAccessInstance",
+			"ModifyVerifiedAccessInstanceLoggingConfiguration",
+			"ModifyVerifiedAccessTrustProvider",
+			"ModifyVolume",
+			"ModifyVolumeAttribute",
+			"ModifyVpcAttribute",
+			"ModifyVpcEndpoint",
+			"ModifyVpcEndpointConnectionNotification",
+			"ModifyVpcEndpointServiceConfiguration",
+			"ModifyVpcEndpointServicePayerResponsibility",
+			"ModifyVpcEndpointServicePermissions",
+			"ModifyVpcPeeringConnectionOptions",
+			"ModifyVpcTenancy",
+			"ModifyVpnConnection",
+			"ModifyVpnConnectionOptions",
+			"ModifyVpnTunnelCertificate",
+			"ModifyVpnTunnelOptions",
+			"MonitorInstances",
+			"MoveAddressToVpc",
+			"MoveByoipCidrToIpam",
+			"ProvisionByoipCidr",
+			"ProvisionIpamPoolCidr",
+			"ProvisionPublicIpv4PoolCidr",
+			"PurchaseHostReservation",
+			"PurchaseReservedInstancesOffering",
+			"PurchaseScheduledInstances",
+			"RebootInstances",
+			"RegisterImage",
+			"RegisterInstanceEventNotificationAttributes",
+			"RegisterTransitGatewayMulticastGroupMembers",
+			"RegisterTransitGatewayMulticastGroupSources",
+			"RejectTransitGatewayMulticastDomainAssociations",
+			"RejectTransitGatewayPeeringAttachment",
+			"RejectTransitGatewayVpcAttachment",
+			"RejectVpcEndpointConnections",
+			"RejectVpcPeeringConnection",
+			"ReleaseAddress",
+			"ReleaseHosts",
+			"ReleaseIpamPoolAllocation",
+			"ReplaceIamInstanceProfileAssociation",
+			"ReplaceNetworkAclAssociation",
+			"ReplaceNetworkAclEntry",
+			"ReplaceRoute",
+			"ReplaceRouteTableAssociation",
+			"ReplaceTransitGatewayRoute",
+			"ReportInstanceStatus",
+			"RequestSpotFleet",
+			"RequestSpotInstances",
+			"ResetAddressAttribute",
+			"ResetEbsDefaultKmsKeyId",
+			"ResetFpgaImageAttribute",
+			"ResetImageAttribute",
+			"ResetInstanceAttribute",
+			"ResetNetworkInterfaceAttribute",
+			"ResetSnapshotAttribute",
+			"RestoreAddressToClassic",
+			"RestoreImageFromRecycleBin",
+			"RestoreManagedPrefixListVersion",
+			"RestoreSnapshotFromRecycleBin",
+			"RestoreSnapshotTier",
+			"RevokeClientVpnIngress",
+			"RevokeSecurityGroupEgress",
+			"RevokeSecurityGroupIngress",
+			"RunInstances",
+			"RunScheduledInstances",
+			"SearchLocalGatewayRoutes",
+			"SearchTransitGatewayMulticastGroups",
+			"SearchTransitGatewayRoutes",
+			"SendDiagnosticInterrupt",
+			"StartInstances",
+			"StartNetworkInsightsAccessScopeAnalysis",
+			"StartNetworkInsightsAnalysis",
+			"StartVpcEndpointServicePrivateDnsVerification",
+			"StopInstances",
+			"TerminateClientVpnConnections",
+			"TerminateInstances",
+			"UnassignIpv6Addresses",
+			"UnassignPrivateIpAddresses",
+			"UnmonitorInstances",
+			"UpdateSecurityGroupRuleDescriptionsEgress",
+			"UpdateSecurityGroupRuleDescriptionsIngress",
+			"WithdrawByoipCidr",
+		},
+	},
+	"ec2-instance-connect": {
+		actions: []string{
+			"OpenTunnel",
+			"SendSerialConsoleSSHPublicKey",
+			"SendSSHPublicKey",
+		},
+	},
+	"ec2messages": {
+		actions: []string{
+			"AcknowledgeMessage",
+			"DeleteMessage",
+			"FailMessage",
+			"GetEndpoint",
+			"GetMessages",
+			"SendReply",
+		},
+	},
+	"ecr": {
+		actions: []string{
+			"BatchCheckLayerAvailability",
+			"BatchDeleteImage",
+			"BatchGetImage",
+			"BatchGetRepositoryScanningConfiguration",
+			"BatchImportUpstreamImage",
+			"CompleteLayerUpload",
+			"CreatePullThroughCacheRule",
+			"CreateRepository",
+			"DeleteLifecyclePolicy",
+			"DeletePullThroughCacheRule",
+			"DeleteRegistryPolicy",
+			"DeleteRepository",
+			"DeleteRepositoryPolicy",
+			"DescribeImageReplicationStatus",
+			"DescribeImages",
+			"DescribeImageScanFindings",
+			"DescribePullThroughCacheRules",
+			"DescribeRegistry",
+			"DescribeRepositories",
+			"GetAuthorizationToken",
+			"GetDownloadUrlForLayer",
+			"GetLifecyclePolicy",
+			"GetLifecyclePolicyPreview",
+			"GetRegistryPolicy",
+			"GetRegistryScanningConfiguration",
+			"GetRepositoryPolicy",
+			"InitiateLayerUpload",
+			"ListImages",
+			"ListTagsForResource",
+			"PutImage",
+			"PutImageScanningConfiguration",
+			"PutImageTagMutability",
+			"PutLifecyclePolicy",
+			"PutRegistryPolicy",
+			"PutRegistryScanningConfiguration",
+			"PutReplicationConfiguration",
+			"ReplicateImage",
+			"SetRepositoryPolicy",
+			"StartImageScan",
+			"StartLifecyclePolicyPreview",
+			"TagResource",
+			"UntagResource",
+			"UploadLayerPart",
+		},
+	},
+	"ecr-public": {
+		actions: []string{
+			"BatchCheckLayerAvailability",
+			"BatchDeleteImage",
+			"CompleteLayerUpload",
+			"CreateRepository",
+			"DeleteRepository",
+			"DeleteRepositoryPolicy",
+			"DescribeImages",
+			"DescribeImageTags",
+			"DescribeRegistries",
+			"DescribeRepositories",
+			"GetAuthorizationToken",
+			"GetRegistryCatalogData",
+			"GetRepositoryCatalogData",
+			"GetRepositoryPolicy",
+			"InitiateLayerUpload",
+			"ListTagsForResource",
+			"PutImage",
+			"PutRegistryCatalogData",
+			"PutRepositoryCatalogData",
+			"SetRepositoryPolicy",
+			"TagResource",
+			"UntagResource",
+			"UploadLayerPart",
+		},
+	},
+	"ecs": {
+		actions: []string{
+			"CreateCapacityProvider",
+			"CreateCluster",
+			"CreateService",
+			"CreateTaskSet",
+			"DeleteAccountSetting",
+			"DeleteAttributes",
+			"DeleteCapacityProvider",
+			"DeleteCluster",
+			"DeleteService",
+			"DeleteTaskSet",
+			"DeregisterContainerInstance",
+			"DeregisterTaskDefinition",
+			"DescribeCapacityProviders",
+			"DescribeClusters",
+			"DescribeContainerInstances",
+			"DescribeServices",
+			"DescribeTaskDefinition",
+			"DescribeTasks",
+			"DescribeTaskSets",
+			"DiscoverPollEndpoint",
+			"ExecuteCommand",
+			"GetTaskProtection",
+			"ListAccountSettings",
+			"ListAttributes",
+			"ListClusters",
+			"ListContainerInstances",
+			"ListServices",
+			"ListServicesByNamespace",
+			"ListTagsForResource",
+			"ListTaskDefinitionFamilies",
+			"ListTaskDefinitions",
+			"ListTasks",
+			"Poll",
+			"PutAccountSetting",
+			"PutAccountSettingDefault",
+			"PutAttributes",
+			"PutClusterCapacityProviders",
+			"RegisterContainerInstance",
+			"RegisterTaskDefinition",
+			"RunTask",
+			"StartTask",
+			"StartTelemetrySession",
+			"StopTask",
+			"SubmitAttachmentStateChanges",
+			"SubmitContainerStateChange",
+			"SubmitTaskStateChange",
+			"TagResource",
+			"UntagResource",
+			"UpdateCapacityProvider",
+			"UpdateCluster",
+			"UpdateClusterSettings",
+			"UpdateContainerAgent",
+			"UpdateContainerInstancesState",
+			"UpdateService",
+			"UpdateServicePrimaryTaskSet",
+			"UpdateTaskProtection",
+			"UpdateTaskSet",
+		},
+	},
+	"eks": {
+		actions: []string{
+			"AssociateEncryptionConfig",
+			"AssociateIdentityProviderConfig",
+			"CreateAddon",
+			"CreateCluster",
+			"CreateFargateProfile",
+			"CreateNodegroup",
+			"DeleteAddon",
+			"DeleteCluster",
+			"DeleteFargateProfile",
+			"DeleteNodegroup",
+			"DeregisterCluster",
+			"DescribeAddon",
+			"DescribeAddonConfiguration",
+			"DescribeAddonVersions",
+			"DescribeCluster",
+			"DescribeFargateProfile",
+			"DescribeIdentityProviderConfig",
+			"DescribeNodegroup",
+			"DescribeUpdate",
+			"DisassociateIdentityProviderConfig",
+			"ListAddons",
+			"ListClusters",
+			"ListFargateProfiles",
+			"ListIdentityProviderConfigs",
+			"ListNodegroups",
+			"ListTagsForResource",
+			"ListUpdates",
+			"RegisterCluster",
+			"TagResource",
+			"UntagResource",
+			"UpdateAddon",
+			"UpdateClusterConfig",
+			"UpdateClusterVersion",
+			"UpdateNodegroupConfig",
+			"UpdateNodegroupVersion",
+		},
+	},
+	"elastic-inference": {
+		actions: []string{
+			"DescribeAcceleratorOfferings",
+			"DescribeAccelerators",
+			"DescribeAcceleratorTypes",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"elasticache": {
+		actions: []string{
+			"AddTagsToResource",
+			"AuthorizeCacheSecurityGroupIngress",
+			"BatchApplyUpdateAction",
+			"BatchStopUpdateAction",
+			"CompleteMigration",
+			"Connect",
+			"CopySnapshot",
+			"CreateCacheCluster",
+			"CreateCacheParameterGroup",
+			"CreateCacheSecurityGroup",
+			"CreateCacheSubnetGroup",
+			"CreateGlobalReplicationGroup",
+			"CreateReplicationGroup",
+			"CreateSnapshot",
+			"CreateUser",
+			"CreateUserGroup",
+			"DecreaseNodeGroupsInGlobalReplicationGroup",
+			"DecreaseReplicaCount",
+			"DeleteCacheCluster",
+			"DeleteCacheParameterGroup",
+			"DeleteCacheSecurityGroup",
+			"DeleteCacheSubnetGroup",
+			"DeleteGlobalReplicationGroup",
+			"DeleteReplicationGroup",
+			"DeleteSnapshot",
+			"DeleteUser",
+			"DeleteUserGroup",
+			"DescribeCacheClusters",
+			"DescribeCacheEngineVersions",
+			"DescribeCacheParameterGroups",
+			"DescribeCacheParameters",
+			"DescribeCacheSecurityGroups",
+			"DescribeCacheSubnetGroups",
+			"DescribeEngineDefaultParameters",
+			"DescribeEvents",
+			"DescribeGlobalReplicationGroups",
+			"DescribeReplicationGroups",
+			"DescribeReservedCacheNodes",
+			"DescribeReservedCacheNodesOfferings",
+			"DescribeServiceUpdates",
+			"DescribeSnapshots",
+			"DescribeUpdateActions",
+			"DescribeUserGroups",
+			"DescribeUsers",
+			"DisassociateGlobalReplicationGroup",
+			"FailoverGlobalReplicationGroup",
+			"IncreaseNodeGroupsInGlobalReplicationGroup",
+			"IncreaseReplicaCount",
+			"ListAllowedNodeTypeModifications",
+			"ListTagsForResource",
+			"ModifyCacheCluster",
+			"ModifyCacheParameterGroup",
+			"ModifyCacheSubnetGroup",
+			"ModifyGlobalReplicationGroup",
+			"ModifyReplicationGroup",
+			"ModifyReplicationGroupShardConfiguration",
+			"ModifyUser",
+			"ModifyUserGroup",
+			"PurchaseReservedCacheNodesOffering",
+			"RebalanceSlotsInGlobalReplicationGroup",
+			"RebootCacheCluster",
+			"RemoveTagsFromResource",
+			"ResetCacheParameterGroup",
+			"RevokeCacheSecurityGroupIngress",
+			"StartMigration",
+			"TestFailover",
+		},
+	},
+	"elasticbeanstalk": {
+		actions: []string{
+			"AbortEnvironmentUpdate",
+			"ApplyEnvironmentManagedAction",
+			"AssociateEnvironmentOperationsRole",
+			"CheckDNSAvailability",
+			"ComposeEnvironments",
+			"CreateApplication",
+			"CreateApplicationVersion",
+			"CreateConfigurationTemplate",
+			"CreateEnvironment",
+			"CreatePlatformVersion",
+			"CreateStorageLocation",
+			"DeleteApplication",
+			"DeleteApplicationVersion",
+			"DeleteConfigurationTemplate",
+			"DeleteEnvironmentConfiguration",
+			"DeletePlatformVersion",
+			"DescribeAccountAttributes",
+			"DescribeApplications",
+			"DescribeApplicationVersions",
+			"DescribeConfigurationOptions",
+			"DescribeConfigurationSettings",
+			"DescribeEnvironmentHealth",
+			"DescribeEnvironmentManagedActionHistory",
+			"DescribeEnvironmentManagedActions",
+			"DescribeEnvironmentResources",
+			"DescribeEnvironments",
+			"DescribeEvents",
+			"DescribeInstancesHealth",
+			"DescribePlatformVersion",
+			"DisassociateEnvironmentOperationsRole",
+			"ListAvailableSolutionStacks",
+			"ListPlatformBranches",
+			"ListPlatformVersions",
+			"ListTagsForResource",
+			"RebuildEnvironment",
+			"RequestEnvironmentInfo",
+			"RestartAppServer",
+			"RetrieveEnvironmentInfo",
+			"SwapEnvironmentCNAMEs",
+			"TerminateEnvironment",
+			"UpdateApplication",
+			"UpdateApplicationResourceLifecycle",
+			"UpdateApplicationVersion",
+			"UpdateConfigurationTemplate",
+			"UpdateEnvironment",
+			"UpdateTagsForResource",
+			"ValidateConfigurationSettings",
+		},
+	},
+	"elasticfilesystem": {
+		actions: []string{
+			"ClientMount",
+			"ClientRootAccess",
+			"ClientWrite",
+			"CreateAccessPoint",
+			"CreateFileSystem",
+			"CreateMountTarget",
+			"CreateReplicationConfiguration",
+			"CreateTags",
+			"DeleteAccessPoint",
+			"DeleteFileSystem",
+			"DeleteFileSystemPolicy",
+			"DeleteMountTarget",
+			"DeleteReplicationConfiguration",
+			"DeleteTags",
+			"DescribeAccessPoints",
+			"DescribeAccountPreferences",
+			"DescribeBackupPolicy",
+			"DescribeFileSystemPolicy",
+			"DescribeFileSystems",
+			"DescribeLifecycleConfiguration",
+			"DescribeMountTargets",
+			"DescribeMountTargetSecurityGroups",
+			"DescribeReplicationConfigurations",
+			"DescribeTags",
+			"ListTagsForResource",
+			"ModifyMountTargetSecurityGroups",
+			"PutAccountPreferences",
+			"PutBackupPolicy",
+			"PutFileSystemPolicy",
+			"PutLifecycleConfiguration",
+			"TagResource",
+			"UntagResource",
+			"UpdateFileSystem",
+		},
+	},
+	"elasticloadbalancing": {
+		actions: []string{
+			"AddListenerCertificates",
+			"AddTags",
+			"ApplySecurityGroupsToLoadBalancer",
+			"AttachLoadBalancerToSubnets",
+			"ConfigureHealthCheck",
+			"CreateAppCookieStickinessPolicy",
+			"CreateLBCookieStickinessPolicy",
+			"CreateListener",
+			"CreateLoadBalancer",
+			"CreateLoadBalancerListeners",
+			"CreateLoadBalancerPolicy",
+			"CreateRule",
+			"CreateTargetGroup",
+			"DeleteListener",
+			"DeleteLoadBalancer",
+			"DeleteLoadBalancerListeners",
+			"DeleteLoadBalancerPolicy",
+			"DeleteRule",
+			"DeleteTargetGroup",
+			"DeregisterInstancesFromLoadBalancer",
+			"DeregisterTargets",
+			"DescribeAccountLimits",
+			"DescribeInstanceHealth",
+			"DescribeListenerCertificates",
+			"DescribeListeners",
+			"DescribeLoadBalancerAttributes",
+			"DescribeLoadBalancerPolicies",
+			"DescribeLoadBalancerPolicyTypes",
+			"DescribeLoadBalancers",
+			"DescribeRules",
+			"DescribeSSLPolicies",
+			"DescribeTags",
+			"DescribeTargetGroupAttributes",
+			"DescribeTargetGroups",
+			"DescribeTargetHealth",
+			"DetachLoadBalancerFromSubnets",
+			"DisableAvailabilityZonesForLoadBalancer",
+			"EnableAvailabilityZonesForLoadBalancer",
+			"ModifyListener",
+			"ModifyLoadBalancerAttributes",
+			"ModifyRule",
+			"ModifyTargetGroup",
+			"ModifyTargetGroupAttributes",
+			"RegisterInstancesWithLoadBalancer",
+			"RegisterTargets",
+			"RemoveListenerCertificates",
+			"RemoveTags",
+			"SetIpAddressType",
+			"SetLoadBalancerListenerSSLCertificate",
+			"SetLoadBalancerPoliciesForBackendServer",
+			"SetLoadBalancerPoliciesOfListener",
+			"SetRulePriorities",
+			"SetSecurityGroups",
+			"SetSubnets",
+		},
+	},
+	"elasticmapreduce": {
+		actions: []string{
+			"AddInstanceFleet",
+			"AddInstanceGroups",
+			"AddJobFlowSteps",
+			"AddTags",
+			"CancelSteps",
+			"CreateSecurityConfiguration",
+			"CreateStudio",
+			"CreateStudioSessionMapping",
+			"DeleteSecurityConfiguration",
+			"DeleteStudio",
+			"DeleteStudioSessionMapping",
+			"DescribeCluster",
+			"DescribeJobFlows",
+			"DescribeNotebookExecution",
+			"DescribeReleaseLabel",
+			"DescribeSecurityConfiguration",
+			"DescribeStep",
+			"DescribeStudio",
+			"GetAutoTerminationPolicy",
+			"GetBlockPublicAccessConfiguration",
+			"GetClusterSessionCredentials",
+			"GetManagedScalingPolicy",
+			"GetStudioSessionMapping",
+			"ListBootstrapActions",
+			"ListClusters",
+			"ListInstanceFleets",
+			"ListInstanceGroups",
+			"ListInstances",
+			"ListNotebookExecutions",
+			"ListReleaseLabels",
+			"ListSecurityConfigurations",
+			"ListSteps",
+			"ListStudios",
+			"ListStudioSessionMappings",
+			"ModifyCluster",
+			"ModifyInstanceFleet",
+			"ModifyInstanceGroups",
+			"PutAutoScalingPolicy",
+			"PutAutoTerminationPolicy",
+			"PutBlockPublicAccessConfiguration",
+			"PutManagedScalingPolicy",
+			"RemoveAutoScalingPolicy",
+			"RemoveAutoTerminationPolicy",
+			"RemoveManagedScalingPolicy",
+			"RemoveTags",
+			"RunJobFlow",
+			"SetTerminationProtection",
+			"SetVisibleToAllUsers",
+			"StartNotebookExecution",
+			"StopNotebookExecution",
+			"TerminateJobFlows",
+			"UpdateStudio",
+			"UpdateStudioSessionMapping",
+		},
+	},
+	"elastictranscoder": {
+		actions: []string{
+			"CancelJob",
+			"CreateJob",
+			"CreatePipeline",
+			"CreatePreset",
+			"DeletePipeline",
+			"DeletePreset",
+			"ListJobsByPipeline",
+			"ListJobsByStatus",
+			"ListPipelines",
+			"ListPresets",
+			"ReadJob",
+			"ReadPipeline",
+			"ReadPreset",
+			"TestRole",
+			"UpdatePipeline",
+			"UpdatePipelineNotifications",
+			"UpdatePipelineStatus",
+		},
+	},
+	"emr-containers": {
+		actions: []string{
+			"CancelJobRun",
+			"CreateJobTemplate",
+			"CreateManagedEndpoint",
+			"CreateVirtualCluster",
+			"DeleteJobTemplate",
+			"DeleteManagedEndpoint",
+			"DeleteVirtualCluster",
+			"DescribeJobRun",
+			"DescribeJobTemplate",
+			"DescribeManagedEndpoint",
+			"DescribeVirtualCluster",
+			"ListJobRuns",
+			"ListJobTemplates",
+			"ListManagedEndpoints",
+			"ListTagsForResource",
+			"ListVirtualClusters",
+			"StartJobRun",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"emr-serverless": {
+		actions: []string{
+			"CancelJobRun",
+			"CreateApplication",
+			"DeleteApplication",
+			"GetApplication",
+			"GetDashboardForJobRun",
+			"GetJobRun",
+			"ListApplications",
+			"ListJobRuns",
+			"ListTagsForResource",
+			"StartApplication",
+			"StartJobRun",
+			"StopApplication",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+		},
+	},
+	"es": {
+		actions: []string{
+			"AcceptInboundConnection",
+			"AcceptInboundCrossClusterSearchConnection",
+			"AddTags",
+			"AssociatePackage",
+			"AuthorizeVpcEndpointAccess",
+			"CancelElasticsearchServiceSoftwareUpdate",
+			"CancelServiceSoftwareUpdate",
+			"CreateDomain",
+			"CreateElasticsearchDomain",
+			"CreateOutboundConnection",
+			"CreateOutboundCrossClusterSearchConnection",
+			"CreatePackage",
+			"CreateVpcEndpoint",
+			"DeleteDomain",
+			"DeleteElasticsearchDomain",
+			"DeleteElasticsearchServiceRole",
+			"DeleteInboundConnection",
+			"DeleteInboundCrossClusterSearchConnection",
+			"DeleteOutboundConnection",
+			"DeleteOutboundCrossClusterSearchConnection",
+			"DeletePackage",
+			"DeleteVpcEndpoint",
+			"DescribeDomain",
+			"DescribeDomainAutoTunes",
+			"DescribeDomainChangeProgress",
+			"DescribeDomainConfig",
+			"DescribeDomains",
+			"DescribeElasticsearchDomain",
+			"DescribeElasticsearchDomainConfig",
+			"DescribeElasticsearchDomains",
+			"DescribeElasticsearchInstanceTypeLimits",
+			"DescribeInboundConnections",
+			"DescribeInboundCrossClusterSearchConnections",
+			"DescribeInstanceTypeLimits",
+			"DescribeOutboundConnections",
+			"DescribeOutboundCrossClusterSearchConnections",
+			"DescribePackages",
+			"DescribeReservedElasticsearchInstanceOfferings",
+			"DescribeReservedElasticsearchInstances",
+			"DescribeReservedInstanceOfferings",
+			"DescribeReservedInstances",
+			"DescribeVpcEndpoints",
+			"DissociatePackage",
+			"ESCrossClusterGet",
+			"ESHttpDelete",
+			"ESHttpGet",
+			"ESHttpHead",
+			"ESHttpPatch",
+			"ESHttpPost",
+			"ESHttpPut",
+			"GetCompatibleElasticsearchVersions",
+			"GetCompatibleVersions",
+			"GetPackageVersionHistory",
+			"GetUpgradeHistory",
+			"GetUpgradeStatus",
+			"ListDomainNames",
+			"ListDomainsForPackage",
+			"ListElasticsearchInstanceTypes",
+			"ListElasticsearchVersions",
+			"ListInstanceTypeDetails",
+			"ListPackagesForDomain",
+			"ListTags",
+			"ListVersions",
+			"ListVpcEndpointAccess",
+			"ListVpcEndpoints",
+			"ListVpcEndpointsForDomain",
+			"PurchaseReservedElasticsearchInstanceOffering",
+			"PurchaseReservedInstanceOffering",
+			"RejectInboundConnection",
+			"RejectInboundCrossClusterSearchConnection",
+			"RemoveTags",
+			"RevokeVpcEndpointAccess",
+			"StartElasticsearchServiceSoftwareUpdate",
+			"StartServiceSoftwareUpdate",
+			"UpdateDomainConfig",
+			"UpdateElasticsearchDomainConfig",
+			"UpdatePackage",
+			"UpdateVpcEndpoint",
+			"UpgradeDomain",
+			"UpgradeElasticsearchDomain",
+		},
+	},
+	"events": {
+		actions: []string{
+			"ActivateEventSource",
+			"CancelReplay",
+			"CreateApiDestination",
+			"CreateArchive",
+			"CreateConnection",
+			"CreateEndpoint",
+			"CreateEventBus",
+			"CreatePartnerEventSource",
+			"DeactivateEventSource",
+			"DeauthorizeConnection",
+			"DeleteApiDestination",
+			"DeleteArchive",
+			"DeleteConnection",
+			"DeleteEndpoint",
+			"DeleteEventBus",
+			"DeletePartnerEventSource",
+			"DeleteRule",
+			"DescribeApiDestination",
+			"DescribeArchive",
+			"DescribeConnection",
+			"DescribeEndpoint",
+			"DescribeEventBus",
+			"DescribeEventSource",
+			"DescribePartnerEventSource",
+			"DescribeReplay",
+			"DescribeRule",
+			"DisableRule",
+			"EnableRule",
+			"ListApiDestinations",
+			"ListArchives",
+			"ListConnections",
+			"ListEndpoints",
+			"ListEventBuses",
+			"ListEventSources",
+			"ListPartnerEventSourceAccounts",
+			"ListPartnerEventSources",
+			"ListReplays",
+			"ListRuleNamesByTarget",
+			"ListRules",
+			"ListTagsForResource",
+			"ListTargetsByRule",
+			"PutEvents",
+			"PutPartnerEvents",
+			"PutPermission",
+			"PutRule",
+			"PutTargets",
+			"RemovePermission",
+			"RemoveTargets",
+			"StartReplay",
+			"TagResource",
+			"TestEventPattern",
+			"UntagResource",
+			"UpdateApiDestination",
+			"UpdateArchive",
+			"UpdateConnection",
+			"UpdateEndpoint",
+		},
+	},
+	"evidently": {
+		actions: []string{
+			"BatchEvaluateFeature",
+			"CreateExperiment",
+			"CreateFeature",
+			"CreateLaunch",
+			"CreateProject",
+			"CreateSegment",
+			"DeleteExperiment",
+			"DeleteFeature",
+			"DeleteLaunch",
+			"DeleteProject",
+			"DeleteSegment",
+			"EvaluateFeature",
+			"GetExperiment",
+			"GetExperimentResults",
+			"GetFeature",
+			"GetLaunch",
+			"GetProject",
+			"GetSegment",
+			"ListExperiments",
+			"ListFeatures",
+			"ListLaunches",
+			"ListProjects",
+			"ListSegmentReferences",
+			"ListSegments",
+			"ListTagsForResource",
+			"PutProjectEvents",
+			"StartExperiment",
+			"StartLaunch",
+			"StopExperiment",
+			"StopLaunch",
+			"TagResource",
+			"TestSegmentPattern",
+			"UntagResource",
+			"UpdateExperiment",
+			"UpdateFeature",
+			"UpdateLaunch",
+			"UpdateProject",
+			"UpdateProjectDataDelivery",
+		},
+	},
+	"execute-api": {
+		actions: []string{
+			"InvalidateCache",
+			"Invoke",
+			"ManageConnections",
+		},
+	},
+	"finspace": {
+		actions: []string{
+			"CreateEnvironment",
+			"DeleteEnvironment",
+			"GetEnvironment",
+			"ListEnvironments",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateEnvironment",
+		},
+	},
+	"finspace-api": {
+		actions: []string{
+			"AssociateUserToPermissionGroup",
+			"CreateChangeset",
+			"CreateDataset",
+			"CreateDataView",
+			"CreatePermissionGroup",
+			"CreateUser",
+			"DeleteDataset",
+			"DeletePermissionGroup",
+			"DisableUser",
+			"DisassociateUserFromPermissionGroup",
+			"EnableUser",
+			"GetChangeset",
+			"GetDataset",
+			"GetDataView",
+			"GetExternalDataViewAccessDetails",
+			"GetPermissionGroup",
+			"GetProgrammaticAccessCredentials",
+			"GetUser",
+			"GetWorkingLocation",
+			"ListChangesets",
+			"ListDatasets",
+			"ListDataViews",
+			"ListPermissionGroups",
+			"ListPermissionGroupsByUser",
+			"ListUsers",
+			"ListUsersByPermissionGroup",
+			"ResetUserPassword",
+			"UpdateChangeset",
+			"UpdateDataset",
+			"UpdatePermissionGroup",
+			"UpdateUser",
+		},
+	},
+	"firehose": {
+		actions: []string{
+			"CreateDeliveryStream",
+			"DeleteDeliveryStream",
+			"DescribeDeliveryStream",
+			"ListDeliveryStreams",
+			"ListTagsForDeliveryStream",
+			"PutRecord",
+			"PutRecordBatch",
+			"StartDeliveryStreamEncryption",
+			"StopDeliveryStreamEncryption",
+			"TagDeliveryStream",
+			"UntagDeliveryStream",
+			"UpdateDestination",
+		},
+	},
+	"fis": {
+		actions: []string{
+			"CreateExperimentTemplate",
+			"DeleteExperimentTemplate",
+			"GetAction",
+			"GetExperiment",
+			"GetExperimentTemplate",
+			"GetTargetResourceType",
+			"ListActions",
+			"ListExperiments",
+			"ListExperimentTemplates",
+			"ListTagsForResource",
+			"ListTargetResourceTypes",
+			"StartExperiment",
+			"StopExperiment",
+			"TagResource",
+			"UntagResource",
+			"UpdateExperimentTemplate",
+		},
+	},
+	"fms": {
+		actions: []string{
+			"AssociateAdminAccount",
+			"AssociateThirdPartyFirewall",
+			"BatchAssociateResource",
+			"BatchDisassociateResource",
+			"DeleteAppsList",
+			"DeleteNotificationChannel",
+			"DeletePolicy",
+			"DeleteProtocolsList",
+			"DeleteResourceSet",
+			"DisassociateAdminAccount",
+			"DisassociateThirdPartyFirewall",
+			"GetAdminAccount",
+			"GetAppsList",
+			"GetComplianceDetail",
+			"GetNotificationChannel",
+			"GetPolicy",
+			"GetProtectionStatus",
+			"GetProtocolsList",
+			"GetResourceSet",
+			"GetThirdPartyFirewallAssociationStatus",
+			"GetViolationDetails",
+			"ListAppsLists",
+			"ListComplianceStatus",
+			"ListDiscoveredResources",
+			"ListMemberAccounts",
+			"ListPolicies",
+			"ListProtocolsLists",
+			"ListResourceSetResources",
+			"ListResourceSets",
+			"ListTagsForResource",
+			"ListThirdPartyFirewallFirewallPolicies",
+			"PutAppsList",
+			"PutNotificationChannel",
+			"PutPolicy",
+			"PutProtocolsList",
+			"PutResourceSet",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"forecast": {
+		actions: []string{
+			"CreateAutoPredictor",
+			"CreateDataset",
+			"CreateDatasetGroup",
+			"CreateDatasetImportJob",
+			"CreateExplainability",
+			"CreateExplainabilityExport",
+			"CreateForecast",
+			"CreateForecastExportJob",
+			"CreateMonitor",
+			"CreatePredictor",
+			"CreatePredictorBacktestExportJob",
+			"CreateWhatIfAnalysis",
+			"CreateWhatIfForecast",
+			"CreateWhatIfForecastExport",
+			"DeleteDataset",
+			"DeleteDatasetGroup",
+			"DeleteDatasetImportJob",
+			"DeleteExplainability",
+			"DeleteExplainabilityExport",
+			"DeleteForecast",
+			"DeleteForecastExportJob",
+			"DeleteMonitor",
+			"DeletePredictor",
+			"DeletePredictorBacktestExportJob",
+			"DeleteResourceTree",
+			"DeleteWhatIfAnalysis",
+			"DeleteWhatIfForecast",
+			"DeleteWhatIfForecastExport",
+			"DescribeAutoPredictor",
+			"DescribeDataset",
+			"DescribeDatasetGroup",
+			"DescribeDatasetImportJob",
+			"DescribeExplainability",
+			"DescribeExplainabilityExport",
+			"DescribeForecast",
+			"DescribeForecastExportJob",
+			"DescribeMonitor",
+			"DescribePredictor",
+			"DescribePredictorBacktestExportJob",
+			"DescribeWhatIfAnalysis",
+			"DescribeWhatIfForecast",
+			"DescribeWhatIfForecastExport",
+			"GetAccuracyMetrics",
+			"ListDatasetGroups",
+			"ListDatasetImportJobs",
+			"ListDatasets",
+			"ListExplainabilities",
+			"ListExplainabilityExports",
+			"ListForecastExportJobs",
+			"ListForecasts",
+			"ListMonitorEvaluations",
+			"ListMonitors",
+			"ListPredictorBacktestExportJobs",
+			"ListPredictors",
+			"ListTagsForResource",
+			"ListWhatIfAnalyses",
+			"ListWhatIfForecastExports",
+			"ListWhatIfForecasts",
+			"QueryForecast",
+			"QueryWhatIfForecast",
+			"ResumeResource",
+			"StopResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateDatasetGroup",
+		},
+	},
+	"frauddetector": {
+		actions: []string{
+			"BatchCreateVariable",
+			"BatchGetVariable",
+			"CancelBatchImportJob",
+			"CancelBatchPredictionJob",
+			"CreateBatchImportJob",
+			"CreateBatchPredictionJob",
+			"CreateDetectorVersion",
+			"CreateModel",
+			"CreateModelVersion",
+			"CreateRule",
+			"CreateVariable",
+			"DeleteBatchImportJob",
+			"DeleteBatchPredictionJob",
+			"DeleteDetector",
+			"DeleteDetectorVersion",
+			"DeleteEntityType",
+			"DeleteEvent",
+			"DeleteEventsByEventType",
+			"DeleteEventType",
+			"DeleteExternalModel",
+			"DeleteLabel",
+			"DeleteModel",
+			"DeleteModelVersion",
+			"DeleteOutcome",
+			"DeleteRule",
+			"DeleteVariable",
+			"DescribeDetector",
+			"DescribeModelVersions",
+			"GetBatchImportJobs",
+			"GetBatchPredictionJobs",
+			"GetDeleteEventsByEventTypeStatus",
+			"GetDetectors",
+			"GetDetectorVersion",
+			"GetEntityTypes",
+			"GetEvent",
+			"GetEventPrediction",
+			"GetEventPredictionMetadata",
+			"GetEventTypes",
+			"GetExternalModels",
+			"GetKMSEncryptionKey",
+			"GetLabels",
+			"GetModels",
+			"GetModelVersion",
+			"GetOutcomes",
+			"GetRules",
+			"GetVariables",
+			"ListEventPredictions",
+			"ListTagsForResource",
+			"PutDetector",
+			"PutEntityType",
+			"PutEventType",
+			"PutExternalModel",
+			"PutKMSEncryptionKey",
+			"PutLabel",
+			"PutOutcome",
+			"SendEvent",
+			"TagResource",
+			"UntagResource",
+			"UpdateDetectorVersion",
+			"UpdateDetectorVersionMetadata",
+			"UpdateDetectorVersionStatus",
+			"UpdateEventLabel",
+			"UpdateModel",
+			"UpdateModelVersion",
+			"UpdateModelVersionStatus",
+			"UpdateRuleMetadata",
+			"UpdateRuleVersion",
+			"UpdateVariable",
+		},
+	},
+	"fsx": {
+		actions: []string{
+			"AssociateFileSystemAliases",
+			"CancelDataRepositoryTask",
+			"CopyBackup",
+			"CreateBackup",
+			"CreateDataRepositoryAssociation",
+			"CreateDataRepositoryTask",
+			"CreateFileCache",
+			"CreateFileSystem",
+			"CreateFileSystemFromBackup",
+			"CreateSnapshot",
+			"CreateStorageVirtualMachine",
+			"CreateVolume",
+			"CreateVolumeFromBackup",
+			"DeleteBackup",
+			"DeleteDataRepositoryAssociation",
+			"DeleteFileCache",
+			"DeleteFileSystem",
+			"DeleteSnapshot",
+			"DeleteStorageVirtualMachine",
+			"DeleteVolume",
+			"DescribeBackups",
+			"DescribeDataRepositoryAssociations",
+			"DescribeDataRepositoryTasks",
+			"DescribeFileCaches",
+			"DescribeFileSystemAliases",
+			"DescribeFileSystems",
+			"DescribeSnapshots",
+			"DescribeStorageVirtualMachines",
+			"DescribeVolumes",
+			"DisassociateFileSystemAliases",
+			"ListTagsForResource",
+			"ReleaseFileSystemNfsV3Locks",
+			"RestoreVolumeFromSnapshot",
+			"TagResource",
+			"UntagResource",
+			"UpdateDataRepositoryAssociation",
+			"UpdateFileCache",
+			"UpdateFileSystem",
+			"UpdateSnapshot",
+			"UpdateStorageVirtualMachine",
+			"UpdateVolume",
+		},
+	},
+	"gamelift": {
+		actions: []string{
+			"AcceptMatch",
+			"ClaimGameServer",
+			"CreateAlias",
+			"CreateBuild",
+			"CreateFleet",
+			"CreateFleetLocations",
+			"CreateGameServerGroup",
+			"CreateGameSession",
+			"CreateGameSessionQueue",
+			"CreateLocation",
+			"CreateMatchmakingConfiguration",
+			"CreateMatchmakingRuleSet",
+			"CreatePlayerSession",
+			"CreatePlayerSessions",
+			"CreateScript",
+			"CreateVpcPeeringAuthorization",
+			"CreateVpcPeeringConnection",
+			"DeleteAlias",
+			"DeleteBuild",
+			"DeleteFleet",
+			"DeleteFleetLocations",
+			"DeleteGameServerGroup",
+			"DeleteGameSessionQueue",
+			"DeleteLocation",
+			"DeleteMatchmakingConfiguration",
+			"DeleteMatchmakingRuleSet",
+			"DeleteScalingPolicy",
+			"DeleteScript",
+			"DeleteVpcPeeringAuthorization",
+			"DeleteVpcPeeringConnection",
+			"DeregisterCompute",
+			"DeregisterGameServer",
+			"DescribeAlias",
+			"DescribeBuild",
+			"DescribeCompute",
+			"DescribeEC2InstanceLimits",
+			"DescribeFleetAttributes",
+			"DescribeFleetCapacity",
+			"DescribeFleetEvents",
+			"DescribeFleetLocationAttributes",
+			"DescribeFleetLocationCapacity",
+			"DescribeFleetLocationUtilization",
+			"DescribeFleetPortSettings",
+			"DescribeFleetUtilization",
+			"DescribeGameServer",
+			"DescribeGameServerGroup",
+			"DescribeGameServerInstances",
+			"DescribeGameSessionDetails",
+			"DescribeGameSessionPlacement",
+			"DescribeGameSessionQueues",
+			"DescribeGameSessions",
+			"DescribeInstances",
+			"DescribeMatchmaking",
+			"DescribeMatchmakingConfigurations",
+			"DescribeMatchmakingRuleSets",
+			"DescribePlayerSessions",
+			"DescribeRuntimeConfiguration",
+			"DescribeScalingPolicies",
+			"DescribeScript",
+			"DescribeVpcPeeringAuthorizations",
+			"DescribeVpcPeeringConnections",
+			"GetComputeAccess",
+			"GetComputeAuthToken",
+			"GetGameSessionLogUrl",
+			"GetInstanceAccess",
+			"ListAliases",
+			"ListBuilds",
+			"ListCompute",
+			"ListFleets",
+			"ListGameServerGroups",
+			"ListGameServers",
+			"ListLocations",
+			"ListScripts",
+			"ListTagsForResource",
+			"PutScalingPolicy",
+			"RegisterCompute",
+			"RegisterGameServer",
+			"RequestUploadCredentials",
+			"ResolveAlias",
+			"ResumeGameServerGroup",
+			"SearchGameSessions",
+			"StartFleetActions",
+			"StartGameSessionPlacement",
+			"StartMatchBackfill",
+			"StartMatchmaking",
+			"StopFleetActions",
+			"StopGameSessionPlacement",
+			"StopMatchmaking",
+			"SuspendGameServerGroup",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlias",
+			"UpdateBuild",
+			"UpdateFleetAttributes",
+			"UpdateFleetCapacity",
+			"UpdateFleetPortSettings",
+			"UpdateGameServer",
+			"UpdateGameServerGroup",
+			"UpdateGameSession",
+			"UpdateGameSessionQueue",
+			"UpdateMatchmakingConfiguration",
+			"UpdateRuntimeConfiguration",
+			"UpdateScript",
+			"ValidateMatchmakingRuleSet",
+		},
+	},
+	"gamesparks": {
+		actions: []string{
+			"CreateGame",
+			"CreateSnapshot",
+			"CreateStage",
+			"DeleteGame",
+			"DeleteStage",
+			"DisconnectPlayer",
+			"ExportSnapshot",
+			"GetExtension",
+			"GetExtensionVersion",
+			"GetGame",
+			"GetGameConfiguration",
+			"GetGeneratedCodeJob",
+			"GetPlayerConnectionStatus",
+			"GetSnapshot",
+			"GetStage",
+			"GetStageDeployment",
+			"ImportGameConfiguration",
+			"ListExtensions",
+			"ListExtensionVersions",
+			"ListGames",
+			"ListGeneratedCodeJobs",
+			"ListSnapshots",
+			"ListStageDeployments",
+			"ListStages",
+			"ListTagsForResource",
+			"StartGeneratedCodeJob",
+			"StartStageDeployment",
+			"TagResource",
+			"UntagResource",
+			"UpdateGame",
+			"UpdateGameConfiguration",
+			"UpdateSnapshot",
+			"UpdateStage",
+		},
+	},
+	"geo": {
+		actions: []string{
+			"AssociateTrackerConsumer",
+			"BatchDeleteDevicePositionHistory",
+			"BatchDeleteGeofence",
+			"BatchEvaluateGeofences",
+			"BatchGetDevicePosition",
+			"BatchPutGeofence",
+			"BatchUpdateDevicePosition",
+			"CalculateRoute",
+			"CalculateRouteMatrix",
+			"CreateGeofenceCollection",
+			"CreateMap",
+			"CreatePlaceIndex",
+			"CreateRouteCalculator",
+			"CreateTracker",
+			"DeleteGeofenceCollection",
+			"DeleteMap",
+			"DeletePlaceIndex",
+			"DeleteRouteCalculator",
+			"DeleteTracker",
+			"DescribeGeofenceCollection",
+			"DescribeMap",
+			"DescribePlaceIndex",
+			"DescribeRouteCalculator",
+			"DescribeTracker",
+			"DisassociateTrackerConsumer",
+			"GetDevicePosition",
+			"GetDevicePositionHistory",
+			"GetGeofence",
+			"GetMapGlyphs",
+			"GetMapSprites",
+			"GetMapStyleDescriptor",
+			"GetMapTile",
+			"GetPlace",
+			"ListDevicePositions",
+			"ListGeofenceCollections",
+			"ListGeofences",
+			"ListMaps",
+			"ListPlaceIndexes",
+			"ListRouteCalculators",
+			"ListTagsForResource",
+			"ListTrackerConsumers",
+			"ListTrackers",
+			"PutGeofence",
+			"SearchPlaceIndexForPosition",
+			"SearchPlaceIndexForSuggestions",
+			"SearchPlaceIndexForText",
+			"TagResource",
+			"UntagResource",
+			"UpdateGeofenceCollection",
+			"UpdateMap",
+			"UpdatePlaceIndex",
+			"UpdateRouteCalculator",
+			"UpdateTracker",
+		},
+	},
+	"glacier": {
+		actions: []string{
+			"AbortMultipartUpload",
+			"AbortVaultLock",
+			"AddTagsToVault",
+			"CompleteMultipartUpload",
+			"CompleteVaultLock",
+			"CreateVault",
+			"DeleteArchive",
+			"DeleteVault",
+			"DeleteVaultAccessPolicy",
+			"DeleteVaultNotifications",
+			"DescribeJob",
+			"DescribeVault",
+			"GetDataRetrievalPolicy",
+			"GetJobOutput",
+			"GetVaultAccessPolicy",
+			"GetVaultLock",
+			"GetVaultNotifications",
+			"InitiateJob",
+			"InitiateMultipartUpload",
+			"InitiateVaultLock",
+			"ListJobs",
+			"ListMultipartUploads",
+			"ListParts",
+			"ListProvisionedCapacity",
+			"ListTagsForVault",
+			"ListVaults",
+			"PurchaseProvisionedCapacity",
+			"RemoveTagsFromVault",
+			"SetDataRetrievalPolicy",
+			"SetVaultAccessPolicy",
+			"SetVaultNotifications",
+			"UploadArchive",
+			"UploadMultipartPart",
+		},
+	},
+	"globalaccelerator": {
+		actions: []string{
+			"AddCustomRoutingEndpoints",
+			"AddEndpoints",
+			"AdvertiseByoipCidr",
+			"AllowCustomRoutingTraffic",
+			"CreateAccelerator",
+			"CreateCustomRoutingAccelerator",
+			"CreateCustomRoutingEndpointGroup",
+			"CreateCustomRoutingListener",
+			"CreateEndpointGroup",
+			"CreateListener",
+			"DeleteAccelerator",
+			"DeleteCustomRoutingAccelerator",
+			"DeleteCustomRoutingEndpointGroup",
+			"DeleteCustomRoutingListener",
+			"DeleteEndpointGroup",
+			"DeleteListener",
+			"DenyCustomRoutingTraffic",
+			"DeprovisionByoipCidr",
+			"DescribeAccelerator",
+			"DescribeAcceleratorAttributes",
+			"DescribeCustomRoutingAccelerator",
+			"DescribeCustomRoutingAcceleratorAttributes",
+			"DescribeCustomRoutingEndpointGroup",
+			"DescribeCustomRoutingListener",
+			"DescribeEndpointGroup",
+			"DescribeListener",
+			"ListAccelerators",
+			"ListByoipCidrs",
+			"ListCustomRoutingAccelerators",
+			"ListCustomRoutingEndpointGroups",
+			"ListCustomRoutingListeners",
+			"ListCustomRoutingPortMappings",
+			"ListCustomRoutingPortMappingsByDestination",
+			"ListEndpointGroups",
+			"ListListeners",
+			"ListTagsForResource",
+			"ProvisionByoipCidr",
+			"RemoveCustomRoutingEndpoints",
+			"RemoveEndpoints",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccelerator",
+			"UpdateAcceleratorAttributes",
+			"UpdateCustomRoutingAccelerator",
+			"UpdateCustomRoutingAcceleratorAttributes",
+			"UpdateCustomRoutingListener",
+			"UpdateEndpointGroup",
+			"UpdateListener",
+			"WithdrawByoipCidr",
+		},
+	},
+	"glue": {
+		actions: []string{
+			"BatchCreatePartition",
+			"BatchDeleteConnection",
+			"BatchDeletePartition",
+			"BatchDeleteTable",
+			"BatchDeleteTableVersion",
+			"BatchGetBlueprints",
+			"BatchGetCrawlers",
+			"BatchGetCustomEntityTypes",
+			"BatchGetDataQualityResult",
+			"BatchGetDevEndpoints",
+			"BatchGetJobs",
+			"BatchGetPartition",
+			"BatchGetTriggers",
+			"BatchGetWorkflows",
+			"BatchStopJobRun",
+			"BatchUpdatePartition",
+			"CancelDataQualityRuleRecommendationRun",
+			"CancelDataQualityRulesetEvaluationRun",
+			"CancelMLTaskRun",
+			"CancelStatement",
+			"CheckSchemaVersionValidity",
+			"CreateBlueprint",
+			"CreateClassifier",
+			"CreateConnection",
+			"CreateCrawler",
+			"CreateCustomEntityType",
+			"CreateDatabase",
+			"CreateDataQualityRuleset",
+			"CreateDevEndpoint",
+			"CreateJob",
+			"CreateMLTransform",
+			"CreatePartition",
+			"CreatePartitionIndex",
+			"CreateRegistry",
+			"CreateSchema",
+			"CreateScript",
+			"CreateSecurityConfiguration",
+			"CreateSession",
+			"CreateTable",
+			"CreateTrigger",
+			"CreateUserDefinedFunction",
+			"CreateWorkflow",
+			"DeleteBlueprint",
+			"DeleteClassifier",
+			"DeleteColumnStatisticsForPartition",
+			"DeleteColumnStatisticsForTable",
+			"DeleteConnection",
+			"DeleteCrawler",
+			"DeleteCustomEntityType",
+			"DeleteDatabase",
+			"DeleteDataQualityRuleset",
+			"DeleteDevEndpoint",
+			"DeleteJob",
+			"DeleteMLTransform",
+			"DeletePartition",
+			"DeletePartitionIndex",
+			"DeleteRegistry",
+			"DeleteResourcePolicy",
+			"DeleteSchema",
+			"DeleteSchemaVersions",
+			"DeleteSecurityConfiguration",
+			"DeleteSession",
+			"DeleteTable",
+			"DeleteTableVersion",
+			"DeleteTrigger",
+			"DeleteUserDefinedFunction",
+			"DeleteWorkflow",
+			"GetBlueprint",
+			"GetBlueprintRun",
+			"GetBlueprintRuns",
+			"GetCatalogImportStatus",
+			"GetClassifier",
+			"GetClassifiers",
+			"GetColumnStatisticsForPartition",
+			"GetColumnStatisticsForTable",
+			"GetConnection",
+			"GetConnections",
+			"GetCrawler",
+			"GetCrawlerMetrics",
+			"GetCrawlers",
+			"GetCustomEntityType",
+			"GetDatabase",
+			"GetDatabases",
+			"GetDataCatalogEncryptionSettings",
+			"GetDataflowGraph",
+			"GetDataQualityResult",
+			"GetDataQualityRuleRecommendationRun",
+			"GetDataQualityRuleset",
+			"GetDataQualityRulesetEvaluationRun",
+			"GetDevEndpoint",
+			"GetDevEndpoints",
+			"GetJob",
+			"GetJobBookmark",
+			"GetJobRun",
+			"GetJobRuns",
+			"GetJobs",
+			"GetMapping",
+			"GetMLTaskRun",
+			"GetMLTaskRuns",
+			"GetMLTransform",
+			"GetMLTransforms",
+			"GetPartition",
+			"GetPartitionIndexes",
+			"GetPartitions",
+			"GetPlan",
+			"GetRegistry",
+			"GetResourcePolicies",
+			"GetResourcePolicy",
+			"GetSchema",
+			"GetSchemaByDefinition",
+			"GetSchemaVersion",
+			"GetSchemaVersionsDiff",
+			"GetSecurityConfiguration",
+			"GetSecurityConfigurations",
+			"GetSession",
+			"GetStatement",
+			"GetTable",
+			"GetTables",
+			"GetTableVersion",
+			"GetTableVersions",
+			"GetTags",
+			"GetTrigger",
+			"GetTriggers",
+			"GetUnfilteredPartitionMetadata",
+			"GetUnfilteredPartitionsMetadata",
+			"GetUnfilteredTableMetadata",
+			"GetUserDefinedFunction",
+			"GetUserDefinedFunctions",
+			"GetWorkflow",
+			"GetWorkflowRun",
+			"GetWorkflowRunProperties",
+			"GetWorkflowRuns",
+			"ImportCatalogToGlue",
+			"ListBlueprints",
+			"ListCrawlers",
+			"ListCrawls",
+			"ListCustomEntityTypes",
+			"ListDataQualityResults",
+			"ListDataQualityRuleRecommendationRuns",
+			"ListDataQualityRulesetEvaluationRuns",
+			"ListDataQualityRulesets",
+			"ListDevEndpoints",
+			"ListJobs",
+			"ListMLTransforms",
+			"ListRegistries",
+			"ListSchemas",
+			"ListSchemaVersions",
+			"ListSessions",
+			"ListStatements",
+			"ListTriggers",
+			"ListWorkflows",
+			"PutDataCatalogEncryptionSettings",
+			"PutResourcePolicy",
+			"PutSchemaVersionMetadata",
+			"PutWorkflowRunProperties",
+			"QuerySchemaVersionMetadata",
+			"RegisterSchemaVersion",
+			"RemoveSchemaVersionMetadata",
+			"ResetJobBookmark",
+			"ResumeWorkflowRun",
+			"RunStatement",
+			"SearchTables",
+			"StartBlueprintRun",
+			"StartCrawler",
+			"StartCrawlerSchedule",
+			"StartDataQualityRuleRecommendationRun",
+			"StartDataQualityRulesetEvaluationRun",
+			"StartExportLabelsTaskRun",
+			"StartImportLabelsTaskRun",
+			"StartJobRun",
+			"StartMLEvaluationTaskRun",
+			"StartMLLabelingSetGenerationTaskRun",
+			"StartTrigger",
+			"StartWorkflowRun",
+			"StopCrawler",
+			"StopCrawlerSchedule",
+			"StopSession",
+			"StopTrigger",
+			"StopWorkflowRun",
+			"TagResource",
+			"UntagResource",
+			"UpdateBlueprint",
+			"UpdateClassifier",
+			"UpdateColumnStatisticsForPartition",
+			"UpdateColumnStatisticsForTable",
+			"UpdateConnection",
+			"UpdateCrawler",
+			"UpdateCrawlerSchedule",
+			"UpdateDatabase",
+			"UpdateDataQualityRuleset",
+			"UpdateDevEndpoint",
+			"UpdateJob",
+			"UpdateJobFromSourceControl",
+			"UpdateMLTransform",
+			"UpdatePartition",
+			"UpdateRegistry",
+			"UpdateSchema",
+			"UpdateSourceControlFromJob",
+			"UpdateTable",
+			"UpdateTrigger",
+			"UpdateUserDefinedFunction",
+			"UpdateWorkflow",
+		},
+	},
+	"grafana": {
+		actions: []string{
+			"AssociateLicense",
+			"CreateWorkspace",
+			"CreateWorkspaceApiKey",
+			"DeleteWorkspace",
+			"DeleteWorkspaceApiKey",
+			"DescribeWorkspace",
+			"DescribeWorkspaceAuthentication",
+			"DescribeWorkspaceConfiguration",
+			"DisassociateLicense",
+			"ListPermissions",
+			"ListTagsForResource",
+			"ListWorkspaces",
+			"TagResource",
+			"UntagResource",
+			"UpdatePermissions",
+			"UpdateWorkspace",
+			"UpdateWorkspaceAuthentication",
+			"UpdateWorkspaceConfiguration",
+		},
+	},
+	"greengrass": {
+		actions: []string{
+			"AssociateRoleToGroup",
+			"AssociateServiceRoleToAccount",
+			"BatchAssociateClientDeviceWithCoreDevice",
+			"BatchDisassociateClientDeviceFromCoreDevice",
+			"CancelDeployment",
+			"CreateComponentVersion",
+			"CreateConnectorDefinition",
+			"CreateConnectorDefinitionVersion",
+			"CreateCoreDefinition",
+			"CreateCoreDefinitionVersion",
+			"CreateDeployment",
+			"CreateDeviceDefinition",
+			"CreateDeviceDefinitionVersion",
+			"CreateFunctionDefinition",
+			"CreateFunctionDefinitionVersion",
+			"CreateGroup",
+			"CreateGroupCertificateAuthority",
+			"CreateGroupVersion",
+			"CreateLoggerDefinition",
+			"CreateLoggerDefinitionVersion",
+			"CreateResourceDefinition",
+			"CreateResourceDefinitionVersion",
+			"CreateSoftwareUpdateJob",
+			"CreateSubscriptionDefinition",
+			"CreateSubscriptionDefinitionVersion",
+			"DeleteComponent",
+			"DeleteConnectorDefinition",
+			"DeleteCoreDefinition",
+			"DeleteCoreDevice",
+			"DeleteDeployment",
+			"DeleteDeviceDefinition",
+			"DeleteFunctionDefinition",
+			"DeleteGroup",
+			"DeleteLoggerDefinition",
+			"DeleteResourceDefinition",
+			"DeleteSubscriptionDefinition",
+			"DescribeComponent",
+			"DisassociateRoleFromGroup",
+			"DisassociateServiceRoleFromAccount",
+			"GetAssociatedRole",
+			"GetBulkDeploymentStatus",
+			"GetComponent",
+			"GetComponentVersionArtifact",
+			"GetConnectivityInfo",
+			"GetConnectorDefinition",
+			"GetConnectorDefinitionVersion",
+			"GetCoreDefinition",
+			"GetCoreDefinitionVersion",
+			"GetCoreDevice",
+			"GetDeployment",
+			"GetDeploymentStatus",
+			"GetDeviceDefinition",
+			"GetDeviceDefinitionVersion",
+			"GetFunctionDefinition",
+			"GetFunctionDefinitionVersion",
+			"GetGroup",
+			"GetGroupCertificateAuthority",
+			"GetGroupCertificateConfiguration",
+			"GetGroupVersion",
+			"GetLoggerDefinition",
+			"GetLoggerDefinitionVersion",
+			"GetResourceDefinition",
+			"GetResourceDefinitionVersion",
+			"GetServiceRoleForAccount",
+			"GetSubscriptionDefinition",
+			"GetSubscriptionDefinitionVersion",
+			"GetThingRuntimeConfiguration",
+			"ListBulkDeploymentDetailedReports",
+			"ListBulkDeployments",
+			"ListClientDevicesAssociatedWithCoreDevice",
+			"ListComponents",
+			"ListComponentVersions",
+			"ListConnectorDefinitions",
+			"ListConnectorDefinitionVersions",
+			"ListCoreDefinitions",
+			"ListCoreDefinitionVersions",
+			"ListCoreDevices",
+			"ListDeployments",
+			"ListDeviceDefinitions",
+			"ListDeviceDefinitionVersions",
+			"ListEffectiveDeployments",
+			"ListFunctionDefinitions",
+			"ListFunctionDefinitionVersions",
+			"ListGroupCertificateAuthorities",
+			"ListGroups",
+			"ListGroupVersions",
+			"ListInstalledComponents",
+			"ListLoggerDefinitions",
+			"ListLoggerDefinitionVersions",
+			"ListResourceDefinitions",
+			"ListResourceDefinitionVersions",
+			"ListSubscriptionDefinitions",
+			"ListSubscriptionDefinitionVersions",
+			"ListTagsForResource",
+			"ResetDeployments",
+			"ResolveComponentCandidates",
+			"StartBulkDeployment",
+			"StopBulkDeployment",
+			"TagResource",
+			"UntagResource",
+			"UpdateConnectivityInfo",
+			"UpdateConnectorDefinition",
+			"UpdateCoreDefinition",
+			"UpdateDeviceDefinition",
+			"UpdateFunctionDefinition",
+			"UpdateGroup",
+			"UpdateGroupCertificateConfiguration",
+			"UpdateLoggerDefinition",
+			"UpdateResourceDefinition",
+			"UpdateSubscriptionDefinition",
+			"UpdateThingRuntimeConfiguration",
+		},
+	},
+	"groundstation": {
+		actions: []string{
+			"CancelContact",
+			"CreateConfig",
+			"CreateDataflowEndpointGroup",
+			"CreateEphemeris",
+			"CreateMissionProfile",
+			"DeleteConfig",
+			"DeleteDataflowEndpointGroup",
+			"DeleteEphemeris",
+			"DeleteMissionProfile",
+			"DescribeContact",
+			"DescribeEphemeris",
+			"GetConfig",
+			"GetDataflowEndpointGroup",
+			"GetMinuteUsage",
+			"GetMissionProfile",
+			"GetSatellite",
+			"ListConfigs",
+			"ListContacts",
+			"ListDataflowEndpointGroups",
+			"ListEphemerides",
+			"ListGroundStations",
+			"ListMissionProfiles",
+			"ListSatellites",
+			"ListTagsForResource",
+			"ReserveContact",
+			"TagResource",
+			"UntagResource",
+			"UpdateConfig",
+			"UpdateEphemeris",
+			"UpdateMissionProfile",
+		},
+	},
+	"guardduty": {
+		actions: []string{
+			"AcceptAdministratorInvitation",
+			"AcceptInvitation",
+			"ArchiveFindings",
+			"CreateDetector",
+			"CreateFilter",
+			"CreateIPSet",
+			"CreateMembers",
+			"CreatePublishingDestination",
+			"CreateSampleFindings",
+			"CreateThreatIntelSet",
+			"DeclineInvitations",
+			"DeleteDetector",
+			"DeleteFilter",
+			"DeleteInvitations",
+			"DeleteIPSet",
+			"DeleteMembers",
+			"DeletePublishingDestination",
+			"DeleteThreatIntelSet",
+			"DescribeMalwareScans",
+			"DescribeOrganizationConfiguration",
+			"DescribePublishingDestination",
+			"DisableOrganizationAdminAccount",
+			"DisassociateFromAdministratorAccount",
+			"DisassociateFromMasterAccount",
+			"DisassociateMembers",
+			"EnableOrganizationAdminAccount",
+			"GetAdministratorAccount",
+			"GetDetector",
+			"GetFilter",
+			"GetFindings",
+			"GetFindingsStatistics",
+			"GetInvitationsCount",
+			"GetIPSet",
+			"GetMalwareScanSettings",
+			"GetMasterAccount",
+			"GetMemberDetectors",
+			"GetMembers",
+			"GetRemainingFreeTrialDays",
+			"GetThreatIntelSet",
+			"GetUsageStatistics",
+			"InviteMembers",
+			"ListDetectors",
+			"ListFilters",
+			"ListFindings",
+			"ListInvitations",
+			"ListIPSets",
+			"ListMembers",
+			"ListOrganizationAdminAccounts",
+			"ListPublishingDestinations",
+			"ListTagsForResource",
+			"ListThreatIntelSets",
+			"StartMonitoringMembers",
+			"StopMonitoringMembers",
+			"TagResource",
+			"UnarchiveFindings",
+			"UntagResource",
+			"UpdateDetector",
+			"UpdateFilter",
+			"UpdateFindingsFeedback",
+			"UpdateIPSet",
+			"UpdateMalwareScanSettings",
+			"UpdateMemberDetectors",
+			"UpdateOrganizationConfiguration",
+			"UpdatePublishingDestination",
+			"UpdateThreatIntelSet",
+		},
+	},
+	"health": {
+		actions: []string{
+			"DescribeAffectedAccountsForOrganization",
+			"DescribeAffectedEntities",
+			"DescribeAffectedEntitiesForOrganization",
+			"DescribeEntityAggregates",
+			"DescribeEventAggregates",
+			"DescribeEventDetails",
+			"DescribeEventDetailsForOrganization",
+			"DescribeEvents",
+			"DescribeEventsForOrganization",
+			"DescribeEventTypes",
+			"DescribeHealthServiceStatusForOrganization",
+			"DisableHealthServiceAccessForOrganization",
+			"EnableHealthServiceAccessForOrganization",
+		},
+	},
+	"healthlake": {
+		actions: []string{
+			"CreateFHIRDatastore",
+			"DeleteFHIRDatastore",
+			"DescribeFHIRDatastore",
+			"DescribeFHIRExportJob",
+			"DescribeFHIRImportJob",
+			"ListFHIRDatastores",
+			"ListFHIRExportJobs",
+			"ListFHIRImportJobs",
+			"ListTagsForResource",
+			"StartFHIRExportJob",
+			"StartFHIRImportJob",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"honeycode": {
+		actions: []string{
+			"BatchCreateTableRows",
+			"BatchDeleteTableRows",
+			"BatchUpdateTableRows",
+			"BatchUpsertTableRows",
+			"DescribeTableDataImportJob",
+			"GetScreenData",
+			"InvokeScreenAutomation",
+			"ListTableColumns",
+			"ListTableRows",
+			"ListTables",
+			"ListTagsForResource",
+			"QueryTableRows",
+			"StartTableDataImportJob",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"iam": {
+		actions: []string{
+			"AddClientIDToOpenIDConnectProvider",
+			"AddRoleToInstanceProfile",
+			"AddUserToGroup",
+			"AttachGroupPolicy",
+			"AttachRolePolicy",
+			"AttachUserPolicy",
+			"ChangePassword",
+			"CreateAccessKey",
+			"CreateAccountAlias",
+			"CreateGroup",
+			"CreateInstanceProfile",
+			"CreateLoginProfile",
+			"CreateOpenIDConnectProvider",
+			"CreatePolicy",
+			"CreatePolicyVersion",
+			"CreateRole",
+			"CreateSAMLProvider",
+			"CreateServiceLinkedRole",
+			"CreateServiceSpecificCredential",
+			"CreateUser",
+			"CreateVirtualMFADevice",
+			"DeactivateMFADevice",
+			"DeleteAccessKey",
+			"DeleteAccountAlias",
+			"DeleteAccountPasswordPolicy",
+			"DeleteGroup",
+			"DeleteGroupPolicy",
+			"DeleteInstanceProfile",
+			"DeleteLoginProfile",
+			"DeleteOpenIDConnectProvider",
+			"DeletePolicy",
+			"DeletePolicyVersion",
+			"DeleteRole",
+			"DeleteRolePermissionsBoundary",
+			"DeleteRolePolicy",
+			"DeleteSAMLProvider",
+			"DeleteServerCertificate",
+			"DeleteServiceLinkedRole",
+			"DeleteServiceSpecificCredential",
+			"DeleteSigningCertificate",
+			"DeleteSSHPublicKey",
+			"DeleteUser",
+			"DeleteUserPermissionsBoundary",
+			"DeleteUserPolicy",
+			"DeleteVirtualMFADevice",
+			"DetachGroupPolicy",
+			"DetachRolePolicy",
+			"DetachUserPolicy",
+			"EnableMFADevice",
+			"GenerateCredentialReport",
+			"GenerateOrganizationsAccessReport",
+			"GenerateServiceLastAccessedDetails",
+			"GetAccessKeyLastUsed",
+			"GetAccountAuthorizationDetails",
+			"GetAccountPasswordPolicy",
+			"GetAccountSummary",
+			"GetContextKeysForCustomPolicy",
+			"GetContextKeysForPrincipalPolicy",
+			"GetCredentialReport",
+			"GetGroup",
+			"GetGroupPolicy",
+			"GetInstanceProfile",
+			"GetLoginProfile",
+			"GetOpenIDConnectProvider",
+			"GetOrganizationsAccessReport",
+			"GetPolicy",
+			"GetPolicyVersion",
+			"GetRole",
+			"GetRolePolicy",
+			"GetSAMLProvider",
+			"GetServerCertificate",
+			"GetServiceLastAccessedDetails",
+			"GetServiceLastAccessedDetailsWithEntities",
+			"GetServiceLinkedRoleDeletionStatus",
+			"GetSSHPublicKey",
+			"GetUser",
+			"GetUserPolicy",
+			"ListAccessKeys",
+			"ListAccountAliases",
+			"ListAttachedGroupPolicies",
+			"ListAttachedRolePolicies",
+			"ListAttachedUserPolicies",
+			"ListEntitiesForPolicy",
+			"ListGroupPolicies",
+			"ListGroups",
+			"ListGroupsForUser",
+			"ListInstanceProfiles",
+			"ListInstanceProfilesForRole",
+			"ListInstanceProfileTags",
+			"ListMFADevices",
+			"ListMFADeviceTags",
+			"ListOpenIDConnectProviders",
+			"ListOpenIDConnectProviderTags",
+			"ListPolicies",
+			"ListPoliciesGrantingServiceAccess",
+			"ListPolicyTags",
+			"ListPolicyVersions",
+			"ListRolePolicies",
+			"ListRoles",
+			"ListRoleTags",
+			"ListSAMLProviders",
+			"ListSAMLProviderTags",
+			"ListServerCertificates",
+			"ListServerCertificateTags",
+			"ListServiceSpecificCredentials",
+			"ListSigningCertificates",
+			"ListSSHPublicKeys",
+			"ListUserPolicies",
+			"ListUsers",
+			"ListUserTags",
+			"ListVirtualMFADevices",
+			"PassRole",
+			"PutGroupPolicy",
+			"PutRolePermissionsBoundary",
+			"PutRolePolicy",
+			"PutUserPermissionsBoundary",
+			"PutUserPolicy",
+			"RemoveClientIDFromOpenIDConnectProvider",
+			"RemoveRoleFromInstanceProfile",
+			"RemoveUserFromGroup",
+			"ResetServiceSpecificCredential",
+			"ResyncMFADevice",
+			"SetDefaultPolicyVersion",
+			"SetSecurityTokenServicePreferences",
+			"SimulateCustomPolicy",
+			"SimulatePrincipalPolicy",
+			"TagInstanceProfile",
+			"TagMFADevice",
+			"TagOpenIDConnectProvider",
+			"TagPolicy",
+			"TagRole",
+			"TagSAMLProvider",
+			"TagServerCertificate",
+			"TagUser",
+			"UntagInstanceProfile",
+			"UntagMFADevice",
+			"UntagOpenIDConnectProvider",
+			"UntagPolicy",
+			"UntagRole",
+			"UntagSAMLProvider",
+			"UntagServerCertificate",
+			"UntagUser",
+			"UpdateAccessKey",
+			"UpdateAccountPasswordPolicy",
+			"UpdateAssumeRolePolicy",
+			"UpdateGroup",
+			"UpdateLoginProfile",
+			"UpdateOpenIDConnectProviderThumbprint",
+			"UpdateRole",
+			"UpdateRoleDescription",
+			"UpdateSAMLProvider",
+			"UpdateServerCertificate",
+			"UpdateServiceSpecificCredential",
+			"UpdateSigningCertificate",
+			"UpdateSSHPublicKey",
+			"UpdateUser",
+			"UploadServerCertificate",
+			"UploadSigningCertificate",
+			"UploadSSHPublicKey",
+		},
+	},
+	"identitystore": {
+		actions: []string{
+			"CreateGroup",
+			"CreateGroupMembership",
+			"CreateUser",
+			"DeleteGroup",
+			"DeleteGroupMembership",
+			"DeleteUser",
+			"DescribeGroup",
+			"DescribeGroupMembership",
+			"DescribeUser",
+			"GetGroupId",
+			"GetGroupMembershipId",
+			"GetUserId",
+			"IsMemberInGroups",
+			"ListGroupMemberships",
+			"ListGroupMembershipsForMember",
+			"ListGroups",
+			"ListUsers",
+			"UpdateGroup",
+			"UpdateUser",
+		},
+	},
+	"imagebuilder": {
+		actions: []string{
+			"CancelImageCreation",
+			"CreateComponent",
+			"CreateContainerRecipe",
+			"CreateDistributionConfiguration",
+			"CreateImage",
+			"CreateImagePipeline",
+			"CreateImageRecipe",
+			"CreateInfrastructureConfiguration",
+			"DeleteComponent",
+			"DeleteContainerRecipe",
+			"DeleteDistributionConfiguration",
+			"DeleteImage",
+			"DeleteImagePipeline",
+			"DeleteImageRecipe",
+			"DeleteInfrastructureConfiguration",
+			"GetComponent",
+			"GetComponentPolicy",
+			"GetContainerRecipe",
+			"GetContainerRecipePolicy",
+			"GetDistributionConfiguration",
+			"GetImage",
+			"GetImagePipeline",
+			"GetImagePolicy",
+			"GetImageRecipe",
+			"GetImageRecipePolicy",
+			"GetInfrastructureConfiguration",
+			"ImportComponent",
+			"ImportVmImage",
+			"ListComponentBuildVersions",
+			"ListComponents",
+			"ListContainerRecipes",
+			"ListDistributionConfigurations",
+			"ListImageBuildVersions",
+			"ListImagePackages",
+			"ListImagePipelineImages",
+			"ListImagePipelines",
+			"ListImageRecipes",
+			"ListImages",
+			"ListInfrastructureConfigurations",
+			"ListTagsForResource",
+			"PutComponentPolicy",
+			"PutContainerRecipePolicy",
+			"PutImagePolicy",
+			"PutImageRecipePolicy",
+			"StartImagePipelineExecution",
+			"TagResource",
+			"UntagResource",
+			"UpdateDistributionConfiguration",
+			"UpdateImagePipeline",
+			"UpdateInfrastructureConfiguration",
+		},
+	},
+	"importexport": {
+		actions: []string{
+			"CancelJob",
+			"CreateJob",
+			"GetShippingLabel",
+			"GetStatus",
+			"ListJobs",
+			"UpdateJob",
+		},
+	},
+	"inspector": {
+		actions: []string{
+			"AddAttributesToFindings",
+			"CreateAssessmentTarget",
+			"CreateAssessmentTemplate",
+			"CreateExclusionsPreview",
+			"CreateResourceGroup",
+			"DeleteAssessmentRun",
+			"DeleteAssessmentTarget",
+			"DeleteAssessmentTemplate",
+			"DescribeAssessmentRuns",
+			"DescribeAssessmentTargets",
+			"DescribeAssessmentTemplates",
+			"DescribeCrossAccountAccessRole",
+			"DescribeExclusions",
+			"DescribeFindings",
+			"DescribeResourceGroups",
+			"DescribeRulesPackages",
+			"GetAssessmentReport",
+			"GetExclusionsPreview",
+			"GetTelemetryMetadata",
+			"ListAssessmentRunAgents",
+			"ListAssessmentRuns",
+			"ListAssessmentTargets",
+			"ListAssessmentTemplates",
+			"ListEventSubscriptions",
+			"ListExclusions",
+			"ListFindings",
+			"ListRulesPackages",
+			"ListTagsForResource",
+			"PreviewAgents",
+			"RegisterCrossAccountAccessRole",
+			"RemoveAttributesFromFindings",
+			"SetTagsForResource",
+			"StartAssessmentRun",
+			"StopAssessmentRun",
+			"SubscribeToEvent",
+			"UnsubscribeFromEvent",
+			"UpdateAssessmentTarget",
+		},
+	},
+	"inspector2": {
+		actions: []string{
+			"AssociateMember",
+			"BatchGetAccountStatus",
+			"BatchGetFreeTrialInfo",
+			"CancelFindingsReport",
+			"CreateFilter",
+			"CreateFindingsReport",
+			"DeleteFilter",
+			"DescribeOrganizationConfiguration",
+			"Disable",
+			"DisableDelegatedAdminAccount",
+			"DisassociateMember",
+			"Enable",
+			"EnableDelegatedAdminAccount",
+			"GetConfiguration",
+			"GetDelegatedAdminAccount",
+			"GetFindingsReportStatus",
+			"GetMember",
+			"ListAccountPermissions",
+			"ListCoverage",
+			"ListCoverageStatistics",
+			"ListDelegatedAdminAccounts",
+			"ListFilters",
+			"ListFindingAggregations",
+			"ListFindings",
+			"ListMembers",
+			"ListTagsForResource",
+			"ListUsageTotals",
+			"TagResource",
+			"UntagResource",
+			"UpdateConfiguration",
+			"UpdateFilter",
+			"UpdateOrganizationConfiguration",
+		},
+	},
+	"iot": {
+		actions: []string{
+			"AcceptCertificateTransfer",
+			"AddThingToBillingGroup",
+			"AddThingToThingGroup",
+			"AssociateTargetsWithJob",
+			"AttachPolicy",
+			"AttachPrincipalPolicy",
+			"AttachSecurityProfile",
+			"AttachThingPrincipal",
+			"CancelAuditMitigationActionsTask",
+			"CancelAuditTask",
+			"CancelCertificateTransfer",
+			"CancelDetectMitigationActionsTask",
+			"CancelJob",
+			"CancelJobExecution",
+			"ClearDefaultAuthorizer",
+			"CloseTunnel",
+			"ConfirmTopicRuleDestination",
+			"Connect",
+			"CreateAuditSuppression",
+			"CreateAuthorizer",
+			"CreateBillingGroup",
+			"CreateCertificateFromCsr",
+			"CreateCustomMetric",
+			"CreateDimension",
+			"CreateDomainConfiguration",
+			"CreateDynamicThingGroup",
+			"CreateFleetMetric",
+			"CreateJob",
+			"CreateJobTemplate",
+			"CreateKeysAndCertificate",
+			"CreateMitigationAction",
+			"CreateOTAUpdate",
+			"CreatePolicy",
+			"CreatePolicyVersion",
+			"CreateProvisioningClaim",
+			"CreateProvisioningTemplate",
+			"CreateProvisioningTemplateVersion",
+			"CreateRoleAlias",
+			"CreateScheduledAudit",
+			"CreateSecurityProfile",
+			"CreateStream",
+			"CreateThing",
+			"CreateThingGroup",
+			"CreateThingType",
+			"CreateTopicRule",
+			"CreateTopicRuleDestination",
+			"DeleteAccountAuditConfiguration",
+			"DeleteAuditSuppression",
+			"DeleteAuthorizer",
+			"DeleteBillingGroup",
+			"DeleteCACertificate",
+			"DeleteCertificate",
+			"DeleteCustomMetric",
+			"DeleteDimension",
+			"DeleteDomainConfiguration",
+			"DeleteDynamicThingGroup",
+			"DeleteFleetMetric",
+			"DeleteJob",
+			"DeleteJobExecution",
+			"DeleteJobTemplate",
+			"DeleteMitigationAction",
+			"DeleteOTAUpdate",
+			"DeletePolicy",
+			"DeletePolicyVersion",
+			"DeleteProvisioningTemplate",
+			"DeleteProvisioningTemplateVersion",
+			"DeleteRegistrationCode",
+			"DeleteRoleAlias",
+			"DeleteScheduledAudit",
+			"DeleteSecurityProfile",
+			"DeleteStream",
+			"DeleteThing",
+			"DeleteThingGroup",
+			"DeleteThingShadow",
+			"DeleteThingType",
+			"DeleteTopicRule",
+			"DeleteTopicRuleDestination",
+			"DeleteV2LoggingLevel",
+			"DeprecateThingType",
+			"DescribeAccountAuditConfiguration",
+			"DescribeAuditFinding",
+			"DescribeAuditMitigationActionsTask",
+			"DescribeAuditSuppression",
+			"DescribeAuditTask",
+			"DescribeAuthorizer",
+			"DescribeBillingGroup",
+			"DescribeCACertificate",
+			"DescribeCertificate",
+			"DescribeCustomMetric",
+			"DescribeDefaultAuthorizer",
+			"DescribeDetectMitigationActionsTask",
+			"DescribeDimension",
+			"DescribeDomainConfiguration",
+			"DescribeEndpoint",
+			"DescribeEventConfigurations",
+			"DescribeFleetMetric",
+			"DescribeIndex",
+			"DescribeJob",
+			"DescribeJobExecution",
+			"DescribeJobTemplate",
+			"DescribeManagedJobTemplate",
+			"DescribeMitigationAction",
+			"DescribeProvisioningTemplate",
+			"DescribeProvisioningTemplateVersion",
+			"DescribeRoleAlias",
+			"DescribeScheduledAudit",
+			"DescribeSecurityProfile",
+			"DescribeStream",
+			"DescribeThing",
+			"DescribeThingGroup",
+			"DescribeThingRegistrationTask",
+			"DescribeThingType",
+			"DescribeTunnel",
+			"DetachPolicy",
+			"DetachPrincipalPolicy",
+			"DetachSecurityProfile",
+			"DetachThingPrincipal",
+			"DisableTopicRule",
+			"EnableTopicRule",
+			"GetBehaviorModelTrainingSummaries",
+			"GetBucketsAggregation",
+			"GetCardinality",
+			"GetEffectivePolicies",
+			"GetIndexingConfiguration",
+			"GetJobDocument",
+			"GetLoggingOptions",
+			"GetOTAUpdate",
+			"GetPendingJobExecutions",
+			"GetPercentiles",
+			"GetPolicy",
+			"GetPolicyVersion",
+			"GetRegistrationCode",
+			"GetRetainedMessage",
+			"GetStatistics",
+			"GetThingShadow",
+			"GetTopicRule",
+			"GetTopicRuleDestination",
+			"GetV2LoggingOptions",
+			"ListActiveViolations",
+			"ListAttachedPolicies",
+			"ListAuditFindings",
+			"ListAuditMitigationActionsExecutions",
+			"ListAuditMitigationActionsTasks",
+			"ListAuditSuppressions",
+			"ListAuditTasks",
+			"ListAuthorizers",
+			"ListBillingGroups",
+			"ListCACertificates",
+			"ListCertificates",
+			"ListCertificatesByCA",
+			"ListCustomMetrics",
+			"ListDetectMitigationActionsExecutions",
+			"ListDetectMitigationActionsTasks",
+			"ListDimensions",
+			"ListDomainConfigurations",
+			"ListFleetMetrics",
+			"ListIndices",
+			"ListJobExecutionsForJob",
+			"ListJobExecutionsForThing",
+			"ListJobs",
+			"ListJobTemplates",
+			"ListManagedJobTemplates",
+			"ListMetricValues",
+			"ListMitigationActions",
+			"ListNamedShadowsForThing",
+			"ListOTAUpdates",
+			"ListOutgoingCertificates",
+			"ListPolicies",
+			"ListPolicyPrincipals",
+			"ListPolicyVersions",
+			"ListPrincipalPolicies",
+			"ListPrincipalThings",
+			"ListProvisioningTemplates",
+			"ListProvisioningTemplateVersions",
+			"ListRelatedResourcesForAuditFinding",
+			"ListRetainedMessages",
+			"ListRoleAliases",
+			"ListScheduledAudits",
+			"ListSecurityProfiles",
+			"ListSecurityProfilesForTarget",
+			"ListStreams",
+			"ListTagsForResource",
+			"ListTargetsForPolicy",
+			"ListTargetsForSecurityProfile",
+			"ListThingGroups",
+			"ListThingGroupsForThing",
+			"ListThingPrincipals",
+			"ListThingRegistrationTaskReports",
+			"ListThingRegistrationTasks",
+			"ListThings",
+			"ListThingsInBillingGroup",
+			"ListThingsInThingGroup",
+			"ListThingTypes",
+			"ListTopicRuleDestinations",
+			"ListTopicRules",
+			"ListTunnels",
+			"ListV2LoggingLevels",
+			"ListViolationEvents",
+			"OpenTunnel",
+			"Publish",
+			"PutVerificationStateOnViolation",
+			"Receive",
+			"RegisterCACertificate",
+			"RegisterCertificate",
+			"RegisterCertificateWithoutCA",
+			"RegisterThing",
+			"RejectCertificateTransfer",
+			"RemoveThingFromBillingGroup",
+			"RemoveThingFromThingGroup",
+			"ReplaceTopicRule",
+			"RetainPublish",
+			"RotateTunnelAccessToken",
+			"SearchIndex",
+			"SetDefaultAuthorizer",
+			"SetDefaultPolicyVersion",
+			"SetLoggingOptions",
+			"SetV2LoggingLevel",
+			"SetV2LoggingOptions",
+			"StartAuditMitigationActionsTask",
+			"StartDetectMitigationActionsTask",
+			"StartNextPendingJobExecution",
+			"StartOnDemandAuditTask",
+			"StartThingRegistrationTask",
+			"StopThingRegistrationTask",
+			"Subscribe",
+			"TagResource",
+			"TestAuthorization",
+			"TestInvokeAuthorizer",
+			"TransferCertificate",
+			"UntagResource",
+			"UpdateAccountAuditConfiguration",
+			"UpdateAuditSuppression",
+			"UpdateAuthorizer",
+			"UpdateBillingGroup",
+			"UpdateCACertificate",
+			"UpdateCertificate",
+			"UpdateCustomMetric",
+			"UpdateDimension",
+			"UpdateDomainConfiguration",
+			"UpdateDynamicThingGroup",
+			"UpdateEventConfigurations",
+			"UpdateFleetMetric",
+			"UpdateIndexingConfiguration",
+			"UpdateJob",
+			"UpdateJobExecution",
+			"UpdateMitigationAction",
+			"UpdateProvisioningTemplate",
+			"UpdateRoleAlias",
+			"UpdateScheduledAudit",
+			"UpdateSecurityProfile",
+			"UpdateStream",
+			"UpdateThing",
+			"UpdateThingGroup",
+			"UpdateThingGroupsForThing",
+			"UpdateThingShadow",
+			"UpdateTopicRuleDestination",
+			"ValidateSecurityProfileBehaviors",
+		},
+	},
+	"iot1click": {
+		actions: []string{
+			"AssociateDeviceWithPlacement",
+			"ClaimDevicesByClaimCode",
+			"CreatePlacement",
+			"CreateProject",
+			"DeletePlacement",
+			"DeleteProject",
+			"DescribeDevice",
+			"DescribePlacement",
+			"DescribeProject",
+			"DisassociateDeviceFromPlacement",
+			"FinalizeDeviceClaim",
+			"GetDeviceMethods",
+			"GetDevicesInPlacement",
+			"InitiateDeviceClaim",
+			"InvokeDeviceMethod",
+			"ListDeviceEvents",
+			"ListDevices",
+			"ListPlacements",
+			"ListProjects",
+			"ListTagsForResource",
+			"TagResource",
+			"UnclaimDevice",
+			"UntagResource",
+			"UpdateDeviceState",
+			"UpdatePlacement",
+			"UpdateProject",
+		},
+	},
+	"iotanalytics": {
+		actions: []string{
+			"BatchPutMessage",
+			"CancelPipelineReprocessing",
+			"CreateChannel",
+			"CreateDataset",
+			"CreateDatasetContent",
+			"CreateDatastore",
+			"CreatePipeline",
+			"DeleteChannel",
+			"DeleteDataset",
+			"DeleteDatasetContent",
+			"DeleteDatastore",
+			"DeletePipeline",
+			"DescribeChannel",
+			"DescribeDataset",
+			"DescribeDatastore",
+			"DescribeLoggingOptions",
+			"DescribePipeline",
+			"GetDatasetContent",
+			"ListChannels",
+			"ListDatasetContents",
+			"ListDatasets",
+			"ListDatastores",
+			"ListPipelines",
+			"ListTagsForResource",
+			"PutLoggingOptions",
+			"RunPipelineActivity",
+			"SampleChannelData",
+			"StartPipelineReprocessing",
+			"TagResource",
+			"UntagResource",
+			"UpdateChannel",
+			"UpdateDataset",
+			"UpdateDatastore",
+			"UpdatePipeline",
+		},
+	},
+	"iotdeviceadvisor": {
+		actions: []string{
+			"CreateSuiteDefinition",
+			"DeleteSuiteDefinition",
+			"GetEndpoint",
+			"GetSuiteDefinition",
+			"GetSuiteRun",
+			"GetSuiteRunReport",
+			"ListSuiteDefinitions",
+			"ListSuiteRuns",
+			"ListTagsForResource",
+			"StartSuiteRun",
+			"StopSuiteRun",
+			"TagResource",
+			"UntagResource",
+			"UpdateSuiteDefinition",
+		},
+	},
+	"iotevents": {
+		actions: []string{
+			"BatchAcknowledgeAlarm",
+			"BatchDeleteDetector",
+			"BatchDisableAlarm",
+			"BatchEnableAlarm",
+			"BatchPutMessage",
+			"BatchResetAlarm",
+			"BatchSnoozeAlarm",
+			"BatchUpdateDetector",
+			"CreateAlarmModel",
+			"CreateDetectorModel",
+			"CreateInput",
+			"DeleteAlarmModel",
+			"DeleteDetectorModel",
+			"DeleteInput",
+			"DescribeAlarm",
+			"DescribeAlarmModel",
+			"DescribeDetector",
+			"DescribeDetectorModel",
+			"DescribeDetectorModelAnalysis",
+			"DescribeInput",
+			"DescribeLoggingOptions",
+			"GetDetectorModelAnalysisResults",
+			"ListAlarmModels",
+			"ListAlarmModelVersions",
+			"ListAlarms",
+			"ListDetectorModels",
+			"ListDetectorModelVersions",
+			"ListDetectors",
+			"ListInputRoutings",
+			"ListInputs",
+			"ListTagsForResource",
+			"PutLoggingOptions",
+			"StartDetectorModelAnalysis",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlarmModel",
+			"UpdateDetectorModel",
+			"UpdateInput",
+		},
+	},
+	"iotfleethub": {
+		actions: []string{
+			"CreateApplication",
+			"DeleteApplication",
+			"DescribeApplication",
+			"ListApplications",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+		},
+	},
+	"iotfleetwise": {
+		actions: []string{
+			"AssociateVehicleFleet",
+			"BatchCreateVehicle",
+			"BatchUpdateVehicle",
+			"CreateCampaign",
+			"CreateDecoderManifest",
+			"CreateFleet",
+			"CreateModelManifest",
+			"CreateSignalCatalog",
+			"CreateVehicle",
+			"DeleteCampaign",
+			"DeleteDecoderManifest",
+			"DeleteFleet",
+			"DeleteModelManifest",
+			"DeleteSignalCatalog",
+			"DeleteVehicle",
+			"DisassociateVehicleFleet",
+			"GetCampaign",
+			"GetDecoderManifest",
+			"GetFleet",
+			"GetLoggingOptions",
+			"GetModelManifest",
+			"GetRegisterAccountStatus",
+			"GetSignalCatalog",
+			"GetVehicle",
+			"GetVehicleStatus",
+			"ImportDecoderManifest",
+			"ImportSignalCatalog",
+			"ListCampaigns",
+			"ListDecoderManifestNetworkInterfaces",
+			"ListDecoderManifests",
+			"ListDecoderManifestSignals",
+			"ListFleets",
+			"ListFleetsForVehicle",
+			"ListModelManifestNodes",
+			"ListModelManifests",
+			"ListSignalCatalogNodes",
+			"ListSignalCatalogs",
+			"ListTagsForResource",
+			"ListVehicles",
+			"ListVehiclesInFleet",
+			"PutLoggingOptions",
+			"RegisterAccount",
+			"TagResource",
+			"UntagResource",
+			"UpdateCampaign",
+			"UpdateDecoderManifest",
+			"UpdateFleet",
+			"UpdateModelManifest",
+			"UpdateSignalCatalog",
+			"UpdateVehicle",
+		},
+	},
+	"iotroborunner": {
+		actions: []string{
+			"CreateDestination",
+			"CreateSite",
+			"CreateWorker",
+			"CreateWorkerFleet",
+			"DeleteDestination",
+			"DeleteSite",
+			"DeleteWorker",
+			"DeleteWorkerFleet",
+			"GetDestination",
+			"GetSite",
+			"GetWorker",
+			"GetWorkerFleet",
+			"ListDestinations",
+			"ListSites",
+			"ListWorkerFleets",
+			"ListWorkers",
+			"UpdateDestination",
+			"UpdateSite",
+			"UpdateWorker",
+			"UpdateWorkerFleet",
+		},
+	},
+	"iotsitewise": {
+		actions: []string{
+			"AssociateAssets",
+			"AssociateTimeSeriesToAssetProperty",
+			"BatchAssociateProjectAssets",
+			"BatchDisassociateProjectAssets",
+			"BatchGetAssetPropertyAggregates",
+			"BatchGetAssetPropertyValue",
+			"BatchGetAssetPropertyValueHistory",
+			"BatchPutAssetPropertyValue",
+			"CreateAccessPolicy",
+			"CreateAsset",
+			"CreateAssetModel",
+			"CreateBulkImportJob",
+			"CreateDashboard",
+			"CreateGateway",
+			"CreatePortal",
+			"CreateProject",
+			"DeleteAccessPolicy",
+			"DeleteAsset",
+			"DeleteAssetModel",
+			"DeleteDashboard",
+			"DeleteGateway",
+			"DeletePortal",
+			"DeleteProject",
+			"DeleteTimeSeries",
+			"DescribeAccessPolicy",
+			"DescribeAsset",
+			"DescribeAssetModel",
+			"DescribeAssetProperty",
+			"DescribeBulkImportJob",
+			"DescribeDashboard",
+			"DescribeDefaultEncryptionConfiguration",
+			"DescribeGateway",
+			"DescribeGatewayCapabilityConfiguration",
+			"DescribeLoggingOptions",
+			"DescribePortal",
+			"DescribeProject",
+			"DescribeStorageConfiguration",
+			"DescribeTimeSeries",
+			"DisassociateAssets",
+			"DisassociateTimeSeriesFromAssetProperty",
+			"GetAssetPropertyAggregates",
+			"GetAssetPropertyValue",
+			"GetAssetPropertyValueHistory",
+			"GetInterpolatedAssetPropertyValues",
+			"ListAccessPolicies",
+			"ListAssetModelProperties",
+			"ListAssetModels",
+			"ListAssetProperties",
+			"ListAssetRelationships",
+			"ListAssets",
+			"ListAssociatedAssets",
+			"ListBulkImportJobs",
+			"ListDashboards",
+			"ListGateways",
+			"ListPortals",
+			"ListProjectAssets",
+			"ListProjects",
+			"ListTagsForResource",
+			"ListTimeSeries",
+			"PutDefaultEncryptionConfiguration",
+			"PutLoggingOptions",
+			"PutStorageConfiguration",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccessPolicy",
+			"UpdateAsset",
+			"UpdateAssetModel",
+			"UpdateAssetProperty",
+			"UpdateDashboard",
+			"UpdateGateway",
+			"UpdateGatewayCapabilityConfiguration",
+			"UpdatePortal",
+			"UpdateProject",
+		},
+	},
+	"iotthingsgraph": {
+		actions: []string{
+			"AssociateEntityToThing",
+			"CreateFlowTemplate",
+			"CreateSystemInstance",
+			"CreateSystemTemplate",
+			"DeleteFlowTemplate",
+			"DeleteNamespace",
+			"DeleteSystemInstance",
+			"DeleteSystemTemplate",
+			"DeploySystemInstance",
+			"DeprecateFlowTemplate",
+			"DeprecateSystemTemplate",
+			"DescribeNamespace",
+			"DissociateEntityFromThing",
+			"GetEntities",
+			"GetFlowTemplate",
+			"GetFlowTemplateRevisions",
+			"GetNamespaceDeletionStatus",
+			"GetSystemInstance",
+			"GetSystemTemplate",
+			"GetSystemTemplateRevisions",
+			"GetUploadStatus",
+			"ListFlowExecutionMessages",
+			"ListTagsForResource",
+			"SearchEntities",
+			"SearchFlowExecutions",
+			"SearchFlowTemplates",
+			"SearchSystemInstances",
+			"SearchSystemTemplates",
+			"SearchThings",
+			"TagResource",
+			"UndeploySystemInstance",
+			"UntagResource",
+			"UpdateFlowTemplate",
+			"UpdateSystemTemplate",
+			"UploadEntityDefinitions",
+		},
+	},
+	"iottwinmaker": {
+		actions: []string{
+			"BatchPutPropertyValues",
+			"CreateComponentType",
+			"CreateEntity",
+			"CreateScene",
+			"CreateSyncJob",
+			"CreateWorkspace",
+			"DeleteComponentType",
+			"DeleteEntity",
+			"DeleteScene",
+			"DeleteSyncJob",
+			"DeleteWorkspace",
+			"ExecuteQuery",
+			"GetComponentType",
+			"GetEntity",
+			"GetPricingPlan",
+			"GetPropertyValue",
+			"GetPropertyValueHistory",
+			"GetScene",
+			"GetSyncJob",
+			"GetWorkspace",
+			"ListComponentTypes",
+			"ListEntities",
+			"ListScenes",
+			"ListSyncJobs",
+			"ListSyncResources",
+			"ListTagsForResource",
+			"ListWorkspaces",
+			"TagResource",
+			"UntagResource",
+			"UpdateComponentType",
+			"UpdateEntity",
+			"UpdatePricingPlan",
+			"UpdateScene",
+			"UpdateWorkspace",
+		},
+	},
+	"iotwireless": {
+		actions: []string{
+			"AssociateAwsAccountWithPartnerAccount",
+			"AssociateMulticastGroupWithFuotaTask",
+			"AssociateWirelessDeviceWithFuotaTask",
+			"AssociateWirelessDeviceWithMulticastGroup",
+			"AssociateWirelessDeviceWithThing",
+			"AssociateWirelessGatewayWithCertificate",
+			"AssociateWirelessGatewayWithThing",
+			"CancelMulticastGroupSession",
+			"CreateDestination",
+			"CreateDeviceProfile",
+			"CreateFuotaTask",
+			"CreateMulticastGroup",
+			"CreateNetworkAnalyzerConfiguration",
+			"CreateServiceProfile",
+			"CreateWirelessDevice",
+			"CreateWirelessGateway",
+			"CreateWirelessGatewayTask",
+			"CreateWirelessGatewayTaskDefinition",
+			"DeleteDestination",
+			"DeleteDeviceProfile",
+			"DeleteFuotaTask",
+			"DeleteMulticastGroup",
+			"DeleteNetworkAnalyzerConfiguration",
+			"DeleteQueuedMessages",
+			"DeleteServiceProfile",
+			"DeleteWirelessDevice",
+			"DeleteWirelessGateway",
+			"DeleteWirelessGatewayTask",
+			"DeleteWirelessGatewayTaskDefinition",
+			"DisassociateAwsAccountFromPartnerAccount",
+			"DisassociateMulticastGroupFromFuotaTask",
+			"DisassociateWirelessDeviceFromFuotaTask",
+			"DisassociateWirelessDeviceFromMulticastGroup",
+			"DisassociateWirelessDeviceFromThing",
+			"DisassociateWirelessGatewayFromCertificate",
+			"DisassociateWirelessGatewayFromThing",
+			"GetDestination",
+			"GetDeviceProfile",
+			"GetEventConfigurationByResourceTypes",
+			"GetFuotaTask",
+			"GetLogLevelsByResourceTypes",
+			"GetMulticastGroup",
+			"GetMulticastGroupSession",
+			"GetNetworkAnalyzerConfiguration",
+			"GetPartnerAccount",
+			"GetPosition",
+			"GetPositionConfiguration",
+			"GetPositionEstimate",
+			"GetResourceEventConfiguration",
+			"GetResourceLogLevel",
+			"GetResourcePosition",
+			"GetServiceEndpoint",
+			"GetServiceProfile",
+			"GetWirelessDevice",
+			"GetWirelessDeviceStatistics",
+			"GetWirelessGateway",
+			"GetWirelessGatewayCertificate",
+			"GetWirelessGatewayFirmwareInformation",
+			"GetWirelessGatewayStatistics",
+			"GetWirelessGatewayTask",
+			"GetWirelessGatewayTaskDefinition",
+			"ListDestinations",
+			"ListDeviceProfiles",
+			"ListEventConfigurations",
+			"ListFuotaTasks",
+			"ListMulticastGroups",
+			"ListMulticastGroupsByFuotaTask",
+			"ListNetworkAnalyzerConfigurations",
+			"ListPartnerAccounts",
+			"ListPositionConfigurations",
+			"ListQueuedMessages",
+			"ListServiceProfiles",
+			"ListTagsForResource",
+			"ListWirelessDevices",
+			"ListWirelessGateways",
+			"ListWirelessGatewayTaskDefinitions",
+			"PutPositionConfiguration",
+			"PutResourceLogLevel",
+			"ResetAllResourceLogLevels",
+			"ResetResourceLogLevel",
+			"SendDataToMulticastGroup",
+			"SendDataToWirelessDevice",
+			"StartBulkAssociateWirelessDeviceWithMulticastGroup",
+			"StartBulkDisassociateWirelessDeviceFromMulticastGroup",
+			"StartFuotaTask",
+			"StartMulticastGroupSession",
+			"TagResource",
+			"TestWirelessDevice",
+			"UntagResource",
+			"UpdateDestination",
+			"UpdateEventConfigurationByResourceTypes",
+			"UpdateFuotaTask",
+			"UpdateLogLevelsByResourceTypes",
+			"UpdateMulticastGroup",
+			"UpdateNetworkAnalyzerConfiguration",
+			"UpdatePartnerAccount",
+			"UpdatePosition",
+			"UpdateResourceEventConfiguration",
+			"UpdateResourcePosition",
+			"UpdateWirelessDevice",
+			"UpdateWirelessGateway",
+		},
+	},
+	"ivs": {
+		actions: []string{
+			"BatchGetChannel",
+			"BatchGetStreamKey",
+			"CreateChannel",
+			"CreateRecordingConfiguration",
+			"CreateStreamKey",
+			"DeleteChannel",
+			"DeletePlaybackKeyPair",
+			"DeleteRecordingConfiguration",
+			"DeleteStreamKey",
+			"GetChannel",
+			"GetPlaybackKeyPair",
+			"GetRecordingConfiguration",
+			"GetStream",
+			"GetStreamKey",
+			"GetStreamSession",
+			"ImportPlaybackKeyPair",
+			"ListChannels",
+			"ListPlaybackKeyPairs",
+			"ListRecordingConfigurations",
+			"ListStreamKeys",
+			"ListStreams",
+			"ListStreamSessions",
+			"ListTagsForResource",
+			"PutMetadata",
+			"StopStream",
+			"TagResource",
+			"UntagResource",
+			"UpdateChannel",
+		},
+	},
+	"ivschat": {
+		actions: []string{
+			"CreateChatToken",
+			"CreateLoggingConfiguration",
+			"CreateRoom",
+			"DeleteLoggingConfiguration",
+			"DeleteMessage",
+			"DeleteRoom",
+			"DisconnectUser",
+			"GetLoggingConfiguration",
+			"GetRoom",
+			"ListLoggingConfigurations",
+			"ListRooms",
+			"ListTagsForResource",
+			"SendEvent",
+			"TagResource",
+			"UntagResource",
+			"UpdateLoggingConfiguration",
+			"UpdateRoom",
+		},
+	},
+	"kafka": {
+		actions: []string{
+			"BatchAssociateScramSecret",
+			"BatchDisassociateScramSecret",
+			"CreateCluster",
+			"CreateClusterV2",
+			"CreateConfiguration",
+			"DeleteCluster",
+			"DeleteConfiguration",
+			"DescribeCluster",
+			"DescribeClusterOperation",
+			"DescribeClusterV2",
+			"DescribeConfiguration",
+			"DescribeConfigurationRevision",
+			"GetBootstrapBrokers",
+			"GetCompatibleKafkaVersions",
+			"ListClusterOperations",
+			"ListClusters",
+			"ListClustersV2",
+			"ListConfigurationRevisions",
+			"ListConfigurations",
+			"ListKafkaVersions",
+			"ListNodes",
+			"ListScramSecrets",
+			"ListTagsForResource",
+			"RebootBroker",
+			"TagResource",
+			"UntagResource",
+			"UpdateBrokerCount",
+			"UpdateBrokerStorage",
+			"UpdateBrokerType",
+			"UpdateClusterConfiguration",
+			"UpdateClusterKafkaVersion",
+			"UpdateConfiguration",
+			"UpdateConnectivity",
+			"UpdateMonitoring",
+			"UpdateSecurity",
+			"UpdateStorage",
+		},
+	},
+	"kafka-cluster": {
+		actions: []string{
+			"AlterCluster",
+			"AlterClusterDynamicConfiguration",
+			"AlterGroup",
+			"AlterTopic",
+			"AlterTopicDynamicConfiguration",
+			"AlterTransactionalId",
+			"Connect",
+			"CreateTopic",
+			"DeleteGroup",
+			"DeleteTopic",
+			"DescribeCluster",
+			"DescribeClusterDynamicConfiguration",
+			"DescribeGroup",
+			"DescribeTopic",
+			"DescribeTopicDynamicConfiguration",
+			"DescribeTransactionalId",
+			"ReadData",
+			"WriteData",
+			"WriteDataIdempotently",
+		},
+	},
+	"kafkaconnect": {
+		actions: []string{
+			"CreateConnector",
+			"CreateCustomPlugin",
+			"CreateWorkerConfiguration",
+			"DeleteConnector",
+			"DeleteCustomPlugin",
+			"DescribeConnector",
+			"DescribeCustomPlugin",
+			"DescribeWorkerConfiguration",
+			"ListConnectors",
+			"ListCustomPlugins",
+			"ListWorkerConfigurations",
+			"UpdateConnector",
+		},
+	},
+	"kendra": {
+		actions: []string{
+			"AssociateEntitiesToExperience",
+			"AssociatePersonasToEntities",
+			"BatchDeleteDocument",
+			"BatchGetDocumentStatus",
+			"BatchPutDocument",
+			"ClearQuerySuggestions",
+			"CreateAccessControlConfiguration",
+			"CreateDataSource",
+			"CreateExperience",
+			"CreateFaq",
+			"CreateIndex",
+			"CreateQuerySuggestionsBlockList",
+			"CreateThesaurus",
+			"DeleteAccessControlConfiguration",
+			"DeleteDataSource",
+			"DeleteExperience",
+			"DeleteFaq",
+			"DeleteIndex",
+			"DeletePrincipalMapping",
+			"DeleteQuerySuggestionsBlockList",
+			"DeleteThesaurus",
+			"DescribeAccessControlConfiguration",
+			"DescribeDataSource",
+			"DescribeExperience",
+			"DescribeFaq",
+			"DescribeIndex",
+			"DescribePrincipalMapping",
+			"DescribeQuerySuggestionsBlockList",
+			"DescribeQuerySuggestionsConfig",
+			"DescribeThesaurus",
+			"DisassociateEntitiesFromExperience",
+			"DisassociatePersonasFromEntities",
+			"GetQuerySuggestions",
+			"GetSnapshots",
+			"ListAccessControlConfigurations",
+			"ListDataSources",
+			"ListDataSourceSyncJobs",
+			"ListEntityPersonas",
+			"ListExperienceEntities",
+			"ListExperiences",
+			"ListFaqs",
+			"ListGroupsOlderThanOrderingId",
+			"ListIndices",
+			"ListQuerySuggestionsBlockLists",
+			"ListTagsForResource",
+			"ListThesauri",
+			"PutPrincipalMapping",
+			"Query",
+			"StartDataSourceSyncJob",
+			"StopDataSourceSyncJob",
+			"SubmitFeedback",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccessControlConfiguration",
+			"UpdateDataSource",
+			"UpdateExperience",
+			"UpdateIndex",
+			"UpdateQuerySuggestionsBlockList",
+			"UpdateQuerySuggestionsConfig",
+			"UpdateThesaurus",
+		},
+	},
+	"kendra-ranking": {
+		actions: []string{
+			"CreateRescoreExecutionPlan",
+			"DeleteRescoreExecutionPlan",
+			"DescribeRescoreExecutionPlan",
+			"ListRescoreExecutionPlans",
+			"ListTagsForResource",
+			"Rescore",
+			"TagResource",
+			"UntagResource",
+			"UpdateRescoreExecutionPlan",
+		},
+	},
+	"kinesis": {
+		actions: []string{
+			"AddTagsToStream",
+			"CreateStream",
+			"DecreaseStreamRetentionPeriod",
+			"DeleteStream",
+			"DeregisterStreamConsumer",
+			"DescribeLimits",
+			"DescribeStream",
+			"DescribeStreamConsumer",
+			"DescribeStreamSummary",
+			"DisableEnhancedMonitoring",
+			"EnableEnhancedMonitoring",
+			"GetRecords",
+			"GetShardIterator",
+			"IncreaseStreamRetentionPeriod",
+			"ListShards",
+			"ListStreamConsumers",
+			"ListStreams",
+			"ListTagsForStream",
+			"MergeShards",
+			"PutRecord",
+			"PutRecords",
+			"RegisterStreamConsumer",
+			"RemoveTagsFromStream",
+			"SplitShard",
+			"StartStreamEncryption",
+			"StopStreamEncryption",
+			"SubscribeToShard",
+			"UpdateShardCount",
+			"UpdateStreamMode",
+		},
+	},
+	"kinesisanalytics": {
+		actions: []string{
+			"AddApplicationCloudWatchLoggingOption",
+			"AddApplicationInput",
+			"AddApplicationInputProcessingConfiguration",
+			"AddApplicationOutput",
+			"AddApplicationReferenceDataSource",
+			"AddApplicationVpcConfiguration",
+			"CreateApplication",
+			"CreateApplicationPresignedUrl",
+			"CreateApplicationSnapshot",
+			"DeleteApplication",
+			"DeleteApplicationCloudWatchLoggingOption",
+			"DeleteApplicationInputProcessingConfiguration",
+			"DeleteApplicationOutput",
+			"DeleteApplicationReferenceDataSource",
+			"DeleteApplicationSnapshot",
+			"DeleteApplicationVpcConfiguration",
+			"DescribeApplication",
+			"DescribeApplicationSnapshot",
+			"DescribeApplicationVersion",
+			"DiscoverInputSchema",
+			"ListApplications",
+			"ListApplicationSnapshots",
+			"ListApplicationVersions",
+			"ListTagsForResource",
+			"RollbackApplication",
+			"StartApplication",
+			"StopApplication",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateApplicationMaintenanceConfiguration",
+		},
+	},
+	"kinesisvideo": {
+		actions: []string{
+			"CreateSignalingChannel",
+			"CreateStream",
+			"DeleteSignalingChannel",
+			"DeleteStream",
+			"DescribeEdgeConfiguration",
+			"DescribeImageGenerationConfiguration",
+			"DescribeMappedResourceConfiguration",
+			"DescribeMediaStorageConfiguration",
+			"DescribeNotificationConfiguration",
+			"DescribeSignalingChannel",
+			"DescribeStream",
+			"GetClip",
+			"GetDASHStreamingSessionURL",
+			"GetDataEndpoint",
+			"GetHLSStreamingSessionURL",
+			"GetIceServerConfig",
+			"GetImages",
+			"GetMedia",
+			"GetMediaForFragmentList",
+			"GetSignalingChannelEndpoint",
+			"JoinStorageSession",
+			"ListFragments",
+			"ListSignalingChannels",
+			"ListStreams",
+			"ListTagsForResource",
+			"ListTagsForStream",
+			"SendAlexaOfferToMaster",
+			"StartEdgeConfigurationUpdate",
+			"TagResource",
+			"TagStream",
+			"UntagResource",
+			"UntagStream",
+			"UpdateDataRetention",
+			"UpdateImageGenerationConfiguration",
+			"UpdateMediaStorageConfiguration",
+			"UpdateNotificationConfiguration",
+			"UpdateSignalingChannel",
+			"UpdateStream",
+		},
+	},
+	"kms": {
+		actions: []string{
+			"CancelKeyDeletion",
+			"ConnectCustomKeyStore",
+			"CreateAlias",
+			"CreateCustomKeyStore",
+			"CreateGrant",
+			"CreateKey",
+			"Decrypt",
+			"DeleteAlias",
+			"DeleteCustomKeyStore",
+			"DeleteImportedKeyMaterial",
+			"DescribeCustomKeyStores",
+			"DescribeKey",
+			"DisableKey",
+			"DisableKeyRotation",
+			"DisconnectCustomKeyStore",
+			"EnableKey",
+			"EnableKeyRotation",
+			"Encrypt",
+			"GenerateDataKey",
+			"GenerateDataKeyPair",
+			"GenerateDataKeyPairWithoutPlaintext",
+			"GenerateDataKeyWithoutPlaintext",
+			"GenerateMac",
+			"GenerateRandom",
+			"GetKeyPolicy",
+			"GetKeyRotationStatus",
+			"GetParametersForImport",
+			"GetPublicKey",
+			"ImportKeyMaterial",
+			"ListAliases",
+			"ListGrants",
+			"ListKeyPolicies",
+			"ListKeys",
+			"ListResourceTags",
+			"ListRetirableGrants",
+			"PutKeyPolicy",
+			"ReEncrypt",
+			"ReplicateKey",
+			"RetireGrant",
+			"RevokeGrant",
+			"ScheduleKeyDeletion",
+			"Sign",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlias",
+			"UpdateCustomKeyStore",
+			"UpdateKeyDescription",
+			"UpdatePrimaryRegion",
+			"Verify",
+			"VerifyMac",
+		},
+		conditionKeys: []string{
+			"kms:BypassPolicyLockoutSafetyCheck",
+			"kms:CallerAccount",
+			"kms:CustomerMasterKeySpec",
+			"kms:CustomerMasterKeyUsage",
+			"kms:DataKeyPairSpec",
+			"kms:EncryptionAlgorithm",
+			"kms:EncryptionContext:",
+			"kms:EncryptionContextKeys",
+			"kms:ExpirationModel",
+			"kms:GrantConstraintType",
+			"kms:GranteePrincipal",
+			"kms:GrantIsForAWSResource",
+			"kms:GrantOperations",
+			"kms:KeyAgreementAlgorithm",
+			"kms:KeyOrigin",
+			"kms:KeySpec",
+			"kms:KeyUsage",
+			"kms:MacAlgorithm",
+			"kms:MessageType",
+			"kms:MultiRegion",
+			"kms:MultiRegionKeyType",
+			"kms:PrimaryRegion",
+			"kms:RecipientAttestation:ImageSha384",
+			"kms:RecipientAttestation:PCR",
+			"kms:ReEncryptOnSameKey",
+			"kms:ReplicaRegion",
+			"kms:RequestAlias",
+			"kms:ResourceAliases",
+			"kms:RetiringPrincipal",
+			"kms:RotationPeriodInDays",
+			"kms:ScheduleKeyDeletionPendingWindowInDays",
+			"kms:SigningAlgorithm",
+			"kms:ValidTo",
+			"kms:ViaService",
+			"kms:WrappingAlgorithm",
+			"kms:WrappingKeySpec",
+		},
+	},
+	"lakeformation": {
+		actions: []string{
+			"AddLFTagsToResource",
+			"AssumeDecoratedRoleWithSAML",
+			"BatchGrantPermissions",
+			"BatchRevokePermissions",
+			"CancelTransaction",
+			"CommitTransaction",
+			"CreateDataCellsFilter",
+			"CreateLFTag",
+			"DeleteDataCellsFilter",
+			"DeleteLFTag",
+			"DeleteObjectsOnCancel",
+			"DeregisterResource",
+			"DescribeResource",
+			"DescribeTransaction",
+			"ExtendTransaction",
+			"GetDataAccess",
+			"GetDataLakeSettings",
+			"GetEffectivePermissionsForPath",
+			"GetLFTag",
+			"GetQueryState",
+			"GetQueryStatistics",
+			"GetResourceLFTags",
+			"GetTableObjects",
+			"GetTemporaryGluePartitionCredentials",
+			"GetTemporaryGlueTableCredentials",
+			"GetWorkUnitResults",
+			"GetWorkUnits",
+			"GrantPermissions",
+			"ListDataCellsFilter",
+			"ListLFTags",
+			"ListPermissions",
+			"ListResources",
+			"ListTableStorageOptimizers",
+			"ListTransactions",
+			"PutDataLakeSettings",
+			"RegisterResource",
+			"RemoveLFTagsFromResource",
+			"RevokePermissions",
+			"SearchDatabasesByLFTags",
+			"SearchTablesByLFTags",
+			"StartQueryPlanning",
+			"StartTransaction",
+			"UpdateLFTag",
+			"UpdateResource",
+			"UpdateTableObjects",
+			"UpdateTableStorageOptimizer",
+		},
+	},
+	"lambda": {
+		actions: []string{
+			"AddLayerVersionPermission",
+			"AddPermission",
+			"CreateAlias",
+			"CreateCodeSigningConfig",
+			"CreateEventSourceMapping",
+			"CreateFunction",
+			"CreateFunctionUrlConfig",
+			"DeleteAlias",
+			"DeleteCodeSigningConfig",
+			"DeleteEventSourceMapping",
+			"DeleteFunction",
+			"DeleteFunctionCodeSigningConfig",
+			"DeleteFunctionConcurrency",
+			"DeleteFunctionEventInvokeConfig",
+			"DeleteFunctionUrlConfig",
+			"DeleteLayerVersion",
+			"DeleteProvisionedConcurrencyConfig",
+			"GetAccountSettings",
+			"GetAlias",
+			"GetCodeSigningConfig",
+			"GetEventSourceMapping",
+			"GetFunction",
+			"GetFunctionCodeSigningConfig",
+			"GetFunctionConcurrency",
+			"GetFunctionConfiguration",
+			"GetFunctionEventInvokeConfig",
+			"GetFunctionUrlConfig",
+			"GetLayerVersion",
+			"GetLayerVersionByArn",
+			"GetLayerVersionPolicy",
+			"GetPolicy",
+			"GetProvisionedConcurrencyConfig",
+			"Invoke",
+			"InvokeAsync",
+			"InvokeFunction",
+			"InvokeFunctionUrl",
+			"ListAliases",
+			"ListCodeSigningConfigs",
+			"ListEventSourceMappings",
+			"ListFunctionEventInvokeConfigs",
+			"ListFunctions",
+			"ListFunctionsByCodeSigningConfig",
+			"ListFunctionUrlConfigs",
+			"ListLayers",
+			"ListLayerVersions",
+			"ListProvisionedConcurrencyConfigs",
+			"ListTags",
+			"ListVersionsByFunction",
+			"PublishLayerVersion",
+			"PublishVersion",
+			"PutFunctionCodeSigningConfig",
+			"PutFunctionConcurrency",
+			"PutFunctionEventInvokeConfig",
+			"PutProvisionedConcurrencyConfig",
+			"RemoveLayerVersionPermission",
+			"RemovePermission",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlias",
+			"UpdateCodeSigningConfig",
+			"UpdateEventSourceMapping",
+			"UpdateFunctionCode",
+			"UpdateFunctionConfiguration",
+			"UpdateFunctionEventInvokeConfig",
+			"UpdateFunctionUrlConfig",
+		},
+	},
+	"lex": {
+		actions: []string{
+			"BatchCreateCustomVocabularyItem",
+			"BatchDeleteCustomVocabularyItem",
+			"BatchUpdateCustomVocabularyItem",
+			"BuildBotLocale",
+			"CreateBot",
+			"CreateBotAlias",
+			"CreateBotLocale",
+			"CreateBotVersion",
+			"CreateExport",
+			"CreateIntent",
+			"CreateIntentVersion",
+			"CreateResourcePolicy",
+			"CreateResourcePolicyStatement",
+			"CreateSlot",
+			"CreateSlotType",
+			"CreateSlotTypeVersion",
+			"CreateUploadUrl",
+			"DeleteBot",
+			"DeleteBotAlias",
+			"DeleteBotChannelAssociation",
+			"DeleteBotLocale",
+			"DeleteBotVersion",
+			"DeleteCustomVocabulary",
+			"DeleteExport",
+			"DeleteImport",
+			"DeleteIntent",
+			"DeleteIntentVersion",
+			"DeleteResourcePolicy",
+			"DeleteResourcePolicyStatement",
+			"DeleteSession",
+			"DeleteSlot",
+			"DeleteSlotType",
+			"DeleteSlotTypeVersion",
+			"DeleteUtterances",
+			"DescribeBot",
+			"DescribeBotAlias",
+			"DescribeBotLocale",
+			"DescribeBotRecommendation",
+			"DescribeBotVersion",
+			"DescribeCustomVocabularyMetadata",
+			"DescribeExport",
+			"DescribeImport",
+			"DescribeIntent",
+			"DescribeResourcePolicy",
+			"DescribeSlot",
+			"DescribeSlotType",
+			"GetBot",
+			"GetBotAlias",
+			"GetBotAliases",
+			"GetBotChannelAssociation",
+			"GetBotChannelAssociations",
+			"GetBots",
+			"GetBotVersions",
+			"GetBuiltinIntent",
+			"GetBuiltinIntents",
+			"GetBuiltinSlotTypes",
+			"GetExport",
+			"GetImport",
+			"GetIntent",
+			"GetIntents",
+			"GetIntentVersions",
+			"GetMigration",
+			"GetMigrations",
+			"GetSession",
+			"GetSlotType",
+			"GetSlotTypes",
+			"GetSlotTypeVersions",
+			"GetUtterancesView",
+			"ListAggregatedUtterances",
+			"ListBotAliases",
+			"ListBotLocales",
+			"ListBotRecommendations",
+			"ListBots",
+			"ListBotVersions",
+			"ListBuiltInIntents",
+			"ListBuiltInSlotTypes",
+			"ListCustomVocabularyItems",
+			"ListExports",
+			"ListImports",
+			"ListIntents",
+			"ListRecommendedIntents",
+			"ListSlots",
+			"ListSlotTypes",
+			"ListTagsForResource",
+			"PostContent",
+			"PostText",
+			"PutBot",
+			"PutBotAlias",
+			"PutIntent",
+			"PutSession",
+			"PutSlotType",
+			"RecognizeText",
+			"RecognizeUtterance",
+			"SearchAssociatedTranscripts",
+			"StartBotRecommendation",
+			"StartConversation",
+			"StartImport",
+			"StartMigration",
+			"StopBotRecommendation",
+			"TagResource",
+			"UntagResource",
+			"UpdateBot",
+			"UpdateBotAlias",
+			"UpdateBotLocale",
+			"UpdateBotRecommendation",
+			"UpdateExport",
+			"UpdateIntent",
+			"UpdateResourcePolicy",
+			"UpdateSlot",
+			"UpdateSlotType",
+		},
+	},
+	"license-manager": {
+		actions: []string{
+			"AcceptGrant",
+			"CheckInLicense",
+			"CheckoutBorrowLicense",
+			"CheckoutLicense",
+			"CreateGrant",
+			"CreateGrantVersion",
+			"CreateLicense",
+			"CreateLicenseConfiguration",
+			"CreateLicenseConversionTaskForResource",
+			"CreateLicenseManagerReportGenerator",
+			"CreateLicenseVersion",
+			"CreateToken",
+			"DeleteGrant",
+			"DeleteLicense",
+			"DeleteLicenseConfiguration",
+			"DeleteLicenseManagerReportGenerator",
+			"DeleteToken",
+			"ExtendLicenseConsumption",
+			"GetAccessToken",
+			"GetGrant",
+			"GetLicense",
+			"GetLicenseConfiguration",
+			"GetLicenseConversionTask",
+			"GetLicenseManagerReportGenerator",
+			"GetLicenseUsage",
+			"GetServiceSettings",
+			"ListAssociationsForLicenseConfiguration",
+			"ListDistributedGrants",
+			"ListFailuresForLicenseConfigurationOperations",
+			"ListLicenseConfigurations",
+			"ListLicenseConversionTasks",
+			"ListLicenseManagerReportGenerators",
+			"ListLicenses",
+			"ListLicenseSpecificationsForResource",
+			"ListLicenseVersions",
+			"ListReceivedGrants",
+			"ListReceivedGrantsForOrganization",
+			"ListReceivedLicenses",
+			"ListReceivedLicensesForOrganization",
+			"ListResourceInventory",
+			"ListTagsForResource",
+			"ListTokens",
+			"ListUsageForLicenseConfiguration",
+			"RejectGrant",
+			"TagResource",
+			"UntagResource",
+			"UpdateLicenseConfiguration",
+			"UpdateLicenseManagerReportGenerator",
+			"UpdateLicenseSpecificationsForResource",
+			"UpdateServiceSettings",
+		},
+	},
+	"license-manager-linux-subscriptions": {
+		actions: []string{
+			"GetServiceSettings",
+			"ListLinuxSubscriptionInstances",
+			"ListLinuxSubscriptions",
+			"UpdateServiceSettings",
+		},
+	},
+	"license-manager-user-subscriptions": {
+		actions: []string{
+			"AssociateUser",
+			"DeregisterIdentityProvider",
+			"DisassociateUser",
+			"ListIdentityProviders",
+			"ListInstances",
+			"ListProductSubscriptions",
+			"ListUserAssociations",
+			"RegisterIdentityProvider",
+			"StartProductSubscription",
+			"StopProductSubscription",
+			"UpdateIdentityProviderSettings",
+		},
+	},
+	"lightsail": {
+		actions: []string{
+			"AllocateStaticIp",
+			"AttachCertificateToDistribution",
+			"AttachDisk",
+			"AttachInstancesToLoadBalancer",
+			"AttachLoadBalancerTlsCertificate",
+			"AttachStaticIp",
+			"CloseInstancePublicPorts",
+			"CopySnapshot",
+			"CreateBucket",
+			"CreateBucketAccessKey",
+			"CreateCertificate",
+			"CreateCloudFormationStack",
+			"CreateContactMethod",
+			"CreateContainerService",
+			"CreateContainerServiceDeployment",
+			"CreateContainerServiceRegistryLogin",
+			"CreateDisk",
+			"CreateDiskFromSnapshot",
+			"CreateDiskSnapshot",
+			"CreateDistribution",
+			"CreateDomain",
+			"CreateDomainEntry",
+			"CreateInstances",
+			"CreateInstancesFromSnapshot",
+			"CreateInstanceSnapshot",
+			"CreateKeyPair",
+			"CreateLoadBalancer",
+			"CreateLoadBalancerTlsCertificate",
+			"CreateRelationalDatabase",
+			"CreateRelationalDatabaseFromSnapshot",
+			"CreateRelationalDatabaseSnapshot",
+			"DeleteAlarm",
+			"DeleteAutoSnapshot",
+			"DeleteBucket",
+			"DeleteBucketAccessKey",
+			"DeleteCertificate",
+			"DeleteContactMethod",
+			"DeleteContainerImage",
+			"DeleteContainerService",
+			"DeleteDisk",
+			"DeleteDiskSnapshot",
+			"DeleteDistribution",
+			"DeleteDomain",
+			"DeleteDomainEntry",
+			"DeleteInstance",
+			"DeleteInstanceSnapshot",
+			"DeleteKeyPair",
+			"DeleteKnownHostKeys",
+			"DeleteLoadBalancer",
+			"DeleteLoadBalancerTlsCertificate",
+			"DeleteRelationalDatabase",
+			"DeleteRelationalDatabaseSnapshot",
+			"DetachCertificateFromDistribution",
+			"DetachDisk",
+			"DetachInstancesFromLoadBalancer",
+			"DetachStaticIp",
+			"DisableAddOn",
+			"DownloadDefaultKeyPair",
+			"EnableAddOn",
+			"ExportSnapshot",
+			"GetActiveNames",
+			"GetAlarms",
+			"GetAutoSnapshots",
+			"GetBlueprints",
+			"GetBucketAccessKeys",
+			"GetBucketBundles",
+			"GetBucketMetricData",
+			"GetBuckets",
+			"GetBundles",
+			"GetCertificates",
+			"GetCloudFormationStackRecords",
+			"GetContactMethods",
+			"GetContainerAPIMetadata",
+			"GetContainerImages",
+			"GetContainerLog",
+			"GetContainerServiceDeployments",
+			"GetContainerServiceMetricData",
+			"GetContainerServicePowers",
+			"GetContainerServices",
+			"GetDisk",
+			"GetDisks",
+			"GetDiskSnapshot",
+			"GetDiskSnapshots",
+			"GetDistributionBundles",
+			"GetDistributionLatestCacheReset",
+			"GetDistributionMetricData",
+			"GetDistributions",
+			"GetDomain",
+			"GetDomains",
+			"GetExportSnapshotRecords",
+			"GetInstance",
+			"GetInstanceAccessDetails",
+			"GetInstanceMetricData",
+			"GetInstancePortStates",
+			"GetInstances",
+			"GetInstanceSnapshot",
+			"GetInstanceSnapshots",
+			"GetInstanceState",
+			"GetKeyPair",
+			"GetKeyPairs",
+			"GetLoadBalancer",
+			"GetLoadBalancerMetricData",
+			"GetLoadBalancers",
+			"GetLoadBalancerTlsCertificates",
+			"GetLoadBalancerTlsPolicies",
+			"GetOperation",
+			"GetOperations",
+			"GetOperationsForResource",
+			"GetRegions",
+			"GetRelationalDatabase",
+			"GetRelationalDatabaseBlueprints",
+			"GetRelationalDatabaseBundles",
+			"GetRelationalDatabaseEvents",
+			"GetRelationalDatabaseLogEvents",
+			"GetRelationalDatabaseLogStreams",
+			"GetRelationalDatabaseMasterUserPassword",
+			"GetRelationalDatabaseMetricData",
+			"GetRelationalDatabaseParameters",
+			"GetRelationalDatabases",
+			"GetRelationalDatabaseSnapshot",
+			"GetRelationalDatabaseSnapshots",
+			"GetStaticIp",
+			"GetStaticIps",
+			"ImportKeyPair",
+			"IsVpcPeered",
+			"OpenInstancePublicPorts",
+			"PeerVpc",
+			"PutAlarm",
+			"PutInstancePublicPorts",
+			"RebootInstance",
+			"RebootRelationalDatabase",
+			"RegisterContainerImage",
+			"ReleaseStaticIp",
+			"ResetDistributionCache",
+			"SendContactMethodVerification",
+			"SetIpAddressType",
+			"SetResourceAccessForBucket",
+			"StartInstance",
+			"StartRelationalDatabase",
+			"StopInstance",
+			"StopRelationalDatabase",
+			"TagResource",
+			"TestAlarm",
+			"UnpeerVpc",
+			"UntagResource",
+			"UpdateBucket",
+			"UpdateBucketBundle",
+			"UpdateContainerService",
+			"UpdateDistribution",
+			"UpdateDistributionBundle",
+			"UpdateDomainEntry",
+			"UpdateInstanceMetadataOptions",
+			"UpdateLoadBalancerAttribute",
+			"UpdateRelationalDatabase",
+			"UpdateRelationalDatabaseParameters",
+		},
+	},
+	"logs": {
+		actions: []string{
+			"AssociateKmsKey",
+			"CancelExportTask",
+			"CreateExportTask",
+			"CreateLogDelivery",
+			"CreateLogGroup",
+			"CreateLogStream",
+			"DeleteDataProtectionPolicy",
+			"DeleteDestination",
+			"DeleteLogDelivery",
+			"DeleteLogGroup",
+			"DeleteLogStream",
+			"DeleteMetricFilter",
+			"DeleteQueryDefinition",
+			"DeleteResourcePolicy",
+			"DeleteRetentionPolicy",
+			"DeleteSubscriptionFilter",
+			"DescribeDestinations",
+			"DescribeExportTasks",
+			"DescribeLogGroups",
+			"DescribeLogStreams",
+			"DescribeMetricFilters",
+			"DescribeQueries",
+			"DescribeQueryDefinitions",
+			"DescribeResourcePolicies",
+			"DescribeSubscriptionFilters",
+			"DisassociateKmsKey",
+			"FilterLogEvents",
+			"GetDataProtectionPolicy",
+			"GetLogDelivery",
+			"GetLogEvents",
+			"GetLogGroupFields",
+			"GetLogRecord",
+			"GetQueryResults",
+			"Link",
+			"ListLogDeliveries",
+			"ListTagsForResource",
+			"ListTagsLogGroup",
+			"PutDataProtectionPolicy",
+			"PutDestination",
+			"PutDestinationPolicy",
+			"PutLogEvents",
+			"PutMetricFilter",
+			"PutQueryDefinition",
+			"PutResourcePolicy",
+			"PutRetentionPolicy",
+			"PutSubscriptionFilter",
+			"StartQuery",
+			"StopQuery",
+			"TagLogGroup",
+			"TagResource",
+			"TestMetricFilter",
+			"Unmask",
+			"UntagLogGroup",
+			"UntagResource",
+			"UpdateLogDelivery",
+		},
+	},
+	"lookoutequipment": {
+		actions: []string{
+			"CreateDataset",
+			"CreateInferenceScheduler",
+			"CreateLabel",
+			"CreateLabelGroup",
+			"CreateModel",
+			"DeleteDataset",
+			"DeleteInferenceScheduler",
+			"DeleteLabel",
+			"DeleteLabelGroup",
+			"DeleteModel",
+			"DescribeDataIngestionJob",
+			"DescribeDataset",
+			"DescribeInferenceScheduler",
+			"DescribeLabel",
+			"DescribeLabelGroup",
+			"DescribeModel",
+			"ListDataIngestionJobs",
+			"ListDatasets",
+			"ListInferenceEvents",
+			"ListInferenceExecutions",
+			"ListInferenceSchedulers",
+			"ListLabelGroups",
+			"ListLabels",
+			"ListModels",
+			"ListSensorStatistics",
+			"ListTagsForResource",
+			"StartDataIngestionJob",
+			"StartInferenceScheduler",
+			"StopInferenceScheduler",
+			"TagResource",
+			"UntagResource",
+			"UpdateInferenceScheduler",
+			"UpdateLabelGroup",
+		},
+	},
+	"lookoutmetrics": {
+		actions: []string{
+			"ActivateAnomalyDetector",
+			"BackTestAnomalyDetector",
+			"CreateAlert",
+			"CreateAnomalyDetector",
+			"CreateMetricSet",
+			"DeactivateAnomalyDetector",
+			"DeleteAlert",
+			"DeleteAnomalyDetector",
+			"DescribeAlert",
+			"DescribeAnomalyDetectionExecutions",
+			"DescribeAnomalyDetector",
+			"DescribeMetricSet",
+			"DetectMetricSetConfig",
+			"GetAnomalyGroup",
+			"GetDataQualityMetrics",
+			"GetFeedback",
+			"GetSampleData",
+			"ListAlerts",
+			"ListAnomalyDetectors",
+			"ListAnomalyGroupRelatedMetrics",
+			"ListAnomalyGroupSummaries",
+			"ListAnomalyGroupTimeSeries",
+			"ListMetricSets",
+			"ListTagsForResource",
+			"PutFeedback",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlert",
+			"UpdateAnomalyDetector",
+			"UpdateMetricSet",
+		},
+	},
+	"lookoutvision": {
+		actions: []string{
+			"CreateDataset",
+			"CreateModel",
+			"CreateProject",
+			"DeleteDataset",
+			"DeleteModel",
+			"DeleteProject",
+			"DescribeDataset",
+			"DescribeModel",
+			"DescribeModelPackagingJob",
+			"DescribeProject",
+			"DetectAnomalies",
+			"ListDatasetEntries",
+			"ListModelPackagingJobs",
+			"ListModels",
+			"ListProjects",
+			"ListTagsForResource",
+			"StartModel",
+			"StartModelPackagingJob",
+			"StopModel",
+			"TagResource",
+			"UntagResource",
+			"UpdateDatasetEntries",
+		},
+	},
+	"m2": {
+		actions: []string{
+			"CancelBatchJobExecution",
+			"CreateApplication",
+			"CreateDataSetImportTask",
+			"CreateDeployment",
+			"CreateEnvironment",
+			"DeleteApplication",
+			"DeleteApplicationFromEnvironment",
+			"DeleteEnvironment",
+			"GetApplication",
+			"GetApplicationVersion",
+			"GetBatchJobExecution",
+			"GetDataSetDetails",
+			"GetDataSetImportTask",
+			"GetDeployment",
+			"GetEnvironment",
+			"ListApplications",
+			"ListApplicationVersions",
+			"ListBatchJobDefinitions",
+			"ListBatchJobExecutions",
+			"ListDataSetImportHistory",
+			"ListDataSets",
+			"ListDeployments",
+			"ListEngineVersions",
+			"ListEnvironments",
+			"ListTagsForResource",
+			"StartApplication",
+			"StartBatchJob",
+			"StopApplication",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateEnvironment",
+		},
+	},
+	"machinelearning": {
+		actions: []string{
+			"AddTags",
+			"CreateBatchPrediction",
+			"CreateDataSourceFromRDS",
+			"CreateDataSourceFromRedshift",
+			"CreateDataSourceFromS3",
+			"CreateEvaluation",
+			"CreateMLModel",
+			"CreateRealtimeEndpoint",
+			"DeleteBatchPrediction",
+			"DeleteDataSource",
+			"DeleteEvaluation",
+			"DeleteMLModel",
+			"DeleteRealtimeEndpoint",
+			"DeleteTags",
+			"DescribeBatchPredictions",
+			"DescribeDataSources",
+			"DescribeEvaluations",
+			"DescribeMLModels",
+			"DescribeTags",
+			"GetBatchPrediction",
+			"GetDataSource",
+			"GetEvaluation",
+			"GetMLModel",
+			"Predict",
+			"UpdateBatchPrediction",
+			"UpdateDataSource",
+			"UpdateEvaluation",
+			"UpdateMLModel",
+		},
+	},
+	"macie": {
+		actions: []string{
+			"AssociateMemberAccount",
+			"AssociateS3Resources",
+			"DisassociateMemberAccount",
+			"DisassociateS3Resources",
+			"ListMemberAccounts",
+			"ListS3Resources",
+			"UpdateS3Resources",
+		},
+	},
+	"macie2": {
+		actions: []string{
+			"AcceptInvitation",
+			"BatchGetCustomDataIdentifiers",
+			"CreateAllowList",
+			"CreateClassificationJob",
+			"CreateCustomDataIdentifier",
+			"CreateFindingsFilter",
+			"CreateInvitations",
+			"CreateMember",
+			"CreateSampleFindings",
+			"DeclineInvitations",
+			"DeleteAllowList",
+			"DeleteCustomDataIdentifier",
+			"DeleteFindingsFilter",
+			"DeleteInvitations",
+			"DeleteMember",
+			"DescribeBuckets",
+			"DescribeClassificationJob",
+			"DescribeOrganizationConfiguration",
+			"DisableMacie",
+			"DisableOrganizationAdminAccount",
+			"DisassociateFromAdministratorAccount",
+			"DisassociateFromMasterAccount",
+			"DisassociateMember",
+			"EnableMacie",
+			"EnableOrganizationAdminAccount",
+			"GetAdministratorAccount",
+			"GetAllowList",
+			"GetAutomatedDiscoveryConfiguration",
+			"GetBucketStatistics",
+			"GetClassificationExportConfiguration",
+			"GetClassificationScope",
+			"GetCustomDataIdentifier",
+			"GetFindings",
+			"GetFindingsFilter",
+			"GetFindingsPublicationConfiguration",
+			"GetFindingStatistics",
+			"GetInvitationsCount",
+			"GetMacieSession",
+			"GetMasterAccount",
+			"GetMember",
+			"GetResourceProfile",
+			"GetRevealConfiguration",
+			"GetSensitiveDataOccurrences",
+			"GetSensitiveDataOccurrencesAvailability",
+			"GetSensitivityInspectionTemplate",
+			"GetUsageStatistics",
+			"GetUsageTotals",
+			"ListAllowLists",
+			"ListClassificationJobs",
+			"ListClassificationScopes",
+			"ListCustomDataIdentifiers",
+			"ListFindings",
+			"ListFindingsFilters",
+			"ListInvitations",
+			"ListManagedDataIdentifiers",
+			"ListMembers",
+			"ListOrganizationAdminAccounts",
+			"ListResourceProfileArtifacts",
+			"ListResourceProfileDetections",
+			"ListSensitivityInspectionTemplates",
+			"ListTagsForResource",
+			"PutClassificationExportConfiguration",
+			"PutFindingsPublicationConfiguration",
+			"SearchResources",
+			"TagResource",
+			"TestCustomDataIdentifier",
+			"UntagResource",
+			"UpdateAllowList",
+			"UpdateAutomatedDiscoveryConfiguration",
+			"UpdateClassificationJob",
+			"UpdateClassificationScope",
+			"UpdateFindingsFilter",
+			"UpdateMacieSession",
+			"UpdateMemberSession",
+			"UpdateOrganizationConfiguration",
+			"UpdateResourceProfile",
+			"UpdateResourceProfileDetections",
+			"UpdateRevealConfiguration",
+			"UpdateSensitivityInspectionTemplate",
+		},
+	},
+	"managedblockchain": {
+		actions: []string{
+			"CreateAccessor",
+			"CreateMember",
+			"CreateNetwork",
+			"CreateNode",
+			"CreateProposal",
+			"DeleteAccessor",
+			"DeleteMember",
+			"DeleteNode",
+			"GetAccessor",
+			"GetMember",
+			"GetNetwork",
+			"GetNode",
+			"GetProposal",
+			"ListAccessors",
+			"ListInvitations",
+			"ListMembers",
+			"ListNetworks",
+			"ListNodes",
+			"ListProposals",
+			"ListProposalVotes",
+			"ListTagsForResource",
+			"RejectInvitation",
+			"TagResource",
+			"UntagResource",
+			"UpdateMember",
+			"UpdateNode",
+			"VoteOnProposal",
+		},
+	},
+	"marketplacecommerceanalytics": {
+		actions: []string{
+			"GenerateDataSet",
+			"StartSupportDataExport",
+		},
+	},
+	"mechanicalturk": {
+		actions: []string{
+			"AcceptQualificationRequest",
+			"ApproveAssignment",
+			"AssociateQualificationWithWorker",
+			"CreateAdditionalAssignmentsForHIT",
+			"CreateHIT",
+			"CreateHITType",
+			"CreateHITWithHITType",
+			"CreateQualificationType",
+			"CreateWorkerBlock",
+			"DeleteHIT",
+			"DeleteQualificationType",
+			"DeleteWorkerBlock",
+			"DisassociateQualificationFromWorker",
+			"GetAccountBalance",
+			"GetAssignment",
+			"GetFileUploadURL",
+			"GetHIT",
+			"GetQualificationScore",
+			"GetQualificationType",
+			"ListAssignmentsForHIT",
+			"ListBonusPayments",
+			"ListHITs",
+			"ListHITsForQualificationType",
+			"ListQualificationRequests",
+			"ListQualificationTypes",
+			"ListReviewableHITs",
+			"ListReviewPolicyResultsForHIT",
+			"ListWorkerBlocks",
+			"ListWorkersWithQualificationType",
+			"NotifyWorkers",
+			"RejectAssignment",
+			"RejectQualificationRequest",
+			"SendBonus",
+			"SendTestEventNotification",
+			"UpdateExpirationForHIT",
+			"UpdateHITReviewStatus",
+			"UpdateHITTypeOfHIT",
+			"UpdateNotificationSettings",
+			"UpdateQualificationType",
+		},
+	},
+	"mediaconnect": {
+		actions: []string{
+			"AddFlowMediaStreams",
+			"AddFlowOutputs",
+			"AddFlowSources",
+			"AddFlowVpcInterfaces",
+			"CreateFlow",
+			"DeleteFlow",
+			"DescribeFlow",
+			"DescribeOffering",
+			"DescribeReservation",
+			"GrantFlowEntitlements",
+			"ListEntitlements",
+			"ListFlows",
+			"ListOfferings",
+			"ListReservations",
+			"ListTagsForResource",
+			"PurchaseOffering",
+			"RemoveFlowMediaStream",
+			"RemoveFlowOutput",
+			"RemoveFlowSource",
+			"RemoveFlowVpcInterface",
+			"RevokeFlowEntitlement",
+			"StartFlow",
+			"StopFlow",
+			"TagResource",
+			"UntagResource",
+			"UpdateFlow",
+			"UpdateFlowEntitlement",
+			"UpdateFlowMediaStream",
+			"UpdateFlowOutput",
+			"UpdateFlowSource",
+		},
+	},
+	"mediaconvert": {
+		actions: []string{
+			"AssociateCertificate",
+			"CancelJob",
+			"CreateJob",
+			"CreateJobTemplate",
+			"CreatePreset",
+			"CreateQueue",
+			"DeleteJobTemplate",
+			"DeletePolicy",
+			"DeletePreset",
+			"DeleteQueue",
+			"DescribeEndpoints",
+			"DisassociateCertificate",
+			"GetJob",
+			"GetJobTemplate",
+			"GetPolicy",
+			"GetPreset",
+			"GetQueue",
+			"ListJobs",
+			"ListJobTemplates",
+			"ListPresets",
+			"ListQueues",
+			"ListTagsForResource",
+			"PutPolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateJobTemplate",
+			"UpdatePreset",
+			"UpdateQueue",
+		},
+	},
+	"medialive": {
+		actions: []string{
+			"AcceptInputDeviceTransfer",
+			"BatchDelete",
+			"BatchStart",
+			"BatchStop",
+			"BatchUpdateSchedule",
+			"CancelInputDeviceTransfer",
+			"ClaimDevice",
+			"CreateChannel",
+			"CreateInput",
+			"CreateInputSecurityGroup",
+			"CreateMultiplex",
+			"CreateMultiplexProgram",
+			"CreatePartnerInput",
+			"CreateTags",
+			"DeleteChannel",
+			"DeleteInput",
+			"DeleteInputSecurityGroup",
+			"DeleteMultiplex",
+			"DeleteMultiplexProgram",
+			"DeleteReservation",
+			"DeleteSchedule",
+			"DeleteTags",
+			"DescribeChannel",
+			"DescribeInput",
+			"DescribeInputDevice",
+			"DescribeInputDeviceThumbnail",
+			"DescribeInputSecurityGroup",
+			"DescribeMultiplex",
+			"DescribeMultiplexProgram",
+			"DescribeOffering",
+			"DescribeReservation",
+			"DescribeSchedule",
+			"ListChannels",
+			"ListInputDevices",
+			"ListInputDeviceTransfers",
+			"ListInputs",
+			"ListInputSecurityGroups",
+			"ListMultiplexes",
+			"ListMultiplexPrograms",
+			"ListOfferings",
+			"ListReservations",
+			"ListTagsForResource",
+			"PurchaseOffering",
+			"RebootInputDevice",
+			"RejectInputDeviceTransfer",
+			"StartChannel",
+			"StartInputDeviceMaintenanceWindow",
+			"StartMultiplex",
+			"StopChannel",
+			"StopMultiplex",
+			"TransferInputDevice",
+			"UpdateChannel",
+			"UpdateChannelClass",
+			"UpdateInput",
+			"UpdateInputDevice",
+			"UpdateInputSecurityGroup",
+			"UpdateMultiplex",
+			"UpdateMultiplexProgram",
+			"UpdateReservation",
+		},
+	},
+	"mediapackage": {
+		actions: []string{
+			"ConfigureLogs",
+			"CreateChannel",
+			"CreateHarvestJob",
+			"CreateOriginEndpoint",
+			"DeleteChannel",
+			"DeleteOriginEndpoint",
+			"DescribeChannel",
+			"DescribeHarvestJob",
+			"DescribeOriginEndpoint",
+			"ListChannels",
+			"ListHarvestJobs",
+			"ListOriginEndpoints",
+			"ListTagsForResource",
+			"RotateChannelCredentials",
+			"RotateIngestEndpointCredentials",
+			"TagResource",
+			"UntagResource",
+			"UpdateChannel",
+			"UpdateOriginEndpoint",
+		},
+	},
+	"mediapackage-vod": {
+		actions: []string{
+			"ConfigureLogs",
+			"CreateAsset",
+			"CreatePackagingConfiguration",
+			"CreatePackagingGroup",
+			"DeleteAsset",
+			"DeletePackagingConfiguration",
+			"DeletePackagingGroup",
+			"DescribeAsset",
+			"DescribePackagingConfiguration",
+			"DescribePackagingGroup",
+			"ListAssets",
+			"ListPackagingConfigurations",
+			"ListPackagingGroups",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdatePackagingGroup",
+		},
+	},
+	"mediastore": {
+		actions: []string{
+			"CreateContainer",
+			"DeleteContainer",
+			"DeleteContainerPolicy",
+			"DeleteCorsPolicy",
+			"DeleteLifecyclePolicy",
+			"DeleteMetricPolicy",
+			"DeleteObject",
+			"DescribeContainer",
+			"DescribeObject",
+			"GetContainerPolicy",
+			"GetCorsPolicy",
+			"GetLifecyclePolicy",
+			"GetMetricPolicy",
+			"GetObject",
+			"ListContainers",
+			"ListItems",
+			"ListTagsForResource",
+			"PutContainerPolicy",
+			"PutCorsPolicy",
+			"PutLifecyclePolicy",
+			"PutMetricPolicy",
+			"PutObject",
+			"StartAccessLogging",
+			"StopAccessLogging",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"mediatailor": {
+		actions: []string{
+			"ConfigureLogsForPlaybackConfiguration",
+			"CreateChannel",
+			"CreateLiveSource",
+			"CreatePrefetchSchedule",
+			"CreateProgram",
+			"CreateSourceLocation",
+			"CreateVodSource",
+			"DeleteChannel",
+			"DeleteChannelPolicy",
+			"DeleteLiveSource",
+			"DeletePlaybackConfiguration",
+			"DeletePrefetchSchedule",
+			"DeleteProgram",
+			"DeleteSourceLocation",
+			"DeleteVodSource",
+			"DescribeChannel",
+			"DescribeLiveSource",
+			"DescribeProgram",
+			"DescribeSourceLocation",
+			"DescribeVodSource",
+			"GetChannelPolicy",
+			"GetChannelSchedule",
+			"GetPlaybackConfiguration",
+			"GetPrefetchSchedule",
+			"ListAlerts",
+			"ListChannels",
+			"ListLiveSources",
+			"ListPlaybackConfigurations",
+			"ListPrefetchSchedules",
+			"ListSourceLocations",
+			"ListTagsForResource",
+			"ListVodSources",
+			"PutChannelPolicy",
+			"PutPlaybackConfiguration",
+			"StartChannel",
+			"StopChannel",
+			"TagResource",
+			"UntagResource",
+			"UpdateChannel",
+			"UpdateLiveSource",
+			"UpdateSourceLocation",
+			"UpdateVodSource",
+		},
+	},
+	"memorydb": {
+		actions: []string{
+			"BatchUpdateCluster",
+			"Connect",
+			"CopySnapshot",
+			"CreateACL",
+			"CreateCluster",
+			"CreateParameterGroup",
+			"CreateSnapshot",
+			"CreateSubnetGroup",
+			"CreateUser",
+			"DeleteACL",
+			"DeleteCluster",
+			"DeleteParameterGroup",
+			"DeleteSnapshot",
+			"DeleteSubnetGroup",
+			"DeleteUser",
+			"DescribeACLs",
+			"DescribeClusters",
+			"DescribeEngineVersions",
+			"DescribeEvents",
+			"DescribeParameterGroups",
+			"DescribeParameters",
+			"DescribeReservedNodes",
+			"DescribeReservedNodesOfferings",
+			"DescribeServiceUpdates",
+			"DescribeSnapshots",
+			"DescribeSubnetGroups",
+			"DescribeUsers",
+			"FailoverShard",
+			"ListAllowedNodeTypeUpdates",
+			"ListTags",
+			"PurchaseReservedNodesOffering",
+			"ResetParameterGroup",
+			"TagResource",
+			"UntagResource",
+			"UpdateACL",
+			"UpdateCluster",
+			"UpdateParameterGroup",
+			"UpdateSubnetGroup",
+			"UpdateUser",
+		},
+	},
+	"mgh": {
+		actions: []string{
+			"AssociateCreatedArtifact",
+			"AssociateDiscoveredResource",
+			"CreateHomeRegionControl",
+			"CreateProgressUpdateStream",
+			"DeleteProgressUpdateStream",
+			"DescribeApplicationState",
+			"DescribeHomeRegionControls",
+			"DescribeMigrationTask",
+			"DisassociateCreatedArtifact",
+			"DisassociateDiscoveredResource",
+			"GetHomeRegion",
+			"ImportMigrationTask",
+			"ListApplicationStates",
+			"ListCreatedArtifacts",
+			"ListDiscoveredResources",
+			"ListMigrationTasks",
+			"ListProgressUpdateStreams",
+			"NotifyApplicationState",
+			"NotifyMigrationTaskState",
+			"PutResourceAttributes",
+		},
+	},
+	"mgn": {
+		actions: []string{
+			"ArchiveApplication",
+			"ArchiveWave",
+			"AssociateApplications",
+			"AssociateSourceServers",
+			"ChangeServerLifeCycleState",
+			"CreateApplication",
+			"CreateLaunchConfigurationTemplate",
+			"CreateReplicationConfigurationTemplate",
+			"CreateWave",
+			"DeleteApplication",
+			"DeleteJob",
+			"DeleteLaunchConfigurationTemplate",
+			"DeleteReplicationConfigurationTemplate",
+			"DeleteSourceServer",
+			"DeleteVcenterClient",
+			"DeleteWave",
+			"DescribeJobLogItems",
+			"DescribeJobs",
+			"DescribeLaunchConfigurationTemplates",
+			"DescribeReplicationConfigurationTemplates",
+			"DescribeSourceServers",
+			"DescribeVcenterClients",
+			"DisassociateApplications",
+			"DisassociateSourceServers",
+			"DisconnectFromService",
+			"FinalizeCutover",
+			"GetLaunchConfiguration",
+			"GetReplicationConfiguration",
+			"InitializeService",
+			"ListApplications",
+			"ListSourceServerActions",
+			"ListTagsForResource",
+			"ListTemplateActions",
+			"ListWaves",
+			"MarkAsArchived",
+			"PutSourceServerAction",
+			"PutTemplateAction",
+			"RemoveSourceServerAction",
+			"RemoveTemplateAction",
+			"RetryDataReplication",
+			"StartCutover",
+			"StartReplication",
+			"StartTest",
+			"TagResource",
+			"TerminateTargetInstances",
+			"UnarchiveApplication",
+			"UnarchiveWave",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateLaunchConfiguration",
+			"UpdateLaunchConfigurationTemplate",
+			"UpdateReplicationConfiguration",
+			"UpdateReplicationConfigurationTemplate",
+			"UpdateSourceServerReplicationType",
+			"UpdateWave",
+		},
+	},
+	"migrationhub-orchestrator": {
+		actions: []string{
+			"CreateWorkflow",
+			"CreateWorkflowStep",
+			"CreateWorkflowStepGroup",
+			"DeleteWorkflow",
+			"DeleteWorkflowStep",
+			"DeleteWorkflowStepGroup",
+			"GetTemplate",
+			"GetTemplateStep",
+			"GetTemplateStepGroup",
+			"GetWorkflow",
+			"GetWorkflowStep",
+			"GetWorkflowStepGroup",
+			"ListPlugins",
+			"ListTagsForResource",
+			"ListTemplates",
+			"ListTemplateStepGroups",
+			"ListTemplateSteps",
+			"ListWorkflows",
+			"ListWorkflowStepGroups",
+			"ListWorkflowSteps",
+			"RetryWorkflowStep",
+			"StartWorkflow",
+			"StopWorkflow",
+			"TagResource",
+			"UntagResource",
+			"UpdateWorkflow",
+			"UpdateWorkflowStep",
+			"UpdateWorkflowStepGroup",
+		},
+	},
+	"migrationhub-strategy": {
+		actions: []string{
+			"GetApplicationComponentDetails",
+			"GetApplicationComponentStrategies",
+			"GetAssessment",
+			"GetImportFileTask",
+			"GetLatestAssessmentId",
+			"GetPortfolioPreferences",
+			"GetPortfolioSummary",
+			"GetRecommendationReportDetails",
+			"GetServerDetails",
+			"GetServerStrategies",
+			"ListApplicationComponents",
+			"ListCollectors",
+			"ListImportFileTask",
+			"ListServers",
+			"PutPortfolioPreferences",
+			"StartAssessment",
+			"StartImportFileTask",
+			"StartRecommendationReportGeneration",
+			"StopAssessment",
+			"UpdateApplicationComponentConfig",
+			"UpdateServerConfig",
+		},
+	},
+	"mobileanalytics": {
+		actions: []string{
+			"PutEvents",
+		},
+	},
+	"mobilehub": {
+		actions: []string{
+			"CreateProject",
+			"DeleteProject",
+			"DescribeBundle",
+			"DescribeProject",
+			"ExportBundle",
+			"ExportProject",
+			"ListBundles",
+			"ListProjects",
+			"UpdateProject",
+		},
+	},
+	"mobiletargeting": {
+		actions: []string{
+			"CreateApp",
+			"CreateCampaign",
+			"CreateEmailTemplate",
+			"CreateExportJob",
+			"CreateImportJob",
+			"CreateInAppTemplate",
+			"CreateJourney",
+			"CreatePushTemplate",
+			"CreateRecommenderConfiguration",
+			"CreateSegment",
+			"CreateSmsTemplate",
+			"CreateVoiceTemplate",
+			"DeleteAdmChannel",
+			"DeleteApnsChannel",
+			"DeleteApnsSandboxChannel",
+			"DeleteApnsVoipChannel",
+			"DeleteApnsVoipSandboxChannel",
+			"DeleteApp",
+			"DeleteBaiduChannel",
+			"DeleteCampaign",
+			"DeleteEmailChannel",
+			"DeleteEmailTemplate",
+			"DeleteEndpoint",
+			"DeleteEventStream",
+			"DeleteGcmChannel",
+			"DeleteInAppTemplate",
+			"DeleteJourney",
+			"DeletePushTemplate",
+			"DeleteRecommenderConfiguration",
+			"DeleteSegment",
+			"DeleteSmsChannel",
+			"DeleteSmsTemplate",
+			"DeleteUserEndpoints",
+			"DeleteVoiceChannel",
+			"DeleteVoiceTemplate",
+			"GetAdmChannel",
+			"GetApnsChannel",
+			"GetApnsSandboxChannel",
+			"GetApnsVoipChannel",
+			"GetApnsVoipSandboxChannel",
+			"GetApp",
+			"GetApplicationDateRangeKpi",
+			"GetApplicationSettings",
+			"GetApps",
+			"GetBaiduChannel",
+			"GetCampaign",
+			"GetCampaignActivities",
+			"GetCampaignDateRangeKpi",
+			"GetCampaigns",
+			"GetCampaignVersion",
+			"GetCampaignVersions",
+			"GetChannels",
+			"GetEmailChannel",
+			"GetEmailTemplate",
+			"GetEndpoint",
+			"GetEventStream",
+			"GetExportJob",
+			"GetExportJobs",
+			"GetGcmChannel",
+			"GetImportJob",
+			"GetImportJobs",
+			"GetInAppMessages",
+			"GetInAppTemplate",
+			"GetJourney",
+			"GetJourneyDateRangeKpi",
+			"GetJourneyExecutionActivityMetrics",
+			"GetJourneyExecutionMetrics",
+			"GetPushTemplate",
+			"GetRecommenderConfiguration",
+			"GetRecommenderConfigurations",
+			"GetSegment",
+			"GetSegmentExportJobs",
+			"GetSegmentImportJobs",
+			"GetSegments",
+			"GetSegmentVersion",
+			"GetSegmentVersions",
+			"GetSmsChannel",
+			"GetSmsTemplate",
+			"GetUserEndpoints",
+			"GetVoiceChannel",
+			"GetVoiceTemplate",
+			"ListJourneys",
+			"ListTagsForResource",
+			"ListTemplates",
+			"ListTemplateVersions",
+			"PhoneNumberValidate",
+			"PutEvents",
+			"PutEventStream",
+			"RemoveAttributes",
+			"SendMessages",
+			"SendOTPMessage",
+			"SendUsersMessages",
+			"TagResource",
+			"UntagResource",
+			"UpdateAdmChannel",
+			"UpdateApnsChannel",
+			"UpdateApnsSandboxChannel",
+			"UpdateApnsVoipChannel",
+			"UpdateApnsVoipSandboxChannel",
+			"UpdateApplicationSettings",
+			"UpdateBaiduChannel",
+			"UpdateCampaign",
+			"UpdateEmailChannel",
+			"UpdateEmailTemplate",
+			"UpdateEndpoint",
+			"UpdateEndpointsBatch",
+			"UpdateGcmChannel",
+			"UpdateInAppTemplate",
+			"UpdateJourney",
+			"UpdateJourneyState",
+			"UpdatePushTemplate",
+			"UpdateRecommenderConfiguration",
+			"UpdateSegment",
+			"UpdateSmsChannel",
+			"UpdateSmsTemplate",
+			"UpdateTemplateActiveVersion",
+			"UpdateVoiceChannel",
+			"UpdateVoiceTemplate",
+			"VerifyOTPMessage",
+		},
+	},
+	"mq": {
+		actions: []string{
+			"CreateBroker",
+			"CreateConfiguration",
+			"CreateTags",
+			"CreateUser",
+			"DeleteBroker",
+			"DeleteTags",
+			"DeleteUser",
+			"DescribeBroker",
+			"DescribeBrokerEngineTypes",
+			"DescribeBrokerInstanceOptions",
+			"DescribeConfiguration",
+			"DescribeConfigurationRevision",
+			"DescribeUser",
+			"ListBrokers",
+			"ListConfigurationRevisions",
+			"ListConfigurations",
+			"ListTags",
+			"ListUsers",
+			"RebootBroker",
+			"UpdateBroker",
+			"UpdateConfiguration",
+			"UpdateUser",
+		},
+	},
+	"neptune-db": {
+		actions: []string{
+			"CancelLoaderJob",
+			"CancelMLDataProcessingJob",
+			"CancelMLModelTrainingJob",
+			"CancelMLModelTransformJob",
+			"CancelQuery",
+			"connect",
+			"DeleteDataViaQuery",
+			"DeleteMLEndpoint",
+			"DeleteStatistics",
+			"GetEngineStatus",
+			"GetGraphSummary",
+			"GetLoaderJobStatus",
+			"GetMLDataProcessingJobStatus",
+			"GetMLEndpointStatus",
+			"GetMLModelTrainingJobStatus",
+			"GetMLModelTransformJobStatus",
+			"GetQueryStatus",
+			"GetStatisticsStatus",
+			"GetStreamRecords",
+			"ListLoaderJobs",
+			"ListMLDataProcessingJobs",
+			"ListMLEndpoints",
+			"ListMLModelTrainingJobs",
+			"ListMLModelTransformJobs",
+			"ManageStatistics",
+			"ReadDataViaQuery",
+			"ResetDatabase",
+			"StartLoaderJob",
+			"StartMLDataProcessingJob",
+			"StartMLModelTrainingJob",
+			"StartMLModelTransformJob",
+			"WriteDataViaQuery",
+		},
+	},
+	"network-firewall": {
+		actions: []string{
+			"AssociateFirewallPolicy",
+			"AssociateSubnets",
+			"CreateFirewall",
+			"CreateFirewallPolicy",
+			"CreateRuleGroup",
+			"DeleteFirewall",
+			"DeleteFirewallPolicy",
+			"DeleteResourcePolicy",
+			"DeleteRuleGroup",
+			"DescribeFirewall",
+			"DescribeFirewallPolicy",
+			"DescribeLoggingConfiguration",
+			"DescribeResourcePolicy",
+			"DescribeRuleGroup",
+			"DescribeRuleGroupMetadata",
+			"DisassociateSubnets",
+			"ListFirewallPolicies",
+			"ListFirewalls",
+			"ListRuleGroups",
+			"ListTagsForResource",
+			"PutResourcePolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateFirewallDeleteProtection",
+			"UpdateFirewallDescription",
+			"UpdateFirewallEncryptionConfiguration",
+			"UpdateFirewallPolicy",
+			"UpdateFirewallPolicyChangeProtection",
+			"UpdateLoggingConfiguration",
+			"UpdateRuleGroup",
+			"UpdateSubnetChangeProtection",
+		},
+	},
+	"networkmanager": {
+		actions: []string{
+			"AcceptAttachment",
+			"AssociateConnectPeer",
+			"AssociateCustomerGateway",
+			"AssociateLink",
+			"AssociateTransitGatewayConnectPeer",
+			"CreateConnectAttachment",
+			"CreateConnection",
+			"CreateConnectPeer",
+			"CreateCoreNetwork",
+			"CreateDevice",
+			"CreateGlobalNetwork",
+			"CreateLink",
+			"CreateSite",
+			"CreateSiteToSiteVpnAttachment",
+			"CreateTransitGatewayPeering",
+			"CreateTransitGatewayRouteTableAttachment",
+			"CreateVpcAttachment",
+			"DeleteAttachment",
+			"DeleteConnection",
+			"DeleteConnectPeer",
+			"DeleteCoreNetwork",
+			"DeleteCoreNetworkPolicyVersion",
+			"DeleteDevice",
+			"DeleteGlobalNetwork",
+			"DeleteLink",
+			"DeletePeering",
+			"DeleteResourcePolicy",
+			"DeleteSite",
+			"DeregisterTransitGateway",
+			"DescribeGlobalNetworks",
+			"DisassociateConnectPeer",
+			"DisassociateCustomerGateway",
+			"DisassociateLink",
+			"DisassociateTransitGatewayConnectPeer",
+			"ExecuteCoreNetworkChangeSet",
+			"GetConnectAttachment",
+			"GetConnections",
+			"GetConnectPeer",
+			"GetConnectPeerAssociations",
+			"GetCoreNetwork",
+			"GetCoreNetworkChangeEvents",
+			"GetCoreNetworkChangeSet",
+			"GetCoreNetworkPolicy",
+			"GetCustomerGatewayAssociations",
+			"GetDevices",
+			"GetLinkAssociations",
+			"GetLinks",
+			"GetNetworkResourceCounts",
+			"GetNetworkResourceRelationships",
+			"GetNetworkResources",
+			"GetNetworkRoutes",
+			"GetNetworkTelemetry",
+			"GetResourcePolicy",
+			"GetRouteAnalysis",
+			"GetSites",
+			"GetSiteToSiteVpnAttachment",
+			"GetTransitGatewayConnectPeerAssociations",
+			"GetTransitGatewayPeering",
+			"GetTransitGatewayRegistrations",
+			"GetTransitGatewayRouteTableAttachment",
+			"GetVpcAttachment",
+			"ListAttachments",
+			"ListConnectPeers",
+			"ListCoreNetworkPolicyVersions",
+			"ListCoreNetworks",
+			"ListOrganizationServiceAccessStatus",
+			"ListPeerings",
+			"ListTagsForResource",
+			"PutCoreNetworkPolicy",
+			"PutResourcePolicy",
+			"RegisterTransitGateway",
+			"RejectAttachment",
+			"RestoreCoreNetworkPolicyVersion",
+			"StartOrganizationServiceAccessUpdate",
+			"StartRouteAnalysis",
+			"TagResource",
+			"UntagResource",
+			"UpdateConnection",
+			"UpdateCoreNetwork",
+			"UpdateDevice",
+			"UpdateGlobalNetwork",
+			"UpdateLink",
+			"UpdateNetworkResourceMetadata",
+			"UpdateSite",
+			"UpdateVpcAttachment",
+		},
+	},
+	"nimble": {
+		actions: []string{
+			"AcceptEulas",
+			"CreateLaunchProfile",
+			"CreateStreamingImage",
+			"CreateStreamingSession",
+			"CreateStreamingSessionStream",
+			"CreateStudio",
+			"CreateStudioComponent",
+			"DeleteLaunchProfile",
+			"DeleteLaunchProfileMember",
+			"DeleteStreamingImage",
+			"DeleteStreamingSession",
+			"DeleteStudio",
+			"DeleteStudioComponent",
+			"DeleteStudioMember",
+			"GetEula",
+			"GetLaunchProfile",
+			"GetLaunchProfileDetails",
+			"GetLaunchProfileInitialization",
+			"GetLaunchProfileMember",
+			"GetStreamingImage",
+			"GetStreamingSession",
+			"GetStreamingSessionBackup",
+			"GetStreamingSessionStream",
+			"GetStudio",
+			"GetStudioComponent",
+			"GetStudioMember",
+			"ListEulaAcceptances",
+			"ListEulas",
+			"ListLaunchProfileMembers",
+			"ListLaunchProfiles",
+			"ListStreamingImages",
+			"ListStreamingSessionBackups",
+			"ListStreamingSessions",
+			"ListStudioComponents",
+			"ListStudioMembers",
+			"ListStudios",
+			"ListTagsForResource",
+			"PutLaunchProfileMembers",
+			"PutStudioMembers",
+			"StartStreamingSession",
+			"StartStudioSSOConfigurationRepair",
+			"StopStreamingSession",
+			"TagResource",
+			"UntagResource",
+			"UpdateLaunchProfile",
+			"UpdateLaunchProfileMember",
+			"UpdateStreamingImage",
+			"UpdateStudio",
+			"UpdateStudioComponent",
+		},
+	},
+	"oam": {
+		actions: []string{
+			"CreateLink",
+			"CreateSink",
+			"DeleteLink",
+			"DeleteSink",
+			"GetLink",
+			"GetSink",
+			"GetSinkPolicy",
+			"ListAttachedLinks",
+			"ListLinks",
+			"ListSinks",
+			"ListTagsForResource",
+			"PutSinkPolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateLink",
+		},
+	},
+	"omics": {
+		actions: []string{
+			"BatchDeleteReadSet",
+			"CancelAnnotationImportJob",
+			"CancelRun",
+			"CancelVariantImportJob",
+			"CreateAnnotationStore",
+			"CreateReferenceStore",
+			"CreateRunGroup",
+			"CreateSequenceStore",
+			"CreateVariantStore",
+			"CreateWorkflow",
+			"DeleteAnnotationStore",
+			"DeleteReference",
+			"DeleteReferenceStore",
+			"DeleteRun",
+			"DeleteRunGroup",
+			"DeleteSequenceStore",
+			"DeleteVariantStore",
+			"DeleteWorkflow",
+			"GetAnnotationImportJob",
+			"GetAnnotationStore",
+			"GetReadSet",
+			"GetReadSetActivationJob",
+			"GetReadSetExportJob",
+			"GetReadSetImportJob",
+			"GetReadSetMetadata",
+			"GetReference",
+			"GetReferenceImportJob",
+			"GetReferenceMetadata",
+			"GetReferenceStore",
+			"GetRun",
+			"GetRunGroup",
+			"GetRunTask",
+			"GetSequenceStore",
+			"GetVariantImportJob",
+			"GetVariantStore",
+			"GetWorkflow",
+			"ListAnnotationImportJobs",
+			"ListAnnotationStores",
+			"ListReadSetActivationJobs",
+			"ListReadSetExportJobs",
+			"ListReadSetImportJobs",
+			"ListReadSets",
+			"ListReferenceImportJobs",
+			"ListReferences",
+			"ListReferenceStores",
+			"ListRunGroups",
+			"ListRuns",
+			"ListRunTasks",
+			"ListSequenceStores",
+			"ListTagsForResource",
+			"ListVariantImportJobs",
+			"ListVariantStores",
+			"ListWorkflows",
+			"StartAnnotationImportJob",
+			"StartReadSetActivationJob",
+			"StartReadSetExportJob",
+			"StartReadSetImportJob",
+			"StartReferenceImportJob",
+			"StartRun",
+			"StartVariantImportJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateAnnotationStore",
+			"UpdateRunGroup",
+			"UpdateVariantStore",
+			"UpdateWorkflow",
+		},
+	},
+	"opsworks": {
+		actions: []string{
+			"AssignInstance",
+			"AssignVolume",
+			"AssociateElasticIp",
+			"AttachElasticLoadBalancer",
+			"CloneStack",
+			"CreateApp",
+			"CreateDeployment",
+			"CreateInstance",
+			"CreateLayer",
+			"CreateStack",
+			"CreateUserProfile",
+			"DeleteApp",
+			"DeleteInstance",
+			"DeleteLayer",
+			"DeleteStack",
+			"DeleteUserProfile",
+			"DeregisterEcsCluster",
+			"DeregisterElasticIp",
+			"DeregisterInstance",
+			"DeregisterRdsDbInstance",
+			"DeregisterVolume",
+			"DescribeAgentVersions",
+			"DescribeApps",
+			"DescribeCommands",
+			"DescribeDeployments",
+			"DescribeEcsClusters",
+			"DescribeElasticIps",
+			"DescribeElasticLoadBalancers",
+			"DescribeInstances",
+			"DescribeLayers",
+			"DescribeLoadBasedAutoScaling",
+			"DescribeMyUserProfile",
+			"DescribeOperatingSystems",
+			"DescribePermissions",
+			"DescribeRaidArrays",
+			"DescribeRdsDbInstances",
+			"DescribeServiceErrors",
+			"DescribeStackProvisioningParameters",
+			"DescribeStacks",
+			"DescribeStackSummary",
+			"DescribeTimeBasedAutoScaling",
+			"DescribeUserProfiles",
+			"DescribeVolumes",
+			"DetachElasticLoadBalancer",
+			"DisassociateElasticIp",
+			"GetHostnameSuggestion",
+			"GrantAccess",
+			"ListTags",
+			"RebootInstance",
+			"RegisterEcsCluster",
+			"RegisterElasticIp",
+			"RegisterInstance",
+			"RegisterRdsDbInstance",
+			"RegisterVolume",
+			"SetLoadBasedAutoScaling",
+			"SetPermission",
+			"SetTimeBasedAutoScaling",
+			"StartInstance",
+			"StartStack",
+			"StopInstance",
+			"StopStack",
+			"TagResource",
+			"UnassignInstance",
+			"UnassignVolume",
+			"UntagResource",
+			"UpdateApp",
+			"UpdateElasticIp",
+			"UpdateInstance",
+			"UpdateLayer",
+			"UpdateMyUserProfile",
+			"UpdateRdsDbInstance",
+			"UpdateStack",
+			"UpdateUserProfile",
+			"UpdateVolume",
+		},
+	},
+	"opsworks-cm": {
+		actions: []string{
+			"AssociateNode",
+			"CreateBackup",
+			"CreateServer",
+			"DeleteBackup",
+			"DeleteServer",
+			"DescribeAccountAttributes",
+			"DescribeBackups",
+			"DescribeEvents",
+			"DescribeNodeAssociationStatus",
+			"DescribeServers",
+			"DisassociateNode",
+			"ExportServerEngineAttribute",
+			"ListTagsForResource",
+			"RestoreServer",
+			"StartMaintenance",
+			"TagResource",
+			"UntagResource",
+			"UpdateServer",
+			"UpdateServerEngineAttributes",
+		},
+	},
+	"organizations": {
+		actions: []string{
+			"AcceptHandshake",
+			"AttachPolicy",
+			"CancelHandshake",
+			"CloseAccount",
+			"CreateAccount",
+			"CreateGovCloudAccount",
+			"CreateOrganization",
+			"CreateOrganizationalUnit",
+			"CreatePolicy",
+			"DeclineHandshake",
+			"DeleteOrganization",
+			"DeleteOrganizationalUnit",
+			"DeletePolicy",
+			"DeleteResourcePolicy",
+			"DeregisterDelegatedAdministrator",
+			"DescribeAccount",
+			"DescribeCreateAccountStatus",
+			"DescribeEffectivePolicy",
+			"DescribeHandshake",
+			"DescribeOrganization",
+			"DescribeOrganizationalUnit",
+			"DescribePolicy",
+			"DescribeResourcePolicy",
+			"DetachPolicy",
+			"DisableAWSServiceAccess",
+			"DisablePolicyType",
+			"EnableAllFeatures",
+			"EnableAWSServiceAccess",
+			"EnablePolicyType",
+			"InviteAccountToOrganization",
+			"LeaveOrganization",
+			"ListAccounts",
+			"ListAccountsForParent",
+			"ListAWSServiceAccessForOrganization",
+			"ListChildren",
+			"ListCreateAccountStatus",
+			"ListDelegatedAdministrators",
+			"ListDelegatedServicesForAccount",
+			"ListHandshakesForAccount",
+			"ListHandshakesForOrganization",
+			"ListOrganizationalUnitsForParent",
+			"ListParents",
+			"ListPolicies",
+			"ListPoliciesForTarget",
+			"ListRoots",
+			"ListTagsForResource",
+			"ListTargetsForPolicy",
+			"MoveAccount",
+			"PutResourcePolicy",
+			"RegisterDelegatedAdministrator",
+			"RemoveAccountFromOrganization",
+			"TagResource",
+			"UntagResource",
+			"UpdateOrganizationalUnit",
+			"UpdatePolicy",
+		},
+	},
+	"outposts": {
+		actions: []string{
+			"CancelOrder",
+			"CreateOrder",
+			"CreateOutpost",
+			"CreateSite",
+			"DeleteOutpost",
+			"DeleteSite",
+			"GetCatalogItem",
+			"GetConnection",
+			"GetOrder",
+			"GetOutpost",
+			"GetOutpostInstanceTypes",
+			"GetSite",
+			"GetSiteAddress",
+			"ListAssets",
+			"ListCatalogItems",
+			"ListOrders",
+			"ListOutposts",
+			"ListSites",
+			"ListTagsForResource",
+			"StartConnection",
+			"TagResource",
+			"UntagResource",
+			"UpdateOutpost",
+			"UpdateSite",
+			"UpdateSiteAddress",
+			"UpdateSiteRackPhysicalProperties",
+		},
+	},
+	"panorama": {
+		actions: []string{
+			"CreateApplicationInstance",
+			"CreateJobForDevices",
+			"CreateNodeFromTemplateJob",
+			"CreatePackage",
+			"CreatePackageImportJob",
+			"DeleteDevice",
+			"DeletePackage",
+			"DeregisterPackageVersion",
+			"DescribeApplicationInstance",
+			"DescribeApplicationInstanceDetails",
+			"DescribeDevice",
+			"DescribeDeviceJob",
+			"DescribeNode",
+			"DescribeNodeFromTemplateJob",
+			"DescribePackage",
+			"DescribePackageImportJob",
+			"DescribePackageVersion",
+			"ListApplicationInstanceDependencies",
+			"ListApplicationInstanceNodeInstances",
+			"ListApplicationInstances",
+			"ListDevices",
+			"ListDevicesJobs",
+			"ListNodeFromTemplateJobs",
+			"ListNodes",
+			"ListPackageImportJobs",
+			"ListPackages",
+			"ListTagsForResource",
+			"ProvisionDevice",
+			"RegisterPackageVersion",
+			"RemoveApplicationInstance",
+			"SignalApplicationInstanceNodeInstances",
+			"TagResource",
+			"UntagResource",
+			"UpdateDeviceMetadata",
+		},
+	},
+	"personalize": {
+		actions: []string{
+			"CreateBatchInferenceJob",
+			"CreateBatchSegmentJob",
+			"CreateCampaign",
+			"CreateDataset",
+			"CreateDatasetExportJob",
+			"CreateDatasetGroup",
+			"CreateDatasetImportJob",
+			"CreateEventTracker",
+			"CreateFilter",
+			"CreateMetricAttribution",
+			"CreateRecommender",
+			"CreateSchema",
+			"CreateSolution",
+			"CreateSolutionVersion",
+			"DeleteCampaign",
+			"DeleteDataset",
+			"DeleteDatasetGroup",
+			"DeleteEventTracker",
+			"DeleteFilter",
+			"DeleteMetricAttribution",
+			"DeleteRecommender",
+			"DeleteSchema",
+			"DeleteSolution",
+			"DescribeAlgorithm",
+			"DescribeBatchInferenceJob",
+			"DescribeBatchSegmentJob",
+			"DescribeCampaign",
+			"DescribeDataset",
+			"DescribeDatasetExportJob",
+			"DescribeDatasetGroup",
+			"DescribeDatasetImportJob",
+			"DescribeEventTracker",
+			"DescribeFeatureTransformation",
+			"DescribeFilter",
+			"DescribeMetricAttribution",
+			"DescribeRecipe",
+			"DescribeRecommender",
+			"DescribeSchema",
+			"DescribeSolution",
+			"DescribeSolutionVersion",
+			"GetPersonalizedRanking",
+			"GetRecommendations",
+			"GetSolutionMetrics",
+			"ListBatchInferenceJobs",
+			"ListBatchSegmentJobs",
+			"ListCampaigns",
+			"ListDatasetExportJobs",
+			"ListDatasetGroups",
+			"ListDatasetImportJobs",
+			"ListDatasets",
+			"ListEventTrackers",
+			"ListFilters",
+			"ListMetricAttributionMetrics",
+			"ListMetricAttributions",
+			"ListRecipes",
+			"ListRecommenders",
+			"ListSchemas",
+			"ListSolutions",
+			"ListSolutionVersions",
+			"ListTagsForResource",
+			"PutEvents",
+			"PutItems",
+			"PutUsers",
+			"StartRecommender",
+			"StopRecommender",
+			"StopSolutionVersionCreation",
+			"TagResource",
+			"UntagResource",
+			"UpdateCampaign",
+			"UpdateMetricAttribution",
+			"UpdateRecommender",
+		},
+	},
+	"pi": {
+		actions: []string{
+			"DescribeDimensionKeys",
+			"GetDimensionKeyDetails",
+			"GetResourceMetadata",
+			"GetResourceMetrics",
+			"ListAvailableResourceDimensions",
+			"ListAvailableResourceMetrics",
+		},
+	},
+	"pipes": {
+		actions: []string{
+			"CreatePipe",
+			"DeletePipe",
+			"DescribePipe",
+			"ListPipes",
+			"ListTagsForResource",
+			"StartPipe",
+			"StopPipe",
+			"TagResource",
+			"UntagResource",
+			"UpdatePipe",
+		},
+	},
+	"polly": {
+		actions: []string{
+			"DeleteLexicon",
+			"DescribeVoices",
+			"GetLexicon",
+			"GetSpeechSynthesisTask",
+			"ListLexicons",
+			"ListSpeechSynthesisTasks",
+			"PutLexicon",
+			"StartSpeechSynthesisTask",
+			"SynthesizeSpeech",
+		},
+	},
+	"pricing": {
+		actions: []string{
+			"DescribeServices",
+			"GetAttributeValues",
+			"GetProducts",
+		},
+	},
+	"private-networks": {
+		actions: []string{
+			"AcknowledgeOrderReceipt",
+			"ActivateDeviceIdentifier",
+			"ActivateNetworkSite",
+			"ConfigureAccessPoint",
+			"CreateNetwork",
+			"CreateNetworkSite",
+			"DeactivateDeviceIdentifier",
+			"DeleteNetwork",
+			"DeleteNetworkSite",
+			"GetDeviceIdentifier",
+			"GetNetwork",
+			"GetNetworkResource",
+			"GetNetworkSite",
+			"GetOrder",
+			"ListDeviceIdentifiers",
+			"ListNetworkResources",
+			"ListNetworks",
+			"ListNetworkSites",
+			"ListOrders",
+			"ListTagsForResource",
+			"Ping",
+			"TagResource",
+			"UntagResource",
+			"UpdateNetworkSite",
+			"UpdateNetworkSitePlan",
+		},
+	},
+	"profile": {
+		actions: []string{
+			"AddProfileKey",
+			"CreateDomain",
+			"CreateIntegrationWorkflow",
+			"CreateProfile",
+			"DeleteDomain",
+			"DeleteIntegration",
+			"DeleteProfile",
+			"DeleteProfileKey",
+			"DeleteProfileObject",
+			"DeleteProfileObjectType",
+			"DeleteWorkflow",
+			"GetAutoMergingPreview",
+			"GetDomain",
+			"GetIdentityResolutionJob",
+			"GetIntegration",
+			"GetMatches",
+			"GetProfileObjectType",
+			"GetProfileObjectTypeTemplate",
+			"GetWorkflow",
+			"GetWorkflowSteps",
+			"ListAccountIntegrations",
+			"ListDomains",
+			"ListIdentityResolutionJobs",
+			"ListIntegrations",
+			"ListProfileObjects",
+			"ListProfileObjectTypes",
+			"ListProfileObjectTypeTemplates",
+			"ListTagsForResource",
+			"ListWorkflows",
+			"MergeProfiles",
+			"PutIntegration",
+			"PutProfileObject",
+			"PutProfileObjectType",
+			"SearchProfiles",
+			"TagResource",
+			"UntagResource",
+			"UpdateDomain",
+			"UpdateProfile",
+		},
+	},
+	"proton": {
+		actions: []string{
+			"AcceptEnvironmentAccountConnection",
+			"CancelComponentDeployment",
+			"CancelEnvironmentDeployment",
+			"CancelServiceInstanceDeployment",
+			"CancelServicePipelineDeployment",
+			"CreateComponent",
+			"CreateEnvironment",
+			"CreateEnvironmentAccountConnection",
+			"CreateEnvironmentTemplate",
+			"CreateEnvironmentTemplateVersion",
+			"CreateRepository",
+			"CreateService",
+			"CreateServiceTemplate",
+			"CreateServiceTemplateVersion",
+			"CreateTemplateSyncConfig",
+			"DeleteComponent",
+			"DeleteEnvironment",
+			"DeleteEnvironmentAccountConnection",
+			"DeleteEnvironmentTemplate",
+			"DeleteEnvironmentTemplateVersion",
+			"DeleteRepository",
+			"DeleteService",
+			"DeleteServiceTemplate",
+			"DeleteServiceTemplateVersion",
+			"DeleteTemplateSyncConfig",
+			"GetAccountSettings",
+			"GetComponent",
+			"GetEnvironment",
+			"GetEnvironmentAccountConnection",
+			"GetEnvironmentTemplate",
+			"GetEnvironmentTemplateVersion",
+			"GetRepository",
+			"GetRepositorySyncStatus",
+			"GetService",
+			"GetServiceInstance",
+			"GetServiceTemplate",
+			"GetServiceTemplateVersion",
+			"GetTemplateSyncConfig",
+			"GetTemplateSyncStatus",
+			"ListComponentOutputs",
+			"ListComponentProvisionedResources",
+			"ListComponents",
+			"ListEnvironmentAccountConnections",
+			"ListEnvironmentOutputs",
+			"ListEnvironmentProvisionedResources",
+			"ListEnvironments",
+			"ListEnvironmentTemplates",
+			"ListEnvironmentTemplateVersions",
+			"ListRepositories",
+			"ListRepositorySyncDefinitions",
+			"ListServiceInstanceOutputs",
+			"ListServiceInstanceProvisionedResources",
+			"ListServiceInstances",
+			"ListServicePipelineOutputs",
+			"ListServicePipelineProvisionedResources",
+			"ListServices",
+			"ListServiceTemplates",
+			"ListServiceTemplateVersions",
+			"ListTagsForResource",
+			"NotifyResourceDeploymentStatusChange",
+			"RejectEnvironmentAccountConnection",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccountSettings",
+			"UpdateComponent",
+			"UpdateEnvironment",
+			"UpdateEnvironmentAccountConnection",
+			"UpdateEnvironmentTemplate",
+			"UpdateEnvironmentTemplateVersion",
+			"UpdateService",
+			"UpdateServiceInstance",
+			"UpdateServicePipeline",
+			"UpdateServiceTemplate",
+			"UpdateServiceTemplateVersion",
+			"UpdateTemplateSyncConfig",
+		},
+	},
+	"qldb": {
+		actions: []string{
+			"CancelJournalKinesisStream",
+			"CreateLedger",
+			"DeleteLedger",
+			"DescribeJournalKinesisStream",
+			"DescribeJournalS3Export",
+			"DescribeLedger",
+			"ExportJournalToS3",
+			"GetBlock",
+			"GetDigest",
+			"GetRevision",
+			"ListJournalKinesisStreamsForLedger",
+			"ListJournalS3Exports",
+			"ListJournalS3ExportsForLedger",
+			"ListLedgers",
+			"ListTagsForResource",
+			"SendCommand",
+			"StreamJournalToKinesis",
+			"TagResource",
+			"UntagResource",
+			"UpdateLedger",
+			"UpdateLedgerPermissionsMode",
+		},
+	},
+	"quicksight": {
+		actions: []string{
+			"CancelIngestion",
+			"CreateAccountCustomization",
+			"CreateAccountSubscription",
+			"CreateAnalysis",
+			"CreateDashboard",
+			"CreateDataSet",
+			"CreateDataSource",
+			"CreateFolder",
+			"CreateFolderMembership",
+			"CreateGroup",
+			"CreateGroupMembership",
+			"CreateIAMPolicyAssignment",
+			"CreateIngestion",
+			"CreateNamespace",
+			"CreateTemplate",
+			"CreateTemplateAlias",
+			"CreateTheme",
+			"CreateThemeAlias",
+			"DeleteAccountCustomization",
+			"DeleteAccountSubscription",
+			"DeleteAnalysis",
+			"DeleteDashboard",
+			"DeleteDataSet",
+			"DeleteDataSource",
+			"DeleteFolder",
+			"DeleteFolderMembership",
+			"DeleteGroup",
+			"DeleteGroupMembership",
+			"DeleteIAMPolicyAssignment",
+			"DeleteNamespace",
+			"DeleteTemplate",
+			"DeleteTemplateAlias",
+			"DeleteTheme",
+			"DeleteThemeAlias",
+			"DeleteUser",
+			"DeleteUserByPrincipalId",
+			"DescribeAccountCustomization",
+			"DescribeAccountSettings",
+			"DescribeAccountSubscription",
+			"DescribeAnalysis",
+			"DescribeAnalysisDefinition",
+			"DescribeAnalysisPermissions",
+			"DescribeDashboard",
+			"DescribeDashboardDefinition",
+			"DescribeDashboardPermissions",
+			"DescribeDataSet",
+			"DescribeDataSetPermissions",
+			"DescribeDataSource",
+			"DescribeDataSourcePermissions",
+			"DescribeFolder",
+			"DescribeFolderPermissions",
+			"DescribeFolderResolvedPermissions",
+			"DescribeGroup",
+			"DescribeGroupMembership",
+			"DescribeIAMPolicyAssignment",
+			"DescribeIngestion",
+			"DescribeIpRestriction",
+			"DescribeNamespace",
+			"DescribeTemplate",
+			"DescribeTemplateAlias",
+			"DescribeTemplateDefinition",
+			"DescribeTemplatePermissions",
+			"DescribeTheme",
+			"DescribeThemeAlias",
+			"DescribeThemePermissions",
+			"DescribeUser",
+			"GenerateEmbedUrlForAnonymousUser",
+			"GenerateEmbedUrlForRegisteredUser",
+			"GetDashboardEmbedUrl",
+			"GetSessionEmbedUrl",
+			"ListAnalyses",
+			"ListDashboards",
+			"ListDashboardVersions",
+			"ListDataSets",
+			"ListDataSources",
+			"ListFolderMembers",
+			"ListFolders",
+			"ListGroupMemberships",
+			"ListGroups",
+			"ListIAMPolicyAssignments",
+			"ListIAMPolicyAssignmentsForUser",
+			"ListIngestions",
+			"ListNamespaces",
+			"ListTagsForResource",
+			"ListTemplateAliases",
+			"ListTemplates",
+			"ListTemplateVersions",
+			"ListThemeAliases",
+			"ListThemes",
+			"ListThemeVersions",
+			"ListUserGroups",
+			"ListUsers",
+			"PassDataSource",
+			"RegisterUser",
+			"RestoreAnalysis",
+			"SearchAnalyses",
+			"SearchDashboards",
+			"SearchDataSets",
+			"SearchDataSources",
+			"SearchFolders",
+			"SearchGroups",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccountCustomization",
+			"UpdateAccountSettings",
+			"UpdateAnalysis",
+			"UpdateAnalysisPermissions",
+			"UpdateDashboard",
+			"UpdateDashboardPermissions",
+			"UpdateDashboardPublishedVersion",
+			"UpdateDataSet",
+			"UpdateDataSetPermissions",
+			"UpdateDataSource",
+			"UpdateDataSourcePermissions",
+			"UpdateFolder",
+			"UpdateFolderPermissions",
+			"UpdateGroup",
+			"UpdateIAMPolicyAssignment",
+			"UpdateIpRestriction",
+			"UpdatePublicSharingSettings",
+			"UpdateTemplate",
+			"UpdateTemplateAlias",
+			"UpdateTemplatePermissions",
+			"UpdateTheme",
+			"UpdateThemeAlias",
+			"UpdateThemePermissions",
+			"UpdateUser",
+		},
+	},
+	"ram": {
+		actions: []string{
+			"AcceptResourceShareInvitation",
+			"AssociateResourceShare",
+			"AssociateResourceSharePermission",
+			"CreateResourceShare",
+			"DeleteResourceShare",
+			"DisassociateResourceShare",
+			"DisassociateResourceSharePermission",
+			"EnableSharingWithAwsOrganization",
+			"GetPermission",
+			"GetResourcePolicies",
+			"GetResourceShareAssociations",
+			"GetResourceShareInvitations",
+			"GetResourceShares",
+			"ListPendingInvitationResources",
+			"ListPermissions",
+			"ListPermissionVersions",
+			"ListPrincipals",
+			"ListResources",
+			"ListResourceSharePermissions",
+			"ListResourceTypes",
+			"PromoteResourceShareCreatedFromPolicy",
+			"RejectResourceShareInvitation",
+			"TagResource",
+			"UntagResource",
+			"UpdateResourceShare",
+		},
+	},
+	"rbin": {
+		actions: []string{
+			"CreateRule",
+			"DeleteRule",
+			"GetRule",
+			"ListRules",
+			"ListTagsForResource",
+			"LockRule",
+			"TagResource",
+			"UnlockRule",
+			"UntagResource",
+			"UpdateRule",
+		},
+	},
+	"rds": {
+		actions: []string{
+			"AddRoleToDBCluster",
+			"AddRoleToDBInstance",
+			"AddSourceIdentifierToSubscription",
+			"AddTagsToResource",
+			"ApplyPendingMaintenanceAction",
+			"AuthorizeDBSecurityGroupIngress",
+			"BacktrackDBCluster",
+			"CancelExportTask",
+			"CopyDBClusterParameterGroup",
+			"CopyDBClusterSnapshot",
+			"CopyDBParameterGroup",
+			"CopyDBSnapshot",
+			"CopyOptionGroup",
+			"CreateBlueGreenDeployment",
+			"CreateCustomDBEngineVersion",
+			"CreateDBCluster",
+			"CreateDBClusterEndpoint",
+			"CreateDBClusterParameterGroup",
+			"CreateDBClusterSnapshot",
+			"CreateDBInstance",
+			"CreateDBInstanceReadReplica",
+			"CreateDBParameterGroup",
+			"CreateDBProxy",
+			"CreateDBProxyEndpoint",
+			"CreateDBSecurityGroup",
+			"CreateDBSnapshot",
+			"CreateDBSubnetGroup",
+			"CreateEventSubscription",
+			"CreateGlobalCluster",
+			"CreateOptionGroup",
+			"CrossRegionCommunication",
+			"DeleteBlueGreenDeployment",
+			"DeleteCustomDBEngineVersion",
+			"DeleteDBCluster",
+			"DeleteDBClusterEndpoint",
+			"DeleteDBClusterParameterGroup",
+			"DeleteDBClusterSnapshot",
+			"DeleteDBInstance",
+			"DeleteDBInstanceAutomatedBackup",
+			"DeleteDBParameterGroup",
+			"DeleteDBProxy",
+			"DeleteDBProxyEndpoint",
+			"DeleteDBSecurityGroup",
+			"DeleteDBSnapshot",
+			"DeleteDBSubnetGroup",
+			"DeleteEventSubscription",
+			"DeleteGlobalCluster",
+			"DeleteOptionGroup",
+			"DeregisterDBProxyTargets",
+			"DescribeAccountAttributes",
+			"DescribeBlueGreenDeployments",
+			"DescribeCertificates",
+			"DescribeDBClusterBacktracks",
+			"DescribeDBClusterEndpoints",
+			"DescribeDBClusterParameterGroups",
+			"DescribeDBClusterParameters",
+			"DescribeDBClusters",
+			"DescribeDBClusterSnapshotAttributes",
+			"DescribeDBClusterSnapshots",
+			"DescribeDBEngineVersions",
+			"DescribeDBInstanceAutomatedBackups",
+			"DescribeDBInstances",
+			"DescribeDBLogFiles",
+			"DescribeDBParameterGroups",
+			"DescribeDBParameters",
+			"DescribeDBProxies",
+			"DescribeDBProxyEndpoints",
+			"DescribeDBProxyTargetGroups",
+			"DescribeDBProxyTargets",
+			"DescribeDBSecurityGroups",
+			"DescribeDBSnapshotAttributes",
+			"DescribeDBSnapshots",
+			"DescribeDBSubnetGroups",
+			"DescribeEngineDefaultClusterParameters",
+			"DescribeEngineDefaultParameters",
+			"DescribeEventCategories",
+			"DescribeEvents",
+			"DescribeEventSubscriptions",
+			"DescribeExportTasks",
+			"DescribeGlobalClusters",
+			"DescribeOptionGroupOptions",
+			"DescribeOptionGroups",
+			"DescribeOrderableDBInstanceOptions",
+			"DescribePendingMaintenanceActions",
+			"DescribeReservedDBInstances",
+			"DescribeReservedDBInstancesOfferings",
+			"DescribeSourceRegions",
+			"DescribeValidDBInstanceModifications",
+			"DownloadDBLogFilePortion",
+			"FailoverDBCluster",
+			"FailoverGlobalCluster",
+			"ListTagsForResource",
+			"ModifyActivityStream",
+			"ModifyCertificates",
+			"ModifyCurrentDBClusterCapacity",
+			"ModifyCustomDBEngineVersion",
+			"ModifyDBCluster",
+			"ModifyDBClusterEndpoint",
+			"ModifyDBClusterParameterGroup",
+			"ModifyDBClusterSnapshotAttribute",
+			"ModifyDBInstance",
+			"ModifyDBParameterGroup",
+			"ModifyDBProxy",
+			"ModifyDBProxyEndpoint",
+			"ModifyDBProxyTargetGroup",
+			"ModifyDBSnapshot",
+			"ModifyDBSnapshotAttribute",
+			"ModifyDBSubnetGroup",
+			"ModifyEventSubscription",
+			"ModifyGlobalCluster",
+			"ModifyOptionGroup",
+			"PromoteReadReplica",
+			"PromoteReadReplicaDBCluster",
+			"PurchaseReservedDBInstancesOffering",
+			"RebootDBCluster",
+			"RebootDBInstance",
+			"RegisterDBProxyTargets",
+			"RemoveFromGlobalCluster",
+			"RemoveRoleFromDBCluster",
+			"RemoveRoleFromDBInstance",
+			"RemoveSourceIdentifierFromSubscription",
+			"RemoveTagsFromResource",
+			"ResetDBClusterParameterGroup",
+			"ResetDBParameterGroup",
+			"RestoreDBClusterFromS3",
+			"RestoreDBClusterFromSnapshot",
+			"RestoreDBClusterToPointInTime",
+			"RestoreDBInstanceFromDBSnapshot",
+			"RestoreDBInstanceFromS3",
+			"RestoreDBInstanceToPointInTime",
+			"RevokeDBSecurityGroupIngress",
+			"StartActivityStream",
+			"StartDBCluster",
+			"StartDBInstance",
+			"StartDBInstanceAutomatedBackupsReplication",
+			"StartExportTask",
+			"StopActivityStream",
+			"StopDBCluster",
+			"StopDBInstance",
+			"StopDBInstanceAutomatedBackupsReplication",
+			"SwitchoverBlueGreenDeployment",
+			"SwitchoverReadReplica",
+		},
+	},
+	"rds-data": {
+		actions: []string{
+			"BatchExecuteStatement",
+			"BeginTransaction",
+			"CommitTransaction",
+			"ExecuteSql",
+			"ExecuteStatement",
+			"RollbackTransaction",
+		},
+	},
+	"rds-db": {
+		actions: []string{
+			"connect",
+		},
+	},
+	"redshift": {
+		actions: []string{
+			"AcceptReservedNodeExchange",
+			"AddPartner",
+			"AssociateDataShareConsumer",
+			"AuthorizeClusterSecurityGroupIngress",
+			"AuthorizeDataShare",
+			"AuthorizeEndpointAccess",
+			"AuthorizeSnapshotAccess",
+			"BatchDeleteClusterSnapshots",
+			"BatchModifyClusterSnapshots",
+			"CancelResize",
+			"CopyClusterSnapshot",
+			"CreateAuthenticationProfile",
+			"CreateCluster",
+			"CreateClusterParameterGroup",
+			"CreateClusterSecurityGroup",
+			"CreateClusterSnapshot",
+			"CreateClusterSubnetGroup",
+			"CreateClusterUser",
+			"CreateEndpointAccess",
+			"CreateEventSubscription",
+			"CreateHsmClientCertificate",
+			"CreateHsmConfiguration",
+			"CreateScheduledAction",
+			"CreateSnapshotCopyGrant",
+			"CreateSnapshotSchedule",
+			"CreateTags",
+			"CreateUsageLimit",
+			"DeauthorizeDataShare",
+			"DeleteAuthenticationProfile",
+			"DeleteCluster",
+			"DeleteClusterParameterGroup",
+			"DeleteClusterSecurityGroup",
+			"DeleteClusterSnapshot",
+			"DeleteClusterSubnetGroup",
+			"DeleteEndpointAccess",
+			"DeleteEventSubscription",
+			"DeleteHsmClientCertificate",
+			"DeleteHsmConfiguration",
+			"DeletePartner",
+			"DeleteScheduledAction",
+			"DeleteSnapshotCopyGrant",
+			"DeleteSnapshotSchedule",
+			"DeleteTags",
+			"DeleteUsageLimit",
+			"DescribeAccountAttributes",
+			"DescribeAuthenticationProfiles",
+			"DescribeClusterDbRevisions",
+			"DescribeClusterParameterGroups",
+			"DescribeClusterParameters",
+			"DescribeClusters",
+			"DescribeClusterSecurityGroups",
+			"DescribeClusterSnapshots",
+			"DescribeClusterSubnetGroups",
+			"DescribeClusterTracks",
+			"DescribeClusterVersions",
+			"DescribeDataShares",
+			"DescribeDataSharesForConsumer",
+			"DescribeDataSharesForProducer",
+			"DescribeDefaultClusterParameters",
+			"DescribeEndpointAccess",
+			"DescribeEndpointAuthorization",
+			"DescribeEventCategories",
+			"DescribeEvents",
+			"DescribeEventSubscriptions",
+			"DescribeHsmClientCertificates",
+			"DescribeHsmConfigurations",
+			"DescribeLoggingStatus",
+			"DescribeNodeConfigurationOptions",
+			"DescribeOrderableClusterOptions",
+			"DescribePartners",
+			"DescribeReservedNodeExchangeStatus",
+			"DescribeReservedNodeOfferings",
+			"DescribeReservedNodes",
+			"DescribeResize",
+			"DescribeScheduledActions",
+			"DescribeSnapshotCopyGrants",
+			"DescribeSnapshotSchedules",
+			"DescribeStorage",
+			"DescribeTableRestoreStatus",
+			"DescribeTags",
+			"DescribeUsageLimits",
+			"DisableLogging",
+			"DisableSnapshotCopy",
+			"DisassociateDataShareConsumer",
+			"EnableLogging",
+			"EnableSnapshotCopy",
+			"GetClusterCredentials",
+			"GetClusterCredentialsWithIAM",
+			"GetReservedNodeExchangeConfigurationOptions",
+			"GetReservedNodeExchangeOfferings",
+			"JoinGroup",
+			"ModifyAquaConfiguration",
+			"ModifyAuthenticationProfile",
+			"ModifyCluster",
+			"ModifyClusterDbRevision",
+			"ModifyClusterIamRoles",
+			"ModifyClusterMaintenance",
+			"ModifyClusterParameterGroup",
+			"ModifyClusterSnapshot",
+			"ModifyClusterSnapshotSchedule",
+			"ModifyClusterSubnetGroup",
+			"ModifyEndpointAccess",
+			"ModifyEventSubscription",
+			"ModifyScheduledAction",
+			"ModifySnapshotCopyRetentionPeriod",
+			"ModifySnapshotSchedule",
+			"ModifyUsageLimit",
+			"PauseCluster",
+			"PurchaseReservedNodeOffering",
+			"RebootCluster",
+			"RejectDataShare",
+			"ResetClusterParameterGroup",
+			"ResizeCluster",
+			"RestoreFromClusterSnapshot",
+			"RestoreTableFromClusterSnapshot",
+			"ResumeCluster",
+			"RevokeClusterSecurityGroupIngress",
+			"RevokeEndpointAccess",
+			"RevokeSnapshotAccess",
+			"RotateEncryptionKey",
+			"UpdatePartnerStatus",
+		},
+	},
+	"redshift-data": {
+		actions: []string{
+			"BatchExecuteStatement",
+			"CancelStatement",
+			"DescribeStatement",
+			"DescribeTable",
+			"ExecuteStatement",
+			"GetStatementResult",
+			"ListDatabases",
+			"ListSchemas",
+			"ListStatements",
+			"ListTables",
+		},
+	},
+	"redshift-serverless": {
+		actions: []string{
+			"ConvertRecoveryPointToSnapshot",
+			"CreateEndpointAccess",
+			"CreateNamespace",
+			"CreateSnapshot",
+			"CreateUsageLimit",
+			"CreateWorkgroup",
+			"DeleteEndpointAccess",
+			"DeleteNamespace",
+			"DeleteResourcePolicy",
+			"DeleteSnapshot",
+			"DeleteUsageLimit",
+			"DeleteWorkgroup",
+			"GetCredentials",
+			"GetEndpointAccess",
+			"GetNamespace",
+			"GetRecoveryPoint",
+			"GetResourcePolicy",
+			"GetSnapshot",
+			"GetTableRestoreStatus",
+			"GetUsageLimit",
+			"GetWorkgroup",
+			"ListEndpointAccess",
+			"ListNamespaces",
+			"ListRecoveryPoints",
+			"ListSnapshots",
+			"ListTableRestoreStatus",
+			"ListTagsForResource",
+			"ListUsageLimits",
+			"ListWorkgroups",
+			"PutResourcePolicy",
+			"RestoreFromRecoveryPoint",
+			"RestoreFromSnapshot",
+			"RestoreTableFromSnapshot",
+			"TagResource",
+			"UntagResource",
+			"UpdateEndpointAccess",
+			"UpdateNamespace",
+			"UpdateSnapshot",
+			"UpdateUsageLimit",
+			"UpdateWorkgroup",
+		},
+	},
+	"refactor-spaces": {
+		actions: []string{
+			"CreateApplication",
+			"CreateEnvironment",
+			"CreateRoute",
+			"CreateService",
+			"DeleteApplication",
+			"DeleteEnvironment",
+			"DeleteResourcePolicy",
+			"DeleteRoute",
+			"DeleteService",
+			"GetApplication",
+			"GetEnvironment",
+			"GetResourcePolicy",
+			"GetRoute",
+			"GetService",
+			"ListApplications",
+			"ListEnvironments",
+			"ListEnvironmentVpcs",
+			"ListRoutes",
+			"ListServices",
+			"ListTagsForResource",
+			"PutResourcePolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateRoute",
+		},
+	},
+	"rekognition": {
+		actions: []string{
+			"CompareFaces",
+			"CopyProjectVersion",
+			"CreateCollection",
+			"CreateDataset",
+			"CreateProject",
+			"CreateProjectVersion",
+			"CreateStreamProcessor",
+			"DeleteCollection",
+			"DeleteDataset",
+			"DeleteFaces",
+			"DeleteProject",
+			"DeleteProjectPolicy",
+			"DeleteProjectVersion",
+			"DeleteStreamProcessor",
+			"DescribeCollection",
+			"DescribeDataset",
+			"DescribeProjects",
+			"DescribeProjectVersions",
+			"DescribeStreamProcessor",
+			"DetectCustomLabels",
+			"DetectFaces",
+			"DetectLabels",
+			"DetectModerationLabels",
+			"DetectProtectiveEquipment",
+			"DetectText",
+			"DistributeDatasetEntries",
+			"GetCelebrityInfo",
+			"GetCelebrityRecognition",
+			"GetContentModeration",
+			"GetFaceDetection",
+			"GetFaceSearch",
+			"GetLabelDetection",
+			"GetPersonTracking",
+			"GetSegmentDetection",
+			"GetTextDetection",
+			"IndexFaces",
+			"ListCollections",
+			"ListDatasetEntries",
+			"ListDatasetLabels",
+			"ListFaces",
+			"ListProjectPolicies",
+			"ListStreamProcessors",
+			"ListTagsForResource",
+			"PutProjectPolicy",
+			"RecognizeCelebrities",
+			"SearchFaces",
+			"SearchFacesByImage",
+			"StartCelebrityRecognition",
+			"StartContentModeration",
+			"StartFaceDetection",
+			"StartFaceSearch",
+			"StartLabelDetection",
+			"StartPersonTracking",
+			"StartProjectVersion",
+			"StartSegmentDetection",
+			"StartStreamProcessor",
+			"StartTextDetection",
+			"StopProjectVersion",
+			"StopStreamProcessor",
+			"TagResource",
+			"UntagResource",
+			"UpdateDatasetEntries",
+			"UpdateStreamProcessor",
+		},
+	},
+	"resiliencehub": {
+		actions: []string{
+			"AddDraftAppVersionResourceMappings",
+			"CreateApp",
+			"CreateRecommendationTemplate",
+			"CreateResiliencyPolicy",
+			"DeleteApp",
+			"DeleteAppAssessment",
+			"DeleteRecommendationTemplate",
+			"DeleteResiliencyPolicy",
+			"DescribeApp",
+			"DescribeAppAssessment",
+			"DescribeAppVersionResourcesResolutionStatus",
+			"DescribeAppVersionTemplate",
+			"DescribeDraftAppVersionResourcesImportStatus",
+			"DescribeResiliencyPolicy",
+			"ImportResourcesToDraftAppVersion",
+			"ListAlarmRecommendations",
+			"ListAppAssessments",
+			"ListAppComponentCompliances",
+			"ListAppComponentRecommendations",
+			"ListApps",
+			"ListAppVersionResourceMappings",
+			"ListAppVersionResources",
+			"ListAppVersions",
+			"ListRecommendationTemplates",
+			"ListResiliencyPolicies",
+			"ListSopRecommendations",
+			"ListSuggestedResiliencyPolicies",
+			"ListTagsForResource",
+			"ListTestRecommendations",
+			"ListUnsupportedAppVersionResources",
+			"PublishAppVersion",
+			"PutDraftAppVersionTemplate",
+			"RemoveDraftAppVersionResourceMappings",
+			"ResolveAppVersionResources",
+			"StartAppAssessment",
+			"TagResource",
+			"UntagResource",
+			"UpdateApp",
+			"UpdateResiliencyPolicy",
+		},
+	},
+	"resource-explorer-2": {
+		actions: []string{
+			"AssociateDefaultView",
+			"BatchGetView",
+			"CreateIndex",
+			"CreateView",
+			"DeleteIndex",
+			"DeleteView",
+			"DisassociateDefaultView",
+			"GetDefaultView",
+			"GetIndex",
+			"GetView",
+			"ListIndexes",
+			"ListSupportedResourceTypes",
+			"ListTagsForResource",
+			"ListViews",
+			"Search",
+			"TagResource",
+			"UntagResource",
+			"UpdateIndexType",
+			"UpdateView",
+		},
+	},
+	"resource-groups": {
+		actions: []string{
+			"CreateGroup",
+			"DeleteGroup",
+			"GetAccountSettings",
+			"GetGroup",
+			"GetGroupConfiguration",
+			"GetGroupQuery",
+			"GetTags",
+			"GroupResources",
+			"ListGroupResources",
+			"ListGroups",
+			"PutGroupConfiguration",
+			"SearchResources",
+			"Tag",
+			"UngroupResources",
+			"Untag",
+			"UpdateAccountSettings",
+			"UpdateGroup",
+			"UpdateGroupQuery",
+		},
+	},
+	"robomaker": {
+		actions: []string{
+			"BatchDeleteWorlds",
+			"BatchDescribeSimulationJob",
+			"CancelDeploymentJob",
+			"CancelSimulationJob",
+			"CancelSimulationJobBatch",
+			"CancelWorldExportJob",
+			"CancelWorldGenerationJob",
+			"CreateDeploymentJob",
+			"CreateFleet",
+			"CreateRobot",
+			"CreateRobotApplication",
+			"CreateRobotApplicationVersion",
+			"CreateSimulationApplication",
+			"CreateSimulationApplicationVersion",
+			"CreateSimulationJob",
+			"CreateWorldExportJob",
+			"CreateWorldGenerationJob",
+			"CreateWorldTemplate",
+			"DeleteFleet",
+			"DeleteRobot",
+			"DeleteRobotApplication",
+			"DeleteSimulationApplication",
+			"DeleteWorldTemplate",
+			"DeregisterRobot",
+			"DescribeDeploymentJob",
+			"DescribeFleet",
+			"DescribeRobot",
+			"DescribeRobotApplication",
+			"DescribeSimulationApplication",
+			"DescribeSimulationJob",
+			"DescribeSimulationJobBatch",
+			"DescribeWorld",
+			"DescribeWorldExportJob",
+			"DescribeWorldGenerationJob",
+			"DescribeWorldTemplate",
+			"GetWorldTemplateBody",
+			"ListDeploymentJobs",
+			"ListFleets",
+			"ListRobotApplications",
+			"ListRobots",
+			"ListSimulationApplications",
+			"ListSimulationJobBatches",
+			"ListSimulationJobs",
+			"ListTagsForResource",
+			"ListWorldExportJobs",
+			"ListWorldGenerationJobs",
+			"ListWorlds",
+			"ListWorldTemplates",
+			"RegisterRobot",
+			"RestartSimulationJob",
+			"StartSimulationJobBatch",
+			"SyncDeploymentJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateRobotApplication",
+			"UpdateSimulationApplication",
+			"UpdateWorldTemplate",
+		},
+	},
+	"rolesanywhere": {
+		actions: []string{
+			"CreateProfile",
+			"CreateTrustAnchor",
+			"DeleteCrl",
+			"DeleteProfile",
+			"DeleteTrustAnchor",
+			"DisableCrl",
+			"DisableProfile",
+			"DisableTrustAnchor",
+			"EnableCrl",
+			"EnableProfile",
+			"EnableTrustAnchor",
+			"GetCrl",
+			"GetProfile",
+			"GetSubject",
+			"GetTrustAnchor",
+			"ImportCrl",
+			"ListCrls",
+			"ListProfiles",
+			"ListSubjects",
+			"ListTagsForResource",
+			"ListTrustAnchors",
+			"TagResource",
+			"UntagResource",
+			"UpdateCrl",
+			"UpdateProfile",
+			"UpdateTrustAnchor",
+		},
+	},
+	"route53": {
+		actions: []string{
+			"ActivateKeySigningKey",
+			"AssociateVPCWithHostedZone",
+			"ChangeCidrCollection",
+			"ChangeResourceRecordSets",
+			"ChangeTagsForResource",
+			"CreateCidrCollection",
+			"CreateHealthCheck",
+			"CreateHostedZone",
+			"CreateKeySigningKey",
+			"CreateQueryLoggingConfig",
+			"CreateReusableDelegationSet",
+			"CreateTrafficPolicy",
+			"CreateTrafficPolicyInstance",
+			"CreateTrafficPolicyVersion",
+			"CreateVPCAssociationAuthorization",
+			"DeactivateKeySigningKey",
+			"DeleteCidrCollection",
+			"DeleteHealthCheck",
+			"DeleteHostedZone",
+			"DeleteKeySigningKey",
+			"DeleteQueryLoggingConfig",
+			"DeleteReusableDelegationSet",
+			"DeleteTrafficPolicy",
+			"DeleteTrafficPolicyInstance",
+			"DeleteVPCAssociationAuthorization",
+			"DisableHostedZoneDNSSEC",
+			"DisassociateVPCFromHostedZone",
+			"EnableHostedZoneDNSSEC",
+			"GetAccountLimit",
+			"GetChange",
+			"GetCheckerIpRanges",
+			"GetDNSSEC",
+			"GetGeoLocation",
+			"GetHealthCheck",
+			"GetHealthCheckCount",
+			"GetHealthCheckLastFailureReason",
+			"GetHealthCheckStatus",
+			"GetHostedZone",
+			"GetHostedZoneCount",
+			"GetHostedZoneLimit",
+			"GetQueryLoggingConfig",
+			"GetReusableDelegationSet",
+			"GetReusableDelegationSetLimit",
+			"GetTrafficPolicy",
+			"GetTrafficPolicyInstance",
+			"GetTrafficPolicyInstanceCount",
+			"ListCidrBlocks",
+			"ListCidrCollections",
+			"ListCidrLocations",
+			"ListGeoLocations",
+			"ListHealthChecks",
+			"ListHostedZones",
+			"ListHostedZonesByName",
+			"ListHostedZonesByVPC",
+			"ListQueryLoggingConfigs",
+			"ListResourceRecordSets",
+			"ListReusableDelegationSets",
+			"ListTagsForResource",
+			"ListTagsForResources",
+			"ListTrafficPolicies",
+			"ListTrafficPolicyInstances",
+			"ListTrafficPolicyInstancesByHostedZone",
+			"ListTrafficPolicyInstancesByPolicy",
+			"ListTrafficPolicyVersions",
+			"ListVPCAssociationAuthorizations",
+			"TestDNSAnswer",
+			"UpdateHealthCheck",
+			"UpdateHostedZoneComment",
+			"UpdateTrafficPolicyComment",
+			"UpdateTrafficPolicyInstance",
+		},
+	},
+	"route53-recovery-cluster": {
+		actions: []string{
+			"GetRoutingControlState",
+			"ListRoutingControls",
+			"UpdateRoutingControlState",
+			"UpdateRoutingControlStates",
+		},
+	},
+	"route53-recovery-control-config": {
+		actions: []string{
+			"CreateCluster",
+			"CreateControlPanel",
+			"CreateRoutingControl",
+			"CreateSafetyRule",
+			"DeleteCluster",
+			"DeleteControlPanel",
+			"DeleteRoutingControl",
+			"DeleteSafetyRule",
+			"DescribeCluster",
+			"DescribeControlPanel",
+			"DescribeRoutingControl",
+			"DescribeSafetyRule",
+			"ListAssociatedRoute53HealthChecks",
+			"ListClusters",
+			"ListControlPanels",
+			"ListRoutingControls",
+			"ListSafetyRules",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateControlPanel",
+			"UpdateRoutingControl",
+			"UpdateSafetyRule",
+		},
+	},
+	"route53-recovery-readiness": {
+		actions: []string{
+			"CreateCell",
+			"CreateCrossAccountAuthorization",
+			"CreateReadinessCheck",
+			"CreateRecoveryGroup",
+			"CreateResourceSet",
+			"DeleteCell",
+			"DeleteCrossAccountAuthorization",
+			"DeleteReadinessCheck",
+			"DeleteRecoveryGroup",
+			"DeleteResourceSet",
+			"GetArchitectureRecommendations",
+			"GetCell",
+			"GetCellReadinessSummary",
+			"GetReadinessCheck",
+			"GetReadinessCheckResourceStatus",
+			"GetReadinessCheckStatus",
+			"GetRecoveryGroup",
+			"GetRecoveryGroupReadinessSummary",
+			"GetResourceSet",
+			"ListCells",
+			"ListCrossAccountAuthorizations",
+			"ListReadinessChecks",
+			"ListRecoveryGroups",
+			"ListResourceSets",
+			"ListRules",
+			"ListTagsForResources",
+			"TagResource",
+			"UntagResource",
+			"UpdateCell",
+			"UpdateReadinessCheck",
+			"UpdateRecoveryGroup",
+			"UpdateResourceSet",
+		},
+	},
+	"route53domains": {
+		actions: []string{
+			"AcceptDomainTransferFromAnotherAwsAccount",
+			"AssociateDelegationSignerToDomain",
+			"CancelDomainTransferToAnotherAwsAccount",
+			"CheckDomainAvailability",
+			"CheckDomainTransferability",
+			"DeleteDomain",
+			"DeleteTagsForDomain",
+			"DisableDomainAutoRenew",
+			"DisableDomainTransferLock",
+			"DisassociateDelegationSignerFromDomain",
+			"EnableDomainAutoRenew",
+			"EnableDomainTransferLock",
+			"GetContactReachabilityStatus",
+			"GetDomainDetail",
+			"GetDomainSuggestions",
+			"GetOperationDetail",
+			"ListDomains",
+			"ListOperations",
+			"ListPrices",
+			"ListTagsForDomain",
+			"PushDomain",
+			"RegisterDomain",
+			"RejectDomainTransferFromAnotherAwsAccount",
+			"RenewDomain",
+			"ResendContactReachabilityEmail",
+			"ResendOperationAuthorization",
+			"RetrieveDomainAuthCode",
+			"TransferDomain",
+			"TransferDomainToAnotherAwsAccount",
+			"UpdateDomainContact",
+			"UpdateDomainContactPrivacy",
+			"UpdateDomainNameservers",
+			"UpdateTagsForDomain",
+			"ViewBilling",
+		},
+	},
+	"route53resolver": {
+		actions: []string{
+			"AssociateFirewallRuleGroup",
+			"AssociateResolverEndpointIpAddress",
+			"AssociateResolverQueryLogConfig",
+			"AssociateResolverRule",
+			"CreateFirewallDomainList",
+			"CreateFirewallRule",
+			"CreateFirewallRuleGroup",
+			"CreateResolverEndpoint",
+			"CreateResolverQueryLogConfig",
+			"CreateResolverRule",
+			"DeleteFirewallDomainList",
+			"DeleteFirewallRule",
+			"DeleteFirewallRuleGroup",
+			"DeleteResolverEndpoint",
+			"DeleteResolverQueryLogConfig",
+			"DeleteResolverRule",
+			"DisassociateFirewallRuleGroup",
+			"DisassociateResolverEndpointIpAddress",
+			"DisassociateResolverQueryLogConfig",
+			"DisassociateResolverRule",
+			"GetFirewallConfig",
+			"GetFirewallDomainList",
+			"GetFirewallRuleGroup",
+			"GetFirewallRuleGroupAssociation",
+			"GetFirewallRuleGroupPolicy",
+			"GetResolverConfig",
+			"GetResolverDnssecConfig",
+			"GetResolverEndpoint",
+			"GetResolverQueryLogConfig",
+			"GetResolverQueryLogConfigAssociation",
+			"GetResolverQueryLogConfigPolicy",
+			"GetResolverRule",
+			"GetResolverRuleAssociation",
+			"GetResolverRulePolicy",
+			"ImportFirewallDomains",
+			"ListFirewallConfigs",
+			"ListFirewallDomainLists",
+			"ListFirewallDomains",
+			"ListFirewallRuleGroupAssociations",
+			"ListFirewallRuleGroups",
+			"ListFirewallRules",
+			"ListResolverConfigs",
+			"ListResolverDnssecConfigs",
+			"ListResolverEndpointIpAddresses",
+			"ListResolverEndpoints",
+			"ListResolverQueryLogConfigAssociations",
+			"ListResolverQueryLogConfigs",
+			"ListResolverRuleAssociations",
+			"ListResolverRules",
+			"ListTagsForResource",
+			"PutFirewallRuleGroupPolicy",
+			"PutResolverQueryLogConfigPolicy",
+			"PutResolverRulePolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateFirewallConfig",
+			"UpdateFirewallDomains",
+			"UpdateFirewallRule",
+			"UpdateFirewallRuleGroupAssociation",
+			"UpdateResolverConfig",
+			"UpdateResolverDnssecConfig",
+			"UpdateResolverEndpoint",
+			"UpdateResolverRule",
+		},
+	},
+	"rum": {
+		actions: []string{
+			"BatchCreateRumMetricDefinitions",
+			"BatchDeleteRumMetricDefinitions",
+			"BatchGetRumMetricDefinitions",
+			"CreateAppMonitor",
+			"DeleteAppMonitor",
+			"DeleteRumMetricsDestination",
+			"GetAppMonitor",
+			"GetAppMonitorData",
+			"ListAppMonitors",
+			"ListRumMetricsDestinations",
+			"ListTagsForResource",
+			"PutRumEvents",
+			"PutRumMetricsDestination",
+			"TagResource",
+			"UntagResource",
+			"UpdateAppMonitor",
+			"UpdateRumMetricDefinition",
+		},
+	},
+	"s3": {
+		actions: []string{
+			"AbortMultipartUpload",
+			"BypassGovernanceRetention",
+			"CompleteMultipartUpload",
+			"CopyObject",
+			"CreateAccessPoint",
+			"CreateAccessPointForObjectLambda",
+			"CreateBucket",
+			"CreateJob",
+			"CreateMultipartUpload",
+			"CreateMultiRegionAccessPoint",
+			"DeleteAccessPoint",
+			"DeleteAccessPointForObjectLambda",
+			"DeleteAccessPointPolicy",
+			"DeleteAccessPointPolicyForObjectLambda",
+			"DeleteBucket",
+			"DeleteBucketAnalyticsConfiguration",
+			"DeleteBucketCors",
+			"DeleteBucketEncryption",
+			"DeleteBucketIntelligentTieringConfiguration",
+			"DeleteBucketInventoryConfiguration",
+			"DeleteBucketLifecycle",
+			"DeleteBucketLifecycleConfiguration",
+			"DeleteBucketMetricsConfiguration",
+			"DeleteBucketOwnershipControls",
+			"DeleteBucketPolicy",
+			"DeleteBucketReplication",
+			"DeleteBucketTagging",
+			"DeleteBucketWebsite",
+			"DeleteJobTagging",
+			"DeleteMultiRegionAccessPoint",
+			"DeleteObject",
+			"DeleteObjects",
+			"DeleteObjectTagging",
+			"DeleteObjectVersion",
+			"DeleteObjectVersionTagging",
+			"DeletePublicAccessBlock",
+			"DeleteStorageLensConfiguration",
+			"DeleteStorageLensConfigurationTagging",
+			"DescribeJob",
+			"DescribeMultiRegionAccessPointOperation",
+			"GetAccelerateConfiguration",
+			"GetAccessPoint",
+			"GetAccessPointConfigurationForObjectLambda",
+			"GetAccessPointForObjectLambda",
+			"GetAccessPointPolicy",
+			"GetAccessPointPolicyForObjectLambda",
+			"GetAccessPointPolicyStatus",
+			"GetAccessPointPolicyStatusForObjectLambda",
+			"GetAccountPublicAccessBlock",
+			"GetAnalyticsConfiguration",
+			"GetBucket",
+			"GetBucketAccelerateConfiguration",
+			"GetBucketAcl",
+			"GetBucketAnalyticsConfiguration",
+			"GetBucketCors",
+			"GetBucketEncryption",
+			"GetBucketIntelligentTieringConfiguration",
+			"GetBucketInventoryConfiguration",
+			"GetBucketLifecycle",
+			"GetBucketLifecycleConfiguration",
+			"GetBucketLocation",
+			"GetBucketLogging",
+			"GetBucketMetricsConfiguration",
+			"GetBucketNotification",
+			"GetBucketNotificationConfiguration",
+			"GetBucketObjectLockConfiguration",
+			"GetBucketOwnershipControls",
+			"GetBucketPolicy",
+			"GetBucketPolicyStatus",
+			"GetBucketPublicAccessBlock",
+			"GetBucketReplication",
+			"GetBucketRequestPayment",
+			"GetBucketTagging",
+			"GetBucketVersioning",
+			"GetBucketWebsite",
+			"GetEncryptionConfiguration",
+			"GetIntelligentTieringConfiguration",
+			"GetInventoryConfiguration",
+			"GetJobTagging",
+			"GetLifecycleConfiguration",
+			"GetMetricsConfiguration",
+			"GetMultiRegionAccessPoint",
+			"GetMultiRegionAccessPointPolicy",
+			"GetMultiRegionAccessPointPolicyStatus",
+			"GetMultiRegionAccessPointRoutes",
+			"GetObject",
+			"GetObjectAcl",
+			"GetObjectAttributes",
+			"GetObjectLegalHold",
+			"GetObjectLockConfiguration",
+			"GetObjectRetention",
+			"GetObjectTagging",
+			"GetObjectTorrent",
+			"GetObjectVersion",
+			"GetObjectVersionAcl",
+			"GetObjectVersionAttributes",
+			"GetObjectVersionForReplication",
+			"GetObjectVersionTagging",
+			"GetObjectVersionTorrent",
+			"GetPublicAccessBlock",
+			"GetReplicationConfiguration",
+			"GetStorageLensConfiguration",
+			"GetStorageLensConfigurationTagging",
+			"HeadBucket",
+			"HeadObject",
+			"ListAccessPoints",
+			"ListAccessPointsForObjectLambda",
+			"ListAllMyBuckets",
+			"ListBucket",
+			"ListBucketAnalyticsConfigurations",
+			"ListBucketIntelligentTieringConfigurations",
+			"ListBucketInventoryConfigurations",
+			"ListBucketMetricsConfigurations",
+			"ListBucketMultipartUploads",
+			"ListBuckets",
+			"ListBucketVersions",
+			"ListJobs",
+			"ListMultipartUploadParts",
+			"ListMultipartUploads",
+			"ListMultiRegionAccessPoints",
+			"ListObjects",
+			"ListObjectsV2",
+			"ListObjectVersions",
+			"ListParts",
+			"ListRegionalBuckets",
+			"ListStorageLensConfigurations",
+			"ObjectOwnerOverrideToBucketOwner",
+			"PutAccelerateConfiguration",
+			"PutAccessPointConfigurationForObjectLambda",
+			"PutAccessPointPolicy",
+			"PutAccessPointPolicyForObjectLambda",
+			"PutAccountPublicAccessBlock",
+			"PutAnalyticsConfiguration",
+			"PutBucketAccelerateConfiguration",
+			"PutBucketAcl",
+			"PutBucketAnalyticsConfiguration",
+			"PutBucketCors",
+			"PutBucketEncryption",
+			"PutBucketIntelligentTieringConfiguration",
+			"PutBucketInventoryConfiguration",
+			"PutBucketLifecycle",
+			"PutBucketLifecycleConfiguration",
+			"PutBucketLogging",
+			"PutBucketMetricsConfiguration",
+			"PutBucketNotification",
+			"PutBucketNotificationConfiguration",
+			"PutBucketObjectLockConfiguration",
+			"PutBucketOwnershipControls",
+			"PutBucketPolicy",
+			"PutBucketPublicAccessBlock",
+			"PutBucketReplication",
+			"PutBucketRequestPayment",
+			"PutBucketTagging",
+			"PutBucketVersioning",
+			"PutBucketWebsite",
+			"PutEncryptionConfiguration",
+			"PutIntelligentTieringConfiguration",
+			"PutInventoryConfiguration",
+			"PutJobTagging",
+			"PutLifecycleConfiguration",
+			"PutMetricsConfiguration",
+			"PutMultiRegionAccessPointPolicy",
+			"PutObject",
+			"PutObjectAcl",
+			"PutObjectLegalHold",
+			"PutObjectLockConfiguration",
+			"PutObjectRetention",
+			"PutObjectTagging",
+			"PutObjectVersionAcl",
+			"PutObjectVersionTagging",
+			"PutPublicAccessBlock",
+			"PutReplicationConfiguration",
+			"PutStorageLensConfiguration",
+			"PutStorageLensConfigurationTagging",
+			"ReplicateDelete",
+			"ReplicateObject",
+			"ReplicateTags",
+			"RestoreObject",
+			"SelectObjectContent",
+			"SubmitMultiRegionAccessPointRoutes",
+			"UpdateJobPriority",
+			"UpdateJobStatus",
+			"UploadPart",
+			"UploadPartCopy",
+			"WriteGetObjectResponse",
+		},
+		conditionKeys: []string{
+			"s3:AccessPointNetworkOrigin",
+			"s3:authType",
+			"s3:DataAccessPointAccount",
+			"s3:DataAccessPointArn",
+			"s3:delimiter",
+			"s3:ExistingJobOperation",
+			"s3:ExistingJobPriority",
+			"s3:ExistingObjectTag/",
+			"s3:JobSuspendedCause",
+			"s3:LocationConstraint",
+			"s3:max-keys",
+			"s3:object-lock-legal-hold",
+			"s3:object-lock-mode",
+			"s3:object-lock-remaining-retention-days",
+			"s3:object-lock-retain-until-date",
+			"s3:prefix",
+			"s3:RequestJobOperation",
+			"s3:RequestJobPriority",
+			"s3:RequestObjectTag/",
+			"s3:RequestObjectTagKeys",
+			"s3:ResourceAccount",
+			"s3:signatureAge",
+			"s3:signatureversion",
+			"s3:TlsVersion",
+			"s3:VersionId",
+			"s3:x-amz-acl",
+			"s3:x-amz-content-sha256",
+			"s3:x-amz-copy-source",
+			"s3:x-amz-grant-full-control",
+			"s3:x-amz-grant-read",
+			"s3:x-amz-grant-read-acp",
+			"s3:x-amz-grant-write",
+			"s3:x-amz-grant-write-acp",
+			"s3:x-amz-metadata-directive",
+			"s3:x-amz-object-ownership",
+			"s3:x-amz-server-side-encryption",
+			"s3:x-amz-server-side-encryption-aws-kms-key-id",
+			"s3:x-amz-server-side-encryption-customer-algorithm",
+			"s3:x-amz-storage-class",
+			"s3:x-amz-website-redirect-location",
+		},
+	},
+	"s3-object-lambda": {
+		actions: []string{
+			"AbortMultipartUpload",
+			"DeleteObject",
+			"GetObject",
+			"ListBucket",
+			"ListMultipartUploadParts",
+			"PutObject",
+			"WriteGetObjectResponse",
+		},
+	},
+	"s3-outposts": {
+		actions: []string{
+			"AbortMultipartUpload",
+			"CreateEndpoint",
+			"DeleteEndpoint",
+			"DeleteObject",
+			"GetObject",
+			"ListBucket",
+			"ListEndpoints",
+			"ListMultipartUploadParts",
+			"ListSharedEndpoints",
+			"PutObject",
+		},
+	},
+	"sagemaker": {
+		actions: []string{
+			"AddAssociation",
+			"AddTags",
+			"AssociateTrialComponent",
+			"BatchDescribeModelPackage",
+			"BatchGetRecord",
+			"BatchPutMetrics",
+			"CreateAction",
+			"CreateAlgorithm",
+			"CreateApp",
+			"CreateAppImageConfig",
+			"CreateArtifact",
+			"CreateAutoMLJob",
+			"CreateCodeRepository",
+			"CreateCompilationJob",
+			"CreateContext",
+			"CreateDataQualityJobDefinition",
+			"CreateDeviceFleet",
+			"CreateDomain",
+			"CreateEdgeDeploymentPlan",
+			"CreateEdgeDeploymentStage",
+			"CreateEdgePackagingJob",
+			"CreateEndpoint",
+			"CreateEndpointConfig",
+			"CreateExperiment",
+			"CreateFeatureGroup",
+			"CreateFlowDefinition",
+			"CreateHub",
+			"CreateHumanTaskUi",
+			"CreateHyperParameterTuningJob",
+			"CreateImage",
+			"CreateImageVersion",
+			"CreateInferenceExperiment",
+			"CreateInferenceRecommendationsJob",
+			"CreateLabelingJob",
+			"CreateModel",
+			"CreateModelBiasJobDefinition",
+			"CreateModelCard",
+			"CreateModelCardExportJob",
+			"CreateModelExplainabilityJobDefinition",
+			"CreateModelPackage",
+			"CreateModelPackageGroup",
+			"CreateModelQualityJobDefinition",
+			"CreateMonitoringSchedule",
+			"CreateNotebookInstance",
+			"CreateNotebookInstanceLifecycleConfig",
+			"CreatePipeline",
+			"CreatePresignedDomainUrl",
+			"CreatePresignedNotebookInstanceUrl",
+			"CreateProcessingJob",
+			"CreateProject",
+			"CreateSpace",
+			"CreateStudioLifecycleConfig",
+			"CreateTrainingJob",
+			"CreateTransformJob",
+			"CreateTrial",
+			"CreateTrialComponent",
+			"CreateUserProfile",
+			"CreateWorkforce",
+			"CreateWorkteam",
+			"DeleteAction",
+			"DeleteAlgorithm",
+			"DeleteApp",
+			"DeleteAppImageConfig",
+			"DeleteArtifact",
+			"DeleteAssociation",
+			"DeleteCodeRepository",
+			"DeleteContext",
+			"DeleteDataQualityJobDefinition",
+			"DeleteDeviceFleet",
+			"DeleteDomain",
+			"DeleteEdgeDeploymentPlan",
+			"DeleteEdgeDeploymentStage",
+			"DeleteEndpoint",
+			"DeleteEndpointConfig",
+			"DeleteExperiment",
+			"DeleteFeatureGroup",
+			"DeleteFlowDefinition",
+			"DeleteHub",
+			"DeleteHubContent",
+			"DeleteHumanLoop",
+			"DeleteHumanTaskUi",
+			"DeleteImage",
+			"DeleteImageVersion",
+			"DeleteInferenceExperiment",
+			"DeleteModel",
+			"DeleteModelBiasJobDefinition",
+			"DeleteModelCard",
+			"DeleteModelExplainabilityJobDefinition",
+			"DeleteModelPackage",
+			"DeleteModelPackageGroup",
+			"DeleteModelPackageGroupPolicy",
+			"DeleteModelQualityJobDefinition",
+			"DeleteMonitoringSchedule",
+			"DeleteNotebookInstance",
+			"DeleteNotebookInstanceLifecycleConfig",
+			"DeletePipeline",
+			"DeleteProject",
+			"DeleteRecord",
+			"DeleteSpace",
+			"DeleteStudioLifecycleConfig",
+			"DeleteTags",
+			"DeleteTrial",
+			"DeleteTrialComponent",
+			"DeleteUserProfile",
+			"DeleteWorkforce",
+			"DeleteWorkteam",
+			"DeregisterDevices",
+			"DescribeAction",
+			"DescribeAlgorithm",
+			"DescribeApp",
+			"DescribeAppImageConfig",
+			"DescribeArtifact",
+			"DescribeAutoMLJob",
+			"DescribeCodeRepository",
+			"DescribeCompilationJob",
+			"DescribeContext",
+			"DescribeDataQualityJobDefinition",
+			"DescribeDevice",
+			"DescribeDeviceFleet",
+			"DescribeDomain",
+			"DescribeEdgeDeploymentPlan",
+			"DescribeEdgePackagingJob",
+			"DescribeEndpoint",
+			"DescribeEndpointConfig",
+			"DescribeExperiment",
+			"DescribeFeatureGroup",
+			"DescribeFeatureMetadata",
+			"DescribeFlowDefinition",
+			"DescribeHub",
+			"DescribeHubContent",
+			"DescribeHumanLoop",
+			"DescribeHumanTaskUi",
+			"DescribeHyperParameterTuningJob",
+			"DescribeImage",
+			"DescribeImageVersion",
+			"DescribeInferenceExperiment",
+			"DescribeInferenceRecommendationsJob",
+			"DescribeLabelingJob",
+			"DescribeLineageGroup",
+			"DescribeModel",
+			"DescribeModelBiasJobDefinition",
+			"DescribeModelCard",
+			"DescribeModelCardExportJob",
+			"DescribeModelExplainabilityJobDefinition",
+			"DescribeModelPackage",
+			"DescribeModelPackageGroup",
+			"DescribeModelQualityJobDefinition",
+			"DescribeMonitoringSchedule",
+			"DescribeNotebookInstance",
+			"DescribeNotebookInstanceLifecycleConfig",
+			"DescribePipeline",
+			"DescribePipelineDefinitionForExecution",
+			"DescribePipelineExecution",
+			"DescribeProcessingJob",
+			"DescribeProject",
+			"DescribeSpace",
+			"DescribeStudioLifecycleConfig",
+			"DescribeSubscribedWorkteam",
+			"DescribeTrainingJob",
+			"DescribeTransformJob",
+			"DescribeTrial",
+			"DescribeTrialComponent",
+			"DescribeUserProfile",
+			"DescribeWorkforce",
+			"DescribeWorkteam",
+			"DisableSagemakerServicecatalogPortfolio",
+			"DisassociateTrialComponent",
+			"EnableSagemakerServicecatalogPortfolio",
+			"GetDeployments",
+			"GetDeviceFleetReport",
+			"GetDeviceRegistration",
+			"GetLineageGroupPolicy",
+			"GetModelPackageGroupPolicy",
+			"GetRecord",
+			"GetSagemakerServicecatalogPortfolioStatus",
+			"GetSearchSuggestions",
+			"ImportHubContent",
+			"InvokeEndpoint",
+			"InvokeEndpointAsync",
+			"ListActions",
+			"ListAlgorithms",
+			"ListAliases",
+			"ListAppImageConfigs",
+			"ListApps",
+			"ListArtifacts",
+			"ListAssociations",
+			"ListAutoMLJobs",
+			"ListCandidatesForAutoMLJob",
+			"ListCodeRepositories",
+			"ListCompilationJobs",
+			"ListContexts",
+			"ListDataQualityJobDefinitions",
+			"ListDeviceFleets",
+			"ListDevices",
+			"ListDomains",
+			"ListEdgeDeploymentPlans",
+			"ListEdgePackagingJobs",
+			"ListEndpointConfigs",
+			"ListEndpoints",
+			"ListExperiments",
+			"ListFeatureGroups",
+			"ListFlowDefinitions",
+			"ListHubContents",
+			"ListHubContentVersions",
+			"ListHubs",
+			"ListHumanLoops",
+			"ListHumanTaskUis",
+			"ListHyperParameterTuningJobs",
+			"ListImages",
+			"ListImageVersions",
+			"ListInferenceExperiments",
+			"ListInferenceRecommendationsJobs",
+			"ListInferenceRecommendationsJobSteps",
+			"ListLabelingJobs",
+			"ListLabelingJobsForWorkteam",
+			"ListLineageGroups",
+			"ListModelBiasJobDefinitions",
+			"ListModelCardExportJobs",
+			"ListModelCards",
+			"ListModelCardVersions",
+			"ListModelExplainabilityJobDefinitions",
+			"ListModelMetadata",
+			"ListModelPackageGroups",
+			"ListModelPackages",
+			"ListModelQualityJobDefinitions",
+			"ListModels",
+			"ListMonitoringAlertHistory",
+			"ListMonitoringAlerts",
+			"ListMonitoringExecutions",
+			"ListMonitoringSchedules",
+			"ListNotebookInstanceLifecycleConfigs",
+			"ListNotebookInstances",
+			"ListPipelineExecutions",
+			"ListPipelineExecutionSteps",
+			"ListPipelineParametersForExecution",
+			"ListPipelines",
+			"ListProcessingJobs",
+			"ListProjects",
+			"ListSpaces",
+			"ListStageDevices",
+			"ListStudioLifecycleConfigs",
+			"ListSubscribedWorkteams",
+			"ListTags",
+			"ListTrainingJobs",
+			"ListTrainingJobsForHyperParameterTuningJob",
+			"ListTransformJobs",
+			"ListTrialComponents",
+			"ListTrials",
+			"ListUserProfiles",
+			"ListWorkforces",
+			"ListWorkteams",
+			"PutModelPackageGroupPolicy",
+			"PutRecord",
+			"QueryLineage",
+			"RegisterDevices",
+			"RenderUiTemplate",
+			"RetryPipelineExecution",
+			"Search",
+			"SendHeartbeat",
+			"SendPipelineExecutionStepFailure",
+			"SendPipelineExecutionStepSuccess",
+			"StartEdgeDeploymentStage",
+			"StartHumanLoop",
+			"StartInferenceExperiment",
+			"StartMonitoringSchedule",
+			"StartNotebookInstance",
+			"StartPipelineExecution",
+			"StopAutoMLJob",
+			"StopCompilationJob",
+			"StopEdgeDeploymentStage",
+			"StopEdgePackagingJob",
+			"StopHumanLoop",
+			"StopHyperParameterTuningJob",
+			"StopInferenceExperiment",
+			"StopInferenceRecommendationsJob",
+			"StopLabelingJob",
+			"StopMonitoringSchedule",
+			"StopNotebookInstance",
+			"StopPipelineExecution",
+			"StopProcessingJob",
+			"StopTrainingJob",
+			"StopTransformJob",
+			"UpdateAction",
+			"UpdateAppImageConfig",
+			"UpdateArtifact",
+			"UpdateCodeRepository",
+			"UpdateContext",
+			"UpdateDeviceFleet",
+			"UpdateDevices",
+			"UpdateDomain",
+			"UpdateEndpoint",
+			"UpdateEndpointWeightsAndCapacities",
+			"UpdateExperiment",
+			"UpdateFeatureGroup",
+			"UpdateFeatureMetadata",
+			"UpdateHub",
+			"UpdateImage",
+			"UpdateImageVersion",
+			"UpdateInferenceExperiment",
+			"UpdateModelCard",
+			"UpdateModelPackage",
+			"UpdateMonitoringAlert",
+			"UpdateMonitoringSchedule",
+			"UpdateNotebookInstance",
+			"UpdateNotebookInstanceLifecycleConfig",
+			"UpdatePipeline",
+			"UpdatePipelineExecution",
+			"UpdateProject",
+			"UpdateSpace",
+			"UpdateTrainingJob",
+			"UpdateTrial",
+			"UpdateTrialComponent",
+			"UpdateUserProfile",
+			"UpdateWorkforce",
+			"UpdateWorkteam",
+		},
+	},
+	"sagemaker-geospatial": {
+		actions: []string{
+			"DeleteEarthObservationJob",
+			"DeleteVectorEnrichmentJob",
+			"ExportEarthObservationJob",
+			"ExportVectorEnrichmentJob",
+			"GetEarthObservationJob",
+			"GetRasterDataCollection",
+			"GetTile",
+			"GetVectorEnrichmentJob",
+			"ListEarthObservationJobs",
+			"ListRasterDataCollections",
+			"ListTagsForResource",
+			"ListVectorEnrichmentJobs",
+			"SearchRasterDataCollection",
+			"StartEarthObservationJob",
+			"StartVectorEnrichmentJob",
+			"StopEarthObservationJob",
+			"StopVectorEnrichmentJob",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"savingsplans": {
+		actions: []string{
+			"CreateSavingsPlan",
+			"DeleteQueuedSavingsPlan",
+			"DescribeSavingsPlanRates",
+			"DescribeSavingsPlans",
+			"DescribeSavingsPlansOfferingRates",
+			"DescribeSavingsPlansOfferings",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"scheduler": {
+		actions: []string{
+			"CreateSchedule",
+			"CreateScheduleGroup",
+			"DeleteSchedule",
+			"DeleteScheduleGroup",
+			"GetSchedule",
+			"GetScheduleGroup",
+			"ListScheduleGroups",
+			"ListSchedules",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateSchedule",
+		},
+	},
+	"schemas": {
+		actions: []string{
+			"CreateDiscoverer",
+			"CreateRegistry",
+			"CreateSchema",
+			"DeleteDiscoverer",
+			"DeleteRegistry",
+			"DeleteResourcePolicy",
+			"DeleteSchema",
+			"DeleteSchemaVersion",
+			"DescribeCodeBinding",
+			"DescribeDiscoverer",
+			"DescribeRegistry",
+			"DescribeSchema",
+			"ExportSchema",
+			"GetCodeBindingSource",
+			"GetDiscoveredSchema",
+			"GetResourcePolicy",
+			"ListDiscoverers",
+			"ListRegistries",
+			"ListSchemas",
+			"ListSchemaVersions",
+			"ListTagsForResource",
+			"PutCodeBinding",
+			"PutResourcePolicy",
+			"SearchSchemas",
+			"StartDiscoverer",
+			"StopDiscoverer",
+			"TagResource",
+			"UntagResource",
+			"UpdateDiscoverer",
+			"UpdateRegistry",
+			"UpdateSchema",
+		},
+	},
+	"sdb": {
+		actions: []string{
+			"BatchDeleteAttributes",
+			"BatchPutAttributes",
+			"CreateDomain",
+			"DeleteAttributes",
+			"DeleteDomain",
+			"DomainMetadata",
+			"GetAttributes",
+			"ListDomains",
+			"PutAttributes",
+			"Select",
+		},
+	},
+	"secretsmanager": {
+		actions: []string{
+			"CancelRotateSecret",
+			"CreateSecret",
+			"DeleteResourcePolicy",
+			"DeleteSecret",
+			"DescribeSecret",
+			"GetRandomPassword",
+			"GetResourcePolicy",
+			"GetSecretValue",
+			"ListSecrets",
+			"ListSecretVersionIds",
+			"PutResourcePolicy",
+			"PutSecretValue",
+			"RemoveRegionsFromReplication",
+			"ReplicateSecretToRegions",
+			"RestoreSecret",
+			"RotateSecret",
+			"StopReplicationToReplica",
+			"TagResource",
+			"UntagResource",
+			"UpdateSecret",
+			"UpdateSecretVersionStage",
+			"ValidateResourcePolicy",
+		},
+	},
+	"securityhub": {
+		actions: []string{
+			"AcceptAdministratorInvitation",
+			"AcceptInvitation",
+			"BatchDisableStandards",
+			"BatchEnableStandards",
+			"BatchImportFindings",
+			"BatchUpdateFindings",
+			"CreateActionTarget",
+			"CreateFindingAggregator",
+			"CreateInsight",
+			"CreateMembers",
+			"DeclineInvitations",
+			"DeleteActionTarget",
+			"DeleteFindingAggregator",
+			"DeleteInsight",
+			"DeleteInvitations",
+			"DeleteMembers",
+			"DescribeActionTargets",
+			"DescribeHub",
+			"DescribeOrganizationConfiguration",
+			"DescribeProducts",
+			"DescribeStandards",
+			"DescribeStandardsControls",
+			"DisableImportFindingsForProduct",
+			"DisableOrganizationAdminAccount",
+			"DisableSecurityHub",
+			"DisassociateFromAdministratorAccount",
+			"DisassociateFromMasterAccount",
+			"DisassociateMembers",
+			"EnableImportFindingsForProduct",
+			"EnableOrganizationAdminAccount",
+			"EnableSecurityHub",
+			"GetAdministratorAccount",
+			"GetEnabledStandards",
+			"GetFindingAggregator",
+			"GetFindings",
+			"GetInsightResults",
+			"GetInsights",
+			"GetInvitationsCount",
+			"GetMasterAccount",
+			"GetMembers",
+			"InviteMembers",
+			"ListEnabledProductsForImport",
+			"ListFindingAggregators",
+			"ListInvitations",
+			"ListMembers",
+			"ListOrganizationAdminAccounts",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateActionTarget",
+			"UpdateFindingAggregator",
+			"UpdateFindings",
+			"UpdateInsight",
+			"UpdateOrganizationConfiguration",
+			"UpdateSecurityHubConfiguration",
+			"UpdateStandardsControl",
+		},
+	},
+	"securitylake": {
+		actions: []string{
+			"CreateAwsLogSource",
+			"CreateCustomLogSource",
+			"CreateDatalake",
+			"CreateDatalakeAutoEnable",
+			"CreateDatalakeDelegatedAdmin",
+			"CreateDatalakeExceptionsSubscription",
+			"CreateSubscriber",
+			"CreateSubscriptionNotificationConfiguration",
+			"DeleteAwsLogSource",
+			"DeleteCustomLogSource",
+			"DeleteDatalake",
+			"DeleteDatalakeAutoEnable",
+			"DeleteDatalakeDelegatedAdmin",
+			"DeleteDatalakeExceptionsSubscription",
+			"DeleteSubscriber",
+			"DeleteSubscriptionNotificationConfiguration",
+			"GetDatalake",
+			"GetDatalakeAutoEnable",
+			"GetDatalakeExceptionsExpiry",
+			"GetDatalakeExceptionsSubscription",
+			"GetDatalakeStatus",
+			"GetSubscriber",
+			"ListDatalakeExceptions",
+			"ListLogSources",
+			"ListSubscribers",
+			"UpdateDatalake",
+			"UpdateDatalakeExceptionsExpiry",
+			"UpdateDatalakeExceptionsSubscription",
+			"UpdateSubscriber",
+			"UpdateSubscriptionNotificationConfiguration",
+		},
+	},
+	"serverlessrepo": {
+		actions: []string{
+			"CreateApplication",
+			"CreateApplicationVersion",
+			"CreateCloudFormationChangeSet",
+			"CreateCloudFormationTemplate",
+			"DeleteApplication",
+			"GetApplication",
+			"GetApplicationPolicy",
+			"GetCloudFormationTemplate",
+			"ListApplicationDependencies",
+			"ListApplications",
+			"ListApplicationVersions",
+			"PutApplicationPolicy",
+			"UnshareApplication",
+			"UpdateApplication",
+		},
+	},
+	"servicecatalog": {
+		actions: []string{
+			"AcceptPortfolioShare",
+			"AssociateAttributeGroup",
+			"AssociateBudgetWithResource",
+			"AssociatePrincipalWithPortfolio",
+			"AssociateProductWithPortfolio",
+			"AssociateResource",
+			"AssociateServiceActionWithProvisioningArtifact",
+			"AssociateTagOptionWithResource",
+			"BatchAssociateServiceActionWithProvisioningArtifact",
+			"BatchDisassociateServiceActionFromProvisioningArtifact",
+			"CopyProduct",
+			"CreateApplication",
+			"CreateAttributeGroup",
+			"CreateConstraint",
+			"CreatePortfolio",
+			"CreatePortfolioShare",
+			"CreateProduct",
+			"CreateProvisionedProductPlan",
+			"CreateProvisioningArtifact",
+			"CreateServiceAction",
+			"CreateTagOption",
+			"DeleteApplication",
+			"DeleteAttributeGroup",
+			"DeleteConstraint",
+			"DeletePortfolio",
+			"DeletePortfolioShare",
+			"DeleteProduct",
+			"DeleteProvisionedProductPlan",
+			"DeleteProvisioningArtifact",
+			"DeleteServiceAction",
+			"DeleteTagOption",
+			"DescribeConstraint",
+			"DescribeCopyProductStatus",
+			"DescribePortfolio",
+			"DescribePortfolioShares",
+			"DescribePortfolioShareStatus",
+			"DescribeProduct",
+			"DescribeProductAsAdmin",
+			"DescribeProductView",
+			"DescribeProvisionedProduct",
+			"DescribeProvisionedProductPlan",
+			"DescribeProvisioningArtifact",
+			"DescribeProvisioningParameters",
+			"DescribeRecord",
+			"DescribeServiceAction",
+			"DescribeServiceActionExecutionParameters",
+			"DescribeTagOption",
+			"DisableAWSOrganizationsAccess",
+			"DisassociateAttributeGroup",
+			"DisassociateBudgetFromResource",
+			"DisassociatePrincipalFromPortfolio",
+			"DisassociateProductFromPortfolio",
+			"DisassociateResource",
+			"DisassociateServiceActionFromProvisioningArtifact",
+			"DisassociateTagOptionFromResource",
+			"EnableAWSOrganizationsAccess",
+			"ExecuteProvisionedProductPlan",
+			"ExecuteProvisionedProductServiceAction",
+			"GetApplication",
+			"GetAssociatedResource",
+			"GetAttributeGroup",
+			"GetAWSOrganizationsAccessStatus",
+			"GetConfiguration",
+			"GetProvisionedProductOutputs",
+			"ImportAsProvisionedProduct",
+			"ListAcceptedPortfolioShares",
+			"ListApplications",
+			"ListAssociatedAttributeGroups",
+			"ListAssociatedResources",
+			"ListAttributeGroups",
+			"ListAttributeGroupsForApplication",
+			"ListBudgetsForResource",
+			"ListConstraintsForPortfolio",
+			"ListLaunchPaths",
+			"ListOrganizationPortfolioAccess",
+			"ListPortfolioAccess",
+			"ListPortfolios",
+			"ListPortfoliosForProduct",
+			"ListPrincipalsForPortfolio",
+			"ListProvisionedProductPlans",
+			"ListProvisioningArtifacts",
+			"ListProvisioningArtifactsForServiceAction",
+			"ListRecordHistory",
+			"ListResourcesForTagOption",
+			"ListServiceActions",
+			"ListServiceActionsForProvisioningArtifact",
+			"ListStackInstancesForProvisionedProduct",
+			"ListTagOptions",
+			"ListTagsForResource",
+			"ProvisionProduct",
+			"PutConfiguration",
+			"RejectPortfolioShare",
+			"ScanProvisionedProducts",
+			"SearchProducts",
+			"SearchProductsAsAdmin",
+			"SearchProvisionedProducts",
+			"SyncResource",
+			"TagResource",
+			"TerminateProvisionedProduct",
+			"UntagResource",
+			"UpdateApplication",
+			"UpdateAttributeGroup",
+			"UpdateConstraint",
+			"UpdatePortfolio",
+			"UpdatePortfolioShare",
+			"UpdateProduct",
+			"UpdateProvisionedProduct",
+			"UpdateProvisionedProductProperties",
+			"UpdateProvisioningArtifact",
+			"UpdateServiceAction",
+			"UpdateTagOption",
+		},
+	},
+	"servicediscovery": {
+		actions: []string{
+			"CreateHttpNamespace",
+			"CreatePrivateDnsNamespace",
+			"CreatePublicDnsNamespace",
+			"CreateService",
+			"DeleteNamespace",
+			"DeleteService",
+			"DeregisterInstance",
+			"DiscoverInstances",
+			"GetInstance",
+			"GetInstancesHealthStatus",
+			"GetNamespace",
+			"GetOperation",
+			"GetService",
+			"ListInstances",
+			"ListNamespaces",
+			"ListOperations",
+			"ListServices",
+			"ListTagsForResource",
+			"RegisterInstance",
+			"TagResource",
+			"UntagResource",
+			"UpdateHttpNamespace",
+			"UpdateInstanceCustomHealthStatus",
+			"UpdatePrivateDnsNamespace",
+			"UpdatePublicDnsNamespace",
+			"UpdateService",
+		},
+	},
+	"servicequotas": {
+		actions: []string{
+			"AssociateServiceQuotaTemplate",
+			"DeleteServiceQuotaIncreaseRequestFromTemplate",
+			"DisassociateServiceQuotaTemplate",
+			"GetAssociationForServiceQuotaTemplate",
+			"GetAWSDefaultServiceQuota",
+			"GetRequestedServiceQuotaChange",
+			"GetServiceQuota",
+			"GetServiceQuotaIncreaseRequestFromTemplate",
+			"ListAWSDefaultServiceQuotas",
+			"ListRequestedServiceQuotaChangeHistory",
+			"ListRequestedServiceQuotaChangeHistoryByQuota",
+			"ListServiceQuotaIncreaseRequestsInTemplate",
+			"ListServiceQuotas",
+			"ListServices",
+			"ListTagsForResource",
+			"PutServiceQuotaIncreaseRequestIntoTemplate",
+			"RequestServiceQuotaIncrease",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"ses": {
+		actions: []string{
+			"BatchGetMetricData",
+			"CloneReceiptRuleSet",
+			"CreateConfigurationSet",
+			"CreateConfigurationSetEventDestination",
+			"CreateConfigurationSetTrackingOptions",
+			"CreateContact",
+			"CreateContactList",
+			"CreateCustomVerificationEmailTemplate",
+			"CreateDedicatedIpPool",
+			"CreateDeliverabilityTestReport",
+			"CreateEmailIdentity",
+			"CreateEmailIdentityPolicy",
+			"CreateEmailTemplate",
+			"CreateImportJob",
+			"CreateReceiptFilter",
+			"CreateReceiptRule",
+			"CreateReceiptRuleSet",
+			"CreateTemplate",
+			"DeleteConfigurationSet",
+			"DeleteConfigurationSetEventDestination",
+			"DeleteConfigurationSetTrackingOptions",
+			"DeleteContact",
+			"DeleteContactList",
+			"DeleteCustomVerificationEmailTemplate",
+			"DeleteDedicatedIpPool",
+			"DeleteEmailIdentity",
+			"DeleteEmailIdentityPolicy",
+			"DeleteEmailTemplate",
+			"DeleteIdentity",
+			"DeleteIdentityPolicy",
+			"DeleteReceiptFilter",
+			"DeleteReceiptRule",
+			"DeleteReceiptRuleSet",
+			"DeleteSuppressedDestination",
+			"DeleteTemplate",
+			"DeleteVerifiedEmailAddress",
+			"DescribeActiveReceiptRuleSet",
+			"DescribeConfigurationSet",
+			"DescribeReceiptRule",
+			"DescribeReceiptRuleSet",
+			"GetAccount",
+			"GetAccountSendingEnabled",
+			"GetBlacklistReports",
+			"GetConfigurationSet",
+			"GetConfigurationSetEventDestinations",
+			"GetContact",
+			"GetContactList",
+			"GetCustomVerificationEmailTemplate",
+			"GetDedicatedIp",
+			"GetDedicatedIpPool",
+			"GetDedicatedIps",
+			"GetDeliverabilityDashboardOptions",
+			"GetDeliverabilityTestReport",
+			"GetDomainDeliverabilityCampaign",
+			"GetDomainStatisticsReport",
+			"GetEmailIdentity",
+			"GetEmailIdentityPolicies",
+			"GetEmailTemplate",
+			"GetIdentityDkimAttributes",
+			"GetIdentityMailFromDomainAttributes",
+			"GetIdentityNotificationAttributes",
+			"GetIdentityPolicies",
+			"GetIdentityVerificationAttributes",
+			"GetImportJob",
+			"GetSendQuota",
+			"GetSendStatistics",
+			"GetSuppressedDestination",
+			"GetTemplate",
+			"ListConfigurationSets",
+			"ListContactLists",
+			"ListContacts",
+			"ListCustomVerificationEmailTemplates",
+			"ListDedicatedIpPools",
+			"ListDeliverabilityTestReports",
+			"ListDomainDeliverabilityCampaigns",
+			"ListEmailIdentities",
+			"ListEmailTemplates",
+			"ListIdentities",
+			"ListIdentityPolicies",
+			"ListImportJobs",
+			"ListReceiptFilters",
+			"ListReceiptRuleSets",
+			"ListRecommendations",
+			"ListSuppressedDestinations",
+			"ListTagsForResource",
+			"ListTemplates",
+			"ListVerifiedEmailAddresses",
+			"PutAccountDedicatedIpWarmupAttributes",
+			"PutAccountDetails",
+			"PutAccountSendingAttributes",
+			"PutAccountSuppressionAttributes",
+			"PutAccountVdmAttributes",
+			"PutConfigurationSetDeliveryOptions",
+			"PutConfigurationSetReputationOptions",
+			"PutConfigurationSetSendingOptions",
+			"PutConfigurationSetSuppressionOptions",
+			"PutConfigurationSetTrackingOptions",
+			"PutConfigurationSetVdmOptions",
+			"PutDedicatedIpInPool",
+			"PutDedicatedIpWarmupAttributes",
+			"PutDeliverabilityDashboardOption",
+			"PutEmailIdentityConfigurationSetAttributes",
+			"PutEmailIdentityDkimAttributes",
+			"PutEmailIdentityDkimSigningAttributes",
+			"PutEmailIdentityFeedbackAttributes",
+			"PutEmailIdentityMailFromAttributes",
+			"PutIdentityPolicy",
+			"PutSuppressedDestination",
+			"ReorderReceiptRuleSet",
+			"SendBounce",
+			"SendBulkEmail",
+			"SendBulkTemplatedEmail",
+			"SendCustomVerificationEmail",
+			"SendEmail",
+			"SendRawEmail",
+			"SendTemplatedEmail",
+			"SetActiveReceiptRuleSet",
+			"SetIdentityDkimEnabled",
+			"SetIdentityFeedbackForwardingEnabled",
+			"SetIdentityHeadersInNotificationsEnabled",
+			"SetIdentityMailFromDomain",
+			"SetIdentityNotificationTopic",
+			"SetReceiptRulePosition",
+			"TagResource",
+			"TestRenderEmailTemplate",
+			"TestRenderTemplate",
+			"UntagResource",
+			"UpdateAccountSendingEnabled",
+			"UpdateConfigurationSetEventDestination",
+			"UpdateConfigurationSetReputationMetricsEnabled",
+			"UpdateConfigurationSetSendingEnabled",
+			"UpdateConfigurationSetTrackingOptions",
+			"UpdateContact",
+			"UpdateContactList",
+			"UpdateCustomVerificationEmailTemplate",
+			"UpdateEmailIdentityPolicy",
+			"UpdateEmailTemplate",
+			"UpdateReceiptRule",
+			"UpdateTemplate",
+			"VerifyDomainDkim",
+			"VerifyDomainIdentity",
+			"VerifyEmailAddress",
+			"VerifyEmailIdentity",
+		},
+	},
+	"shield": {
+		actions: []string{
+			"AssociateDRTLogBucket",
+			"AssociateDRTRole",
+			"AssociateHealthCheck",
+			"AssociateProactiveEngagementDetails",
+			"CreateProtection",
+			"CreateProtectionGroup",
+			"CreateSubscription",
+			"DeleteProtection",
+			"DeleteProtectionGroup",
+			"DeleteSubscription",
+			"DescribeAttack",
+			"DescribeAttackStatistics",
+			"DescribeDRTAccess",
+			"DescribeEmergencyContactSettings",
+			"DescribeProtection",
+			"DescribeProtectionGroup",
+			"DescribeSubscription",
+			"DisableApplicationLayerAutomaticResponse",
+			"DisableProactiveEngagement",
+			"DisassociateDRTLogBucket",
+			"DisassociateDRTRole",
+			"DisassociateHealthCheck",
+			"EnableApplicationLayerAutomaticResponse",
+			"EnableProactiveEngagement",
+			"GetSubscriptionState",
+			"ListAttacks",
+			"ListProtectionGroups",
+			"ListProtections",
+			"ListResourcesInProtectionGroup",
+			"ListTagsForResource",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplicationLayerAutomaticResponse",
+			"UpdateEmergencyContactSettings",
+			"UpdateProtectionGroup",
+			"UpdateSubscription",
+		},
+	},
+	"signer": {
+		actions: []string{
+			"AddProfilePermission",
+			"CancelSigningProfile",
+			"DescribeSigningJob",
+			"GetSigningPlatform",
+			"GetSigningProfile",
+			"ListProfilePermissions",
+			"ListSigningJobs",
+			"ListSigningPlatforms",
+			"ListSigningProfiles",
+			"ListTagsForResource",
+			"PutSigningProfile",
+			"RemoveProfilePermission",
+			"RevokeSignature",
+			"RevokeSigningProfile",
+			"StartSigningJob",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"simspaceweaver": {
+		actions: []string{
+			"DeleteApp",
+			"DeleteSimulation",
+			"DescribeApp",
+			"DescribeSimulation",
+			"ListApps",
+			"ListSimulations",
+			"ListTagsForResource",
+			"StartApp",
+			"StartClock",
+			"StartSimulation",
+			"StopApp",
+			"StopClock",
+			"StopSimulation",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"sms": {
+		actions: []string{
+			"CreateApp",
+			"CreateReplicationJob",
+			"DeleteApp",
+			"DeleteAppLaunchConfiguration",
+			"DeleteAppReplicationConfiguration",
+			"DeleteAppValidationConfiguration",
+			"DeleteReplicationJob",
+			"DeleteServerCatalog",
+			"DisassociateConnector",
+			"GenerateChangeSet",
+			"GenerateTemplate",
+			"GetApp",
+			"GetAppLaunchConfiguration",
+			"GetAppReplicationConfiguration",
+			"GetAppValidationConfiguration",
+			"GetAppValidationOutput",
+			"GetConnectors",
+			"GetReplicationJobs",
+			"GetReplicationRuns",
+			"GetServers",
+			"ImportAppCatalog",
+			"ImportServerCatalog",
+			"LaunchApp",
+			"ListApps",
+			"NotifyAppValidationOutput",
+			"PutAppLaunchConfiguration",
+			"PutAppReplicationConfiguration",
+			"PutAppValidationConfiguration",
+			"StartAppReplication",
+			"StartOnDemandAppReplication",
+			"StartOnDemandReplicationRun",
+			"StopAppReplication",
+			"TerminateApp",
+			"UpdateApp",
+			"UpdateReplicationJob",
+		},
+	},
+	"sms-voice": {
+		actions: []string{
+			"AssociateOriginationIdentity",
+			"CreateConfigurationSet",
+			"CreateConfigurationSetEventDestination",
+			"CreateEventDestination",
+			"CreateOptOutList",
+			"CreatePool",
+			"DeleteConfigurationSet",
+			"DeleteConfigurationSetEventDestination",
+			"DeleteDefaultMessageType",
+			"DeleteDefaultSenderId",
+			"DeleteEventDestination",
+			"DeleteKeyword",
+			"DeleteOptedOutNumber",
+			"DeleteOptOutList",
+			"DeletePool",
+			"DeleteTextMessageSpendLimitOverride",
+			"DeleteVoiceMessageSpendLimitOverride",
+			"DescribeAccountAttributes",
+			"DescribeAccountLimits",
+			"DescribeConfigurationSets",
+			"DescribeKeywords",
+			"DescribeOptedOutNumbers",
+			"DescribeOptOutLists",
+			"DescribePhoneNumbers",
+			"DescribePools",
+			"DescribeSenderIds",
+			"DescribeSpendLimits",
+			"DisassociateOriginationIdentity",
+			"GetConfigurationSetEventDestinations",
+			"ListConfigurationSets",
+			"ListPoolOriginationIdentities",
+			"ListTagsForResource",
+			"PutKeyword",
+			"PutOptedOutNumber",
+			"ReleasePhoneNumber",
+			"RequestPhoneNumber",
+			"SendTextMessage",
+			"SendVoiceMessage",
+			"SetDefaultMessageType",
+			"SetDefaultSenderId",
+			"SetTextMessageSpendLimitOverride",
+			"SetVoiceMessageSpendLimitOverride",
+			"TagResource",
+			"UntagResource",
+			"UpdateConfigurationSetEventDestination",
+			"UpdateEventDestination",
+			"UpdatePhoneNumber",
+			"UpdatePool",
+		},
+	},
+	"snow-device-management": {
+		actions: []string{
+			"CancelTask",
+			"CreateTask",
+			"DescribeDevice",
+			"DescribeDeviceEc2Instances",
+			"DescribeExecution",
+			"DescribeTask",
+			"ListDeviceResources",
+			"ListDevices",
+			"ListExecutions",
+			"ListTagsForResource",
+			"ListTasks",
+			"TagResource",
+			"UntagResource",
+		},
+	},
+	"snowball": {
+		actions: []string{
+			"CancelCluster",
+			"CancelJob",
+			"CreateAddress",
+			"CreateCluster",
+			"CreateJob",
+			"CreateLongTermPricing",
+			"CreateReturnShippingLabel",
+			"DescribeAddress",
+			"DescribeAddresses",
+			"DescribeCluster",
+			"DescribeJob",
+			"DescribeReturnShippingLabel",
+			"GetJobManifest",
+			"GetJobUnlockCode",
+			"GetSnowballUsage",
+			"GetSoftwareUpdates",
+			"ListClusterJobs",
+			"ListClusters",
+			"ListCompatibleImages",
+			"ListJobs",
+			"ListLongTermPricing",
+			"UpdateCluster",
+			"UpdateJob",
+			"UpdateJobShipmentState",
+			"UpdateLongTermPricing",
+		},
+	},
+	"sns": {
+		actions: []string{
+			"AddPermission",
+			"CheckIfPhoneNumberIsOptedOut",
+			"ConfirmSubscription",
+			"CreatePlatformApplication",
+			"CreatePlatformEndpoint",
+			"CreateSMSSandboxPhoneNumber",
+			"CreateTopic",
+			"DeleteEndpoint",
+			"DeletePlatformApplication",
+			"DeleteSMSSandboxPhoneNumber",
+			"DeleteTopic",
+			"GetDataProtectionPolicy",
+			"GetEndpointAttributes",
+			"GetPlatformApplicationAttributes",
+			"GetSMSAttributes",
+			"GetSMSSandboxAccountStatus",
+			"GetSubscriptionAttributes",
+			"GetTopicAttributes",
+			"ListEndpointsByPlatformApplication",
+			"ListOriginationNumbers",
+			"ListPhoneNumbersOptedOut",
+			"ListPlatformApplications",
+			"ListSMSSandboxPhoneNumbers",
+			"ListSubscriptions",
+			"ListSubscriptionsByTopic",
+			"ListTagsForResource",
+			"ListTopics",
+			"OptInPhoneNumber",
+			"Publish",
+			"PublishBatch",
+			"PutDataProtectionPolicy",
+			"RemovePermission",
+			"SetEndpointAttributes",
+			"SetPlatformApplicationAttributes",
+			"SetSMSAttributes",
+			"SetSubscriptionAttributes",
+			"SetTopicAttributes",
+			"Subscribe",
+			"TagResource",
+			"Unsubscribe",
+			"UntagResource",
+			"VerifySMSSandboxPhoneNumber",
+		},
+	},
+	"sqs": {
+		actions: []string{
+			"AddPermission",
+			"ChangeMessageVisibility",
+			"ChangeMessageVisibilityBatch",
+			"CreateQueue",
+			"DeleteMessage",
+			"DeleteMessageBatch",
+			"DeleteQueue",
+			"GetQueueAttributes",
+			"GetQueueUrl",
+			"ListDeadLetterSourceQueues",
+			"ListQueues",
+			"ListQueueTags",
+			"PurgeQueue",
+			"ReceiveMessage",
+			"RemovePermission",
+			"SendMessage",
+			"SendMessageBatch",
+			"SetQueueAttributes",
+			"TagQueue",
+			"UntagQueue",
+		},
+	},
+	"ssm": {
+		actions: []string{
+			"AddTagsToResource",
+			"AssociateOpsItemRelatedItem",
+			"CancelCommand",
+			"CancelMaintenanceWindowExecution",
+			"CreateActivation",
+			"CreateAssociation",
+			"CreateAssociationBatch",
+			"CreateDocument",
+			"CreateMaintenanceWindow",
+			"CreateOpsItem",
+			"CreateOpsMetadata",
+			"CreatePatchBaseline",
+			"CreateResourceDataSync",
+			"DeleteActivation",
+			"DeleteAssociation",
+			"DeleteDocument",
+			"DeleteInventory",
+			"DeleteMaintenanceWindow",
+			"DeleteOpsMetadata",
+			"DeleteParameter",
+			"DeleteParameters",
+			"DeletePatchBaseline",
+			"DeleteResourceDataSync",
+			"DeleteResourcePolicy",
+			"DeregisterManagedInstance",
+			"DeregisterPatchBaselineForPatchGroup",
+			"DeregisterTargetFromMaintenanceWindow",
+			"DeregisterTaskFromMaintenanceWindow",
+			"DescribeActivations",
+			"DescribeAssociation",
+			"DescribeAssociationExecutions",
+			"DescribeAssociationExecutionTargets",
+			"DescribeAutomationExecutions",
+			"DescribeAutomationStepExecutions",
+			"DescribeAvailablePatches",
+			"DescribeDocument",
+			"DescribeDocumentPermission",
+			"DescribeEffectiveInstanceAssociations",
+			"DescribeEffectivePatchesForPatchBaseline",
+			"DescribeInstanceAssociationsStatus",
+			"DescribeInstanceInformation",
+			"DescribeInstancePatches",
+			"DescribeInstancePatchStates",
+			"DescribeInstancePatchStatesForPatchGroup",
+			"DescribeInventoryDeletions",
+			"DescribeMaintenanceWindowExecutions",
+			"DescribeMaintenanceWindowExecutionTaskInvocations",
+			"DescribeMaintenanceWindowExecutionTasks",
+			"DescribeMaintenanceWindows",
+			"DescribeMaintenanceWindowSchedule",
+			"DescribeMaintenanceWindowsForTarget",
+			"DescribeMaintenanceWindowTargets",
+			"DescribeMaintenanceWindowTasks",
+			"DescribeOpsItems",
+			"DescribeParameters",
+			"DescribePatchBaselines",
+			"DescribePatchGroups",
+			"DescribePatchGroupState",
+			"DescribePatchProperties",
+			"DescribeSessions",
+			"DisassociateOpsItemRelatedItem",
+			"GetAutomationExecution",
+			"GetCalendarState",
+			"GetCommandInvocation",
+			"GetConnectionStatus",
+			"GetDefaultPatchBaseline",
+			"GetDeployablePatchSnapshotForInstance",
+			"GetDocument",
+			"GetInventory",
+			"GetInventorySchema",
+			"GetMaintenanceWindow",
+			"GetMaintenanceWindowExecution",
+			"GetMaintenanceWindowExecutionTask",
+			"GetMaintenanceWindowExecutionTaskInvocation",
+			"GetMaintenanceWindowTask",
+			"GetOpsItem",
+			"GetOpsMetadata",
+			"GetOpsSummary",
+			"GetParameter",
+			"GetParameterHistory",
+			"GetParameters",
+			"GetParametersByPath",
+			"GetPatchBaseline",
+			"GetPatchBaselineForPatchGroup",
+			"GetResourcePolicies",
+			"GetServiceSetting",
+			"LabelParameterVersion",
+			"ListAssociations",
+			"ListAssociationVersions",
+			"ListCommandInvocations",
+			"ListCommands",
+			"ListComplianceItems",
+			"ListComplianceSummaries",
+			"ListDocumentMetadataHistory",
+			"ListDocuments",
+			"ListDocumentVersions",
+			"ListInventoryEntries",
+			"ListOpsItemEvents",
+			"ListOpsItemRelatedItems",
+			"ListOpsMetadata",
+			"ListResourceComplianceSummaries",
+			"ListResourceDataSync",
+			"ListTagsForResource",
+			"ModifyDocumentPermission",
+			"PutComplianceItems",
+			"PutInventory",
+			"PutParameter",
+			"PutResourcePolicy",
+			"RegisterDefaultPatchBaseline",
+			"RegisterPatchBaselineForPatchGroup",
+			"RegisterTargetWithMaintenanceWindow",
+			"RegisterTaskWithMaintenanceWindow",
+			"RemoveTagsFromResource",
+			"ResetServiceSetting",
+			"ResumeSession",
+			"SendAutomationSignal",
+			"SendCommand",
+			"StartAssociationsOnce",
+			"StartAutomationExecution",
+			"StartChangeRequestExecution",
+			"StartSession",
+			"StopAutomationExecution",
+			"TerminateSession",
+			"UnlabelParameterVersion",
+			"UpdateAssociation",
+			"UpdateAssociationStatus",
+			"UpdateDocument",
+			"UpdateDocumentDefaultVersion",
+			"UpdateDocumentMetadata",
+			"UpdateMaintenanceWindow",
+			"UpdateMaintenanceWindowTarget",
+			"UpdateMaintenanceWindowTask",
+			"UpdateManagedInstanceRole",
+			"UpdateOpsItem",
+			"UpdateOpsMetadata",
+			"UpdatePatchBaseline",
+			"UpdateResourceDataSync",
+			"UpdateServiceSetting",
+		},
+	},
+	"ssm-contacts": {
+		actions: []string{
+			"AcceptPage",
+			"ActivateContactChannel",
+			"CreateContact",
+			"CreateContactChannel",
+			"DeactivateContactChannel",
+			"DeleteContact",
+			"DeleteContactChannel",
+			"DescribeEngagement",
+			"DescribePage",
+			"GetContact",
+			"GetContactChannel",
+			"GetContactPolicy",
+			"ListContactChannels",
+			"ListContacts",
+			"ListEngagements",
+			"ListPageReceipts",
+			"ListPagesByContact",
+			"ListPagesByEngagement",
+			"ListTagsForResource",
+			"PutContactPolicy",
+			"SendActivationCode",
+			"StartEngagement",
+			"StopEngagement",
+			"TagResource",
+			"UntagResource",
+			"UpdateContact",
+			"UpdateContactChannel",
+		},
+	},
+	"ssm-guiconnect": {
+		actions: []string{
+			"CancelConnection",
+			"GetConnection",
+			"StartConnection",
+		},
+	},
+	"ssm-incidents": {
+		actions: []string{
+			"CreateReplicationSet",
+			"CreateResponsePlan",
+			"CreateTimelineEvent",
+			"DeleteIncidentRecord",
+			"DeleteReplicationSet",
+			"DeleteResourcePolicy",
+			"DeleteResponsePlan",
+			"DeleteTimelineEvent",
+			"GetIncidentRecord",
+			"GetReplicationSet",
+			"GetResourcePolicies",
+			"GetResponsePlan",
+			"GetTimelineEvent",
+			"ListIncidentRecords",
+			"ListRelatedItems",
+			"ListReplicationSets",
+			"ListResponsePlans",
+			"ListTagsForResource",
+			"ListTimelineEvents",
+			"PutResourcePolicy",
+			"StartIncident",
+			"TagResource",
+			"UntagResource",
+			"UpdateDeletionProtection",
+			"UpdateIncidentRecord",
+			"UpdateRelatedItems",
+			"UpdateReplicationSet",
+			"UpdateResponsePlan",
+			"UpdateTimelineEvent",
+		},
+	},
+	"ssm-sap": {
+		actions: []string{
+			"DeleteResourcePermission",
+			"DeregisterApplication",
+			"GetApplication",
+			"GetComponent",
+			"GetDatabase",
+			"GetOperation",
+			"GetResourcePermission",
+			"ListApplications",
+			"ListComponents",
+			"ListDatabases",
+			"ListTagsForResource",
+			"PutResourcePermission",
+			"RegisterApplication",
+			"TagResource",
+			"UntagResource",
+			"UpdateApplicationSettings",
+		},
+	},
+	"ssmmessages": {
+		actions: []string{
+			"CreateControlChannel",
+			"CreateDataChannel",
+			"OpenControlChannel",
+			"OpenDataChannel",
+		},
+	},
+	"sso": {
+		actions: []string{
+			"AttachCustomerManagedPolicyReferenceToPermissionSet",
+			"AttachManagedPolicyToPermissionSet",
+			"CreateAccountAssignment",
+			"CreateInstanceAccessControlAttributeConfiguration",
+			"CreatePermissionSet",
+			"DeleteAccountAssignment",
+			"DeleteInlinePolicyFromPermissionSet",
+			"DeleteInstanceAccessControlAttributeConfiguration",
+			"DeletePermissionsBoundaryFromPermissionSet",
+			"DeletePermissionSet",
+			"DescribeAccountAssignmentCreationStatus",
+			"DescribeAccountAssignmentDeletionStatus",
+			"DescribeInstanceAccessControlAttributeConfiguration",
+			"DescribePermissionSet",
+			"DescribePermissionSetProvisioningStatus",
+			"DetachCustomerManagedPolicyReferenceFromPermissionSet",
+			"DetachManagedPolicyFromPermissionSet",
+			"GetInlinePolicyForPermissionSet",
+			"GetPermissionsBoundaryForPermissionSet",
+			"ListAccountAssignmentCreationStatus",
+			"ListAccountAssignmentDeletionStatus",
+			"ListAccountAssignments",
+			"ListAccountsForProvisionedPermissionSet",
+			"ListCustomerManagedPolicyReferencesInPermissionSet",
+			"ListInstances",
+			"ListManagedPoliciesInPermissionSet",
+			"ListPermissionSetProvisioningStatus",
+			"ListPermissionSets",
+			"ListPermissionSetsProvisionedToAccount",
+			"ListTagsForResource",
+			"ProvisionPermissionSet",
+			"PutInlinePolicyToPermissionSet",
+			"PutPermissionsBoundaryToPermissionSet",
+			"TagResource",
+			"UntagResource",
+			"UpdateInstanceAccessControlAttributeConfiguration",
+			"UpdatePermissionSet",
+		},
+	},
+	"sso-oauth": {
+		actions: []string{
+			"CreateToken",
+			"RegisterClient",
+			"StartDeviceAuthorization",
+		},
+	},
+	"states": {
+		actions: []string{
+			"CreateActivity",
+			"CreateStateMachine",
+			"DeleteActivity",
+			"DeleteStateMachine",
+			"DescribeActivity",
+			"DescribeExecution",
+			"DescribeMapRun",
+			"DescribeStateMachine",
+			"DescribeStateMachineForExecution",
+			"GetActivityTask",
+			"GetExecutionHistory",
+			"ListActivities",
+			"ListExecutions",
+			"ListMapRuns",
+			"ListStateMachines",
+			"ListTagsForResource",
+			"SendTaskFailure",
+			"SendTaskHeartbeat",
+			"SendTaskSuccess",
+			"StartExecution",
+			"StartSyncExecution",
+			"StopExecution",
+			"TagResource",
+			"UntagResource",
+			"UpdateMapRun",
+			"UpdateStateMachine",
+		},
+	},
+	"storagegateway": {
+		actions: []string{
+			"ActivateGateway",
+			"AddCache",
+			"AddTagsToResource",
+			"AddUploadBuffer",
+			"AddWorkingStorage",
+			"AssignTapePool",
+			"AssociateFileSystem",
+			"AttachVolume",
+			"CancelArchival",
+			"CancelRetrieval",
+			"CreateCachediSCSIVolume",
+			"CreateNFSFileShare",
+			"CreateSMBFileShare",
+			"CreateSnapshot",
+			"CreateSnapshotFromVolumeRecoveryPoint",
+			"CreateStorediSCSIVolume",
+			"CreateTapePool",
+			"CreateTapes",
+			"CreateTapeWithBarcode",
+			"DeleteAutomaticTapeCreationPolicy",
+			"DeleteBandwidthRateLimit",
+			"DeleteChapCredentials",
+			"DeleteFileShare",
+			"DeleteGateway",
+			"DeleteSnapshotSchedule",
+			"DeleteTape",
+			"DeleteTapeArchive",
+			"DeleteTapePool",
+			"DeleteVolume",
+			"DescribeAvailabilityMonitorTest",
+			"DescribeBandwidthRateLimit",
+			"DescribeBandwidthRateLimitSchedule",
+			"DescribeCache",
+			"DescribeCachediSCSIVolumes",
+			"DescribeChapCredentials",
+			"DescribeFileSystemAssociations",
+			"DescribeGatewayInformation",
+			"DescribeMaintenanceStartTime",
+			"DescribeNFSFileShares",
+			"DescribeSMBFileShares",
+			"DescribeSMBSettings",
+			"DescribeSnapshotSchedule",
+			"DescribeStorediSCSIVolumes",
+			"DescribeTapeArchives",
+			"DescribeTapeRecoveryPoints",
+			"DescribeTapes",
+			"DescribeUploadBuffer",
+			"DescribeVTLDevices",
+			"DescribeWorkingStorage",
+			"DetachVolume",
+			"DisableGateway",
+			"DisassociateFileSystem",
+			"JoinDomain",
+			"ListAutomaticTapeCreationPolicies",
+			"ListFileShares",
+			"ListFileSystemAssociations",
+			"ListGateways",
+			"ListLocalDisks",
+			"ListTagsForResource",
+			"ListTapePools",
+			"ListTapes",
+			"ListVolumeInitiators",
+			"ListVolumeRecoveryPoints",
+			"ListVolumes",
+			"NotifyWhenUploaded",
+			"RefreshCache",
+			"RemoveTagsFromResource",
+			"ResetCache",
+			"RetrieveTapeArchive",
+			"RetrieveTapeRecoveryPoint",
+			"SetLocalConsolePassword",
+			"SetSMBGuestPassword",
+			"ShutdownGateway",
+			"StartAvailabilityMonitorTest",
+			"StartGateway",
+			"UpdateAutomaticTapeCreationPolicy",
+			"UpdateBandwidthRateLimit",
+			"UpdateBandwidthRateLimitSchedule",
+			"UpdateChapCredentials",
+			"UpdateFileSystemAssociation",
+			"UpdateGatewayInformation",
+			"UpdateGatewaySoftwareNow",
+			"UpdateMaintenanceStartTime",
+			"UpdateNFSFileShare",
+			"UpdateSMBFileShare",
+			"UpdateSMBFileShareVisibility",
+			"UpdateSMBLocalGroups",
+			"UpdateSMBSecurityStrategy",
+			"UpdateSnapshotSchedule",
+			"UpdateVTLDeviceType",
+		},
+	},
+	"sts": {
+		actions: []string{
+			"AssumeRole",
+			"AssumeRoleWithSAML",
+			"AssumeRoleWithWebIdentity",
+			"DecodeAuthorizationMessage",
+			"GetAccessKeyInfo",
+			"GetCallerIdentity",
+			"GetFederationToken",
+			"GetServiceBearerToken",
+			"GetSessionToken",
+			"SetContext",
+			"SetSourceIdentity",
+			"TagSession",
+		},
+		conditionKeys: []string{
+			"sts:AWSServiceName",
+			"sts:DurationSeconds",
+			"sts:ExternalId",
+			"sts:RoleSessionName",
+			"sts:SourceIdentity",
+			"sts:TransitiveTagKeys",
+		},
+	},
+	"support": {
+		actions: []string{
+			"AddAttachmentsToSet",
+			"AddCommunicationToCase",
+			"CreateCase",
+			"DescribeAttachment",
+			"DescribeCases",
+			"DescribeCommunications",
+			"DescribeServices",
+			"DescribeSeverityLevels",
+			"DescribeTrustedAdvisorCheckRefreshStatuses",
+			"DescribeTrustedAdvisorCheckResult",
+			"DescribeTrustedAdvisorChecks",
+			"DescribeTrustedAdvisorCheckSummaries",
+			"RefreshTrustedAdvisorCheck",
+			"ResolveCase",
+		},
+	},
+	"supportapp": {
+		actions: []string{
+			"CreateSlackChannelConfiguration",
+			"DeleteAccountAlias",
+			"DeleteSlackChannelConfiguration",
+			"DeleteSlackWorkspaceConfiguration",
+			"GetAccountAlias",
+			"ListSlackChannelConfigurations",
+			"ListSlackWorkspaceConfigurations",
+			"PutAccountAlias",
+			"RegisterSlackWorkspaceForOrganization",
+			"UpdateSlackChannelConfiguration",
+		},
+	},
+	"swf": {
+		actions: []string{
+			"CountClosedWorkflowExecutions",
+			"CountOpenWorkflowExecutions",
+			"CountPendingActivityTasks",
+			"CountPendingDecisionTasks",
+			"DeprecateActivityType",
+			"DeprecateDomain",
+			"DeprecateWorkflowType",
+			"DescribeActivityType",
+			"DescribeDomain",
+			"DescribeWorkflowExecution",
+			"DescribeWorkflowType",
+			"GetWorkflowExecutionHistory",
+			"ListActivityTypes",
+			"ListClosedWorkflowExecutions",
+			"ListDomains",
+			"ListOpenWorkflowExecutions",
+			"ListTagsForResource",
+			"ListWorkflowTypes",
+			"PollForActivityTask",
+			"PollForDecisionTask",
+			"RecordActivityTaskHeartbeat",
+			"RegisterActivityType",
+			"RegisterDomain",
+			"RegisterWorkflowType",
+			"RequestCancelWorkflowExecution",
+			"RespondActivityTaskCanceled",
+			"RespondActivityTaskCompleted",
+			"RespondActivityTaskFailed",
+			"RespondDecisionTaskCompleted",
+			"SignalWorkflowExecution",
+			"StartWorkflowExecution",
+			"TagResource",
+			"TerminateWorkflowExecution",
+			"UndeprecateActivityType",
+			"UndeprecateDomain",
+			"UndeprecateWorkflowType",
+			"UntagResource",
+		},
+	},
+	"synthetics": {
+		actions: []string{
+			"AssociateResource",
+			"CreateCanary",
+			"CreateGroup",
+			"DeleteCanary",
+			"DeleteGroup",
+			"DescribeCanaries",
+			"DescribeCanariesLastRun",
+			"DescribeRuntimeVersions",
+			"DisassociateResource",
+			"GetCanary",
+			"GetCanaryRuns",
+			"GetGroup",
+			"ListAssociatedGroups",
+			"ListGroupResources",
+			"ListGroups",
+			"ListTagsForResource",
+			"StartCanary",
+			"StopCanary",
+			"TagResource",
+			"UntagResource",
+			"UpdateCanary",
+		},
+	},
+	"tag": {
+		actions: []string{
+			"DescribeReportCreation",
+			"GetComplianceSummary",
+			"GetResources",
+			"GetTagKeys",
+			"GetTagValues",
+			"StartReportCreation",
+			"TagResources",
+			"UntagResources",
+		},
+	},
+	"textract": {
+		actions: []string{
+			"AnalyzeDocument",
+			"AnalyzeExpense",
+			"AnalyzeID",
+			"DetectDocumentText",
+			"GetDocumentAnalysis",
+			"GetDocumentTextDetection",
+			"GetExpenseAnalysis",
+			"GetLendingAnalysis",
+			"GetLendingAnalysisSummary",
+			"StartDocumentAnalysis",
+			"StartDocumentTextDetection",
+			"StartExpenseAnalysis",
+			"StartLendingAnalysis",
+		},
+	},
+	"timestream": {
+		actions: []string{
+			"CancelQuery",
+			"CreateDatabase",
+			"CreateScheduledQuery",
+			"CreateTable",
+			"DeleteDatabase",
+			"DeleteScheduledQuery",
+			"DeleteTable",
+			"DescribeDatabase",
+			"DescribeEndpoints",
+			"DescribeScheduledQuery",
+			"DescribeTable",
+			"ExecuteScheduledQuery",
+			"ListDatabases",
+			"ListScheduledQueries",
+			"ListTables",
+			"ListTagsForResource",
+			"PrepareQuery",
+			"Query",
+			"TagResource",
+			"UntagResource",
+			"UpdateDatabase",
+			"UpdateScheduledQuery",
+			"UpdateTable",
+			"WriteRecords",
+		},
+	},
+	"transcribe": {
+		actions: []string{
+			"CreateCallAnalyticsCategory",
+			"CreateLanguageModel",
+			"CreateMedicalVocabulary",
+			"CreateVocabulary",
+			"CreateVocabularyFilter",
+			"DeleteCallAnalyticsCategory",
+			"DeleteCallAnalyticsJob",
+			"DeleteLanguageModel",
+			"DeleteMedicalTranscriptionJob",
+			"DeleteMedicalVocabulary",
+			"DeleteTranscriptionJob",
+			"DeleteVocabulary",
+			"DeleteVocabularyFilter",
+			"DescribeLanguageModel",
+			"GetCallAnalyticsCategory",
+			"GetCallAnalyticsJob",
+			"GetMedicalTranscriptionJob",
+			"GetMedicalVocabulary",
+			"GetTranscriptionJob",
+			"GetVocabulary",
+			"GetVocabularyFilter",
+			"ListCallAnalyticsCategories",
+			"ListCallAnalyticsJobs",
+			"ListLanguageModels",
+			"ListMedicalTranscriptionJobs",
+			"ListMedicalVocabularies",
+			"ListTagsForResource",
+			"ListTranscriptionJobs",
+			"ListVocabularies",
+			"ListVocabularyFilters",
+			"StartCallAnalyticsJob",
+			"StartCallAnalyticsStreamTranscription",
+			"StartMedicalStreamTranscription",
+			"StartMedicalTranscriptionJob",
+			"StartStreamTranscription",
+			"StartTranscriptionJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateCallAnalyticsCategory",
+			"UpdateMedicalVocabulary",
+			"UpdateVocabulary",
+			"UpdateVocabularyFilter",
+		},
+	},
+	"transfer": {
+		actions: []string{
+			"CreateAccess",
+			"CreateAgreement",
+			"CreateConnector",
+			"CreateProfile",
+			"CreateServer",
+			"CreateUser",
+			"CreateWorkflow",
+			"DeleteAccess",
+			"DeleteAgreement",
+			"DeleteCertificate",
+			"DeleteConnector",
+			"DeleteHostKey",
+			"DeleteProfile",
+			"DeleteServer",
+			"DeleteSshPublicKey",
+			"DeleteUser",
+			"DeleteWorkflow",
+			"DescribeAccess",
+			"DescribeAgreement",
+			"DescribeCertificate",
+			"DescribeConnector",
+			"DescribeExecution",
+			"DescribeHostKey",
+			"DescribeProfile",
+			"DescribeSecurityPolicy",
+			"DescribeServer",
+			"DescribeUser",
+			"DescribeWorkflow",
+			"ImportCertificate",
+			"ImportHostKey",
+			"ImportSshPublicKey",
+			"ListAccesses",
+			"ListAgreements",
+			"ListCertificates",
+			"ListConnectors",
+			"ListExecutions",
+			"ListHostKeys",
+			"ListProfiles",
+			"ListSecurityPolicies",
+			"ListServers",
+			"ListTagsForResource",
+			"ListUsers",
+			"ListWorkflows",
+			"SendWorkflowStepState",
+			"StartFileTransfer",
+			"StartServer",
+			"StopServer",
+			"TagResource",
+			"TestIdentityProvider",
+			"UntagResource",
+			"UpdateAccess",
+			"UpdateAgreement",
+			"UpdateCertificate",
+			"UpdateConnector",
+			"UpdateHostKey",
+			"UpdateProfile",
+			"UpdateServer",
+			"UpdateUser",
+		},
+	},
+	"translate": {
+		actions: []string{
+			"CreateParallelData",
+			"DeleteParallelData",
+			"DeleteTerminology",
+			"DescribeTextTranslationJob",
+			"GetParallelData",
+			"GetTerminology",
+			"ImportTerminology",
+			"ListLanguages",
+			"ListParallelData",
+			"ListTagsForResource",
+			"ListTerminologies",
+			"ListTextTranslationJobs",
+			"StartTextTranslationJob",
+			"StopTextTranslationJob",
+			"TagResource",
+			"TranslateText",
+			"UntagResource",
+			"UpdateParallelData",
+		},
+	},
+	"voiceid": {
+		actions: []string{
+			"CreateDomain",
+			"DeleteDomain",
+			"DeleteFraudster",
+			"DeleteSpeaker",
+			"DescribeDomain",
+			"DescribeFraudster",
+			"DescribeFraudsterRegistrationJob",
+			"DescribeSpeaker",
+			"DescribeSpeakerEnrollmentJob",
+			"EvaluateSession",
+			"ListDomains",
+			"ListFraudsterRegistrationJobs",
+			"ListSpeakerEnrollmentJobs",
+			"ListSpeakers",
+			"ListTagsForResource",
+			"OptOutSpeaker",
+			"StartFraudsterRegistrationJob",
+			"StartSpeakerEnrollmentJob",
+			"TagResource",
+			"UntagResource",
+			"UpdateDomain",
+		},
+	},
+	"waf": {
+		actions: []string{
+			"CreateByteMatchSet",
+			"CreateGeoMatchSet",
+			"CreateIPSet",
+			"CreateRateBasedRule",
+			"CreateRegexMatchSet",
+			"CreateRegexPatternSet",
+			"CreateRule",
+			"CreateRuleGroup",
+			"CreateSizeConstraintSet",
+			"CreateSqlInjectionMatchSet",
+			"CreateWebACL",
+			"CreateWebACLMigrationStack",
+			"CreateXssMatchSet",
+			"DeleteByteMatchSet",
+			"DeleteGeoMatchSet",
+			"DeleteIPSet",
+			"DeleteLoggingConfiguration",
+			"DeletePermissionPolicy",
+			"DeleteRateBasedRule",
+			"DeleteRegexMatchSet",
+			"DeleteRegexPatternSet",
+			"DeleteRule",
+			"DeleteRuleGroup",
+			"DeleteSizeConstraintSet",
+			"DeleteSqlInjectionMatchSet",
+			"DeleteWebACL",
+			"DeleteXssMatchSet",
+			"GetByteMatchSet",
+			"GetChangeToken",
+			"GetChangeTokenStatus",
+			"GetGeoMatchSet",
+			"GetIPSet",
+			"GetLoggingConfiguration",
+			"GetPermissionPolicy",
+			"GetRateBasedRule",
+			"GetRateBasedRuleManagedKeys",
+			"GetRegexMatchSet",
+			"GetRegexPatternSet",
+			"GetRule",
+			"GetRuleGroup",
+			"GetSampledRequests",
+			"GetSizeConstraintSet",
+			"GetSqlInjectionMatchSet",
+			"GetWebACL",
+			"GetXssMatchSet",
+			"ListActivatedRulesInRuleGroup",
+			"ListByteMatchSets",
+			"ListGeoMatchSets",
+			"ListIPSets",
+			"ListLoggingConfigurations",
+			"ListRateBasedRules",
+			"ListRegexMatchSets",
+			"ListRegexPatternSets",
+			"ListRuleGroups",
+			"ListRules",
+			"ListSizeConstraintSets",
+			"ListSqlInjectionMatchSets",
+			"ListSubscribedRuleGroups",
+			"ListTagsForResource",
+			"ListWebACLs",
+			"ListXssMatchSets",
+			"PutLoggingConfiguration",
+			"PutPermissionPolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateByteMatchSet",
+			"UpdateGeoMatchSet",
+			"UpdateIPSet",
+			"UpdateRateBasedRule",
+			"UpdateRegexMatchSet",
+			"UpdateRegexPatternSet",
+			"UpdateRule",
+			"UpdateRuleGroup",
+			"UpdateSizeConstraintSet",
+			"UpdateSqlInjectionMatchSet",
+			"UpdateWebACL",
+			"UpdateXssMatchSet",
+		},
+	},
+	"waf-regional": {
+		actions: []string{
+			"AssociateWebACL",
+			"CreateByteMatchSet",
+			"CreateGeoMatchSet",
+			"CreateIPSet",
+			"CreateRateBasedRule",
+			"CreateRegexMatchSet",
+			"CreateRegexPatternSet",
+			"CreateRule",
+			"CreateRuleGroup",
+			"CreateSizeConstraintSet",
+			"CreateSqlInjectionMatchSet",
+			"CreateWebACL",
+			"CreateWebACLMigrationStack",
+			"CreateXssMatchSet",
+			"DeleteByteMatchSet",
+			"DeleteGeoMatchSet",
+			"DeleteIPSet",
+			"DeleteLoggingConfiguration",
+			"DeletePermissionPolicy",
+			"DeleteRateBasedRule",
+			"DeleteRegexMatchSet",
+			"DeleteRegexPatternSet",
+			"DeleteRule",
+			"DeleteRuleGroup",
+			"DeleteSizeConstraintSet",
+			"DeleteSqlInjectionMatchSet",
+			"DeleteWebACL",
+			"DeleteXssMatchSet",
+			"DisassociateWebACL",
+			"GetByteMatchSet",
+			"GetChangeToken",
+			"GetChangeTokenStatus",
+			"GetGeoMatchSet",
+			"GetIPSet",
+			"GetLoggingConfiguration",
+			"GetPermissionPolicy",
+			"GetRateBasedRule",
+			"GetRateBasedRuleManagedKeys",
+			"GetRegexMatchSet",
+			"GetRegexPatternSet",
+			"GetRule",
+			"GetRuleGroup",
+			"GetSampledRequests",
+			"GetSizeConstraintSet",
+			"GetSqlInjectionMatchSet",
+			"GetWebACL",
+			"GetWebACLForResource",
+			"GetXssMatchSet",
+			"ListActivatedRulesInRuleGroup",
+			"ListByteMatchSets",
+			"ListGeoMatchSets",
+			"ListIPSets",
+			"ListLoggingConfigurations",
+			"ListRateBasedRules",
+			"ListRegexMatchSets",
+			"ListRegexPatternSets",
+			"ListResourcesForWebACL",
+			"ListRuleGroups",
+			"ListRules",
+			"ListSizeConstraintSets",
+			"ListSqlInjectionMatchSets",
+			"ListSubscribedRuleGroups",
+			"ListTagsForResource",
+			"ListWebACLs",
+			"ListXssMatchSets",
+			"PutLoggingConfiguration",
+			"PutPermissionPolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateByteMatchSet",
+			"UpdateGeoMatchSet",
+			"UpdateIPSet",
+			"UpdateRateBasedRule",
+			"UpdateRegexMatchSet",
+			"UpdateRegexPatternSet",
+			"UpdateRule",
+			"UpdateRuleGroup",
+			"UpdateSizeConstraintSet",
+			"UpdateSqlInjectionMatchSet",
+			"UpdateWebACL",
+			"UpdateXssMatchSet",
+		},
+	},
+	"wafv2": {
+		actions: []string{
+			"AssociateWebACL",
+			"CheckCapacity",
+			"CreateIPSet",
+			"CreateRegexPatternSet",
+			"CreateRuleGroup",
+			"CreateWebACL",
+			"DeleteFirewallManagerRuleGroups",
+			"DeleteIPSet",
+			"DeleteLoggingConfiguration",
+			"DeletePermissionPolicy",
+			"DeleteRegexPatternSet",
+			"DeleteRuleGroup",
+			"DeleteWebACL",
+			"DescribeManagedRuleGroup",
+			"DisassociateWebACL",
+			"GenerateMobileSdkReleaseUrl",
+			"GetIPSet",
+			"GetLoggingConfiguration",
+			"GetManagedRuleSet",
+			"GetMobileSdkRelease",
+			"GetPermissionPolicy",
+			"GetRateBasedStatementManagedKeys",
+			"GetRegexPatternSet",
+			"GetRuleGroup",
+			"GetSampledRequests",
+			"GetWebACL",
+			"GetWebACLForResource",
+			"ListAvailableManagedRuleGroups",
+			"ListAvailableManagedRuleGroupVersions",
+			"ListIPSets",
+			"ListLoggingConfigurations",
+			"ListManagedRuleSets",
+			"ListMobileSdkReleases",
+			"ListRegexPatternSets",
+			"ListResourcesForWebACL",
+			"ListRuleGroups",
+			"ListTagsForResource",
+			"ListWebACLs",
+			"PutLoggingConfiguration",
+			"PutManagedRuleSetVersions",
+			"PutPermissionPolicy",
+			"TagResource",
+			"UntagResource",
+			"UpdateIPSet",
+			"UpdateManagedRuleSetVersionExpiryDate",
+			"UpdateRegexPatternSet",
+			"UpdateRuleGroup",
+			"UpdateWebACL",
+		},
+	},
+	"wellarchitected": {
+		actions: []string{
+			"AssociateLenses",
+			"CreateLensShare",
+			"CreateLensVersion",
+			"CreateMilestone",
+			"CreateWorkload",
+			"CreateWorkloadShare",
+			"DeleteLens",
+			"DeleteLensShare",
+			"DeleteWorkload",
+			"DeleteWorkloadShare",
+			"DisassociateLenses",
+			"ExportLens",
+			"GetAnswer",
+			"GetLens",
+			"GetLensReview",
+			"GetLensReviewReport",
+			"GetLensVersionDifference",
+			"GetMilestone",
+			"GetWorkload",
+			"ImportLens",
+			"ListAnswers",
+			"ListCheckDetails",
+			"ListCheckSummaries",
+			"ListLenses",
+			"ListLensReviewImprovements",
+			"ListLensReviews",
+			"ListLensShares",
+			"ListMilestones",
+			"ListNotifications",
+			"ListShareInvitations",
+			"ListTagsForResource",
+			"ListWorkloads",
+			"ListWorkloadShares",
+			"TagResource",
+			"UntagResource",
+			"UpdateAnswer",
+			"UpdateGlobalSettings",
+			"UpdateLensReview",
+			"UpdateShareInvitation",
+			"UpdateWorkload",
+			"UpdateWorkloadShare",
+			"UpgradeLensReview",
+		},
+	},
+	"wisdom": {
+		actions: []string{
+			"CreateAssistant",
+			"CreateAssistantAssociation",
+			"CreateContent",
+			"CreateKnowledgeBase",
+			"CreateSession",
+			"DeleteAssistant",
+			"DeleteAssistantAssociation",
+			"DeleteContent",
+			"DeleteKnowledgeBase",
+			"GetAssistant",
+			"GetAssistantAssociation",
+			"GetContent",
+			"GetContentSummary",
+			"GetKnowledgeBase",
+			"GetRecommendations",
+			"GetSession",
+			"ListAssistantAssociations",
+			"ListAssistants",
+			"ListContents",
+			"ListKnowledgeBases",
+			"ListTagsForResource",
+			"NotifyRecommendationsReceived",
+			"QueryAssistant",
+			"RemoveKnowledgeBaseTemplateUri",
+			"SearchContent",
+			"SearchSessions",
+			"StartContentUpload",
+			"TagResource",
+			"UntagResource",
+			"UpdateContent",
+			"UpdateKnowledgeBaseTemplateUri",
+		},
+	},
+	"workdocs": {
+		actions: []string{
+			"AbortDocumentVersionUpload",
+			"ActivateUser",
+			"AddResourcePermissions",
+			"CreateComment",
+			"CreateCustomMetadata",
+			"CreateFolder",
+			"CreateLabels",
+			"CreateNotificationSubscription",
+			"CreateUser",
+			"DeactivateUser",
+			"DeleteComment",
+			"DeleteCustomMetadata",
+			"DeleteDocument",
+			"DeleteDocumentVersion",
+			"DeleteFolder",
+			"DeleteFolderContents",
+			"DeleteLabels",
+			"DeleteNotificationSubscription",
+			"DeleteUser",
+			"DescribeActivities",
+			"DescribeComments",
+			"DescribeDocumentVersions",
+			"DescribeFolderContents",
+			"DescribeGroups",
+			"DescribeNotificationSubscriptions",
+			"DescribeResourcePermissions",
+			"DescribeRootFolders",
+			"DescribeUsers",
+			"GetCurrentUser",
+			"GetDocument",
+			"GetDocumentPath",
+			"GetDocumentVersion",
+			"GetFolder",
+			"GetFolderPath",
+			"GetResources",
+			"InitiateDocumentVersionUpload",
+			"RemoveAllResourcePermissions",
+			"RemoveResourcePermission",
+			"RestoreDocumentVersions",
+			"UpdateDocument",
+			"UpdateDocumentVersion",
+			"UpdateFolder",
+			"UpdateUser",
+		},
+	},
+	"worklink": {
+		actions: []string{
+			"AssociateDomain",
+			"AssociateWebsiteAuthorizationProvider",
+			"AssociateWebsiteCertificateAuthority",
+			"CreateFleet",
+			"DeleteFleet",
+			"DescribeAuditStreamConfiguration",
+			"DescribeCompanyNetworkConfiguration",
+			"DescribeDevice",
+			"DescribeDevicePolicyConfiguration",
+			"DescribeDomain",
+			"DescribeFleetMetadata",
+			"DescribeIdentityProviderConfiguration",
+			"DescribeWebsiteCertificateAuthority",
+			"DisassociateDomain",
+			"DisassociateWebsiteAuthorizationProvider",
+			"DisassociateWebsiteCertificateAuthority",
+			"ListDevices",
+			"ListDomains",
+			"ListFleets",
+			"ListTagsForResource",
+			"ListWebsiteAuthorizationProviders",
+			"ListWebsiteCertificateAuthorities",
+			"RestoreDomainAccess",
+			"RevokeDomainAccess",
+			"SignOutUser",
+			"TagResource",
+			"UntagResource",
+			"UpdateAuditStreamConfiguration",
+			"UpdateCompanyNetworkConfiguration",
+			"UpdateDevicePolicyConfiguration",
+			"UpdateDomainMetadata",
+			"UpdateFleetMetadata",
+			"UpdateIdentityProviderConfiguration",
+		},
+	},
+	"workmail": {
+		actions: []string{
+			"AssociateDelegateToResource",
+			"AssociateMemberToGroup",
+			"AssumeImpersonationRole",
+			"CancelMailboxExportJob",
+			"CreateAlias",
+			"CreateAvailabilityConfiguration",
+			"CreateGroup",
+			"CreateImpersonationRole",
+			"CreateMobileDeviceAccessRule",
+			"CreateOrganization",
+			"CreateResource",
+			"CreateUser",
+			"DeleteAccessControlRule",
+			"DeleteAlias",
+			"DeleteAvailabilityConfiguration",
+			"DeleteEmailMonitoringConfiguration",
+			"DeleteGroup",
+			"DeleteImpersonationRole",
+			"DeleteMailboxPermissions",
+			"DeleteMobileDeviceAccessOverride",
+			"DeleteMobileDeviceAccessRule",
+			"DeleteOrganization",
+			"DeleteResource",
+			"DeleteRetentionPolicy",
+			"DeleteUser",
+			"DeregisterFromWorkMail",
+			"DeregisterMailDomain",
+			"DescribeEmailMonitoringConfiguration",
+			"DescribeGroup",
+			"DescribeInboundDmarcSettings",
+			"DescribeMailboxExportJob",
+			"DescribeOrganization",
+			"DescribeResource",
+			"DescribeUser",
+			"DisassociateDelegateFromResource",
+			"DisassociateMemberFromGroup",
+			"GetAccessControlEffect",
+			"GetDefaultRetentionPolicy",
+			"GetImpersonationRole",
+			"GetImpersonationRoleEffect",
+			"GetMailboxDetails",
+			"GetMailDomain",
+			"GetMobileDeviceAccessEffect",
+			"GetMobileDeviceAccessOverride",
+			"ListAccessControlRules",
+			"ListAliases",
+			"ListAvailabilityConfigurations",
+			"ListGroupMembers",
+			"ListGroups",
+			"ListImpersonationRoles",
+			"ListMailboxExportJobs",
+			"ListMailboxPermissions",
+			"ListMailDomains",
+			"ListMobileDeviceAccessOverrides",
+			"ListMobileDeviceAccessRules",
+			"ListOrganizations",
+			"ListResourceDelegates",
+			"ListResources",
+			"ListTagsForResource",
+			"ListUsers",
+			"PutAccessControlRule",
+			"PutEmailMonitoringConfiguration",
+			"PutInboundDmarcSettings",
+			"PutMailboxPermissions",
+			"PutMobileDeviceAccessOverride",
+			"PutRetentionPolicy",
+			"RegisterMailDomain",
+			"RegisterToWorkMail",
+			"ResetPassword",
+			"StartMailboxExportJob",
+			"TagResource",
+			"TestAvailabilityConfiguration",
+			"UntagResource",
+			"UpdateAvailabilityConfiguration",
+			"UpdateDefaultMailDomain",
+			"UpdateImpersonationRole",
+			"UpdateMailboxQuota",
+			"UpdateMobileDeviceAccessRule",
+			"UpdatePrimaryEmailAddress",
+			"UpdateResource",
+		},
+	},
+	"workmailmessageflow": {
+		actions: []string{
+			"GetRawMessageContent",
+			"PutRawMessageContent",
+		},
+	},
+	"workspaces": {
+		actions: []string{
+			"AssociateConnectionAlias",
+			"AssociateIpGroups",
+			"AuthorizeIpRules",
+			"CopyWorkspaceImage",
+			"CreateConnectClientAddIn",
+			"CreateConnectionAlias",
+			"CreateIpGroup",
+			"CreateStandbyWorkspaces",
+			"CreateTags",
+			"CreateUpdatedWorkspaceImage",
+			"CreateWorkspaceBundle",
+			"CreateWorkspaceImage",
+			"CreateWorkspaces",
+			"DeleteClientBranding",
+			"DeleteConnectClientAddIn",
+			"DeleteConnectionAlias",
+			"DeleteIpGroup",
+			"DeleteTags",
+			"DeleteWorkspaceBundle",
+			"DeleteWorkspaceImage",
+			"DeregisterWorkspaceDirectory",
+			"DescribeAccount",
+			"DescribeAccountModifications",
+			"DescribeClientBranding",
+			"DescribeClientProperties",
+			"DescribeConnectClientAddIns",
+			"DescribeConnectionAliases",
+			"DescribeConnectionAliasPermissions",
+			"DescribeIpGroups",
+			"DescribeTags",
+			"DescribeWorkspaceBundles",
+			"DescribeWorkspaceDirectories",
+			"DescribeWorkspaceImagePermissions",
+			"DescribeWorkspaceImages",
+			"DescribeWorkspaces",
+			"DescribeWorkspacesConnectionStatus",
+			"DescribeWorkspaceSnapshots",
+			"DisassociateConnectionAlias",
+			"DisassociateIpGroups",
+			"ImportClientBranding",
+			"ImportWorkspaceImage",
+			"ListAvailableManagementCidrRanges",
+			"MigrateWorkspace",
+			"ModifyAccount",
+			"ModifyCertificateBasedAuthProperties",
+			"ModifyClientProperties",
+			"ModifySamlProperties",
+			"ModifySelfservicePermissions",
+			"ModifyWorkspaceAccessProperties",
+			"ModifyWorkspaceCreationProperties",
+			"ModifyWorkspaceProperties",
+			"ModifyWorkspaceState",
+			"RebootWorkspaces",
+			"RebuildWorkspaces",
+			"RegisterWorkspaceDirectory",
+			"RestoreWorkspace",
+			"RevokeIpRules",
+			"StartWorkspaces",
+			"StopWorkspaces",
+			"TerminateWorkspaces",
+			"UpdateConnectClientAddIn",
+			"UpdateConnectionAliasPermission",
+			"UpdateRulesOfIpGroup",
+			"UpdateWorkspaceBundle",
+			"UpdateWorkspaceImagePermission",
+		},
+	},
+	"workspaces-web": {
+		actions: []string{
+			"AssociateBrowserSettings",
+			"AssociateNetworkSettings",
+			"AssociateTrustStore",
+			"AssociateUserAccessLoggingSettings",
+			"AssociateUserSettings",
+			"CreateBrowserSettings",
+			"CreateIdentityProvider",
+			"CreateNetworkSettings",
+			"CreatePortal",
+			"CreateTrustStore",
+			"CreateUserAccessLoggingSettings",
+			"CreateUserSettings",
+			"DeleteBrowserSettings",
+			"DeleteIdentityProvider",
+			"DeleteNetworkSettings",
+			"DeletePortal",
+			"DeleteTrustStore",
+			"DeleteUserAccessLoggingSettings",
+			"DeleteUserSettings",
+			"DisassociateBrowserSettings",
+			"DisassociateNetworkSettings",
+			"DisassociateTrustStore",
+			"DisassociateUserAccessLoggingSettings",
+			"DisassociateUserSettings",
+			"GetBrowserSettings",
+			"GetIdentityProvider",
+			"GetNetworkSettings",
+			"GetPortal",
+			"GetPortalServiceProviderMetadata",
+			"GetTrustStore",
+			"GetTrustStoreCertificate",
+			"GetUserAccessLoggingSettings",
+			"GetUserSettings",
+			"ListBrowserSettings",
+			"ListIdentityProviders",
+			"ListNetworkSettings",
+			"ListPortals",
+			"ListTagsForResource",
+			"ListTrustStoreCertificates",
+			"ListTrustStores",
+			"ListUserAccessLoggingSettings",
+			"ListUserSettings",
+			"TagResource",
+			"UntagResource",
+			"UpdateBrowserSettings",
+			"UpdateIdentityProvider",
+			"UpdateNetworkSettings",
+			"UpdatePortal",
+			"UpdateTrustStore",
+			"UpdateUserAccessLoggingSettings",
+			"UpdateUserSettings",
+		},
+	},
+	"xray": {
+		actions: []string{
+			"BatchGetTraces",
+			"CreateGroup",
+			"CreateSamplingRule",
+			"DeleteGroup",
+			"DeleteResourcePolicy",
+			"DeleteSamplingRule",
+			"GetEncryptionConfig",
+			"GetGroup",
+			"GetGroups",
+			"GetInsight",
+			"GetInsightEvents",
+			"GetInsightImpactGraph",
+			"GetInsightSummaries",
+			"GetSamplingRules",
+			"GetSamplingStatisticSummaries",
+			"GetSamplingTargets",
+			"GetServiceGraph",
+			"GetTimeSeriesServiceStatistics",
+			"GetTraceGraph",
+			"GetTraceSummaries",
+			"ListResourcePolicies",
+			"ListTagsForResource",
+			"PutEncryptionConfig",
+			"PutResourcePolicy",
+			"PutTelemetryRecords",
+			"PutTraceSegments",
+			"TagResource",
+			"UntagResource",
+			"UpdateGroup",
+			"UpdateSamplingRule",
+		},
+	},
+}
+
+var globalConditionKeys = []string{
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/",
+	"aws:PrincipalType",
+	"aws:referer",
+	"aws:RequestedRegion",
+	"aws:RequestTag/",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceOwner",
+	"aws:SourceVpc",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:userid",
+	"aws:username",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+}
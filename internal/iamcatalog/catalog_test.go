@@ -0,0 +1,168 @@
+package iamcatalog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckAction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		action string
+		valid  bool
+	}{
+		{"*", true},
+		{"s3:GetObject", true},
+		{"S3:getobject", true},
+		{"s3:ListBucket", true},
+		{"s3:Get*", true},
+		{"s3:*", true},
+		{"s3:Get?bject", true},
+		{"iam:PassRole", true},
+		{"cloudwatch:PutMetricData", true},
+		{"execute-api:Invoke", true},
+		{"apigateway:GET", true},
+		{"dynamodb:ConditionCheckItem", true},
+		{"codecommit:GitPull", true},
+		{"appsync:GraphQL", true},
+		{"aoss:APIAccessAll", true},
+		{"elasticache:Connect", true},
+		{"kafka-cluster:Connect", true},
+		{"sts:GetServiceBearerToken", true},
+		{"s3:${aws:username}", true},
+		{"s3*:GetObject", true},
+		{"s3:GetObjects", false},
+		{"s3:Frobnicate*", false},
+		{"s4:GetObject", false},
+		{"monitoring:PutMetricData", false},
+		{"apigateway:GetRestApis", false},
+		{"GetObject", false},
+		{"s3:", false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.action, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckAction(testCase.action)
+
+			if got, want := err == nil, testCase.valid; got != want {
+				t.Errorf("CheckAction(%q) = %v, want valid %t", testCase.action, err, want)
+			}
+		})
+	}
+}
+
+func TestCheckConditionKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		key   string
+		valid bool
+	}{
+		{"aws:SourceIp", true},
+		{"aws:sourceip", true},
+		{"aws:ResourceTag/Environment", true},
+		{"aws:PrincipalTag/${aws:username}", true},
+		{"s3:prefix", true},
+		{"s3:ExistingObjectTag/Owner", true},
+		{"kms:EncryptionContext:aws:s3:arn", true},
+		{"sts:ExternalId", true},
+		{"ec2:Region", true},
+		{"saml:aud", true},
+		{"token.actions.githubusercontent.com:sub", true},
+		{"aws:SourceIP4", false},
+		{"aws:ResourceTag/", false},
+		{"s3:prefixes", false},
+		{"kms:ViaServices", false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.key, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckConditionKey(testCase.key)
+
+			if got, want := err == nil, testCase.valid; got != want {
+				t.Errorf("CheckConditionKey(%q) = %v, want valid %t", testCase.key, err, want)
+			}
+		})
+	}
+}
+
+func TestCheckPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy string
+		want   []string
+	}{
+		"valid": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:List*"],"Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`,
+		},
+		"single statement": {
+			policy: `{"Version":"2012-10-17","Statement":{"Sid":"Read","Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}}`,
+			want: []string{
+				`statement 0 (Sid "Read"): action "s3:GetObjects" is not a known s3 action`,
+			},
+		},
+		"multiple statements": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Effect": "Deny", "NotAction": ["ec2:Frobnicate"], "Resource": "*", "Condition": {"StringNotEquals": {"aws:RequestedRegions": ["us-east-1"]}}}
+  ]
+}`,
+			want: []string{
+				`statement 1: action "ec2:Frobnicate" is not a known ec2 action`,
+				`statement 1: condition key "aws:RequestedRegions" is not a known aws condition key`,
+			},
+		},
+		"not JSON": {
+			policy: `{`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range CheckPolicy(testCase.policy) {
+				got = append(got, v.String())
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("CheckPolicy() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"*", "getobject", true},
+		{"get*", "getobject", true},
+		{"*object", "getobject", true},
+		{"g?tobject", "getobject", true},
+		{"get*tag*", "getobjecttagging", true},
+		{"put*", "getobject", false},
+		{"get?", "getobject", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := wildcardMatch(testCase.pattern, testCase.s); got != testCase.want {
+			t.Errorf("wildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, testCase.want)
+		}
+	}
+}
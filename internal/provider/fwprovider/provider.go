@@ -6,7 +6,9 @@ import (
 	"reflect"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -84,6 +87,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"retry_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.\nIn `adaptive` mode the rate of requests to a service is reduced when the service throttles requests.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(aws_sdkv2.RetryModeStandard), string(aws_sdkv2.RetryModeAdaptive)),
+				},
+			},
 			"s3_force_path_style": schema.BoolAttribute{
 				Optional:           true,
 				Description:        "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
@@ -151,7 +161,8 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
+			"assume_role": assumeRoleBlock(),
+			"assume_role_with_web_identity": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
@@ -162,15 +173,6 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
 						},
-						"duration_seconds": schema.Int64Attribute{
-							Optional:           true,
-							Description:        "The duration, in seconds, of the role session.",
-							DeprecationMessage: "Use assume_role.duration instead",
-						},
-						"external_id": schema.StringAttribute{
-							Optional:    true,
-							Description: "A unique identifier that might be required when you assume a role in another account.",
-						},
 						"policy": schema.StringAttribute{
 							Optional:    true,
 							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
@@ -188,92 +190,140 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "An identifier for the assumed role session.",
 						},
-						"source_identity": schema.StringAttribute{
-							Optional:    true,
-							Description: "Source identity specified by the principal assuming the role.",
-						},
-						"tags": schema.MapAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tags.",
+						"web_identity_token": schema.StringAttribute{
+							Optional: true,
 						},
-						"transitive_tag_keys": schema.SetAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Description: "Assume role session tag keys to pass to any subsequent sessions.",
+						"web_identity_token_file": schema.StringAttribute{
+							Optional: true,
 						},
 					},
 				},
 			},
-			"assume_role_with_web_identity": schema.ListNestedBlock{
+			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
+				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
-						"duration": schema.StringAttribute{
-							CustomType:  fwtypes.DurationType,
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
 							Optional:    true,
-							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							Description: "Resource tags to default across all resources",
 						},
-						"policy": schema.StringAttribute{
+					},
+				},
+			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration block with default operation timeouts for a resource type.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
 							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+							Description: "Default timeout for creating resources of the resource type.",
 						},
-						"policy_arns": schema.SetAttribute{
-							ElementType: types.StringType,
+						"delete": schema.StringAttribute{
 							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+							Description: "Default timeout for deleting resources of the resource type.",
 						},
-						"role_arn": schema.StringAttribute{
+						"read": schema.StringAttribute{
 							Optional:    true,
-							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
-						},
-						"session_name": schema.StringAttribute{
-							Optional:    true,
-							Description: "An identifier for the assumed role session.",
+							Description: "Default timeout for reading resources of the resource type.",
 						},
-						"web_identity_token": schema.StringAttribute{
-							Optional: true,
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type, e.g. `aws_db_instance`.",
 						},
-						"web_identity_token_file": schema.StringAttribute{
-							Optional: true,
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for updating resources of the resource type.",
 						},
 					},
 				},
 			},
-			"default_tags": schema.ListNestedBlock{
+			"endpoints": endpointsBlock(),
+			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
-				Description: "Configuration block with settings to default resource tags across all resources.",
+				Description: "Configuration block with settings to ignore resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
-						"tags": schema.MapAttribute{
+						"key_prefixes": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Resource tags to default across all resources",
+							Description: "Resource tag key prefixes to ignore across all resources.",
+						},
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys to ignore across all resources.",
 						},
 					},
 				},
 			},
-			"endpoints": endpointsBlock(),
-			"ignore_tags": schema.ListNestedBlock{
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration block with a client-side limit on the rate of AWS API requests made to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be sent to the service at once. If omitted, default value is `1`.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained rate at which requests are sent to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to rate limit. Any of the service keys in the `endpoints` configuration block can be used.",
+						},
+					},
+				},
+			},
+			"service_override": schema.ListNestedBlock{
+				Description: "Configuration block with settings that override the provider configuration for a single service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Use this to override the service endpoint URL.",
+						},
+						"region": schema.StringAttribute{
+							Optional:    true,
+							Description: "The region where the service's operations will take place.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to override. Any of the service keys in the `endpoints` configuration block can be used.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"assume_role": serviceOverrideAssumeRoleBlock(),
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
-				Description: "Configuration block with settings to ignore resource tags across all resources.",
+				Description: "Configuration block with a tagging standard that resource tags must conform to across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
-						"key_prefixes": schema.SetAttribute{
+						"allowed_value_patterns": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Resource tag key prefixes to ignore across all resources.",
+							Description: "Map of resource tag keys to regular expressions that the entire tag value must match.",
 						},
-						"keys": schema.SetAttribute{
+						"forbidden_keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "Resource tag keys to ignore across all resources.",
+							Description: "Resource tag keys that must not be present on any resource.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be present on every resource.",
 						},
 					},
 				},
@@ -343,8 +393,19 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				continue
 			}
 
+			inner, err := newWrappedResource(v, sp)
+
+			if err != nil {
+				tflog.Warn(ctx, "creating resource", map[string]interface{}{
+					"service_package_name": sp.ServicePackageName(),
+					"error":                err.Error(),
+				})
+
+				continue
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(v)
+				return inner
 			})
 		}
 	}
@@ -352,6 +413,73 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+func assumeRoleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"duration": schema.StringAttribute{
+					CustomType:  fwtypes.DurationType,
+					Optional:    true,
+					Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"duration_seconds": schema.Int64Attribute{
+					Optional:           true,
+					Description:        "The duration, in seconds, of the role session.",
+					DeprecationMessage: "Use assume_role.duration instead",
+				},
+				"external_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "A unique identifier that might be required when you assume a role in another account.",
+				},
+				"policy": schema.StringAttribute{
+					Optional:    true,
+					Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"role_arn": schema.StringAttribute{
+					Optional:    true,
+					Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+				},
+				"source_identity": schema.StringAttribute{
+					Optional:    true,
+					Description: "Source identity specified by the principal assuming the role.",
+				},
+				"tags": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tags.",
+				},
+				"transitive_tag_keys": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Assume role session tag keys to pass to any subsequent sessions.",
+				},
+			},
+		},
+	}
+}
+
+// serviceOverrideAssumeRoleBlock returns the schema of a service_override block's assume_role block.
+// The deprecated duration_seconds argument is not supported.
+func serviceOverrideAssumeRoleBlock() schema.ListNestedBlock {
+	b := assumeRoleBlock()
+
+	delete(b.NestedObject.Attributes, "duration_seconds")
+
+	return b
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -373,10 +501,15 @@ func endpointsBlock() schema.SetNestedBlock {
 type wrappedDataSource struct {
 	inner    datasource.DataSourceWithConfigure
 	typeName string
+	// Terraform type name, e.g. "aws_example_thing".
+	tfTypeName string
 }
 
 func newWrappedDataSource(inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
-	return &wrappedDataSource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+	var response datasource.MetadataResponse
+	inner.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+	return &wrappedDataSource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*"), tfTypeName: response.TypeName}
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -388,6 +521,8 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	ctx = conns.NewResourceContext(ctx, w.tfTypeName)
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
 
 	w.inner.Read(ctx, request, response)
@@ -399,15 +534,32 @@ func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.Co
 	w.inner.Configure(ctx, request, response)
 }
 
+// resourceWithDefaultTimeouts is implemented by resources that embed framework.WithTimeouts.
+type resourceWithDefaultTimeouts interface {
+	OverrideDefaultTimeouts(conns.ResourceTimeouts)
+}
+
 // wrappedResource wraps a resource, adding common functionality.
 type wrappedResource struct {
 	inner    resource.ResourceWithConfigure
 	meta     *conns.AWSClient
+	tags     *tagsInterceptor // nil if the resource is not taggable.
 	typeName string
+	// Terraform type name, e.g. "aws_example_thing".
+	tfTypeName string
 }
 
-func newWrappedResource(inner resource.ResourceWithConfigure) resource.ResourceWithConfigure {
-	return &wrappedResource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+func newWrappedResource(inner resource.ResourceWithConfigure, sp intf.ServicePackage) (resource.ResourceWithConfigure, error) {
+	var response resource.MetadataResponse
+	inner.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+	tags, err := newTagsInterceptor(inner, sp, response.TypeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &wrappedResource{inner: inner, tags: tags, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*"), tfTypeName: response.TypeName}, nil
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -420,35 +572,51 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Create enter", w.typeName))
 
 	w.inner.Create(ctx, request, response)
 
+	if w.tags != nil && !response.Diagnostics.HasError() {
+		w.tags.create(ctx, w.meta, request, response)
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Create exit", w.typeName))
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
 
 	w.inner.Read(ctx, request, response)
 
+	if w.tags != nil && !response.Diagnostics.HasError() {
+		w.tags.read(ctx, w.meta, response)
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read exit", w.typeName))
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Update enter", w.typeName))
 
+	if w.tags != nil {
+		w.tags.update(ctx, w.meta, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	w.inner.Update(ctx, request, response)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Update exit", w.typeName))
@@ -456,7 +624,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete enter", w.typeName))
@@ -472,12 +640,20 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	}
 
 	w.inner.Configure(ctx, request, response)
+
+	// Provider-level default timeouts replace the resource's own defaults, as they do for Plugin SDK resources.
+	// Timeouts configured in the resource's "timeouts" block continue to take precedence.
+	if v, ok := w.inner.(resourceWithDefaultTimeouts); ok && w.meta != nil {
+		if timeouts, ok := w.meta.DefaultTimeouts[w.tfTypeName]; ok {
+			v.OverrideDefaultTimeouts(timeouts)
+		}
+	}
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
+			ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
 		}
 
 		v.ImportState(ctx, request, response)
@@ -492,11 +668,19 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
+	if w.meta != nil {
+		ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
+	}
+
+	if w.tags != nil {
+		w.tags.modifyPlan(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
 		}
+	}
 
+	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		v.ModifyPlan(ctx, request, response)
 
 		return
@@ -514,7 +698,7 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
+			ctx = conns.NewResourceContext(w.meta.InitContext(ctx), w.tfTypeName)
 		}
 
 		v.ValidateConfig(ctx, request, response)
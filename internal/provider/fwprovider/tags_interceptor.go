@@ -0,0 +1,166 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// taggableResource is implemented by resources that embed framework.ResourceWithConfigure and framework.WithTags.
+type taggableResource interface {
+	resource.ResourceWithConfigure
+
+	TagsIdentifierAttribute() string
+	TagsOnCreate() bool
+	ExpandTags(context.Context, types.Map) tftags.KeyValueTags
+	FlattenTags(context.Context, tftags.KeyValueTags) types.Map
+	FlattenTagsAll(context.Context, tftags.KeyValueTags) types.Map
+	SetTagsAll(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse)
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}
+
+// tagsInterceptor manages the "tags" and "tags_all" attributes of a taggable resource
+// using its service package's ListTags and UpdateTags methods.
+type tagsInterceptor struct {
+	inner          taggableResource
+	servicePackage intf.ServicePackageWithTags
+	// Terraform type name, e.g. "aws_example_thing".
+	tfTypeName string
+}
+
+// newTagsInterceptor returns a tags interceptor for the specified resource.
+// Returns nil if the resource is not taggable.
+func newTagsInterceptor(inner resource.ResourceWithConfigure, sp intf.ServicePackage, tfTypeName string) (*tagsInterceptor, error) {
+	v, ok := inner.(taggableResource)
+
+	if !ok || v.TagsIdentifierAttribute() == "" {
+		return nil, nil
+	}
+
+	servicePackage, ok := sp.(intf.ServicePackageWithTags)
+
+	if !ok {
+		return nil, fmt.Errorf("%s is taggable but service package %s does not implement ListTags and UpdateTags", tfTypeName, sp.ServicePackageName())
+	}
+
+	return &tagsInterceptor{
+		inner:          v,
+		servicePackage: servicePackage,
+		tfTypeName:     tfTypeName,
+	}, nil
+}
+
+// identifier returns the value of the resource's tags identifier attribute.
+func (t *tagsInterceptor) identifier(ctx context.Context, getter attributeGetter) (string, diag.Diagnostics) {
+	var identifier types.String
+
+	diags := getter.GetAttribute(ctx, path.Root(t.inner.TagsIdentifierAttribute()), &identifier)
+
+	return identifier.ValueString(), diags
+}
+
+// modifyPlan calculates the planned value of "tags_all".
+func (t *tagsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	t.inner.SetTagsAll(ctx, request, response)
+}
+
+// create applies the planned tags to a newly created resource, unless the resource's Create has already applied them.
+func (t *tagsInterceptor) create(ctx context.Context, meta *conns.AWSClient, request resource.CreateRequest, response *resource.CreateResponse) {
+	var planTags types.Map
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	identifier, diags := t.identifier(ctx, response.State)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags := t.inner.ExpandTags(ctx, planTags)
+
+	if v := tags.IgnoreAWS().IgnoreConfig(meta.IgnoreTagsConfig); len(v) > 0 && !t.inner.TagsOnCreate() {
+		if err := t.servicePackage.UpdateTags(ctx, meta, identifier, nil, v); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding tags to %s (%s)", t.tfTypeName, identifier), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags"), planTags)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags_all"), t.inner.FlattenTagsAll(ctx, tags))...)
+}
+
+// read sets "tags" and "tags_all" from the resource's current tags.
+func (t *tagsInterceptor) read(ctx context.Context, meta *conns.AWSClient, response *resource.ReadResponse) {
+	// The resource has been removed from state.
+	if response.State.Raw.IsNull() {
+		return
+	}
+
+	identifier, diags := t.identifier(ctx, response.State)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() || identifier == "" {
+		return
+	}
+
+	apiTags, err := t.servicePackage.ListTags(ctx, meta, identifier)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for %s (%s)", t.tfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags"), t.inner.FlattenTags(ctx, apiTags))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags_all"), t.inner.FlattenTagsAll(ctx, apiTags))...)
+}
+
+// update applies any change in "tags_all" before the resource is updated.
+func (t *tagsInterceptor) update(ctx context.Context, meta *conns.AWSClient, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var oldTagsAll, newTagsAll types.Map
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("tags_all"), &oldTagsAll)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags_all"), &newTagsAll)...)
+
+	if response.Diagnostics.HasError() || newTagsAll.Equal(oldTagsAll) {
+		return
+	}
+
+	identifier, diags := t.identifier(ctx, request.State)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := t.servicePackage.UpdateTags(ctx, meta, identifier, oldTagsAll, newTagsAll); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating tags for %s (%s)", t.tfTypeName, identifier), err.Error())
+
+		return
+	}
+}
@@ -6,15 +6,20 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
@@ -242,6 +247,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with default operation timeouts for a resource type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for creating resources of the resource type.",
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for deleting resources of the resource type.",
+						},
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for reading resources of the resource type.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The resource type, e.g. `aws_db_instance`.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default timeout for updating resources of the resource type.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -311,12 +354,43 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with a client-side limit on the rate of AWS API requests made to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of requests that can be sent to the service at once. If omitted, default value is `1`.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "The sustained rate at which requests are sent to the service.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service to rate limit. Any of the service keys in the `endpoints` configuration block can be used.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.\n" +
+					"In `adaptive` mode the rate of requests to a service is reduced when the service throttles requests.",
+				ValidateFunc: validation.StringInSlice([]string{string(aws_sdkv2.RetryModeStandard), string(aws_sdkv2.RetryModeAdaptive)}, false),
+			},
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -340,6 +414,31 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_override": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings that override the provider configuration for a single service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assume_role": serviceOverrideAssumeRoleSchema(),
+						"endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Use this to override the service endpoint URL.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region where the service's operations will take place.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service to override. Any of the service keys in the `endpoints` configuration block can be used.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -398,6 +497,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a tagging standard that resource tags must conform to across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_value_patterns": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of resource tag keys to regular expressions that the entire tag value must match.",
+						},
+						"forbidden_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys that must not be present on any resource.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys that must be present on every resource.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -676,21 +805,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
-			"aws_iam_group":                   iam.DataSourceGroup(),
-			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
-			"aws_iam_instance_profiles":       iam.DataSourceInstanceProfiles(),
-			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.DataSourcePolicy(),
-			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
-			"aws_iam_role":                    iam.DataSourceRole(),
-			"aws_iam_roles":                   iam.DataSourceRoles(),
-			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
-			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":         iam.DataSourceSessionContext(),
-			"aws_iam_user":                    iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
-			"aws_iam_users":                   iam.DataSourceUsers(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_instance_profiles":           iam.DataSourceInstanceProfiles(),
+			"aws_iam_openid_connect_provider":     iam.DataSourceOpenIDConnectProvider(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_saml_provider":               iam.DataSourceSAMLProvider(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -1603,32 +1733,38 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatIntelSet(),
 
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_signing_certificate":         iam.ResourceSigningCertificate(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policies_exclusive":           iam.ResourceGroupPoliciesExclusive(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_policy_attachments_exclusive": iam.ResourceGroupPolicyAttachmentsExclusive(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSAMLProvider(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":        iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                iam.ResourceSigningCertificate(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policies_exclusive":            iam.ResourceUserPoliciesExclusive(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_policy_attachments_exclusive":  iam.ResourceUserPolicyAttachmentsExclusive(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                 iam.ResourceVirtualMFADevice(),
 
 			"aws_identitystore_group":            identitystore.ResourceGroup(),
 			"aws_identitystore_user":             identitystore.ResourceUser(),
@@ -2244,7 +2380,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			ds := v.Factory()
 
 			if v := ds.ReadWithoutTimeout; v != nil {
-				ds.ReadWithoutTimeout = wrappedReadContextFunc(v)
+				ds.ReadWithoutTimeout = wrappedReadContextFunc(typeName, v)
 			}
 
 			provider.DataSourcesMap[typeName] = ds
@@ -2261,28 +2397,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			r := v.Factory()
 
 			if v := r.CreateWithoutTimeout; v != nil {
-				r.CreateWithoutTimeout = wrappedCreateContextFunc(v)
+				r.CreateWithoutTimeout = wrappedCreateContextFunc(typeName, v)
 			}
 			if v := r.ReadWithoutTimeout; v != nil {
-				r.ReadWithoutTimeout = wrappedReadContextFunc(v)
+				r.ReadWithoutTimeout = wrappedReadContextFunc(typeName, v)
 			}
 			if v := r.UpdateWithoutTimeout; v != nil {
-				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(v)
+				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(typeName, v)
 			}
 			if v := r.DeleteWithoutTimeout; v != nil {
-				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(v)
+				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(typeName, v)
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = wrappedStateContextFunc(v)
+					r.Importer.StateContext = wrappedStateContextFunc(typeName, v)
 				}
 			}
 			if v := r.CustomizeDiff; v != nil {
-				r.CustomizeDiff = wrappedCustomizeDiffFunc(v)
+				r.CustomizeDiff = wrappedCustomizeDiffFunc(typeName, v)
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
-					stateUpgrader.Upgrade = wrappedStateUpgradeFunc(v)
+					stateUpgrader.Upgrade = wrappedStateUpgradeFunc(typeName, v)
 				}
 			}
 
@@ -2360,6 +2496,25 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		defaultTimeouts, err := expandDefaultTimeouts(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		var servicePackages []intf.ServicePackage
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			servicePackages = v.ServicePackages
+		}
+
+		if err := checkDefaultTimeoutsResourceTypes(ctx, defaultTimeouts, provider.ResourcesMap, servicePackages); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.DefaultTimeouts = defaultTimeouts
+	}
+
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
 		endpoints, err := expandEndpoints(v.(*schema.Set).List())
 
@@ -2382,6 +2537,36 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retry_mode"); ok {
+		retryMode, err := aws_sdkv2.ParseRetryMode(v.(string))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RetryMode = retryMode
+	}
+
+	if v, ok := d.GetOk("service_override"); ok && len(v.([]interface{})) > 0 {
+		serviceOverrides, err := expandServiceOverrides(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ServiceOverrides = serviceOverrides
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2392,6 +2577,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.SharedConfigFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicy, err := expandTagPolicy(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.TagPolicyConfig = tagPolicy
+	}
+
 	if v, null, _ := nullable.Bool(d.Get("skip_metadata_api_check").(string)).Value(); !null {
 		if v {
 			config.EC2MetadataServiceEnableState = imds.ClientDisabled
@@ -2412,6 +2607,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		return nil, diags
 	}
 
+	setDefaultTimeouts(provider.ResourcesMap, meta.DefaultTimeouts)
+
 	// Configure each service.
 	for _, v := range meta.ServicePackages {
 		if err := v.Configure(ctx, meta); err != nil {
@@ -2426,6 +2623,82 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+// setDefaultTimeouts replaces the default operation timeouts of the specified resource types.
+// Only operations for which a resource type already declares a timeout are changed.
+// Timeouts configured in a resource's "timeouts" block continue to take precedence.
+func setDefaultTimeouts(resources map[string]*schema.Resource, defaultTimeouts map[string]conns.ResourceTimeouts) {
+	for typeName, v := range defaultTimeouts {
+		r, ok := resources[typeName]
+
+		if !ok || r.Timeouts == nil {
+			continue
+		}
+
+		// Copy so that any timeouts shared between resource types are not modified.
+		timeouts := *r.Timeouts
+
+		if v.Create > 0 && timeouts.Create != nil {
+			timeouts.Create = durationPointer(v.Create)
+		}
+		if v.Read > 0 && timeouts.Read != nil {
+			timeouts.Read = durationPointer(v.Read)
+		}
+		if v.Update > 0 && timeouts.Update != nil {
+			timeouts.Update = durationPointer(v.Update)
+		}
+		if v.Delete > 0 && timeouts.Delete != nil {
+			timeouts.Delete = durationPointer(v.Delete)
+		}
+
+		r.Timeouts = &timeouts
+	}
+}
+
+// checkDefaultTimeoutsResourceTypes returns an error if default timeouts are configured for a type that is not
+// a resource type of the provider, either Plugin SDK or Plugin Framework.
+func checkDefaultTimeoutsResourceTypes(ctx context.Context, defaultTimeouts map[string]conns.ResourceTimeouts, resources map[string]*schema.Resource, servicePackages []intf.ServicePackage) error {
+	typeNames := make(map[string]struct{})
+
+	for typeName := range resources {
+		typeNames[typeName] = struct{}{}
+	}
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v(ctx)
+
+			if err != nil {
+				continue
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			typeNames[response.TypeName] = struct{}{}
+		}
+	}
+
+	var unknown []string
+
+	for typeName := range defaultTimeouts {
+		if _, ok := typeNames[typeName]; !ok {
+			unknown = append(unknown, typeName)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return fmt.Errorf("default_timeouts: unknown resource type(s): %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+func durationPointer(d time.Duration) *time.Duration {
+	return &d
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -2507,6 +2780,18 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+// serviceOverrideAssumeRoleSchema returns the schema of a service_override block's assume_role block.
+// The deprecated duration_seconds argument is not supported.
+func serviceOverrideAssumeRoleSchema() *schema.Schema {
+	s := assumeRoleSchema()
+	elem := s.Elem.(*schema.Resource)
+
+	delete(elem.Schema, "duration_seconds")
+	elem.Schema["duration"].ConflictsWith = nil
+
+	return s
+}
+
 func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -2584,6 +2869,123 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandDefaultTimeouts(tfList []interface{}) (map[string]conns.ResourceTimeouts, error) {
+	defaultTimeouts := make(map[string]conns.ResourceTimeouts)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		typeName := tfMap["resource_type"].(string)
+
+		if _, ok := defaultTimeouts[typeName]; ok {
+			return nil, fmt.Errorf("default_timeouts: duplicate default timeouts for resource type %s", typeName)
+		}
+
+		var timeouts conns.ResourceTimeouts
+
+		for key, timeout := range map[string]*time.Duration{
+			"create": &timeouts.Create,
+			"read":   &timeouts.Read,
+			"update": &timeouts.Update,
+			"delete": &timeouts.Delete,
+		} {
+			v, ok := tfMap[key].(string)
+
+			if !ok || v == "" {
+				continue
+			}
+
+			duration, err := time.ParseDuration(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("default_timeouts: %s timeout for resource type %s: %w", key, typeName, err)
+			}
+
+			*timeout = duration
+		}
+
+		defaultTimeouts[typeName] = timeouts
+	}
+
+	return defaultTimeouts, nil
+}
+
+func expandRateLimits(tfList []interface{}) (map[string]conns.RateLimit, error) {
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit: %w", err)
+		}
+
+		if _, ok := rateLimits[pkg]; ok {
+			return nil, fmt.Errorf("rate_limit: duplicate rate limit for service %s", alias)
+		}
+
+		rateLimit := conns.RateLimit{
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			Burst:             tfMap["burst"].(int),
+		}
+
+		if rateLimit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("rate_limit: requests_per_second for service %s must be greater than 0", alias)
+		}
+
+		rateLimits[pkg] = rateLimit
+	}
+
+	return rateLimits, nil
+}
+
+func expandServiceOverrides(tfList []interface{}) (map[string]*conns.ServiceOverride, error) {
+	serviceOverrides := make(map[string]*conns.ServiceOverride)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("service_override: %w", err)
+		}
+
+		if _, ok := serviceOverrides[pkg]; ok {
+			return nil, fmt.Errorf("service_override: duplicate override for service %s", alias)
+		}
+
+		serviceOverride := &conns.ServiceOverride{
+			Endpoint: tfMap["endpoint"].(string),
+			Region:   tfMap["region"].(string),
+		}
+
+		if v, ok := tfMap["assume_role"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			serviceOverride.AssumeRole = expandAssumeRole(v[0].(map[string]interface{}))
+		}
+
+		serviceOverrides[pkg] = serviceOverride
+	}
+
+	return serviceOverrides, nil
+}
+
 func expandAssumeRole(tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -2706,6 +3108,45 @@ func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandTagPolicy(tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["allowed_value_patterns"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.AllowedValuePatterns = make(map[string]*regexp.Regexp, len(v))
+
+		for key, pattern := range v {
+			// The entire tag value must match.
+			re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern.(string)))
+
+			if err != nil {
+				return nil, fmt.Errorf("tag_policy: allowed_value_patterns: invalid pattern for tag key %q: %w", key, err)
+			}
+
+			policyConfig.AllowedValuePatterns[key] = re
+		}
+	}
+
+	if v, ok := tfMap["forbidden_keys"].(*schema.Set); ok {
+		policyConfig.ForbiddenKeys = tftags.New(v.List())
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok {
+		policyConfig.RequiredKeys = tftags.New(v.List())
+	}
+
+	for key := range policyConfig.RequiredKeys {
+		if policyConfig.ForbiddenKeys.KeyExists(key) {
+			return nil, fmt.Errorf("tag_policy: tag key %q is both required and forbidden", key)
+		}
+	}
+
+	return policyConfig, nil
+}
+
 func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -2759,57 +3200,57 @@ func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	return endpoints, nil
 }
 
-func wrappedCreateContextFunc(f schema.CreateContextFunc) schema.CreateContextFunc {
+func wrappedCreateContextFunc(typeName string, f schema.CreateContextFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedReadContextFunc(f schema.ReadContextFunc) schema.ReadContextFunc {
+func wrappedReadContextFunc(typeName string, f schema.ReadContextFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedUpdateContextFunc(f schema.UpdateContextFunc) schema.UpdateContextFunc {
+func wrappedUpdateContextFunc(typeName string, f schema.UpdateContextFunc) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedDeleteContextFunc(f schema.DeleteContextFunc) schema.DeleteContextFunc {
+func wrappedDeleteContextFunc(typeName string, f schema.DeleteContextFunc) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
+func wrappedStateContextFunc(typeName string, f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+func wrappedCustomizeDiffFunc(typeName string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedStateUpgradeFunc(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
+func wrappedStateUpgradeFunc(typeName string, f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx = conns.NewResourceContext(meta.(*conns.AWSClient).InitContext(ctx), typeName)
 
 		return f(ctx, rawState, meta)
 	}
@@ -3,9 +3,18 @@ package provider
 import (
 	"context"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -25,6 +34,48 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// TestFrameworkResources verifies that every Plugin Framework resource can be wrapped by the provider.
+// In particular, the service package of each taggable resource must implement ListTags and UpdateTags.
+func TestFrameworkResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected int
+
+	for _, sp := range servicePackages(ctx) {
+		_, withTags := sp.(intf.ServicePackageWithTags)
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			expected++
+
+			r, err := v(ctx)
+
+			if err != nil {
+				t.Errorf("creating %s resource: %s", sp.ServicePackageName(), err)
+
+				continue
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			if v, ok := r.(interface{ TagsIdentifierAttribute() string }); ok && v.TagsIdentifierAttribute() != "" && !withTags {
+				t.Errorf("%s is taggable but service package %s does not implement ListTags and UpdateTags", response.TypeName, sp.ServicePackageName())
+			}
+		}
+	}
+
+	if got := len(fwprovider.New(p).Resources(ctx)); got != expected {
+		t.Errorf("incorrect number of Plugin Framework resources. Expected: %d, got: %d", expected, got)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -49,6 +100,224 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	results, err := expandDefaultTimeouts([]interface{}{
+		map[string]interface{}{"resource_type": "aws_db_instance", "create": "90m", "read": "", "update": "2h", "delete": ""},
+		map[string]interface{}{"resource_type": "aws_eks_node_group", "create": "", "read": "", "update": "", "delete": "1h"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]conns.ResourceTimeouts{
+		"aws_db_instance":    {Create: 90 * time.Minute, Update: 2 * time.Hour},
+		"aws_eks_node_group": {Delete: time.Hour},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	for _, tfList := range [][]interface{}{
+		{map[string]interface{}{"resource_type": "aws_db_instance", "create": "90", "read": "", "update": "", "delete": ""}},
+		{
+			map[string]interface{}{"resource_type": "aws_db_instance", "create": "90m", "read": "", "update": "", "delete": ""},
+			map[string]interface{}{"resource_type": "aws_db_instance", "create": "", "read": "", "update": "", "delete": "90m"},
+		},
+	} {
+		if _, err := expandDefaultTimeouts(tfList); err == nil {
+			t.Errorf("Expected error for %v", tfList)
+		}
+	}
+}
+
+func TestSetDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	shared := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(40 * time.Minute),
+		Delete: schema.DefaultTimeout(60 * time.Minute),
+	}
+	resources := map[string]*schema.Resource{
+		"aws_db_instance":    {Timeouts: shared},
+		"aws_rds_cluster":    {Timeouts: shared},
+		"aws_security_group": {},
+	}
+
+	setDefaultTimeouts(resources, map[string]conns.ResourceTimeouts{
+		"aws_db_instance":    {Create: 2 * time.Hour, Update: time.Hour},
+		"aws_security_group": {Create: time.Hour},
+		"aws_not_a_resource": {Create: time.Hour},
+	})
+
+	if got, expected := *resources["aws_db_instance"].Timeouts.Create, 2*time.Hour; got != expected {
+		t.Errorf("Expected Create timeout %s, got %s", expected, got)
+	}
+
+	if got, expected := *resources["aws_db_instance"].Timeouts.Delete, 60*time.Minute; got != expected {
+		t.Errorf("Expected Delete timeout %s, got %s", expected, got)
+	}
+
+	if got := resources["aws_db_instance"].Timeouts.Update; got != nil {
+		t.Errorf("Expected no Update timeout, got %s", *got)
+	}
+
+	if got, expected := *resources["aws_rds_cluster"].Timeouts.Create, 40*time.Minute; got != expected {
+		t.Errorf("Expected shared Create timeout %s, got %s", expected, got)
+	}
+
+	if got := resources["aws_security_group"].Timeouts; got != nil {
+		t.Errorf("Expected no timeouts, got %v", got)
+	}
+}
+
+func TestCheckDefaultTimeoutsResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	servicePackages := servicePackages(ctx)
+
+	if err := checkDefaultTimeoutsResourceTypes(ctx, map[string]conns.ResourceTimeouts{
+		"aws_db_instance":             {Create: time.Hour},
+		"aws_resourceexplorer2_index": {Create: time.Hour},
+	}, p.ResourcesMap, servicePackages); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	for _, typeName := range []string{"aws_not_a_resource", "aws_db_instances"} {
+		if err := checkDefaultTimeoutsResourceTypes(ctx, map[string]conns.ResourceTimeouts{
+			"aws_db_instance": {Create: time.Hour},
+			typeName:          {Create: time.Hour},
+		}, p.ResourcesMap, servicePackages); err == nil {
+			t.Errorf("Expected error for %s", typeName)
+		}
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	results, err := expandRateLimits([]interface{}{
+		map[string]interface{}{"service": "ec2", "requests_per_second": 10.0, "burst": 20},
+		map[string]interface{}{"service": "cloudwatchlogs", "requests_per_second": 0.5, "burst": 0},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]conns.RateLimit{
+		names.EC2:  {RequestsPerSecond: 10, Burst: 20},
+		names.Logs: {RequestsPerSecond: 0.5},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	for _, tfList := range [][]interface{}{
+		{map[string]interface{}{"service": "notaservice", "requests_per_second": 1.0, "burst": 0}},
+		{map[string]interface{}{"service": "ec2", "requests_per_second": 0.0, "burst": 0}},
+		{
+			map[string]interface{}{"service": "logs", "requests_per_second": 1.0, "burst": 0},
+			map[string]interface{}{"service": "cloudwatchlogs", "requests_per_second": 1.0, "burst": 0},
+		},
+	} {
+		if _, err := expandRateLimits(tfList); err == nil {
+			t.Errorf("Expected error for %v", tfList)
+		}
+	}
+}
+
+func TestExpandServiceOverrides(t *testing.T) {
+	t.Parallel()
+
+	results, err := expandServiceOverrides([]interface{}{
+		map[string]interface{}{
+			"service":  "route53",
+			"endpoint": "",
+			"region":   "",
+			"assume_role": []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/dns", "session_name": "dns"},
+			},
+		},
+		map[string]interface{}{
+			"service":     "s3",
+			"endpoint":    "https://s3.fake.test",
+			"region":      "eu-west-1", // lintignore:AWSAT003
+			"assume_role": []interface{}{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]*conns.ServiceOverride{
+		names.Route53: {
+			AssumeRole: &awsbase.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/dns", SessionName: "dns"},
+		},
+		names.S3: {
+			Endpoint: "https://s3.fake.test",
+			Region:   "eu-west-1", // lintignore:AWSAT003
+		},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	_, err = expandServiceOverrides([]interface{}{
+		map[string]interface{}{"service": "s3", "endpoint": "", "region": ""},
+		map[string]interface{}{"service": "s3", "endpoint": "", "region": ""},
+	})
+	if err == nil {
+		t.Error("Expected error for duplicate service")
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	result, err := expandTagPolicy(map[string]interface{}{
+		"allowed_value_patterns": map[string]interface{}{"env": "dev|prod"},
+		"forbidden_keys":         schema.NewSet(schema.HashString, []interface{}{"owner"}),
+		"required_keys":          schema.NewSet(schema.HashString, []interface{}{"env"}),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if got, expected := result.AllowedValuePatterns["env"].String(), "^(?:dev|prod)$"; got != expected {
+		t.Errorf("Expected pattern %q, got %q", expected, got)
+	}
+
+	if got := result.Violations(tftags.New(map[string]string{"env": "production"})); len(got) != 1 {
+		t.Errorf("Expected 1 violation, got %q", got)
+	}
+
+	_, err = expandTagPolicy(map[string]interface{}{
+		"allowed_value_patterns": map[string]interface{}{"env": "("},
+	})
+	if err == nil {
+		t.Error("Expected error for invalid pattern")
+	}
+
+	_, err = expandTagPolicy(map[string]interface{}{
+		"forbidden_keys": schema.NewSet(schema.HashString, []interface{}{"env"}),
+		"required_keys":  schema.NewSet(schema.HashString, []interface{}{"env"}),
+	})
+	if err == nil {
+		t.Error("Expected error for key both required and forbidden")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	testcases := []struct {
 		endpoints        map[string]string
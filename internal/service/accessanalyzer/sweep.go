@@ -4,26 +4,25 @@
 package accessanalyzer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_accessanalyzer_analyzer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_accessanalyzer_analyzer", &sweep.Sweeper{
 		Name: "aws_accessanalyzer_analyzer",
 		F:    sweepAnalyzers,
 	})
 }
 
-func sweepAnalyzers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAnalyzers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,19 +4,19 @@
 package acm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &sweep.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -40,9 +40,8 @@ func init() {
 	})
 }
 
-func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCertificates(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
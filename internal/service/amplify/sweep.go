@@ -4,26 +4,25 @@
 package amplify
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/amplify"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_amplify_app", &sweep.Sweeper{
 		Name: "aws_amplify_app",
 		F:    sweepApps,
 	})
 }
 
-func sweepApps(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepApps(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package apigateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -18,27 +19,27 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &sweep.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &sweep.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_client_certificate", &sweep.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_usage_plan", &sweep.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_api_key", &sweep.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -46,15 +47,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_domain_name", &sweep.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
 }
 
-func sweepRestAPIs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRestAPIs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -95,9 +95,8 @@ func sweepRestAPIs(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -133,9 +132,8 @@ func sweepVPCLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClientCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClientCertificates(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -176,9 +174,8 @@ func sweepClientCertificates(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepUsagePlans(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -224,9 +221,8 @@ func sweepUsagePlans(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAPIKeys(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -271,9 +267,8 @@ func sweepAPIKeys(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDomainNames(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -4,18 +4,18 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -23,20 +23,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
 }
 
-func sweepAPIs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAPIs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -78,9 +77,8 @@ func sweepAPIs(region string) error {
 	return nil
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDomainNames(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -121,9 +119,8 @@ func sweepDomainNames(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
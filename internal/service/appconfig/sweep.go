@@ -4,6 +4,7 @@
 package appconfig
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appconfig_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_application", &sweep.Sweeper{
 		Name: "aws_appconfig_application",
 		F:    sweepApplications,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_configuration_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_configuration_profile", &sweep.Sweeper{
 		Name: "aws_appconfig_configuration_profile",
 		F:    sweepConfigurationProfiles,
 		Dependencies: []string{
@@ -34,25 +34,24 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_deployment_strategy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_deployment_strategy", &sweep.Sweeper{
 		Name: "aws_appconfig_deployment_strategy",
 		F:    sweepDeploymentStrategies,
 	})
 
-	resource.AddTestSweepers("aws_appconfig_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_environment", &sweep.Sweeper{
 		Name: "aws_appconfig_environment",
 		F:    sweepEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_appconfig_hosted_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appconfig_hosted_configuration_version", &sweep.Sweeper{
 		Name: "aws_appconfig_hosted_configuration_version",
 		F:    sweepHostedConfigurationVersions,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepApplications(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -103,9 +102,8 @@ func sweepApplications(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConfigurationProfiles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConfigurationProfiles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -180,9 +178,8 @@ func sweepConfigurationProfiles(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepDeploymentStrategies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDeploymentStrategies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -239,9 +236,8 @@ func sweepDeploymentStrategies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepEnvironments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEnvironments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -316,9 +312,8 @@ func sweepEnvironments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepHostedConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHostedConfigurationVersions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -4,24 +4,24 @@
 package appmesh
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &sweep.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_mesh", &sweep.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -32,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_route", &sweep.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -45,12 +45,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -58,15 +58,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &sweep.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
 }
 
-func sweepMeshes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMeshes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -108,9 +107,8 @@ func sweepMeshes(region string) error {
 	return nil
 }
 
-func sweepVirtualGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVirtualGateways(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -183,9 +181,8 @@ func sweepVirtualGateways(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualNodes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVirtualNodes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -254,9 +251,8 @@ func sweepVirtualNodes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualRouters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVirtualRouters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -325,9 +321,8 @@ func sweepVirtualRouters(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVirtualServices(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -396,9 +391,8 @@ func sweepVirtualServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayRoutes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGatewayRoutes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -492,9 +486,8 @@ func sweepGatewayRoutes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoutes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRoutes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
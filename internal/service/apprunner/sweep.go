@@ -4,39 +4,38 @@
 package apprunner
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &sweep.Sweeper{
 		Name:         "aws_apprunner_auto_scaling_configuration_version",
 		F:            sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_connection", &sweep.Sweeper{
 		Name:         "aws_apprunner_connection",
 		F:            sweepConnections,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_service", &sweep.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
 }
 
-func sweepAutoScalingConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAutoScalingConfigurationVersions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -100,9 +99,8 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnections(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -161,9 +159,8 @@ func sweepConnections(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServices(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
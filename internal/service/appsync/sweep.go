@@ -4,24 +4,24 @@
 package appsync
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_graphql_api", &sweep.Sweeper{
 		Name: "aws_appsync_graphql_api",
 		F:    sweepGraphQLAPIs,
 	})
 
-	resource.AddTestSweepers("aws_appsync_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_domain_name", &sweep.Sweeper{
 		Name: "aws_appsync_domain_name",
 		F:    sweepDomainNames,
 		Dependencies: []string{
@@ -29,15 +29,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appsync_domain_name_api_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_domain_name_api_association", &sweep.Sweeper{
 		Name: "aws_appsync_domain_name_api_association",
 		F:    sweepDomainNameAssociations,
 	})
 }
 
-func sweepGraphQLAPIs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGraphQLAPIs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -91,9 +90,8 @@ func sweepGraphQLAPIs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDomainNames(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -147,9 +145,8 @@ func sweepDomainNames(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepDomainNameAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDomainNameAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package auditmanager
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,13 +13,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment", &sweep.Sweeper{
 		Name: "aws_auditmanager_assessment",
 		F:    sweepAssessments,
 		Dependencies: []string{
@@ -28,11 +28,11 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
-	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_control", &sweep.Sweeper{
 		Name: "aws_auditmanager_control",
 		F:    sweepControls,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework", &sweep.Sweeper{
 		Name: "aws_auditmanager_framework",
 		F:    sweepFrameworks,
 	})
@@ -51,9 +51,8 @@ func isCompleteSetupError(err error) bool {
 	return false
 }
 
-func sweepAssessments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAssessments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -94,9 +93,8 @@ func sweepAssessments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepControls(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepControls(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -137,9 +135,8 @@ func sweepControls(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFrameworks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,32 +4,31 @@
 package autoscaling
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_autoscaling_group", &sweep.Sweeper{
 		Name: "aws_autoscaling_group",
 		F:    sweepGroups,
 	})
 
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_configuration", &sweep.Sweeper{
 		Name:         "aws_launch_configuration",
 		F:            sweepLaunchConfigurations,
 		Dependencies: []string{"aws_autoscaling_group"},
 	})
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -72,9 +71,8 @@ func sweepGroups(region string) error {
 	return nil
 }
 
-func sweepLaunchConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLaunchConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,26 +4,25 @@
 package autoscalingplans
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscalingplans"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_autoscalingplans_scaling_plan", &sweep.Sweeper{
 		Name: "aws_autoscalingplans_scaling_plan",
 		F:    sweepScalingPlans,
 	})
 }
 
-func sweepScalingPlans(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepScalingPlans(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,24 +4,24 @@
 package budgets
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/budgets"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget_action", &sweep.Sweeper{
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActions,
 	})
 
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget", &sweep.Sweeper{
 		Name: "aws_budgets_budget",
 		F:    sweepBudgets,
 		Dependencies: []string{
@@ -30,9 +30,8 @@ func init() {
 	})
 }
 
-func sweepBudgetActions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBudgetActions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -77,9 +76,8 @@ func sweepBudgetActions(region string) error {
 	return nil
 }
 
-func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBudgets(ctx context.Context, region string) error { // nosemgrep:ci.budgets-in-func-name
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,24 +4,24 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set_instance", &sweep.Sweeper{
 		Name: "aws_cloudformation_stack_set_instance",
 		F:    sweepStackSetInstances,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set", &sweep.Sweeper{
 		Name: "aws_cloudformation_stack_set",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -29,7 +29,7 @@ func init() {
 		F: sweepStackSets,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack", &sweep.Sweeper{
 		Name: "aws_cloudformation_stack",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -38,9 +38,8 @@ func init() {
 	})
 }
 
-func sweepStackSetInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStackSetInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -112,9 +111,8 @@ func sweepStackSetInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepStackSets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStackSets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -158,9 +156,8 @@ func sweepStackSets(region string) error {
 	return nil
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStacks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,20 +4,20 @@
 package cloudfront
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_cache_policy", &sweep.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -25,17 +25,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &sweep.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &sweep.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &sweep.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -43,22 +43,22 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_function", &sweep.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_key_group", &sweep.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_monitoring_subscription", &sweep.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_access_control", &sweep.Sweeper{
 		Name: "aws_cloudfront_origin_access_control",
 		F:    sweepOriginAccessControls,
 		Dependencies: []string{
@@ -66,7 +66,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_request_policy", &sweep.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -74,12 +74,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_realtime_log_config", &sweep.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_response_headers_policy", &sweep.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -88,9 +88,8 @@ func init() {
 	})
 }
 
-func sweepCachePolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCachePolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -148,9 +147,8 @@ func sweepCachePolicies(region string) error {
 	return nil
 }
 
-func sweepDistributions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDistributions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -206,9 +204,8 @@ func sweepDistributions(region string) error {
 	return nil
 }
 
-func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFunctions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -264,9 +261,8 @@ func sweepFunctions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepKeyGroup(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepKeyGroup(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
@@ -324,9 +320,8 @@ func sweepKeyGroup(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMonitoringSubscriptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMonitoringSubscriptions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -373,9 +368,8 @@ func sweepMonitoringSubscriptions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRealtimeLogsConfig(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRealtimeLogsConfig(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -421,9 +415,8 @@ func sweepRealtimeLogsConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFieldLevelEncryptionConfigs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFieldLevelEncryptionConfigs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -479,9 +472,8 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 	return nil
 }
 
-func sweepFieldLevelEncryptionProfiles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFieldLevelEncryptionProfiles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -537,9 +529,8 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 	return nil
 }
 
-func sweepOriginRequestPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOriginRequestPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -597,9 +588,8 @@ func sweepOriginRequestPolicies(region string) error {
 	return nil
 }
 
-func sweepResponseHeadersPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepResponseHeadersPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -657,9 +647,8 @@ func sweepResponseHeadersPolicies(region string) error {
 	return nil
 }
 
-func sweepOriginAccessControls(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOriginAccessControls(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,32 +4,31 @@
 package cloudhsmv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_cluster", &sweep.Sweeper{
 		Name:         "aws_cloudhsm_v2_cluster",
 		F:            sweepClusters,
 		Dependencies: []string{"aws_cloudhsm_v2_hsm"},
 	})
 
-	resource.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudhsm_v2_hsm", &sweep.Sweeper{
 		Name: "aws_cloudhsm_v2_hsm",
 		F:    sweepHSMs,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -74,9 +73,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepHSMs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHSMs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,32 +4,31 @@
 package codeartifact
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_domain", &sweep.Sweeper{
 		Name: "aws_codeartifact_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_repository", &sweep.Sweeper{
 		Name: "aws_codeartifact_repository",
 		F:    sweepRepositories,
 	})
 }
 
-func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDomains(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -74,9 +73,8 @@ func sweepDomains(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRepositories(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRepositories(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,37 +4,36 @@
 package codebuild
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_report_group", &sweep.Sweeper{
 		Name: "aws_codebuild_report_group",
 		F:    sweepReportGroups,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_project", &sweep.Sweeper{
 		Name: "aws_codebuild_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_source_credential", &sweep.Sweeper{
 		Name: "aws_codebuild_source_credential",
 		F:    sweepSourceCredentials,
 	})
 }
 
-func sweepReportGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepReportGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -77,9 +76,8 @@ func sweepReportGroups(region string) error {
 	return nil
 }
 
-func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProjects(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -120,9 +118,8 @@ func sweepProjects(region string) error {
 	return nil
 }
 
-func sweepSourceCredentials(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSourceCredentials(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
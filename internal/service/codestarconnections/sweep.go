@@ -4,23 +4,23 @@
 package codestarconnections
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_connection", &sweep.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_host", &sweep.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -29,9 +29,8 @@ func init() {
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnections(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -73,9 +72,8 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHosts(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
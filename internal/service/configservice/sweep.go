@@ -4,6 +4,7 @@
 package configservice
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -17,22 +18,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &sweep.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &sweep.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &sweep.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_delivery_channel", &sweep.Sweeper{
 		Name: "aws_config_delivery_channel",
 		Dependencies: []string{
 			"aws_config_configuration_recorder",
@@ -41,9 +42,8 @@ func init() {
 	})
 }
 
-func sweepAggregateAuthorizations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAggregateAuthorizations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -79,9 +79,8 @@ func sweepAggregateAuthorizations(region string) error {
 	return nil
 }
 
-func sweepConfigurationAggregators(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConfigurationAggregators(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -118,9 +117,8 @@ func sweepConfigurationAggregators(region string) error {
 	return nil
 }
 
-func sweepConfigurationRecorder(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConfigurationRecorder(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -162,9 +160,8 @@ func sweepConfigurationRecorder(region string) error {
 	return nil
 }
 
-func sweepDeliveryChannels(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDeliveryChannels(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package datasync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,66 +13,64 @@ import (
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &sweep.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_efs", &sweep.Sweeper{
 		Name: "aws_datasync_location_efs",
 		F:    sweepLocationEFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &sweep.Sweeper{
 		Name: "aws_datasync_location_fsx_windows_file_system",
 		F:    sweepLocationFSxWindows,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &sweep.Sweeper{
 		Name: "aws_datasync_location_fsx_lustre_file_system",
 		F:    sweepLocationFSxLustres,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_nfs", &sweep.Sweeper{
 		Name: "aws_datasync_location_nfs",
 		F:    sweepLocationNFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_s3", &sweep.Sweeper{
 		Name: "aws_datasync_location_s3",
 		F:    sweepLocationS3s,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_smb", &sweep.Sweeper{
 		Name: "aws_datasync_location_smb",
 		F:    sweepLocationSMBs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_hdfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_hdfs", &sweep.Sweeper{
 		Name: "aws_datasync_location_hdfs",
 		F:    sweepLocationHDFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_object_storage", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_object_storage", &sweep.Sweeper{
 		Name: "aws_datasync_location_object_storage",
 		F:    sweepLocationObjectStorages,
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &sweep.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
 }
 
-func sweepAgents(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAgents(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -124,9 +123,8 @@ func sweepAgents(region string) error {
 	return nil
 }
 
-func sweepLocationEFSs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationEFSs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -182,9 +180,8 @@ func sweepLocationEFSs(region string) error {
 	return nil
 }
 
-func sweepLocationFSxWindows(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationFSxWindows(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -240,9 +237,8 @@ func sweepLocationFSxWindows(region string) error {
 	return nil
 }
 
-func sweepLocationFSxLustres(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationFSxLustres(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -297,9 +293,8 @@ func sweepLocationFSxLustres(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLocationNFSs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationNFSs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -355,9 +350,8 @@ func sweepLocationNFSs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLocationS3s(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationS3s(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -413,9 +407,8 @@ func sweepLocationS3s(region string) error {
 	return nil
 }
 
-func sweepLocationSMBs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationSMBs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -471,9 +464,8 @@ func sweepLocationSMBs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLocationHDFSs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationHDFSs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -529,9 +521,8 @@ func sweepLocationHDFSs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLocationObjectStorages(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLocationObjectStorages(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -587,9 +578,8 @@ func sweepLocationObjectStorages(region string) error {
 	return nil
 }
 
-func sweepTasks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTasks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
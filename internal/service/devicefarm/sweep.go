@@ -4,32 +4,31 @@
 package devicefarm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_devicefarm_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_devicefarm_project", &sweep.Sweeper{
 		Name: "aws_devicefarm_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_devicefarm_test_grid_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_devicefarm_test_grid_project", &sweep.Sweeper{
 		Name: "aws_devicefarm_test_grid_project",
 		F:    sweepTestGridProjects,
 	})
 }
 
-func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProjects(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -82,9 +81,8 @@ func sweepProjects(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTestGridProjects(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTestGridProjects(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
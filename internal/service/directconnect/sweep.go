@@ -4,6 +4,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,23 +13,22 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &sweep.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &sweep.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &sweep.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &sweep.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -44,22 +44,21 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &sweep.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &sweep.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnections(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -112,9 +111,8 @@ func sweepConnections(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayAssociationProposals(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGatewayAssociationProposals(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -169,9 +167,8 @@ func sweepGatewayAssociationProposals(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGatewayAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -306,9 +303,8 @@ func sweepGatewayAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGateways(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -391,9 +387,8 @@ func sweepGateways(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLags(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLags(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -446,9 +441,8 @@ func sweepLags(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMacSecKeys(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMacSecKeys(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,28 +4,27 @@
 package dlm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dlm"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dlm_lifecycle_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dlm_lifecycle_policy", &sweep.Sweeper{
 		Name: "aws_dlm_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
 
 }
 
-func sweepLifecyclePolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLifecyclePolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -4,19 +4,19 @@
 package dms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &sweep.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -24,20 +24,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &sweep.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
 
-	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_endpoint", &sweep.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
 	})
 }
 
-func sweepReplicationInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepReplicationInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -76,9 +75,8 @@ func sweepReplicationInstances(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepReplicationTasks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepReplicationTasks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -120,9 +118,8 @@ func sweepReplicationTasks(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEndpoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,6 +4,7 @@
 package docdb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_global_cluster", &sweep.Sweeper{
 		Name: "aws_docdb_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_subnet_group", &sweep.Sweeper{
 		Name: "aws_docdb_subnet_group",
 		F:    sweepDBSubnetGroups,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_event_subscription", &sweep.Sweeper{
 		Name: "aws_docdb_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster", &sweep.Sweeper{
 		Name: "aws_docdb_cluster",
 		F:    sweepDBClusters,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_snapshot", &sweep.Sweeper{
 		Name: "aws_docdb_cluster_snapshot",
 		F:    sweepDBClusterSnapshots,
 		Dependencies: []string{
@@ -55,12 +55,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_instance", &sweep.Sweeper{
 		Name: "aws_docdb_cluster_instance",
 		F:    sweepDBInstances,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_parameter_group", &sweep.Sweeper{
 		Name: "aws_docdb_cluster_parameter_group",
 		F:    sweepDBClusterParameterGroups,
 		Dependencies: []string{
@@ -69,9 +69,8 @@ func init() {
 	})
 }
 
-func sweepDBClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDBClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -116,9 +115,8 @@ func sweepDBClusters(region string) error {
 	return nil
 }
 
-func sweepDBClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDBClusterSnapshots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -162,9 +160,8 @@ func sweepDBClusterSnapshots(region string) error {
 	return nil
 }
 
-func sweepDBClusterParameterGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDBClusterParameterGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -209,9 +206,8 @@ func sweepDBClusterParameterGroups(region string) error {
 	return nil
 }
 
-func sweepDBInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDBInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -254,9 +250,8 @@ func sweepDBInstances(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGlobalClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGlobalClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -300,9 +295,8 @@ func sweepGlobalClusters(region string) error {
 	return nil
 }
 
-func sweepDBSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDBSubnetGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -346,9 +340,8 @@ func sweepDBSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
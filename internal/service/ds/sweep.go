@@ -4,6 +4,7 @@
 package ds
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_directory", &sweep.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -33,15 +33,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_directory_service_region", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_region", &sweep.Sweeper{
 		Name: "aws_directory_service_region",
 		F:    sweepRegions,
 	})
 }
 
-func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDirectories(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -84,9 +83,8 @@ func sweepDirectories(region string) error {
 	return nil
 }
 
-func sweepRegions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
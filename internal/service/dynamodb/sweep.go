@@ -21,20 +21,19 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_table", &sweep.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_backup", &sweep.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
 }
 
-func sweepTables(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTables(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -103,9 +102,8 @@ func sweepTables(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBackups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,19 +4,19 @@
 package ecs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &sweep.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &sweep.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &sweep.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &sweep.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
@@ -47,9 +47,8 @@ func init() {
 	})
 }
 
-func sweepCapacityProviders(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCapacityProviders(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -98,9 +97,8 @@ func sweepCapacityProviders(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -142,9 +140,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServices(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -211,9 +208,8 @@ func sweepServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTaskDefinitions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTaskDefinitions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
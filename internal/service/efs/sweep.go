@@ -4,24 +4,24 @@
 package efs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_access_point", &sweep.Sweeper{
 		Name: "aws_efs_access_point",
 		F:    sweepAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_file_system", &sweep.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    sweepFileSystems,
 		Dependencies: []string{
@@ -30,15 +30,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_mount_target", &sweep.Sweeper{
 		Name: "aws_efs_mount_target",
 		F:    sweepMountTargets,
 	})
 }
 
-func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAccessPoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -103,9 +102,8 @@ func sweepAccessPoints(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFileSystems(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFileSystems(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -147,9 +145,8 @@ func sweepFileSystems(region string) error {
 	return nil
 }
 
-func sweepMountTargets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMountTargets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
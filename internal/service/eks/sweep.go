@@ -4,6 +4,7 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,18 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_addon", &sweep.Sweeper{
 		Name: "aws_eks_addon",
 		F:    sweepAddons,
 	})
 
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_cluster", &sweep.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,25 +33,24 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_fargate_profile", &sweep.Sweeper{
 		Name: "aws_eks_fargate_profile",
 		F:    sweepFargateProfiles,
 	})
 
-	resource.AddTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_identity_provider_config", &sweep.Sweeper{
 		Name: "aws_eks_identity_provider_config",
 		F:    sweepIdentityProvidersConfig,
 	})
 
-	resource.AddTestSweepers("aws_eks_node_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_node_group", &sweep.Sweeper{
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
 }
 
-func sweepAddons(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAddons(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -124,9 +123,8 @@ func sweepAddons(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -168,9 +166,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepFargateProfiles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFargateProfiles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -241,9 +238,8 @@ func sweepFargateProfiles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentityProvidersConfig(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepIdentityProvidersConfig(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -315,9 +311,8 @@ func sweepIdentityProvidersConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNodeGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepNodeGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
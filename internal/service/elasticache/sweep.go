@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
@@ -25,7 +24,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_cluster", &sweep.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &sweep.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &sweep.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -47,7 +46,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &sweep.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -55,7 +54,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_security_group", &sweep.Sweeper{
 		Name: "aws_elasticache_security_group",
 		F:    sweepCacheSecurityGroups,
 		Dependencies: []string{
@@ -64,7 +63,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &sweep.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -74,9 +73,8 @@ func init() {
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -121,9 +119,8 @@ func sweepClusters(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGlobalReplicationGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGlobalReplicationGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -176,9 +173,8 @@ func sweepGlobalReplicationGroups(region string) error {
 	return grgErrs.ErrorOrNil()
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepParameterGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -218,9 +214,8 @@ func sweepParameterGroups(region string) error {
 	return nil
 }
 
-func sweepReplicationGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepReplicationGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -270,9 +265,8 @@ func sweepReplicationGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepCacheSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCacheSecurityGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -312,9 +306,8 @@ func sweepCacheSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,6 +4,7 @@
 package elasticbeanstalk
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,27 +13,25 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_application", &sweep.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            sweepApplications,
 	})
 
-	resource.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_environment", &sweep.Sweeper{
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepApplications(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -71,9 +70,8 @@ func sweepApplications(region string) error {
 	return errors
 }
 
-func sweepEnvironments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEnvironments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,25 +4,24 @@
 package elb
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/service/elb"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elb", &sweep.Sweeper{
 		Name: "aws_elb",
 		F:    sweepLoadBalancers,
 	})
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
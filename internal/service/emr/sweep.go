@@ -4,32 +4,31 @@
 package emr
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &sweep.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &sweep.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -84,9 +83,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepStudios(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStudios(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
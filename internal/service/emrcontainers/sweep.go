@@ -4,26 +4,25 @@
 package emrcontainers
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_virtual_cluster", &sweep.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 }
 
-func sweepVirtualClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVirtualClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_api_destination", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -43,17 +43,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_connection", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_permission", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -61,15 +61,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &sweep.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
 }
 
-func sweepAPIDestination(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAPIDestination(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
@@ -118,9 +117,8 @@ func sweepAPIDestination(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepArchives(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepArchives(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
@@ -169,9 +167,8 @@ func sweepArchives(region string) error {
 	return nil
 }
 
-func sweepBuses(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBuses(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
@@ -216,9 +213,8 @@ func sweepBuses(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnection(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnection(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
@@ -264,9 +260,8 @@ func sweepConnection(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPermissions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPermissions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
@@ -309,9 +304,8 @@ func sweepPermissions(region string) error {
 	return nil
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -379,9 +373,8 @@ func sweepRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTargets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTargets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,56 +4,56 @@
 package fsx
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_backup", &sweep.Sweeper{
 		Name: "aws_fsx_backup",
 		F:    sweepBackups,
 	})
 
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_lustre_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_lustre_file_system",
 		F:    sweepLustreFileSystems,
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_file_system", &sweep.Sweeper{
 		Name:         "aws_fsx_ontap_file_system",
 		F:            sweepOntapFileSystems,
 		Dependencies: []string{"aws_fsx_ontap_storage_virtual_machine"},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &sweep.Sweeper{
 		Name:         "aws_fsx_ontap_storage_virtual_machine",
 		F:            sweepOntapStorageVirtualMachine,
 		Dependencies: []string{"aws_fsx_ontap_volume"},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_ontap_volume", &sweep.Sweeper{
 		Name: "aws_fsx_ontap_volume",
 		F:    sweepOntapVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_openzfs_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_openzfs_file_system",
 		F:    sweepOpenZFSFileSystems,
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_openzfs_volume", &sweep.Sweeper{
 		Name: "aws_fsx_openzfs_volume",
 		F:    sweepOpenZFSVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fsx_windows_file_system", &sweep.Sweeper{
 		Name: "aws_fsx_windows_file_system",
 		F:    sweepWindowsFileSystems,
 		Dependencies: []string{
@@ -62,9 +62,8 @@ func init() {
 	})
 }
 
-func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBackups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -107,9 +106,8 @@ func sweepBackups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepLustreFileSystems(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLustreFileSystems(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -156,9 +154,8 @@ func sweepLustreFileSystems(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOntapFileSystems(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOntapFileSystems(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -205,9 +202,8 @@ func sweepOntapFileSystems(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOntapStorageVirtualMachine(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOntapStorageVirtualMachine(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -250,9 +246,8 @@ func sweepOntapStorageVirtualMachine(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOntapVolume(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOntapVolume(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -302,9 +297,8 @@ func sweepOntapVolume(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOpenZFSFileSystems(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOpenZFSFileSystems(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -351,9 +345,8 @@ func sweepOpenZFSFileSystems(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepOpenZFSVolume(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOpenZFSVolume(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -403,9 +396,8 @@ func sweepOpenZFSVolume(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepWindowsFileSystems(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWindowsFileSystems(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
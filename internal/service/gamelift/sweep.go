@@ -11,13 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &sweep.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -25,17 +24,17 @@ func init() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &sweep.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_script", &sweep.Sweeper{
 		Name: "aws_gamelift_script",
 		F:    sweepScripts,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &sweep.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -43,20 +42,19 @@ func init() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_server_group", &sweep.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &sweep.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
 }
 
-func sweepAliases(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAliases(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -93,9 +91,8 @@ func sweepAliases(region string) error {
 	return nil
 }
 
-func sweepBuilds(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBuilds(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -131,9 +128,8 @@ func sweepBuilds(region string) error {
 	return nil
 }
 
-func sweepScripts(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepScripts(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -169,9 +165,8 @@ func sweepScripts(region string) error {
 	return nil
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFleets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -224,9 +219,8 @@ func sweepFleets(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGameServerGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGameServerGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -279,9 +273,8 @@ func sweepGameServerGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGameSessionQueue(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGameSessionQueue(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -4,19 +4,19 @@
 package globalaccelerator
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_listener", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_listener",
 		F:    sweepListeners,
 		Dependencies: []string{
@@ -32,15 +32,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_endpoint_group", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_endpoint_group",
 		F:    sweepEndpointGroups,
 	})
 }
 
-func sweepAccelerators(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAccelerators(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -82,9 +81,8 @@ func sweepAccelerators(region string) error {
 	return nil
 }
 
-func sweepEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEndpointGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -171,9 +169,8 @@ func sweepEndpointGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepListeners(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
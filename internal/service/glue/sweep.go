@@ -4,6 +4,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,76 +13,74 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_catalog_database", &sweep.Sweeper{
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
 	})
 
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_classifier", &sweep.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    sweepClassifiers,
 	})
 
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_connection", &sweep.Sweeper{
 		Name: "aws_glue_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_crawler", &sweep.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    sweepCrawlers,
 	})
 
-	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_dev_endpoint", &sweep.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_job", &sweep.Sweeper{
 		Name: "aws_glue_job",
 		F:    sweepJobs,
 	})
 
-	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_ml_transform", &sweep.Sweeper{
 		Name: "aws_glue_ml_transform",
 		F:    sweepMLTransforms,
 	})
 
-	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_registry", &sweep.Sweeper{
 		Name: "aws_glue_registry",
 		F:    sweepRegistry,
 	})
 
-	resource.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_schema", &sweep.Sweeper{
 		Name: "aws_glue_schema",
 		F:    sweepSchema,
 	})
 
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_security_configuration", &sweep.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    sweepSecurityConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_trigger", &sweep.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    sweepTriggers,
 	})
 
-	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_workflow", &sweep.Sweeper{
 		Name: "aws_glue_workflow",
 		F:    sweepWorkflow,
 	})
 }
 
-func sweepCatalogDatabases(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCatalogDatabases(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -126,9 +125,8 @@ func sweepCatalogDatabases(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClassifiers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClassifiers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -183,9 +181,8 @@ func sweepClassifiers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnections(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -230,9 +227,8 @@ func sweepConnections(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCrawlers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCrawlers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -273,9 +269,8 @@ func sweepCrawlers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDevEndpoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDevEndpoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -323,9 +318,8 @@ func sweepDevEndpoints(region string) error {
 	return nil
 }
 
-func sweepJobs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepJobs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -367,9 +361,8 @@ func sweepJobs(region string) error {
 	return nil
 }
 
-func sweepMLTransforms(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMLTransforms(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -411,9 +404,8 @@ func sweepMLTransforms(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRegistry(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegistry(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -447,9 +439,8 @@ func sweepRegistry(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSchema(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSchema(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -483,9 +474,8 @@ func sweepSchema(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSecurityConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSecurityConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -525,9 +515,8 @@ func sweepSecurityConfigurations(region string) error {
 	return nil
 }
 
-func sweepTriggers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTriggers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -569,9 +558,8 @@ func sweepTriggers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkflow(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWorkflow(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
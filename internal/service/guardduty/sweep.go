@@ -4,6 +4,7 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,27 +12,25 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &sweep.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &sweep.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
 }
 
-func sweepDetectors(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDetectors(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -76,9 +75,8 @@ func sweepDetectors(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPublishingDestinations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPublishingDestinations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -385,3 +385,201 @@ func FindAccessKeys(ctx context.Context, conn *iam.IAM, username string) ([]*iam
 	}
 	return accessKeys, err
 }
+
+// FindGroupAttachedPolicyARNs returns the ARNs of the managed policies attached to the specified group.
+func FindGroupAttachedPolicyARNs(ctx context.Context, conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	err := conn.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttachedPolicies {
+			if v != nil {
+				output = append(output, aws.StringValue(v.PolicyArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindGroupPolicyNames returns the names of the inline policies embedded in the specified group.
+func FindGroupPolicyNames(ctx context.Context, conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	err := conn.ListGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRoleAttachedPolicyARNs returns the ARNs of the managed policies attached to the specified role.
+func FindRoleAttachedPolicyARNs(ctx context.Context, conn *iam.IAM, roleName string) ([]string, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+	var output []string
+
+	err := conn.ListAttachedRolePoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttachedPolicies {
+			if v != nil {
+				output = append(output, aws.StringValue(v.PolicyArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRolePolicyNames returns the names of the inline policies embedded in the specified role.
+func FindRolePolicyNames(ctx context.Context, conn *iam.IAM, roleName string) ([]string, error) {
+	input := &iam.ListRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+	var output []string
+
+	err := conn.ListRolePoliciesPagesWithContext(ctx, input, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindUserAttachedPolicyARNs returns the ARNs of the managed policies attached to the specified user.
+func FindUserAttachedPolicyARNs(ctx context.Context, conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	err := conn.ListAttachedUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttachedPolicies {
+			if v != nil {
+				output = append(output, aws.StringValue(v.PolicyArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindUserPolicyNames returns the names of the inline policies embedded in the specified user.
+func FindUserPolicyNames(ctx context.Context, conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	err := conn.ListUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.PolicyNames)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
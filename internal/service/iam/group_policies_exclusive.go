@@ -0,0 +1,110 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPoliciesExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	groupName := d.Get("group_name").(string)
+	want := d.Get("policy_names").(*schema.Set)
+	names, err := waitPolicyNamesExist(ctx, func() ([]string, error) {
+		return FindGroupPolicyNames(ctx, conn, groupName)
+	}, want)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s) inline policies: %s", groupName, err)
+	}
+
+	// Inline policies are created by aws_iam_group_policy, so only undeclared policies are removed.
+	have := flex.FlattenStringValueSet(names)
+
+	for _, name := range flex.ExpandStringValueSet(have.Difference(want)) {
+		input := &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(name),
+			GroupName:  aws.String(groupName),
+		}
+
+		_, err := conn.DeleteGroupPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IAM Group (%s) inline policy (%s): %s", groupName, name, err)
+		}
+	}
+
+	d.SetId(groupName)
+
+	return append(diags, resourceGroupPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindGroupPolicyNames(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", outputRaw.([]string))
+	d.Set("group_name", d.Id())
+
+	return diags
+}
+
+func resourceGroupPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] IAM Group Policies Exclusive (%s) removed from state, inline policies are unchanged", d.Id())
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMGroupPoliciesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_iam_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_names.*", "aws_iam_group_policy.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPoliciesExclusive_outOfBandPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policies_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, resourceName, 1),
+					testAccCheckGroupPoliciesExclusivePutOutOfBand(ctx, resourceName, rName+"-out-of-band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPoliciesExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_names.*", "aws_iam_group_policy.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupPoliciesExclusiveCount(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+
+		names, err := tfiam.FindGroupPolicyNames(ctx, conn, rs.Primary.Attributes["group_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if len(names) != count {
+			return fmt.Errorf("IAM Group (%s) has %d inline policies, expected %d", rs.Primary.Attributes["group_name"], len(names), count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupPoliciesExclusivePutOutOfBand(ctx context.Context, n, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+
+		_, err := conn.PutGroupPolicyWithContext(ctx, &iam.PutGroupPolicyInput{
+			PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:DescribeVpcs","Resource":"*"}]}`),
+			PolicyName:     aws.String(policyName),
+			GroupName:      aws.String(rs.Primary.Attributes["group_name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccGroupPoliciesExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_group_policy" "test" {
+  name = %[1]q
+  group = aws_iam_group.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policies_exclusive" "test" {
+  group_name    = aws_iam_group.test.name
+  policy_names = [aws_iam_group_policy.test.name]
+}
+`, rName)
+}
@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPolicyAttachmentsExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	groupName := d.Get("group_name").(string)
+	arns, err := FindGroupAttachedPolicyARNs(ctx, conn, groupName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s) policy attachments: %s", groupName, err)
+	}
+
+	have := flex.FlattenStringValueSet(arns)
+	want := d.Get("policy_arns").(*schema.Set)
+
+	for _, arn := range flex.ExpandStringValueSet(want.Difference(have)) {
+		if err := attachPolicyToGroup(ctx, conn, groupName, arn); err != nil {
+			return sdkdiag.AppendErrorf(diags, "attaching IAM Policy (%s) to IAM Group (%s): %s", arn, groupName, err)
+		}
+	}
+
+	for _, arn := range flex.ExpandStringValueSet(have.Difference(want)) {
+		err := detachPolicyFromGroup(ctx, conn, groupName, arn)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching IAM Policy (%s) from IAM Group (%s): %s", arn, groupName, err)
+		}
+	}
+
+	if err := waitPolicyARNsAttached(ctx, func() ([]string, error) {
+		return FindGroupAttachedPolicyARNs(ctx, conn, groupName)
+	}, want); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Group (%s) policy attachments: %s", groupName, err)
+	}
+
+	d.SetId(groupName)
+
+	return append(diags, resourceGroupPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindGroupAttachedPolicyARNs(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", outputRaw.([]string))
+	d.Set("group_name", d.Id())
+
+	return diags
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] IAM Group Policy Attachments Exclusive (%s) removed from state, policy attachments are unchanged", d.Id())
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "aws_iam_policy.test[0].arn, aws_iam_policy.test[1].arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_iam_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test.1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "aws_iam_policy.test[1].arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test.1", "arn"),
+				),
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_outOfBandAttachment(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "aws_iam_policy.test[0].arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					testAccCheckGroupPolicyAttachmentsExclusiveAttachOutOfBand(ctx, resourceName, "aws_iam_policy.test.1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "aws_iam_policy.test[0].arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test.0", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveCount(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+
+		arns, err := tfiam.FindGroupAttachedPolicyARNs(ctx, conn, rs.Primary.Attributes["group_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if len(arns) != count {
+			return fmt.Errorf("IAM Group (%s) has %d policy attachments, expected %d", rs.Primary.Attributes["group_name"], len(arns), count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveAttachOutOfBand(ctx context.Context, n, policyResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		policy, ok := s.RootModule().Resources[policyResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", policyResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+
+		_, err := conn.AttachGroupPolicyWithContext(ctx, &iam.AttachGroupPolicyInput{
+			PolicyArn: aws.String(policy.Primary.Attributes["arn"]),
+			GroupName: aws.String(rs.Primary.Attributes["group_name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, policyARNs string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_policy" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policy_attachments_exclusive" "test" {
+  group_name   = aws_iam_group.test.name
+  policy_arns = [%[2]s]
+}
+`, rName, policyARNs)
+}
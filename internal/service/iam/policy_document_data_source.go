@@ -5,15 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamcatalog"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -35,6 +38,10 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minified_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"override_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -67,6 +74,18 @@ func DataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsJSON,
 				},
 			},
+			"split_json": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"split_size_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -252,6 +271,8 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			}
 
 			stmts[i] = stmt
+
+			diags = append(diags, dataSourcePolicyDocumentCatalogWarnings(i, cfgStmt)...)
 		}
 
 		doc.Statements = stmts
@@ -292,12 +313,67 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	size, err := mergedDoc.MinifiedSize()
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: measuring JSON: %s", err)
+	}
+
+	var splitJSON []string
+	if v, ok := d.GetOk("split_size_limit"); ok {
+		docs, err := mergedDoc.Split(v.(int))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: splitting: %s", err)
+		}
+
+		for _, doc := range docs {
+			jsonDoc, err := doc.IndentedJSON()
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+			}
+
+			splitJSON = append(splitJSON, jsonDoc)
+		}
+	}
+
 	d.Set("json", jsonString)
+	d.Set("minified_size", size)
+	d.Set("split_json", splitJSON)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
 }
 
+// dataSourcePolicyDocumentCatalogWarnings returns a warning for each action and condition key
+// in the specified configured statement that is not in the bundled IAM catalog.
+func dataSourcePolicyDocumentCatalogWarnings(i int, cfgStmt map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var actions, conditionKeys []string
+
+	for _, k := range []string{"actions", "not_actions"} {
+		for _, v := range cfgStmt[k].(*schema.Set).List() {
+			actions = append(actions, v.(string))
+		}
+	}
+
+	for _, v := range cfgStmt["condition"].(*schema.Set).List() {
+		conditionKeys = append(conditionKeys, v.(map[string]interface{})["variable"].(string))
+	}
+
+	sort.Strings(actions)
+	sort.Strings(conditionKeys)
+
+	for _, w := range iamcatalog.CheckStatement(i, cfgStmt["sid"].(string), actions, conditionKeys) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "IAM policy document statement contains an unknown action or condition key",
+			Detail:        w.String(),
+			AttributePath: cty.GetAttrPath("statement").IndexInt(i),
+		})
+	}
+
+	return diags
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
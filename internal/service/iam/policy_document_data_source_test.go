@@ -385,6 +385,38 @@ func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_multiplePr
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_split(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_split(200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_size", "162"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "1"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.0", testAccPolicyDocumentSplitExpectedJSON),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_split(120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_size", "162"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.0", testAccPolicyDocumentSplitExpectedJSONEC2),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_split(100),
+				ExpectError: regexp.MustCompile(`statement 0 \(Sid "EC2"\) alone is 101 characters, which exceeds the limit of 100`),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -1205,6 +1237,56 @@ var testAccPolicyDocumentDuplicateBlankSidExpectedJSON = `{
   ]
 }`
 
+func testAccPolicyDocumentDataSourceConfig_split(limit int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  split_size_limit = %[1]d
+
+  statement {
+    sid       = "EC2"
+    actions   = ["ec2:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "S3"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+`, limit)
+}
+
+const testAccPolicyDocumentSplitExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "EC2",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "S3",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentSplitExpectedJSONEC2 = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "EC2",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
 const testAccPolicyDocumentDataSourceConfig_version20081017 = `
 data "aws_iam_policy_document" "test" {
   version = "2008-10-17"
@@ -1,10 +1,12 @@
 package iam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
+	"unicode/utf8"
 )
 
 const (
@@ -75,6 +77,110 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// MinifiedSize returns the number of characters in the policy document's JSON without whitespace,
+// which is the size that IAM's policy size quotas apply to.
+func (s *IAMPolicyDoc) MinifiedSize() (int, error) {
+	b, err := s.encodeJSON("")
+
+	if err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCount(b), nil
+}
+
+// IndentedJSON returns the policy document's JSON indented with two spaces.
+// The JSON is encoded in the same way as it is measured by MinifiedSize.
+func (s *IAMPolicyDoc) IndentedJSON() (string, error) {
+	b, err := s.encodeJSON("  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// encodeJSON returns the policy document's JSON, indented if indent is not empty.
+// Characters such as "<", ">" and "&", common in condition values, are not escaped,
+// as IAM counts them as single characters.
+func (s *IAMPolicyDoc) encodeJSON(indent string) ([]byte, error) {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+
+	if err := encoder.Encode(s); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSpace(b.Bytes()), nil
+}
+
+// Split packs the policy document's statements into documents with a minified size of at most limit characters.
+// Statements are placed greedily, in order, into the first document with room for them (first fit),
+// so the result is deterministic but not necessarily the fewest possible documents.
+// Each document keeps the policy document's Version and Id.
+func (s *IAMPolicyDoc) Split(limit int) ([]*IAMPolicyDoc, error) {
+	var docs []*IAMPolicyDoc
+
+	if len(s.Statements) == 0 {
+		return []*IAMPolicyDoc{s}, nil
+	}
+
+	for i, statement := range s.Statements {
+		var placed bool
+
+		for _, doc := range docs {
+			candidate := &IAMPolicyDoc{
+				Version:    s.Version,
+				Id:         s.Id,
+				Statements: append(doc.Statements[:len(doc.Statements):len(doc.Statements)], statement),
+			}
+
+			size, err := candidate.MinifiedSize()
+
+			if err != nil {
+				return nil, err
+			}
+
+			if size <= limit {
+				doc.Statements = candidate.Statements
+				placed = true
+				break
+			}
+		}
+
+		if placed {
+			continue
+		}
+
+		doc := &IAMPolicyDoc{
+			Version:    s.Version,
+			Id:         s.Id,
+			Statements: []*IAMPolicyStatement{statement},
+		}
+
+		size, err := doc.MinifiedSize()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if size > limit {
+			if statement.Sid != "" {
+				return nil, fmt.Errorf("statement %d (Sid %q) alone is %d characters, which exceeds the limit of %d", i, statement.Sid, size, limit)
+			}
+
+			return nil, fmt.Errorf("statement %d alone is %d characters, which exceeds the limit of %d", i, size, limit)
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
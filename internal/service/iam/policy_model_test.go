@@ -0,0 +1,125 @@
+package iam_test
+
+import (
+	"strings"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestIAMPolicyDocMinifiedSize(t *testing.T) {
+	t.Parallel()
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "arn:aws:s3:::bucket/a&b", //lintignore:AWSAT005
+			},
+		},
+	}
+
+	size, err := doc.MinifiedSize()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/a&b"}]}` //lintignore:AWSAT005
+	if size != len(expected) {
+		t.Errorf("expected size %d, got %d", len(expected), size)
+	}
+}
+
+func TestIAMPolicyDocIndentedJSON(t *testing.T) {
+	t.Parallel()
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: "arn:aws:s3:::bucket/a&b", //lintignore:AWSAT005
+			},
+		},
+	}
+
+	got, err := doc.IndentedJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	size, err := doc.MinifiedSize()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(got, `"arn:aws:s3:::bucket/a&b"`) { //lintignore:AWSAT005
+		t.Errorf("expected unescaped resource in %s", got)
+	}
+
+	if minified := strings.Join(strings.Fields(got), ""); len(minified) != size {
+		t.Errorf("expected size %d, got %d", len(minified), size)
+	}
+}
+
+func TestIAMPolicyDocSplit(t *testing.T) {
+	t.Parallel()
+
+	statement := func(sid string, n int) *tfiam.IAMPolicyStatement {
+		return &tfiam.IAMPolicyStatement{
+			Sid:       sid,
+			Effect:    "Allow",
+			Actions:   "s3:GetObject",
+			Resources: "arn:aws:s3:::" + strings.Repeat("x", n), //lintignore:AWSAT005
+		}
+	}
+	size := func(statements ...*tfiam.IAMPolicyStatement) int {
+		n, err := (&tfiam.IAMPolicyDoc{Version: "2012-10-17", Statements: statements}).MinifiedSize()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return n
+	}
+
+	a, b, c, d := statement("A", 100), statement("B", 300), statement("C", 100), statement("D", 10)
+	limit := size(a, b, d)
+
+	docs, err := (&tfiam.IAMPolicyDoc{Version: "2012-10-17", Statements: []*tfiam.IAMPolicyStatement{a, b, c, d}}).Split(limit)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, doc := range docs {
+		var sids []string
+		for _, statement := range doc.Statements {
+			sids = append(sids, statement.Sid)
+		}
+		if n := size(doc.Statements...); n > limit {
+			t.Errorf("document %v is %d characters, exceeding the limit of %d", sids, n, limit)
+		}
+		if doc.Version != "2012-10-17" {
+			t.Errorf("expected Version to be kept, got %q", doc.Version)
+		}
+		got = append(got, strings.Join(sids, ","))
+	}
+
+	if expected := []string{"A,B,D", "C"}; strings.Join(got, ";") != strings.Join(expected, ";") {
+		t.Errorf("expected documents %q, got %q", expected, got)
+	}
+
+	if _, err := (&tfiam.IAMPolicyDoc{Statements: []*tfiam.IAMPolicyStatement{a, b}}).Split(size(a) - 1); err == nil {
+		t.Error("expected error for a statement exceeding the limit")
+	}
+
+	docs, err = (&tfiam.IAMPolicyDoc{Version: "2012-10-17"}).Split(limit)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(docs) != 1 {
+		t.Errorf("expected 1 document without statements, got %d", len(docs))
+	}
+}
@@ -0,0 +1,350 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"expected_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"resource_handling_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(principalPolicySimulationResourceHandlingOptions(), false),
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	policySourceARN := d.Get("policy_source_arn").(string)
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+		PolicySourceArn: aws.String(policySourceARN),
+	}
+
+	if v, ok := d.GetOk("additional_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+		input.PolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("caller_arn"); ok {
+		input.CallerArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		input.ContextEntries = expandPrincipalPolicySimulationContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsBoundaryPolicyInputList = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_handling_option"); ok {
+		input.ResourceHandlingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_owner_account_id"); ok {
+		input.ResourceOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		input.ResourcePolicy = aws.String(v.(string))
+	}
+
+	var results []*iam.EvaluationResult
+
+	err := conn.SimulatePrincipalPolicyPagesWithContext(ctx, input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "simulating IAM principal (%s) policy: %s", policySourceARN, err)
+	}
+
+	if len(results) == 0 {
+		return sdkdiag.AppendErrorf(diags, "simulating IAM principal (%s) policy: no actions were evaluated", policySourceARN)
+	}
+
+	allAllowed := true
+	for _, v := range results {
+		if !principalPolicySimulationAllowed(v) {
+			allAllowed = false
+		}
+	}
+
+	// d.GetOk can't distinguish a configured false from an unconfigured bool.
+	if v := d.GetRawConfig().GetAttr("expected_allowed"); v.IsKnown() && !v.IsNull() {
+		expected := v.True()
+		var mismatched []string
+
+		for _, v := range results {
+			if principalPolicySimulationAllowed(v) == expected {
+				continue
+			}
+
+			if len(v.ResourceSpecificResults) == 0 {
+				mismatched = append(mismatched, fmt.Sprintf("%s on %s: %s", aws.StringValue(v.EvalActionName), aws.StringValue(v.EvalResourceName), aws.StringValue(v.EvalDecision)))
+				continue
+			}
+
+			for _, r := range v.ResourceSpecificResults {
+				if allowed := aws.StringValue(r.EvalResourceDecision) == iam.PolicyEvaluationDecisionTypeAllowed; allowed != expected {
+					mismatched = append(mismatched, fmt.Sprintf("%s on %s: %s", aws.StringValue(v.EvalActionName), aws.StringValue(r.EvalResourceName), aws.StringValue(r.EvalResourceDecision)))
+				}
+			}
+		}
+
+		if len(mismatched) > 0 {
+			return sdkdiag.AppendErrorf(diags, "IAM principal (%s) policy simulation: expected allowed to be %t for all actions, but got:\n\n%s", policySourceARN, expected, strings.Join(mismatched, "\n"))
+		}
+	}
+
+	d.SetId(policySourceARN)
+	d.Set("all_allowed", allAllowed)
+	if err := d.Set("results", flattenPrincipalPolicySimulationEvaluationResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+// principalPolicySimulationResourceHandlingOptions returns the EC2 scenarios supported by the policy simulator.
+func principalPolicySimulationResourceHandlingOptions() []string {
+	return []string{
+		"EC2-VPC-EBS",
+		"EC2-VPC-EBS-Subnet",
+		"EC2-VPC-InstanceStore",
+		"EC2-VPC-InstanceStore-Subnet",
+	}
+}
+
+// principalPolicySimulationAllowed returns whether the simulated action is allowed.
+// When the action was evaluated against individual resources it must be allowed on each of them.
+func principalPolicySimulationAllowed(apiObject *iam.EvaluationResult) bool {
+	if aws.StringValue(apiObject.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+		return false
+	}
+
+	for _, v := range apiObject.ResourceSpecificResults {
+		if aws.StringValue(v.EvalResourceDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandPrincipalPolicySimulationContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iam.ContextEntry{
+			ContextKeyName:   aws.String(tfMap["key"].(string)),
+			ContextKeyType:   aws.String(tfMap["type"].(string)),
+			ContextKeyValues: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenPrincipalPolicySimulationEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action_name":          aws.StringValue(apiObject.EvalActionName),
+			"allowed":              principalPolicySimulationAllowed(apiObject),
+			"decision":             aws.StringValue(apiObject.EvalDecision),
+			"decision_details":     flex.PointersMapToStringList(apiObject.EvalDecisionDetails),
+			"matched_statements":   flattenPrincipalPolicySimulationStatements(apiObject.MatchedStatements),
+			"missing_context_keys": flex.FlattenStringSet(apiObject.MissingContextValues),
+			"resource_arn":         aws.StringValue(apiObject.EvalResourceName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPrincipalPolicySimulationStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,128 @@
+package iam_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPolicySimulationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_basic(rName, "s3:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "role"),
+				),
+			},
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_basic(rName, "s3:DeleteObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "implicitDeny"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_expectedAllowed(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_expectedAllowed(rName, `["s3:GetObject"]`, true),
+			},
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_expectedAllowed(rName, `["s3:DeleteObject"]`, false),
+			},
+			{
+				Config:      testAccPrincipalPolicySimulationDataSourceConfig_expectedAllowed(rName, `["s3:GetObject", "s3:DeleteObject"]`, true),
+				ExpectError: regexp.MustCompile(`expected allowed to be true for all actions`),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccPrincipalPolicySimulationDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_principal_policy_simulation" "test" {
+  policy_source_arn = aws_iam_role.test.arn
+  action_names      = [%[2]q]
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/object"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, action))
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_expectedAllowed(rName, actions string, expectedAllowed bool) string {
+	return acctest.ConfigCompose(testAccPrincipalPolicySimulationDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_principal_policy_simulation" "test" {
+  policy_source_arn = aws_iam_role.test.arn
+  action_names      = %[2]s
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/object"]
+  expected_allowed  = %[3]t
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, actions, expectedAllowed))
+}
@@ -324,8 +324,8 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 	}
 
-	managedPolicies, err := readRolePolicyAttachments(ctx, conn, aws.StringValue(role.RoleName))
-	if err != nil {
+	managedPolicies, err := FindRoleAttachedPolicyARNs(ctx, conn, aws.StringValue(role.RoleName))
+	if err != nil && !tfresource.NotFound(err) {
 		return sdkdiag.AppendErrorf(diags, "reading managed policies for IAM role %s, error: %s", d.Id(), err)
 	}
 	d.Set("managed_policy_arns", managedPolicies)
@@ -547,23 +547,23 @@ func DeleteRole(ctx context.Context, conn *iam.IAM, roleName string, forceDetach
 	}
 
 	if forceDetach || hasManaged {
-		managedPolicies, err := readRolePolicyAttachments(ctx, conn, roleName)
-		if err != nil {
+		managedPolicies, err := FindRoleAttachedPolicyARNs(ctx, conn, roleName)
+		if err != nil && !tfresource.NotFound(err) {
 			return err
 		}
 
-		if err := deleteRolePolicyAttachments(ctx, conn, roleName, managedPolicies); err != nil {
+		if err := deleteRolePolicyAttachments(ctx, conn, roleName, aws.StringSlice(managedPolicies)); err != nil {
 			return fmt.Errorf("unable to detach policies: %w", err)
 		}
 	}
 
 	if forceDetach || hasInline {
-		inlinePolicies, err := readRolePolicyNames(ctx, conn, roleName)
-		if err != nil {
+		inlinePolicies, err := FindRolePolicyNames(ctx, conn, roleName)
+		if err != nil && !tfresource.NotFound(err) {
 			return err
 		}
 
-		if err := deleteRoleInlinePolicies(ctx, conn, roleName, inlinePolicies); err != nil {
+		if err := deleteRoleInlinePolicies(ctx, conn, roleName, aws.StringSlice(inlinePolicies)); err != nil {
 			return fmt.Errorf("unable to delete inline policies: %w", err)
 		}
 	}
@@ -645,25 +645,6 @@ func retryCreateRole(ctx context.Context, conn *iam.IAM, input *iam.CreateRoleIn
 	return output, err
 }
 
-func readRolePolicyAttachments(ctx context.Context, conn *iam.IAM, roleName string) ([]*string, error) {
-	managedPolicies := make([]*string, 0)
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
-
-	err := conn.ListAttachedRolePoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
-		for _, v := range page.AttachedPolicies {
-			managedPolicies = append(managedPolicies, v.PolicyArn)
-		}
-		return !lastPage
-	})
-	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-		return nil, err
-	}
-
-	return managedPolicies, nil
-}
-
 func deleteRolePolicyAttachments(ctx context.Context, conn *iam.IAM, roleName string, managedPolicies []*string) error {
 	for _, arn := range managedPolicies {
 		input := &iam.DetachRolePolicyInput{
@@ -683,24 +664,6 @@ func deleteRolePolicyAttachments(ctx context.Context, conn *iam.IAM, roleName st
 	return nil
 }
 
-func readRolePolicyNames(ctx context.Context, conn *iam.IAM, roleName string) ([]*string, error) {
-	inlinePolicies := make([]*string, 0)
-	input := &iam.ListRolePoliciesInput{
-		RoleName: aws.String(roleName),
-	}
-
-	err := conn.ListRolePoliciesPagesWithContext(ctx, input, func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
-		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
-		return !lastPage
-	})
-
-	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-		return nil, err
-	}
-
-	return inlinePolicies, nil
-}
-
 func deleteRoleInlinePolicies(ctx context.Context, conn *iam.IAM, roleName string, policyNames []*string) error {
 	for _, name := range policyNames {
 		if len(aws.StringValue(name)) == 0 {
@@ -842,8 +805,8 @@ func addRoleManagedPolicies(ctx context.Context, roleName string, policies []*st
 func readRoleInlinePolicies(ctx context.Context, roleName string, meta interface{}) ([]*iam.PutRolePolicyInput, error) {
 	conn := meta.(*conns.AWSClient).IAMConn()
 
-	policyNames, err := readRolePolicyNames(ctx, conn, roleName)
-	if err != nil {
+	policyNames, err := FindRolePolicyNames(ctx, conn, roleName)
+	if err != nil && !tfresource.NotFound(err) {
 		return nil, err
 	}
 
@@ -851,7 +814,7 @@ func readRoleInlinePolicies(ctx context.Context, roleName string, meta interface
 	for _, policyName := range policyNames {
 		policyResp, err := conn.GetRolePolicyWithContext(ctx, &iam.GetRolePolicyInput{
 			RoleName:   aws.String(roleName),
-			PolicyName: policyName,
+			PolicyName: aws.String(policyName),
 		})
 		if err != nil {
 			return nil, err
@@ -870,7 +833,7 @@ func readRoleInlinePolicies(ctx context.Context, roleName string, meta interface
 		apiObject := &iam.PutRolePolicyInput{
 			RoleName:       aws.String(roleName),
 			PolicyDocument: aws.String(p),
-			PolicyName:     policyName,
+			PolicyName:     aws.String(policyName),
 		}
 
 		apiObjects = append(apiObjects, apiObject)
@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceRolePolicyAttachmentsExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	roleName := d.Get("role_name").(string)
+	arns, err := FindRoleAttachedPolicyARNs(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	have := flex.FlattenStringValueSet(arns)
+	want := d.Get("policy_arns").(*schema.Set)
+
+	for _, arn := range flex.ExpandStringValueSet(want.Difference(have)) {
+		if err := attachPolicyToRole(ctx, conn, roleName, arn); err != nil {
+			return sdkdiag.AppendErrorf(diags, "attaching IAM Policy (%s) to IAM Role (%s): %s", arn, roleName, err)
+		}
+	}
+
+	for _, arn := range flex.ExpandStringValueSet(have.Difference(want)) {
+		err := DetachPolicyFromRole(ctx, conn, roleName, arn)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching IAM Policy (%s) from IAM Role (%s): %s", arn, roleName, err)
+		}
+	}
+
+	if err := waitPolicyARNsAttached(ctx, func() ([]string, error) {
+		return FindRoleAttachedPolicyARNs(ctx, conn, roleName)
+	}, want); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindRoleAttachedPolicyARNs(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", outputRaw.([]string))
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] IAM Role Policy Attachments Exclusive (%s) removed from state, policy attachments are unchanged", d.Id())
+
+	return nil
+}
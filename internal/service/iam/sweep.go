@@ -4,6 +4,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,13 +14,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &sweep.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -27,18 +27,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_instance_profile", &sweep.Sweeper{
 		Name:         "aws_iam_instance_profile",
 		F:            sweepInstanceProfile,
 		Dependencies: []string{"aws_iam_role"},
 	})
 
-	resource.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_openid_connect_provider", &sweep.Sweeper{
 		Name: "aws_iam_openid_connect_provider",
 		F:    sweepOpenIDConnectProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &sweep.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -48,7 +48,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &sweep.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -77,32 +77,32 @@ func init() {
 		F: sweepRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_saml_provider", &sweep.Sweeper{
 		Name: "aws_iam_saml_provider",
 		F:    sweepSAMLProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_specific_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_specific_credential", &sweep.Sweeper{
 		Name: "aws_iam_service_specific_credential",
 		F:    sweepServiceSpecificCredentials,
 	})
 
-	resource.AddTestSweepers("aws_iam_signing_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_signing_certificate", &sweep.Sweeper{
 		Name: "aws_iam_signing_certificate",
 		F:    sweepSigningCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &sweep.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &sweep.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    sweepServiceLinkedRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &sweep.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -113,15 +113,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_virtual_mfa_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_virtual_mfa_device", &sweep.Sweeper{
 		Name: "aws_iam_virtual_mfa_device",
 		F:    sweepVirtualMFADevice,
 	})
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -235,9 +234,8 @@ func sweepGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepInstanceProfile(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInstanceProfile(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -293,9 +291,8 @@ func sweepInstanceProfile(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepOpenIDConnectProvider(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOpenIDConnectProvider(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -333,9 +330,8 @@ func sweepOpenIDConnectProvider(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepServiceSpecificCredentials(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServiceSpecificCredentials(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -400,9 +396,8 @@ func sweepServiceSpecificCredentials(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -473,9 +468,8 @@ func sweepPolicies(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRoles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -533,9 +527,8 @@ func sweepRoles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSAMLProvider(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSAMLProvider(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -573,9 +566,8 @@ func sweepSAMLProvider(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServerCertificates(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -607,9 +599,8 @@ func sweepServerCertificates(region string) error {
 	return nil
 }
 
-func sweepServiceLinkedRoles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServiceLinkedRoles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -662,9 +653,8 @@ func sweepServiceLinkedRoles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepUsers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -900,9 +890,8 @@ func roleNameFilter(name string) bool {
 	return false
 }
 
-func sweepVirtualMFADevice(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVirtualMFADevice(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -949,9 +938,8 @@ func sweepVirtualMFADevice(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSigningCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSigningCertificates(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
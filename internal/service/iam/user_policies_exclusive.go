@@ -0,0 +1,110 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceUserPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceUserPoliciesExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	userName := d.Get("user_name").(string)
+	want := d.Get("policy_names").(*schema.Set)
+	names, err := waitPolicyNamesExist(ctx, func() ([]string, error) {
+		return FindUserPolicyNames(ctx, conn, userName)
+	}, want)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User (%s) inline policies: %s", userName, err)
+	}
+
+	// Inline policies are created by aws_iam_user_policy, so only undeclared policies are removed.
+	have := flex.FlattenStringValueSet(names)
+
+	for _, name := range flex.ExpandStringValueSet(have.Difference(want)) {
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(name),
+			UserName:   aws.String(userName),
+		}
+
+		_, err := conn.DeleteUserPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IAM User (%s) inline policy (%s): %s", userName, name, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return append(diags, resourceUserPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUserPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindUserPolicyNames(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", outputRaw.([]string))
+	d.Set("user_name", d.Id())
+
+	return diags
+}
+
+func resourceUserPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] IAM User Policies Exclusive (%s) removed from state, inline policies are unchanged", d.Id())
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceUserPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceUserPolicyAttachmentsExclusiveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	userName := d.Get("user_name").(string)
+	arns, err := FindUserAttachedPolicyARNs(ctx, conn, userName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User (%s) policy attachments: %s", userName, err)
+	}
+
+	have := flex.FlattenStringValueSet(arns)
+	want := d.Get("policy_arns").(*schema.Set)
+
+	for _, arn := range flex.ExpandStringValueSet(want.Difference(have)) {
+		if err := attachPolicyToUser(ctx, conn, userName, arn); err != nil {
+			return sdkdiag.AppendErrorf(diags, "attaching IAM Policy (%s) to IAM User (%s): %s", arn, userName, err)
+		}
+	}
+
+	for _, arn := range flex.ExpandStringValueSet(have.Difference(want)) {
+		err := DetachPolicyFromUser(ctx, conn, userName, arn)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching IAM Policy (%s) from IAM User (%s): %s", arn, userName, err)
+		}
+	}
+
+	if err := waitPolicyARNsAttached(ctx, func() ([]string, error) {
+		return FindUserAttachedPolicyARNs(ctx, conn, userName)
+	}, want); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM User (%s) policy attachments: %s", userName, err)
+	}
+
+	d.SetId(userName)
+
+	return append(diags, resourceUserPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindUserAttachedPolicyARNs(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", outputRaw.([]string))
+	d.Set("user_name", d.Id())
+
+	return diags
+}
+
+func resourceUserPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] IAM User Policy Attachments Exclusive (%s) removed from state, policy attachments are unchanged", d.Id())
+
+	return nil
+}
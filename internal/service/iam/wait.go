@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -102,3 +105,51 @@ func statusDeleteServiceLinkedRole(ctx context.Context, conn *iam.IAM, deletionT
 		return resp, aws.StringValue(resp.Status), nil
 	}
 }
+
+// waitPolicyNamesExist waits until the inline policies named in want are listed by find and returns the names of all the listed inline policies.
+// Inline policies are created by other resources, so a declared policy that never appears is an error rather than a perpetual diff.
+func waitPolicyNamesExist(ctx context.Context, find func() ([]string, error), want *schema.Set) ([]string, error) {
+	var names []string
+
+	err := tfresource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+		var err error
+
+		names, err = find()
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if missing := want.Difference(flex.FlattenStringValueSet(names)); missing.Len() > 0 {
+			return resource.RetryableError(fmt.Errorf("inline policies not found: %s", strings.Join(flex.ExpandStringValueSet(missing), ", ")))
+		}
+
+		return nil
+	})
+
+	return names, err
+}
+
+// waitPolicyARNsAttached waits until the managed policies listed by find are exactly those in want.
+// Policy attachments are eventually consistent, so without waiting the subsequent read may report a perpetual diff.
+func waitPolicyARNsAttached(ctx context.Context, find func() ([]string, error), want *schema.Set) error {
+	return tfresource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+		arns, err := find()
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		have := flex.FlattenStringValueSet(arns)
+
+		if missing := want.Difference(have); missing.Len() > 0 {
+			return resource.RetryableError(fmt.Errorf("managed policies not attached: %s", strings.Join(flex.ExpandStringValueSet(missing), ", ")))
+		}
+
+		if extra := have.Difference(want); extra.Len() > 0 {
+			return resource.RetryableError(fmt.Errorf("managed policies not detached: %s", strings.Join(flex.ExpandStringValueSet(extra), ", ")))
+		}
+
+		return nil
+	})
+}
@@ -4,57 +4,56 @@
 package imagebuilder
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_component", &sweep.Sweeper{
 		Name: "aws_imagebuilder_component",
 		F:    sweepComponents,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_distribution_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_pipeline", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_container_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_container_recipe",
 		F:    sweepContainerRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
 }
 
-func sweepComponents(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepComponents(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -129,9 +128,8 @@ func sweepComponents(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDistributionConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDistributionConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -178,9 +176,8 @@ func sweepDistributionConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImagePipelines(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepImagePipelines(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -227,9 +224,8 @@ func sweepImagePipelines(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImageRecipes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepImageRecipes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -278,9 +274,8 @@ func sweepImageRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepContainerRecipes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepContainerRecipes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -329,9 +324,8 @@ func sweepContainerRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepImages(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -410,9 +404,8 @@ func sweepImages(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInfrastructureConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInfrastructureConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package iot
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &sweep.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertifcates,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &sweep.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &sweep.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -39,48 +39,47 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &sweep.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &sweep.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &sweep.Sweeper{
 		Name:         "aws_iot_thing",
 		F:            sweepThings,
 		Dependencies: []string{"aws_iot_thing_principal_attachment"},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
-		Name: "aws_iot_policy_attachment",
+	sweep.AddTestSweepers("aws_iot_thing_group", &sweep.Sweeper{
+		Name: "aws_iot_thing_group",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &sweep.Sweeper{
 		Name:         "aws_iot_thing_type",
 		F:            sweepThingTypes,
 		Dependencies: []string{"aws_iot_thing"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &sweep.Sweeper{
 		Name:         "aws_iot_topic_rule",
 		F:            sweepTopicRules,
 		Dependencies: []string{"aws_iot_topic_rule_destination"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &sweep.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
 }
 
-func sweepCertifcates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCertifcates(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -125,9 +124,8 @@ func sweepCertifcates(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPolicyAttachments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPolicyAttachments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -192,9 +190,8 @@ func sweepPolicyAttachments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -239,9 +236,8 @@ func sweepPolicies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRoleAliases(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRoleAliases(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -286,9 +282,8 @@ func sweepRoleAliases(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepThingPrincipalAttachments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepThingPrincipalAttachments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -353,9 +348,8 @@ func sweepThingPrincipalAttachments(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepThings(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepThings(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -400,9 +394,8 @@ func sweepThings(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepThingTypes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepThingTypes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -447,9 +440,8 @@ func sweepThingTypes(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTopicRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTopicRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -495,9 +487,8 @@ func sweepTopicRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepThingGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepThingGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -539,9 +530,8 @@ func sweepThingGroups(region string) error {
 	return nil
 }
 
-func sweepTopicRuleDestinations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTopicRuleDestinations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,18 +4,18 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_cluster", &sweep.Sweeper{
 		Name: "aws_msk_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_msk_configuration", &sweep.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    sweepConfigurations,
 		Dependencies: []string{
@@ -32,9 +32,8 @@ func init() {
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -76,9 +75,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
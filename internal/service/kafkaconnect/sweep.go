@@ -4,23 +4,23 @@
 package kafkaconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_connector", &sweep.Sweeper{
 		Name: "aws_mskconnect_connector",
 		F:    sweepConnectors,
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_custom_plugin", &sweep.Sweeper{
 		Name: "aws_mskconnect_custom_plugin",
 		F:    sweepCustomPlugins,
 		Dependencies: []string{
@@ -29,9 +29,8 @@ func init() {
 	})
 }
 
-func sweepConnectors(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnectors(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -73,9 +72,8 @@ func sweepConnectors(region string) error {
 	return nil
 }
 
-func sweepCustomPlugins(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCustomPlugins(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,27 +4,26 @@
 package keyspaces
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/keyspaces"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
 	// No need to have separate sweeper for table as would be destroyed as part of keyspace
-	resource.AddTestSweepers("aws_keyspaces_keyspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_keyspaces_keyspace", &sweep.Sweeper{
 		Name: "aws_keyspaces_keyspace",
 		F:    sweepKeyspaces,
 	})
 }
 
-func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-name
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepKeyspaces(ctx context.Context, region string) error { // nosemgrep:ci.keyspaces-in-func-name
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
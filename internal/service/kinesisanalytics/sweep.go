@@ -4,6 +4,7 @@
 package kinesisanalytics
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,21 +13,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_analytics_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_analytics_application", &sweep.Sweeper{
 		Name: "aws_kinesis_analytics_application",
 		F:    sweepApplications,
 	})
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepApplications(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
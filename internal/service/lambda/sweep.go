@@ -4,6 +4,7 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,18 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_function", &sweep.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_layer", &sweep.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 		Dependencies: []string{
@@ -31,9 +31,8 @@ func init() {
 	})
 }
 
-func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFunctions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -76,9 +75,8 @@ func sweepFunctions(region string) error {
 	return nil
 }
 
-func sweepLayerVersions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLayerVersions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
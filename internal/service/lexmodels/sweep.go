@@ -4,45 +4,44 @@
 package lexmodels
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lex_bot_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_bot_alias", &sweep.Sweeper{
 		Name: "aws_lex_bot_alias",
 		F:    sweepBotAliases,
 	})
 
-	resource.AddTestSweepers("aws_lex_bot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_bot", &sweep.Sweeper{
 		Name:         "aws_lex_bot",
 		F:            sweepBots,
 		Dependencies: []string{"aws_lex_bot_alias"},
 	})
 
-	resource.AddTestSweepers("aws_lex_intent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_intent", &sweep.Sweeper{
 		Name:         "aws_lex_intent",
 		F:            sweepIntents,
 		Dependencies: []string{"aws_lex_bot"},
 	})
 
-	resource.AddTestSweepers("aws_lex_slot_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_slot_type", &sweep.Sweeper{
 		Name:         "aws_lex_slot_type",
 		F:            sweepSlotTypes,
 		Dependencies: []string{"aws_lex_intent"},
 	})
 }
 
-func sweepBotAliases(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBotAliases(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -114,9 +113,8 @@ func sweepBotAliases(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepBots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -161,9 +159,8 @@ func sweepBots(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepIntents(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepIntents(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -208,9 +205,8 @@ func sweepIntents(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSlotTypes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSlotTypes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
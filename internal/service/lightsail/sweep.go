@@ -4,37 +4,36 @@
 package lightsail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &sweep.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &sweep.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &sweep.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
 }
 
-func sweepContainerServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepContainerServices(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -83,9 +82,8 @@ func sweepContainerServices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -132,9 +130,8 @@ func sweepInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepStaticIPs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStaticIPs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -4,29 +4,29 @@
 package medialive
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_channel", &sweep.Sweeper{
 		Name: "aws_medialive_channel",
 		F:    sweepChannels,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input", &sweep.Sweeper{
 		Name: "aws_medialive_input",
 		F:    sweepInputs,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input_security_group", &sweep.Sweeper{
 		Name: "aws_medialive_input_security_group",
 		F:    sweepInputSecurityGroups,
 		Dependencies: []string{
@@ -34,15 +34,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_multiplex", &sweep.Sweeper{
 		Name: "aws_medialive_multiplex",
 		F:    sweepMultiplexes,
 	})
 }
 
-func sweepChannels(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepChannels(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -90,9 +89,8 @@ func sweepChannels(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInputs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInputs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -140,9 +138,8 @@ func sweepInputs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInputSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInputSecurityGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -190,9 +187,8 @@ func sweepInputSecurityGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepMultiplexes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMultiplexes(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
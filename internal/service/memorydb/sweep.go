@@ -4,19 +4,19 @@
 package memorydb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/memorydb"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_acl", &sweep.Sweeper{
 		Name: "aws_memorydb_acl",
 		F:    sweepACLs,
 		Dependencies: []string{
@@ -24,12 +24,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_cluster", &sweep.Sweeper{
 		Name: "aws_memorydb_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_parameter_group", &sweep.Sweeper{
 		Name: "aws_memorydb_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_snapshot", &sweep.Sweeper{
 		Name: "aws_memorydb_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -45,7 +45,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_subnet_group", &sweep.Sweeper{
 		Name: "aws_memorydb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -53,7 +53,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_user", &sweep.Sweeper{
 		Name: "aws_memorydb_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -62,9 +62,8 @@ func init() {
 	})
 }
 
-func sweepACLs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepACLs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -112,9 +111,8 @@ func sweepACLs(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -156,9 +154,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepParameterGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -206,9 +203,8 @@ func sweepParameterGroups(region string) error {
 	return nil
 }
 
-func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSnapshots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -250,9 +246,8 @@ func sweepSnapshots(region string) error {
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -300,9 +295,8 @@ func sweepSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepUsers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,6 +4,7 @@
 package neptune
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,21 +12,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_event_subscription", &sweep.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -4,18 +4,18 @@
 package networkfirewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &sweep.Sweeper{
 		Name: "aws_networkfirewall_firewall_policy",
 		F:    sweepFirewallPolicies,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &sweep.Sweeper{
 		Name: "aws_networkfirewall_firewall",
 		F:    sweepFirewalls,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &sweep.Sweeper{
 		Name: "aws_networkfirewall_logging_configuration",
 		F:    sweepLoggingConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &sweep.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -45,9 +45,8 @@ func init() {
 	})
 }
 
-func sweepFirewallPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewallPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -89,9 +88,8 @@ func sweepFirewallPolicies(region string) error {
 	return nil
 }
 
-func sweepFirewalls(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewalls(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -133,9 +131,8 @@ func sweepFirewalls(region string) error {
 	return nil
 }
 
-func sweepLoggingConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLoggingConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -177,9 +174,8 @@ func sweepLoggingConfigurations(region string) error {
 	return nil
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
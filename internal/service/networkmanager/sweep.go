@@ -4,19 +4,19 @@
 package networkmanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_global_network", &sweep.Sweeper{
 		Name: "aws_networkmanager_global_network",
 		F:    sweepGlobalNetworks,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_core_network", &sweep.Sweeper{
 		Name: "aws_networkmanager_core_network",
 		F:    sweepCoreNetworks,
 		Dependencies: []string{
@@ -36,17 +36,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connect_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_connect_attachment",
 		F:    sweepConnectAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_site_to_site_vpn_attachment",
 		F:    sweepSiteToSiteVPNAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_peering",
 		F:    sweepTransitGatewayPeerings,
 		Dependencies: []string{
@@ -54,17 +54,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_route_table_attachment",
 		F:    sweepTransitGatewayRouteTableAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_vpc_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_vpc_attachment",
 		F:    sweepVPCAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site", &sweep.Sweeper{
 		Name: "aws_networkmanager_site",
 		F:    sweepSites,
 		Dependencies: []string{
@@ -73,7 +73,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_device", &sweep.Sweeper{
 		Name: "aws_networkmanager_device",
 		F:    sweepDevices,
 		Dependencies: []string{
@@ -81,7 +81,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link", &sweep.Sweeper{
 		Name: "aws_networkmanager_link",
 		F:    sweepLinks,
 		Dependencies: []string{
@@ -89,7 +89,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link_association", &sweep.Sweeper{
 		Name: "aws_networkmanager_link_association",
 		F:    sweepLinkAssociations,
 		Dependencies: []string{
@@ -97,15 +97,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connection", &sweep.Sweeper{
 		Name: "aws_networkmanager_connection",
 		F:    sweepConnections,
 	})
 }
 
-func sweepGlobalNetworks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGlobalNetworks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -147,9 +146,8 @@ func sweepGlobalNetworks(region string) error {
 	return nil
 }
 
-func sweepCoreNetworks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCoreNetworks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -191,9 +189,8 @@ func sweepCoreNetworks(region string) error {
 	return nil
 }
 
-func sweepConnectAttachments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnectAttachments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -237,9 +234,8 @@ func sweepConnectAttachments(region string) error {
 	return nil
 }
 
-func sweepSiteToSiteVPNAttachments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSiteToSiteVPNAttachments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -283,9 +279,8 @@ func sweepSiteToSiteVPNAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeerings(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTransitGatewayPeerings(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -329,9 +324,8 @@ func sweepTransitGatewayPeerings(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayRouteTableAttachments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTransitGatewayRouteTableAttachments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -375,9 +369,8 @@ func sweepTransitGatewayRouteTableAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVPCAttachments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -421,9 +414,8 @@ func sweepVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepSites(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSites(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -489,9 +481,8 @@ func sweepSites(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDevices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDevices(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -557,9 +548,8 @@ func sweepDevices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLinks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -625,9 +615,8 @@ func sweepLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinkAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLinkAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -692,9 +681,8 @@ func sweepLinkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConnections(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package opsworks
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,13 +13,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_stack", &sweep.Sweeper{
 		Name: "aws_opsworks_stack",
 		F:    sweepStacks,
 		Dependencies: []string{
@@ -29,18 +29,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_application", &sweep.Sweeper{
 		Name: "aws_opsworks_application",
 		F:    sweepApplication,
 	})
 
-	resource.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_instance", &sweep.Sweeper{
 		Name: "aws_opsworks_instance",
 		F:    sweepInstance,
 	})
 
 	// This sweep all the custom, ecs, ganglia, etc. layers
-	resource.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_layer", &sweep.Sweeper{
 		Name: "aws_opsworks_layer",
 		F:    sweepLayers,
 		Dependencies: []string{
@@ -49,7 +49,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_rds_db_instance", &sweep.Sweeper{
 		Name: "aws_opsworks_rds_db_instance",
 		F:    sweepRDSDBInstance,
 		Dependencies: []string{
@@ -57,15 +57,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_user_profile", &sweep.Sweeper{
 		Name: "aws_opsworks_user_profile",
 		F:    sweepUserProfiles,
 	})
 }
 
-func sweepApplication(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepApplication(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -115,9 +114,8 @@ func sweepApplication(region string) error {
 	return sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 }
 
-func sweepInstance(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInstance(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -168,9 +166,8 @@ func sweepInstance(region string) error {
 	return sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 }
 
-func sweepRDSDBInstance(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRDSDBInstance(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -221,9 +218,8 @@ func sweepRDSDBInstance(region string) error {
 	return sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStacks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -264,9 +260,8 @@ func sweepStacks(region string) error {
 	return sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 }
 
-func sweepLayers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLayers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -326,9 +321,8 @@ func sweepLayers(region string) error {
 	return sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 }
 
-func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepUserProfiles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
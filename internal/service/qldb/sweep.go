@@ -4,19 +4,19 @@
 package qldb
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/qldb"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_ledger", &sweep.Sweeper{
 		Name: "aws_qldb_ledger",
 		F:    sweepLedgers,
 		Dependencies: []string{
@@ -24,16 +24,15 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_qldb_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_stream", &sweep.Sweeper{
 		Name: "aws_qldb_stream",
 		F:    sweepStreams,
 	})
 
 }
 
-func sweepLedgers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLedgers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -75,9 +74,8 @@ func sweepLedgers(region string) error {
 	return nil
 }
 
-func sweepStreams(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStreams(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,6 +4,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &sweep.Sweeper{
 		Name: "aws_rds_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &sweep.Sweeper{
 		Name: "aws_db_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &sweep.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -41,12 +41,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_event_subscription", &sweep.Sweeper{
 		Name: "aws_db_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_global_cluster", &sweep.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -54,12 +54,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &sweep.Sweeper{
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_option_group", &sweep.Sweeper{
 		Name: "aws_db_option_group",
 		F:    sweepOptionGroups,
 		Dependencies: []string{
@@ -68,7 +68,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_parameter_group", &sweep.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -76,12 +76,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_proxy", &sweep.Sweeper{
 		Name: "aws_db_proxy",
 		F:    sweepProxies,
 	})
 
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_snapshot", &sweep.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -89,7 +89,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_subnet_group", &sweep.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -97,7 +97,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance_automated_backups_replication", &sweep.Sweeper{
 		Name: "aws_db_instance_automated_backups_replication",
 		F:    sweepInstanceAutomatedBackups,
 		Dependencies: []string{
@@ -106,9 +106,8 @@ func init() {
 	})
 }
 
-func sweepClusterParameterGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusterParameterGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -156,9 +155,8 @@ func sweepClusterParameterGroups(region string) error {
 	return nil
 }
 
-func sweepClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusterSnapshots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -206,9 +204,8 @@ func sweepClusterSnapshots(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -268,9 +265,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -312,9 +308,8 @@ func sweepEventSubscriptions(region string) error {
 	return nil
 }
 
-func sweepGlobalClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGlobalClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -356,9 +351,8 @@ func sweepGlobalClusters(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -404,9 +398,8 @@ func sweepInstances(region string) error {
 	return nil
 }
 
-func sweepOptionGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepOptionGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -454,9 +447,8 @@ func sweepOptionGroups(region string) error {
 	return nil
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepParameterGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -504,9 +496,8 @@ func sweepParameterGroups(region string) error {
 	return nil
 }
 
-func sweepProxies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProxies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
@@ -548,9 +539,8 @@ func sweepProxies(region string) error {
 	return nil
 }
 
-func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSnapshots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -598,9 +588,8 @@ func sweepSnapshots(region string) error {
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -642,9 +631,8 @@ func sweepSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepInstanceAutomatedBackups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepInstanceAutomatedBackups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
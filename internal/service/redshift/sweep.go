@@ -4,6 +4,7 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster_snapshot", &sweep.Sweeper{
 		Name: "aws_redshift_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -25,42 +25,42 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster", &sweep.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_client_certificate", &sweep.Sweeper{
 		Name: "aws_redshift_hsm_client_certificate",
 		F:    sweepHSMClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_configuration", &sweep.Sweeper{
 		Name: "aws_redshift_hsm_configuration",
 		F:    sweepHSMConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_authentication_profile", &sweep.Sweeper{
 		Name: "aws_redshift_authentication_profile",
 		F:    sweepAuthenticationProfiles,
 	})
 
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_event_subscription", &sweep.Sweeper{
 		Name: "aws_redshift_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_scheduled_action", &sweep.Sweeper{
 		Name: "aws_redshift_scheduled_action",
 		F:    sweepScheduledActions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_snapshot_schedule", &sweep.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    sweepSnapshotSchedules,
 	})
 
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_subnet_group", &sweep.Sweeper{
 		Name: "aws_redshift_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -69,9 +69,8 @@ func init() {
 	})
 }
 
-func sweepClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusterSnapshots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -111,9 +110,8 @@ func sweepClusterSnapshots(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -158,9 +156,8 @@ func sweepClusters(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -202,9 +199,8 @@ func sweepEventSubscriptions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepScheduledActions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepScheduledActions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -246,9 +242,8 @@ func sweepScheduledActions(region string) error {
 	return nil
 }
 
-func sweepSnapshotSchedules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSnapshotSchedules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -301,9 +296,8 @@ func sweepSnapshotSchedules(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -357,9 +351,8 @@ func sweepSubnetGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepHSMClientCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHSMClientCertificates(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -403,9 +396,8 @@ func sweepHSMClientCertificates(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepHSMConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHSMConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -449,9 +441,8 @@ func sweepHSMConfigurations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepAuthenticationProfiles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAuthenticationProfiles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
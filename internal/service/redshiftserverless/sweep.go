@@ -4,19 +4,19 @@
 package redshiftserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshiftserverless"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_namespace", &sweep.Sweeper{
 		Name: "aws_redshiftserverless_namespace",
 		F:    sweepNamespaces,
 		Dependencies: []string{
@@ -24,20 +24,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_workgroup", &sweep.Sweeper{
 		Name: "aws_redshiftserverless_workgroup",
 		F:    sweepWorkgroups,
 	})
 
-	resource.AddTestSweepers("aws_redshiftserverless_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshiftserverless_snapshot", &sweep.Sweeper{
 		Name: "aws_redshiftserverless_snapshot",
 		F:    sweepSnapshots,
 	})
 }
 
-func sweepNamespaces(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepNamespaces(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -79,9 +78,8 @@ func sweepNamespaces(region string) error {
 	return nil
 }
 
-func sweepWorkgroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWorkgroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -123,9 +121,8 @@ func sweepWorkgroups(region string) error {
 	return nil
 }
 
-func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSnapshots(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
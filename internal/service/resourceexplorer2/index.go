@@ -33,6 +33,8 @@ func init() {
 
 func newResourceIndex(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceIndex{}
+	r.SetTagsIdentifierAttribute("id")
+	r.SetTagsOnCreate()
 	r.SetDefaultCreateTimeout(2 * time.Hour)
 	r.SetDefaultUpdateTimeout(2 * time.Hour)
 	r.SetDefaultDeleteTimeout(10 * time.Minute)
@@ -42,6 +44,7 @@ func newResourceIndex(context.Context) (resource.ResourceWithConfigure, error) {
 
 type resourceIndex struct {
 	framework.ResourceWithConfigure
+	framework.WithTags
 	framework.WithTimeouts
 }
 
@@ -139,7 +142,6 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 
 	// Set values for unknowns.
 	data.ARN = types.StringValue(arn)
-	data.TagsAll = r.FlattenTagsAll(ctx, tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -173,10 +175,6 @@ func (r *resourceIndex) Read(ctx context.Context, request resource.ReadRequest,
 	data.ARN = flex.StringToFramework(ctx, output.Arn)
 	data.Type = flex.StringValueToFramework(ctx, output.Type)
 
-	apiTags := KeyValueTags(output.Tags)
-	data.Tags = r.FlattenTags(ctx, apiTags)
-	data.TagsAll = r.FlattenTagsAll(ctx, apiTags)
-
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -219,14 +217,6 @@ func (r *resourceIndex) Update(ctx context.Context, request resource.UpdateReque
 		}
 	}
 
-	if !new.TagsAll.Equal(old.TagsAll) {
-		if err := UpdateTags(ctx, conn, new.ID.ValueString(), old.TagsAll, new.TagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s) tags", new.ID.ValueString()), err.Error())
-
-			return
-		}
-	}
-
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -266,10 +256,6 @@ func (r *resourceIndex) ImportState(ctx context.Context, request resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
-func (r *resourceIndex) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
-}
-
 type resourceIndexData struct {
 	ARN      types.String   `tfsdk:"arn"`
 	ID       types.String   `tfsdk:"id"`
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -74,3 +75,15 @@ func UpdateTags(ctx context.Context, conn *resourceexplorer2.Client, identifier
 
 	return nil
 }
+
+// ListTags lists resourceexplorer2 service tags for the resource with the specified identifier.
+// It is used by the provider to manage the tags of Plugin Framework resources.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) (tftags.KeyValueTags, error) {
+	return ListTags(ctx, meta.(*conns.AWSClient).ResourceExplorer2Client(), identifier)
+}
+
+// UpdateTags updates resourceexplorer2 service tags for the resource with the specified identifier.
+// It is used by the provider to manage the tags of Plugin Framework resources.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
+	return UpdateTags(ctx, meta.(*conns.AWSClient).ResourceExplorer2Client(), identifier, oldTags, newTags)
+}
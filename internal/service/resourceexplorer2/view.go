@@ -37,11 +37,16 @@ func init() {
 }
 
 func newResourceView(context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceView{}, nil
+	r := &resourceView{}
+	r.SetTagsIdentifierAttribute("id")
+	r.SetTagsOnCreate()
+
+	return r, nil
 }
 
 type resourceView struct {
 	framework.ResourceWithConfigure
+	framework.WithTags
 }
 
 func (r *resourceView) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -160,7 +165,6 @@ func (r *resourceView) Create(ctx context.Context, request resource.CreateReques
 	// Set values for unknowns.
 	data.ARN = types.StringValue(arn)
 	data.ID = types.StringValue(arn)
-	data.TagsAll = r.FlattenTagsAll(ctx, tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -225,10 +229,6 @@ func (r *resourceView) Read(ctx context.Context, request resource.ReadRequest, r
 	name := parts[1]
 	data.Name = types.StringValue(name)
 
-	apiTags := KeyValueTags(output.Tags)
-	data.Tags = r.FlattenTags(ctx, apiTags)
-	data.TagsAll = r.FlattenTagsAll(ctx, apiTags)
-
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -265,14 +265,6 @@ func (r *resourceView) Update(ctx context.Context, request resource.UpdateReques
 		}
 	}
 
-	if !new.TagsAll.Equal(old.TagsAll) {
-		if err := UpdateTags(ctx, conn, new.ID.ValueString(), old.TagsAll, new.TagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer View (%s) tags", new.ID.ValueString()), err.Error())
-
-			return
-		}
-	}
-
 	if !new.DefaultView.Equal(old.DefaultView) {
 		if new.DefaultView.ValueBool() {
 			input := &resourceexplorer2.AssociateDefaultViewInput{
@@ -331,10 +323,6 @@ func (r *resourceView) ImportState(ctx context.Context, request resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
-func (r *resourceView) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
-}
-
 func (r *resourceView) expandSearchFilter(ctx context.Context, tfList types.List) *awstypes.SearchFilter {
 	if tfList.IsNull() || tfList.IsUnknown() {
 		return nil
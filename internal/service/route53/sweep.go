@@ -4,6 +4,7 @@
 package route53
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,29 +14,28 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_health_check", &sweep.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
 	})
 
-	resource.AddTestSweepers("aws_route53_key_signing_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_key_signing_key", &sweep.Sweeper{
 		Name: "aws_route53_key_signing_key",
 		F:    sweepKeySigningKeys,
 	})
 
-	resource.AddTestSweepers("aws_route53_query_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_query_log", &sweep.Sweeper{
 		Name: "aws_route53_query_log",
 		F:    sweepQueryLogs,
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_traffic_policy", &sweep.Sweeper{
 		Name: "aws_route53_traffic_policy",
 		F:    sweepTrafficPolicies,
 		Dependencies: []string{
@@ -43,12 +43,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_traffic_policy_instance", &sweep.Sweeper{
 		Name: "aws_route53_traffic_policy_instance",
 		F:    sweepTrafficPolicyInstances,
 	})
 
-	resource.AddTestSweepers("aws_route53_zone", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_zone", &sweep.Sweeper{
 		Name: "aws_route53_zone",
 		Dependencies: []string{
 			"aws_service_discovery_http_namespace",
@@ -62,9 +62,8 @@ func init() {
 	})
 }
 
-func sweepHealthChecks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHealthChecks(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -114,9 +113,8 @@ func sweepHealthChecks(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepKeySigningKeys(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepKeySigningKeys(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -194,9 +192,8 @@ func sweepKeySigningKeys(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepQueryLogs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepQueryLogs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -239,9 +236,8 @@ func sweepQueryLogs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTrafficPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTrafficPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -283,9 +279,8 @@ func sweepTrafficPolicies(region string) error {
 	return nil
 }
 
-func sweepTrafficPolicyInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTrafficPolicyInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -327,9 +322,8 @@ func sweepTrafficPolicyInstances(region string) error {
 	return nil
 }
 
-func sweepZones(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepZones(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
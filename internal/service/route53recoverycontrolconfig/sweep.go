@@ -4,19 +4,19 @@
 package route53recoverycontrolconfig
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_control_panel",
 		F:    sweepControlPanels,
 		Dependencies: []string{
@@ -33,20 +33,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_routing_control",
 		F:    sweepRoutingControls,
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &sweep.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_safety_rule",
 		F:    sweepSafetyRules,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepClusters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -88,9 +87,8 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepControlPanels(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepControlPanels(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -159,9 +157,8 @@ func sweepControlPanels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoutingControls(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRoutingControls(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -248,9 +245,8 @@ func sweepRoutingControls(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSafetyRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSafetyRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -4,24 +4,24 @@
 package route53resolver
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_dnssec_config", &sweep.Sweeper{
 		Name: "aws_route53_resolver_dnssec_config",
 		F:    sweepDNSSECConfig,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_endpoint", &sweep.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -29,12 +29,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_config", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_config",
 		F:    sweepFirewallConfigs,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_domain_list",
 		F:    sweepFirewallDomainLists,
 		Dependencies: []string{
@@ -42,12 +42,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group_association",
 		F:    sweepFirewallRuleGroupAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group",
 		F:    sweepFirewallRuleGroups,
 		Dependencies: []string{
@@ -56,7 +56,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule", &sweep.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule",
 		F:    sweepFirewallRules,
 		Dependencies: []string{
@@ -64,12 +64,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config_association", &sweep.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    sweepQueryLogAssociationsConfigs,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config", &sweep.Sweeper{
 		Name: "aws_route53_resolver_query_log_config",
 		F:    sweepQueryLogsConfig,
 		Dependencies: []string{
@@ -77,12 +77,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule_association", &sweep.Sweeper{
 		Name: "aws_route53_resolver_rule_association",
 		F:    sweepRuleAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule", &sweep.Sweeper{
 		Name: "aws_route53_resolver_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -91,9 +91,8 @@ func init() {
 	})
 }
 
-func sweepDNSSECConfig(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDNSSECConfig(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -136,9 +135,8 @@ func sweepDNSSECConfig(region string) error {
 	return nil
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEndpoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -180,9 +178,8 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepFirewallConfigs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewallConfigs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -225,9 +222,8 @@ func sweepFirewallConfigs(region string) error {
 	return nil
 }
 
-func sweepFirewallDomainLists(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewallDomainLists(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -269,9 +265,8 @@ func sweepFirewallDomainLists(region string) error {
 	return nil
 }
 
-func sweepFirewallRuleGroupAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewallRuleGroupAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -313,9 +308,8 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 	return nil
 }
 
-func sweepFirewallRuleGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewallRuleGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -357,9 +351,8 @@ func sweepFirewallRuleGroups(region string) error {
 	return nil
 }
 
-func sweepFirewallRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFirewallRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -424,9 +417,8 @@ func sweepFirewallRules(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepQueryLogAssociationsConfigs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepQueryLogAssociationsConfigs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -470,9 +462,8 @@ func sweepQueryLogAssociationsConfigs(region string) error {
 	return nil
 }
 
-func sweepQueryLogsConfig(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepQueryLogsConfig(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -514,9 +505,8 @@ func sweepQueryLogsConfig(region string) error {
 	return nil
 }
 
-func sweepRuleAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRuleAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -560,9 +550,8 @@ func sweepRuleAssociations(region string) error {
 	return nil
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -72,6 +72,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -176,6 +197,16 @@ func ResourceObject() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -207,6 +238,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		Key:    aws.String(key),
 	}
 
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.RetryContext(ctx, objectCreationTimeout, func() *resource.RetryError {
@@ -243,6 +278,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -428,46 +467,26 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn()
-	uploader := s3manager.NewUploaderWithClient(conn)
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	body, closeBody, err := objectBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	defer closeBody()
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
@@ -540,7 +559,12 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = aws.String(v.(string))
+		uploader.RequestOptions = append(uploader.RequestOptions, newObjectUploadChecksums(v.(string)).requestOption)
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -549,6 +573,42 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// objectBody returns the object content from the source file or the content or content_base64 string.
+// The returned function closes any source file.
+func objectBody(source, content, contentBase64 string) (io.ReadSeeker, func(), error) {
+	switch {
+	case source != "":
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	case content != "":
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	case contentBase64 != "":
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding content_base64: %w", err)
+		}
+
+		return bytes.NewReader(contentRaw), func() {}, nil
+	default:
+		return bytes.NewReader([]byte{}), func() {}, nil
+	}
+}
+
 func resourceObjectSetKMS(ctx context.Context, d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
@@ -581,7 +641,25 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	if hasObjectContentChanges(d) {
+	checksumChanged, err := hasObjectChecksumChanges(d)
+
+	if err != nil {
+		return err
+	}
+
+	if checksumChanged || (hasObjectContentChanges(d) && d.Get("checksum_algorithm").(string) != "") {
+		for _, key := range objectChecksumAttributes {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if checksumChanged {
+		d.SetNewComputed("etag")
+	}
+
+	if checksumChanged || hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
 
@@ -593,10 +671,26 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
-func hasObjectContentChanges(d verify.ResourceDiffer) bool {
+// objectDiffer is implemented by schema.ResourceData and schema.ResourceDiff.
+type objectDiffer interface {
+	verify.ResourceDiffer
+	Get(string) interface{}
+}
+
+func hasObjectContentChanges(d objectDiffer) bool {
+	// The checksum of an object uploaded in parts depends on the part size, so the object is uploaded again.
+	if d.HasChange("upload_part_size") && d.Get("checksum_algorithm").(string) != "" {
+		return true
+	}
+
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -619,6 +713,49 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// hasObjectChecksumChanges returns whether the checksum of the configured object content differs from the
+// checksum of the uploaded object.
+// The content is only read when `source`, `content`, `content_base64` or `checksum_algorithm` has changed,
+// otherwise changes to the content are detected with `etag` or `source_hash`.
+func hasObjectChecksumChanges(d *schema.ResourceDiff) (bool, error) {
+	algorithm := d.Get("checksum_algorithm").(string)
+
+	if d.Id() == "" || algorithm == "" || !d.HasChanges("checksum_algorithm", "content", "content_base64", "source") {
+		return false, nil
+	}
+
+	// The uploaded object has no checksum for a newly configured algorithm.
+	checksum, _ := d.GetChange(objectChecksumAttributes[algorithm])
+
+	if checksum.(string) == "" {
+		return false, nil
+	}
+
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return false, nil
+		}
+	}
+
+	body, closeBody, err := objectBody(d.Get("source").(string), d.Get("content").(string), d.Get("content_base64").(string))
+
+	if err != nil {
+		return false, fmt.Errorf("reading S3 object content: %w", err)
+	}
+
+	defer closeBody()
+
+	// The uploaded object's checksum depends on the part size it was uploaded with.
+	partSize, _ := d.GetChange("upload_part_size")
+	want, err := ObjectChecksum(body, algorithm, int64(partSize.(int)))
+
+	if err != nil {
+		return false, fmt.Errorf("computing S3 object %s checksum: %w", algorithm, err)
+	}
+
+	return !objectChecksumsEqual(checksum.(string), want), nil
+}
+
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
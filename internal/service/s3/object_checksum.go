@@ -0,0 +1,204 @@
+package s3
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// objectChecksumAttributes maps each S3 checksum algorithm to the aws_s3_object attribute holding the checksum.
+var objectChecksumAttributes = map[string]string{
+	s3.ChecksumAlgorithmCrc32:  "checksum_crc32",
+	s3.ChecksumAlgorithmCrc32c: "checksum_crc32c",
+	s3.ChecksumAlgorithmSha1:   "checksum_sha1",
+	s3.ChecksumAlgorithmSha256: "checksum_sha256",
+}
+
+func newObjectChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectUploadPartSize returns the part size used by s3manager to upload an object of the specified size.
+// A zero part size selects s3manager's default.
+func objectUploadPartSize(size, partSize int64) int64 {
+	if partSize == 0 {
+		partSize = s3manager.DefaultUploadPartSize
+	}
+
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = size/int64(s3manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// ObjectChecksum returns the checksum S3 reports for the specified content uploaded by s3manager with the specified
+// checksum algorithm and part size.
+// Content larger than the part size is uploaded in parts and its checksum is the checksum of the parts' checksums,
+// followed by '-' and the number of parts.
+func ObjectChecksum(body io.ReadSeeker, algorithm string, partSize int64) (string, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	partSize = objectUploadPartSize(size, partSize)
+
+	if size <= partSize {
+		return readSeekerChecksum(body, algorithm)
+	}
+
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	var parts int64
+
+	for offset := int64(0); offset < size; offset += partSize {
+		ph, _ := newObjectChecksumHash(algorithm)
+
+		if _, err := io.CopyN(ph, body, partSize); err != nil && err != io.EOF {
+			return "", err
+		}
+
+		h.Write(ph.Sum(nil))
+		parts++
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), parts), nil
+}
+
+// objectChecksumsEqual returns whether two object checksums are equal, ignoring any part count.
+func objectChecksumsEqual(a, b string) bool {
+	a, _, _ = strings.Cut(a, "-")
+	b, _, _ = strings.Cut(b, "-")
+
+	return a == b
+}
+
+// readSeekerChecksum returns the base64-encoded checksum of the remaining content of r and restores r's position.
+func readSeekerChecksum(r io.ReadSeeker, algorithm string) (string, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	offset, err := r.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// objectUploadChecksums adds checksums to the requests made by s3manager, which does not compute them itself.
+type objectUploadChecksums struct {
+	algorithm string
+
+	mu    sync.Mutex
+	parts map[int64]string // Part number -> checksum.
+}
+
+func newObjectUploadChecksums(algorithm string) *objectUploadChecksums {
+	return &objectUploadChecksums{
+		algorithm: algorithm,
+		parts:     make(map[int64]string),
+	}
+}
+
+// requestOption is a request.Option for the s3manager.Uploader.
+func (c *objectUploadChecksums) requestOption(r *request.Request) {
+	switch input := r.Params.(type) {
+	case *s3.PutObjectInput:
+		v, err := readSeekerChecksum(input.Body, c.algorithm)
+
+		if err != nil {
+			r.Error = fmt.Errorf("computing %s checksum: %w", c.algorithm, err)
+			return
+		}
+
+		input.ChecksumAlgorithm = aws.String(c.algorithm)
+		setObjectChecksum(c.algorithm, v, &input.ChecksumCRC32, &input.ChecksumCRC32C, &input.ChecksumSHA1, &input.ChecksumSHA256)
+
+	case *s3.UploadPartInput:
+		v, err := readSeekerChecksum(input.Body, c.algorithm)
+
+		if err != nil {
+			r.Error = fmt.Errorf("computing %s checksum of part %d: %w", c.algorithm, aws.Int64Value(input.PartNumber), err)
+			return
+		}
+
+		input.ChecksumAlgorithm = aws.String(c.algorithm)
+		setObjectChecksum(c.algorithm, v, &input.ChecksumCRC32, &input.ChecksumCRC32C, &input.ChecksumSHA1, &input.ChecksumSHA256)
+
+		c.mu.Lock()
+		c.parts[aws.Int64Value(input.PartNumber)] = v
+		c.mu.Unlock()
+
+	case *s3.CompleteMultipartUploadInput:
+		if input.MultipartUpload == nil {
+			return
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		for _, part := range input.MultipartUpload.Parts {
+			if v, ok := c.parts[aws.Int64Value(part.PartNumber)]; ok {
+				setObjectChecksum(c.algorithm, v, &part.ChecksumCRC32, &part.ChecksumCRC32C, &part.ChecksumSHA1, &part.ChecksumSHA256)
+			}
+		}
+	}
+}
+
+func setObjectChecksum(algorithm, v string, vCRC32, vCRC32C, vSHA1, vSHA256 **string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		*vCRC32 = aws.String(v)
+	case s3.ChecksumAlgorithmCrc32c:
+		*vCRC32C = aws.String(v)
+	case s3.ChecksumAlgorithmSha1:
+		*vSHA1 = aws.String(v)
+	case s3.ChecksumAlgorithmSha256:
+		*vSHA256 = aws.String(v)
+	}
+}
@@ -0,0 +1,97 @@
+package s3_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	partSize := int(s3manager.MinUploadPartSize)
+	large := bytes.Repeat([]byte("a"), 2*partSize+1)
+
+	sha256Sum := func(b []byte) []byte {
+		v := sha256.Sum256(b)
+		return v[:]
+	}
+	var parts []byte
+	for _, part := range [][]byte{large[:partSize], large[partSize : 2*partSize], large[2*partSize:]} {
+		parts = append(parts, sha256Sum(part)...)
+	}
+
+	crc32Sum := crc32.NewIEEE()
+	crc32Sum.Write([]byte("hello"))
+
+	testCases := []struct {
+		TestName  string
+		Content   []byte
+		Algorithm string
+		PartSize  int64
+		Expected  string
+	}{
+		{
+			TestName:  "empty",
+			Content:   []byte{},
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  base64.StdEncoding.EncodeToString(sha256Sum(nil)),
+		},
+		{
+			TestName:  "single part CRC32",
+			Content:   []byte("hello"),
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			Expected:  base64.StdEncoding.EncodeToString(crc32Sum.Sum(nil)),
+		},
+		{
+			TestName:  "single part SHA256",
+			Content:   []byte("hello"),
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  base64.StdEncoding.EncodeToString(sha256Sum([]byte("hello"))),
+		},
+		{
+			TestName:  "multipart SHA256",
+			Content:   large,
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			PartSize:  int64(partSize),
+			Expected:  fmt.Sprintf("%s-3", base64.StdEncoding.EncodeToString(sha256Sum(parts))),
+		},
+		{
+			TestName:  "default part size",
+			Content:   large,
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  fmt.Sprintf("%s-3", base64.StdEncoding.EncodeToString(sha256Sum(parts))),
+		},
+		{
+			TestName:  "part size larger than content",
+			Content:   large,
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			PartSize:  int64(3 * partSize),
+			Expected:  base64.StdEncoding.EncodeToString(sha256Sum(large)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.ObjectChecksum(bytes.NewReader(testCase.Content), testCase.Algorithm, testCase.PartSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
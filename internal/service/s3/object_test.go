@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -260,6 +261,116 @@ func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "hello", s3.ChecksumAlgorithmSha256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "hello"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="),
+				),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "hello", s3.ChecksumAlgorithmCrc32),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "hello"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "NhCmhg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumTrigger(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filename1 := testAccObjectCreateTempFile(t, "Ebben!")
+	defer os.Remove(filename1)
+	filename2 := testAccObjectCreateTempFile(t, "Ebbene")
+	defer os.Remove(filename2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumSource(rName, filename1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "Ebben!"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum_sha256"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_checksumSource(rName, filename2, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "Ebbene"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// Three parts of 5 MiB.
+	filename := testAccObjectCreateTempFile(t, strings.Repeat("a", 11*1024*1024))
+	defer os.Remove(filename)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumSource(rName, filename, 5*1024*1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+				),
+			},
+			{
+				// Two parts of 6 MiB. The object's checksum depends on the part size, so the object is uploaded again.
+				Config: testAccObjectConfig_checksumSource(rName, filename, 6*1024*1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexp.MustCompile(`-2$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "6291456"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_withContentCharacteristics(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1703,6 +1814,53 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_checksumAlgorithm(rName, content, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = %[2]q
+  checksum_algorithm = %[3]q
+}
+`, rName, content, checksumAlgorithm)
+}
+
+func testAccObjectConfig_checksumSource(rName, source string, partSize int) string {
+	if partSize == 0 {
+		return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = "SHA256"
+}
+`, rName, source)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  checksum_algorithm = "SHA256"
+  upload_concurrency = 2
+  upload_part_size   = %[3]d
+}
+`, rName, source, partSize)
+}
+
 func testAccObjectConfig_updateable(rName string, bucketVersioning bool, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_3" {
@@ -24,12 +24,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &sweep.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &sweep.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -40,9 +40,8 @@ func init() {
 	})
 }
 
-func sweepObjects(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepObjects(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -115,9 +114,8 @@ func (os objectSweeper) Delete(ctx context.Context, timeout time.Duration, optFn
 	return nil
 }
 
-func sweepBuckets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBuckets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
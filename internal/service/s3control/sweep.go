@@ -4,6 +4,7 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &sweep.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 		Dependencies: []string{
@@ -25,25 +25,24 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_multi_region_access_point", &sweep.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_object_lambda_access_point", &sweep.Sweeper{
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_storage_lens_configuration", &sweep.Sweeper{
 		Name: "aws_s3control_storage_lens_configuration",
 		F:    sweepStorageLensConfigurations,
 	})
 }
 
-func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAccessPoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -94,14 +93,13 @@ func sweepAccessPoints(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepMultiRegionAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepMultiRegionAccessPoints(ctx context.Context, region string) error {
 	if region != endpoints.UsWest2RegionID {
 		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
 		return nil
 	}
 
-	client, err := sweep.SharedRegionalSweepClient(region)
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -146,9 +144,8 @@ func sweepMultiRegionAccessPoints(region string) error {
 	return nil
 }
 
-func sweepObjectLambdaAccessPoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepObjectLambdaAccessPoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -193,9 +190,8 @@ func sweepObjectLambdaAccessPoints(region string) error {
 	return nil
 }
 
-func sweepStorageLensConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStorageLensConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,6 +4,7 @@
 package sagemaker
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,33 +12,32 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app_image_config", &sweep.Sweeper{
 		Name: "aws_sagemaker_app_image_config",
 		F:    sweepAppImagesConfig,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app", &sweep.Sweeper{
 		Name: "aws_sagemaker_app",
 		F:    sweepApps,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_code_repository", &sweep.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    sweepCodeRepositories,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_device_fleet", &sweep.Sweeper{
 		Name: "aws_sagemaker_device_fleet",
 		F:    sweepDeviceFleets,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_domain", &sweep.Sweeper{
 		Name: "aws_sagemaker_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -48,7 +48,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint_configuration", &sweep.Sweeper{
 		Name: "aws_sagemaker_endpoint_configuration",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -56,7 +56,7 @@ func init() {
 		F: sweepEndpointConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint", &sweep.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -65,37 +65,37 @@ func init() {
 		F: sweepEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_feature_group", &sweep.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    sweepFeatureGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_flow_definition", &sweep.Sweeper{
 		Name: "aws_sagemaker_flow_definition",
 		F:    sweepFlowDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_human_task_ui", &sweep.Sweeper{
 		Name: "aws_sagemaker_human_task_ui",
 		F:    sweepHumanTaskUIs,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_image", &sweep.Sweeper{
 		Name: "aws_sagemaker_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model_package_group", &sweep.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model", &sweep.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    sweepModels,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &sweep.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    sweepNotebookInstanceLifecycleConfiguration,
 		Dependencies: []string{
@@ -103,12 +103,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance", &sweep.Sweeper{
 		Name: "aws_sagemaker_notebook_instance",
 		F:    sweepNotebookInstances,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &sweep.Sweeper{
 		Name: "aws_sagemaker_studio_lifecycle_config",
 		F:    sweepStudioLifecyclesConfig,
 		Dependencies: []string{
@@ -116,7 +116,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_space", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_space", &sweep.Sweeper{
 		Name: "aws_sagemaker_space",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -124,7 +124,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_user_profile", &sweep.Sweeper{
 		Name: "aws_sagemaker_user_profile",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -132,7 +132,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workforce", &sweep.Sweeper{
 		Name: "aws_sagemaker_workforce",
 		F:    sweepWorkforces,
 		Dependencies: []string{
@@ -140,20 +140,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workteam", &sweep.Sweeper{
 		Name: "aws_sagemaker_workteam",
 		F:    sweepWorkteams,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_project", &sweep.Sweeper{
 		Name: "aws_sagemaker_project",
 		F:    sweepProjects,
 	})
 }
 
-func sweepAppImagesConfig(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAppImagesConfig(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -241,9 +240,8 @@ func sweepSpaces(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepApps(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepApps(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -289,9 +287,8 @@ func sweepApps(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCodeRepositories(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepCodeRepositories(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -327,9 +324,8 @@ func sweepCodeRepositories(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDeviceFleets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDeviceFleets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -367,9 +363,8 @@ func sweepDeviceFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDomains(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -407,9 +402,8 @@ func sweepDomains(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEndpointConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEndpointConfigurations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -448,9 +442,8 @@ func sweepEndpointConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepEndpoints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -481,9 +474,8 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepFeatureGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFeatureGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -519,9 +511,8 @@ func sweepFeatureGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFlowDefinitions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFlowDefinitions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -558,9 +549,8 @@ func sweepFlowDefinitions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepHumanTaskUIs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHumanTaskUIs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -597,9 +587,8 @@ func sweepHumanTaskUIs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepImages(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -635,9 +624,8 @@ func sweepImages(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepModelPackageGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepModelPackageGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -673,9 +661,8 @@ func sweepModelPackageGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepModels(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepModels(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -711,9 +698,8 @@ func sweepModels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNotebookInstanceLifecycleConfiguration(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepNotebookInstanceLifecycleConfiguration(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -754,9 +740,8 @@ func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNotebookInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepNotebookInstances(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -794,9 +779,8 @@ func sweepNotebookInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepStudioLifecyclesConfig(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStudioLifecyclesConfig(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -833,9 +817,8 @@ func sweepStudioLifecyclesConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepUserProfiles(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
@@ -874,9 +857,8 @@ func sweepUserProfiles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkforces(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWorkforces(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -913,9 +895,8 @@ func sweepWorkforces(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkteams(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWorkteams(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -952,9 +933,8 @@ func sweepWorkteams(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProjects(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
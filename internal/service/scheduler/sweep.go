@@ -4,19 +4,19 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_scheduler_schedule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_scheduler_schedule_group", &sweep.Sweeper{
 		Name: "aws_scheduler_schedule_group",
 		F:    sweepScheduleGroups,
 		Dependencies: []string{
@@ -24,15 +24,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_scheduler_schedule", &sweep.Sweeper{
 		Name: "aws_scheduler_schedule",
 		F:    sweepSchedules,
 	})
 }
 
-func sweepScheduleGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepScheduleGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -80,9 +79,8 @@ func sweepScheduleGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSchedules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSchedules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -4,6 +4,7 @@
 package schemas
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,18 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_discoverer", &sweep.Sweeper{
 		Name: "aws_schemas_discoverer",
 		F:    sweepDiscoverers,
 	})
 
-	resource.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_registry", &sweep.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepRegistries,
 		Dependencies: []string{
@@ -30,15 +30,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_schemas_schema", &resource.Sweeper{
-		Name: "aws_schemas_registry",
+	sweep.AddTestSweepers("aws_schemas_schema", &sweep.Sweeper{
+		Name: "aws_schemas_schema",
 		F:    sweepSchemas,
 	})
 }
 
-func sweepDiscoverers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDiscoverers(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -79,9 +78,8 @@ func sweepDiscoverers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRegistries(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegistries(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -127,9 +125,8 @@ func sweepRegistries(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSchemas(region string) error { // nosemgrep:ci.schemas-in-func-name
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSchemas(ctx context.Context, region string) error { // nosemgrep:ci.schemas-in-func-name
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -4,32 +4,31 @@
 package secretsmanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret_policy", &sweep.Sweeper{
 		Name: "aws_secretsmanager_secret_policy",
 		F:    sweepSecretPolicies,
 	})
 
-	resource.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret", &sweep.Sweeper{
 		Name: "aws_secretsmanager_secret",
 		F:    sweepSecrets,
 	})
 }
 
-func sweepSecretPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSecretPolicies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -70,9 +69,8 @@ func sweepSecretPolicies(region string) error {
 	return nil
 }
 
-func sweepSecrets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSecrets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
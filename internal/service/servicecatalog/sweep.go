@@ -4,6 +4,7 @@
 package servicecatalog
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,37 +14,36 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_budget_resource_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_budget_resource_association",
 		Dependencies: []string{},
 		F:            sweepBudgetResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_constraint", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_constraint",
 		Dependencies: []string{},
 		F:            sweepConstraints,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_principal_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepPrincipalPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_product_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product", &sweep.Sweeper{
 		Name: "aws_servicecatalog_product",
 		Dependencies: []string{
 			"aws_servicecatalog_provisioning_artifact",
@@ -51,40 +51,39 @@ func init() {
 		F: sweepProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioned_product", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_service_action", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
 		Dependencies: []string{},
 		F:            sweepTagOptionResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_tag_option",
 		Dependencies: []string{},
 		F:            sweepTagOptions,
 	})
 }
 
-func sweepBudgetResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepBudgetResourceAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -194,9 +193,8 @@ func sweepBudgetResourceAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConstraints(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConstraints(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -264,9 +262,8 @@ func sweepConstraints(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPrincipalPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPrincipalPortfolioAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -337,9 +334,8 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProductPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProductPortfolioAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -430,9 +426,8 @@ func sweepProductPortfolioAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProducts(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProducts(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -482,9 +477,8 @@ func sweepProducts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProvisionedProducts(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProvisionedProducts(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -537,9 +531,8 @@ func sweepProvisionedProducts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProvisioningArtifacts(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepProvisioningArtifacts(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -619,9 +612,8 @@ func sweepProvisioningArtifacts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepServiceActions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServiceActions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -671,9 +663,8 @@ func sweepServiceActions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTagOptionResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTagOptionResourceAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -743,9 +734,8 @@ func sweepTagOptionResourceAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTagOptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTagOptions(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -4,18 +4,18 @@
 package servicediscovery
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_http_namespace", &sweep.Sweeper{
 		Name: "aws_service_discovery_http_namespace",
 		F:    sweepHTTPNamespaces,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_private_dns_namespace", &sweep.Sweeper{
 		Name: "aws_service_discovery_private_dns_namespace",
 		F:    sweepPrivateDNSNamespaces,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_public_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_public_dns_namespace", &sweep.Sweeper{
 		Name: "aws_service_discovery_public_dns_namespace",
 		F:    sweepPublicDNSNamespaces,
 		Dependencies: []string{
@@ -39,15 +39,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_service", &sweep.Sweeper{
 		Name: "aws_service_discovery_service",
 		F:    sweepServices,
 	})
 }
 
-func sweepHTTPNamespaces(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepHTTPNamespaces(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -82,9 +81,8 @@ func sweepHTTPNamespaces(region string) error {
 	return nil
 }
 
-func sweepPrivateDNSNamespaces(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPrivateDNSNamespaces(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -119,9 +117,8 @@ func sweepPrivateDNSNamespaces(region string) error {
 	return nil
 }
 
-func sweepPublicDNSNamespaces(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPublicDNSNamespaces(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -156,9 +153,8 @@ func sweepPublicDNSNamespaces(region string) error {
 	return nil
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepServices(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
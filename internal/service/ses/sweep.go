@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,36 +12,38 @@ import (
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &sweep.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &sweep.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeDomain) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, ses.IdentityTypeDomain)
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &sweep.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeEmailAddress) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, ses.IdentityTypeEmailAddress)
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &sweep.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
 }
 
-func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepConfigurationSets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -86,9 +89,8 @@ func sweepConfigurationSets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentities(region, identityType string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepIdentities(ctx context.Context, region, identityType string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -131,9 +133,8 @@ func sweepIdentities(region, identityType string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepReceiptRuleSets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepReceiptRuleSets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -4,31 +4,30 @@
 package sfn
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sfn_activity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sfn_activity", &sweep.Sweeper{
 		Name: "aws_sfn_activity",
 		F:    sweepActivities,
 	})
 
-	resource.AddTestSweepers("aws_sfn_state_machine", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sfn_state_machine", &sweep.Sweeper{
 		Name: "aws_sfn_state_machine",
 		F:    sweepStateMachines,
 	})
 }
 
-func sweepActivities(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepActivities(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -70,9 +69,8 @@ func sweepActivities(region string) error {
 	return nil
 }
 
-func sweepStateMachines(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepStateMachines(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
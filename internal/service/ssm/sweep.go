@@ -15,7 +15,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -24,17 +23,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_default_patch_baseline", &sweep.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepResourceDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &sweep.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_baseline", &sweep.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepResourcePatchBaselines,
 		Dependencies: []string{
@@ -42,15 +41,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &sweep.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
 }
 
-func sweepResourceDefaultPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
-	c, err := sweep.SharedRegionalSweepClient(region)
+func sweepResourceDefaultPatchBaselines(ctx context.Context, region string) error {
+	c, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -115,9 +113,8 @@ func (s defaultPatchBaselineSweeper) Delete(ctx context.Context, timeout time.Du
 	return
 }
 
-func sweepMaintenanceWindows(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMaintenanceWindows(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -171,9 +168,8 @@ func sweepMaintenanceWindows(region string) error {
 	return nil
 }
 
-func sweepResourcePatchBaselines(region string) error {
-	ctx := sweep.Context(region)
-	c, err := sweep.SharedRegionalSweepClient(region)
+func sweepResourcePatchBaselines(ctx context.Context, region string) error {
+	c, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
@@ -215,9 +211,8 @@ func sweepResourcePatchBaselines(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepResourceDataSyncs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepResourceDataSyncs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -4,6 +4,7 @@
 package ssoadmin
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,19 +12,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_account_assignment", &sweep.Sweeper{
 		Name: "aws_ssoadmin_account_assignment",
 		F:    sweepAccountAssignments,
 	})
 
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_permission_set", &sweep.Sweeper{
 		Name: "aws_ssoadmin_permission_set",
 		F:    sweepPermissionSets,
 		Dependencies: []string{
@@ -32,9 +32,8 @@ func init() {
 	})
 }
 
-func sweepAccountAssignments(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepAccountAssignments(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -137,9 +136,8 @@ func sweepAccountAssignments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPermissionSets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepPermissionSets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
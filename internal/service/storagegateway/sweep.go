@@ -4,18 +4,18 @@
 package storagegateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_gateway", &sweep.Sweeper{
 		Name: "aws_storagegateway_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -23,20 +23,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_tape_pool", &sweep.Sweeper{
 		Name: "aws_storagegateway_tape_pool",
 		F:    sweepTapePools,
 	})
 
-	resource.AddTestSweepers("aws_storagegateway_file_system_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_file_system_association", &sweep.Sweeper{
 		Name: "aws_storagegateway_file_system_association",
 		F:    sweepFileSystemAssociations,
 	})
 }
 
-func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGateways(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -78,9 +77,8 @@ func sweepGateways(region string) error {
 	return nil
 }
 
-func sweepTapePools(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTapePools(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -122,9 +120,8 @@ func sweepTapePools(region string) error {
 	return nil
 }
 
-func sweepFileSystemAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepFileSystemAssociations(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -4,32 +4,31 @@
 package timestreamwrite
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_database", &sweep.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_table", &sweep.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDatabases(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -71,9 +70,8 @@ func sweepDatabases(region string) error {
 	return nil
 }
 
-func sweepTables(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepTables(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
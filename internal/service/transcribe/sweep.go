@@ -4,19 +4,19 @@
 package transcribe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_language_model", &sweep.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_medical_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -41,7 +41,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary_filter", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
@@ -50,9 +50,8 @@ func init() {
 	})
 }
 
-func sweepLanguageModels(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepLanguageModels(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -100,9 +99,8 @@ func sweepLanguageModels(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepMedicalVocabularies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepMedicalVocabularies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -151,9 +149,8 @@ func sweepMedicalVocabularies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepVocabularies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVocabularies(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
@@ -202,9 +199,8 @@ func sweepVocabularies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepVocabularyFilters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepVocabularyFilters(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
 	}
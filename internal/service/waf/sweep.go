@@ -4,6 +4,7 @@
 package waf
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_byte_match_set", &sweep.Sweeper{
 		Name: "aws_waf_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_geo_match_set", &sweep.Sweeper{
 		Name: "aws_waf_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_ipset", &sweep.Sweeper{
 		Name: "aws_waf_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rate_based_rule", &sweep.Sweeper{
 		Name: "aws_waf_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -56,7 +56,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_match_set", &sweep.Sweeper{
 		Name: "aws_waf_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -66,7 +66,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_pattern_set", &sweep.Sweeper{
 		Name: "aws_waf_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -76,7 +76,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule_group", &sweep.Sweeper{
 		Name: "aws_waf_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -84,7 +84,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule", &sweep.Sweeper{
 		Name: "aws_waf_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -93,7 +93,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_size_constraint_set", &sweep.Sweeper{
 		Name: "aws_waf_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -103,7 +103,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_sql_injection_match_set", &sweep.Sweeper{
 		Name: "aws_waf_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -113,12 +113,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_web_acl", &sweep.Sweeper{
 		Name: "aws_waf_web_acl",
 		F:    sweepWebACLs,
 	})
 
-	resource.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_xss_match_set", &sweep.Sweeper{
 		Name: "aws_waf_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
@@ -129,9 +129,8 @@ func init() {
 	})
 }
 
-func sweepByteMatchSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepByteMatchSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -204,9 +203,8 @@ func sweepByteMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepGeoMatchSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepGeoMatchSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -279,9 +277,8 @@ func sweepGeoMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepIPSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepIPSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -354,9 +351,8 @@ func sweepIPSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRateBasedRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRateBasedRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -429,9 +425,8 @@ func sweepRateBasedRules(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRegexMatchSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegexMatchSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -504,9 +499,8 @@ func sweepRegexMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRegexPatternSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegexPatternSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -579,9 +573,8 @@ func sweepRegexPatternSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -654,9 +647,8 @@ func sweepRuleGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -733,9 +725,8 @@ func sweepRules(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSizeConstraintSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSizeConstraintSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -808,9 +799,8 @@ func sweepSizeConstraintSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSQLInjectionMatchSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepSQLInjectionMatchSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -883,9 +873,8 @@ func sweepSQLInjectionMatchSet(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWebACLs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -962,9 +951,8 @@ func sweepWebACLs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepXSSMatchSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepXSSMatchSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -13,14 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfwaf "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &sweep.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -28,17 +27,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_match_set", &sweep.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSet,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &sweep.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule", &sweep.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -46,15 +45,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &sweep.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
 	})
 }
 
-func sweepRateBasedRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRateBasedRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -146,9 +144,8 @@ func sweepRateBasedRules(region string) error {
 	return nil
 }
 
-func sweepRegexMatchSet(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegexMatchSet(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -193,9 +190,8 @@ func sweepRegexMatchSet(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -233,9 +229,8 @@ func sweepRuleGroups(region string) error {
 	return nil
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRules(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -326,9 +321,8 @@ func sweepRules(region string) error {
 	return nil
 }
 
-func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWebACLs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
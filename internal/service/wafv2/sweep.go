@@ -4,19 +4,19 @@
 package wafv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &sweep.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &sweep.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_rule_group", &sweep.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -42,15 +42,14 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &sweep.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
 }
 
-func sweepIPSets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepIPSets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -97,9 +96,8 @@ func sweepIPSets(region string) error {
 	return nil
 }
 
-func sweepRegexPatternSets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRegexPatternSets(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -146,9 +144,8 @@ func sweepRegexPatternSets(region string) error {
 	return nil
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -195,9 +192,8 @@ func sweepRuleGroups(region string) error {
 	return nil
 }
 
-func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWebACLs(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
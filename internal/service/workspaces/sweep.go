@@ -4,38 +4,37 @@
 package workspaces
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &sweep.Sweeper{
 		Name:         "aws_workspaces_directory",
 		F:            sweepDirectories,
 		Dependencies: []string{"aws_workspaces_workspace", "aws_workspaces_ip_group"},
 	})
 
-	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_ip_group", &sweep.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
 
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_workspace", &sweep.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
 }
 
-func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepDirectories(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -77,9 +76,8 @@ func sweepDirectories(region string) error {
 	return nil
 }
 
-func sweepIPGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepIPGroups(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
@@ -121,9 +119,8 @@ func sweepIPGroups(region string) error {
 	return nil
 }
 
-func sweepWorkspace(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
+func sweepWorkspace(ctx context.Context, region string) error {
+	client, err := sweep.SharedRegionalSweepClientWithContext(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
@@ -0,0 +1,186 @@
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const defaultDryRunReportPath = "sweep-dry-run-report.json"
+
+// Prefixes of the names of AWS API operations that are allowed in dry-run mode.
+var dryRunReadOnlyOperationPrefixes = []string{
+	"AssumeRole",
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+func isDryRun() bool {
+	return os.Getenv(envvar.SweepDryRun) != ""
+}
+
+// SweepableOptions contains options for sweepable resources.
+type SweepableOptions struct {
+	// MatchReason records why the resource was selected for sweeping, for example that its name has the tf-acc-test prefix.
+	MatchReason string
+}
+
+type SweepableOptionsFunc func(*SweepableOptions)
+
+// WithMatchReason sets the reason the resource was selected for sweeping.
+// The reason is included in the dry-run report.
+func WithMatchReason(format string, a ...any) SweepableOptionsFunc {
+	return func(o *SweepableOptions) {
+		o.MatchReason = fmt.Sprintf(format, a...)
+	}
+}
+
+func newSweepableOptions(optFns ...SweepableOptionsFunc) SweepableOptions {
+	var options SweepableOptions
+
+	for _, optFn := range optFns {
+		optFn(&options)
+	}
+
+	return options
+}
+
+// dryRunResource is a single resource in the dry-run report.
+type dryRunResource struct {
+	Sweeper string `json:"sweeper"`
+	Region  string `json:"region"`
+	ID      string `json:"id"`
+	Reason  string `json:"reason"`
+}
+
+// dryRunDescriber is implemented by sweepable resources that can be included in the dry-run report.
+type dryRunDescriber interface {
+	describe() dryRunResource
+}
+
+type dryRunReport struct {
+	mu        sync.Mutex
+	resources []dryRunResource
+}
+
+// dryRunResources contains the resources that would be deleted by a sweep.
+var dryRunResources = &dryRunReport{}
+
+// add adds the specified resource to the report.
+// The sweeper name and region are taken from the sweeper run in the context, if any.
+func (r *dryRunReport) add(ctx context.Context, resource dryRunResource) {
+	if run := sweeperRunFromContext(ctx); run.name != "" {
+		resource.Sweeper = run.name
+		resource.Region = run.region
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.resources = append(r.resources, resource)
+}
+
+// write writes the report as JSON to the specified file.
+func (r *dryRunReport) write(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resources := make([]dryRunResource, len(r.resources))
+	copy(resources, r.resources)
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Sweeper != resources[j].Sweeper {
+			return resources[i].Sweeper < resources[j].Sweeper
+		}
+		if resources[i].Region != resources[j].Region {
+			return resources[i].Region < resources[j].Region
+		}
+		return resources[i].ID < resources[j].ID
+	})
+
+	report := struct {
+		Resources []dryRunResource `json:"resources"`
+	}{
+		Resources: resources,
+	}
+
+	b, err := json.MarshalIndent(report, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0644) //nolint:gosec // The report contains no secrets.
+}
+
+// matchReason returns why a resource with the specified identifying values was selected for sweeping.
+func matchReason(values ...string) string {
+	for _, v := range values {
+		if strings.HasPrefix(v, ResourcePrefix) {
+			return fmt.Sprintf("%q has prefix %q", v, ResourcePrefix)
+		}
+	}
+
+	for _, v := range values {
+		if strings.Contains(v, ResourcePrefix) {
+			return fmt.Sprintf("%q contains %q", v, ResourcePrefix)
+		}
+	}
+
+	return "no reason recorded"
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range dryRunReadOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func dryRunOperationError(name string) error {
+	return fmt.Errorf("dry run: refusing to call %s", name)
+}
+
+// addDryRunHandlers prevents the specified client making AWS API calls that aren't read-only.
+// This stops sweepers that call delete APIs directly from deleting resources in dry-run mode.
+func addDryRunHandlers(client *conns.AWSClient) {
+	client.AddSDKv1HandlersFunc(func(handlers *request.Handlers) {
+		handlers.Validate.PushFront(func(r *request.Request) {
+			if name := r.Operation.Name; !isReadOnlyOperation(name) {
+				r.Error = dryRunOperationError(name)
+			}
+		})
+	})
+	client.AddSDKv2ConfigFunc(func(cfg *aws_sdkv2.Config) {
+		cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweepDryRun", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				if name := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(name) {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, dryRunOperationError(name)
+				}
+
+				return next.HandleInitialize(ctx, in)
+			}), middleware.After)
+		})
+	})
+}
@@ -0,0 +1,134 @@
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type panicSweepable struct{}
+
+func (panicSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	panic("Delete called in dry-run mode")
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) { //nolint:paralleltest
+	t.Setenv(envvar.SweepDryRun, "1")
+	dryRunResources = &dryRunReport{}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	d1 := r.Data(nil)
+	d1.SetId("id-1")
+	d1.Set("name", "tf-acc-test-1")
+
+	d2 := r.Data(nil)
+	d2.SetId("id-2")
+
+	d3 := r.Data(nil)
+	d3.SetId("id-3")
+
+	sweepables := []Sweepable{
+		NewSweepResource(r, d1, nil),
+		NewSweepResource(r, d2, nil),
+		NewSweepResource(r, d3, nil, WithMatchReason("tag %q is %q", "Purpose", "testing")),
+		NewSweepFrameworkResource(nil, "my-tf-acc-test-4", nil),
+		panicSweepable{},
+	}
+
+	stats := new(sweeperStats)
+	ctx := contextWithSweeperRun(context.Background(), &sweeperRun{name: "aws_example_thing", region: "us-west-2", stats: stats})
+
+	if err := SweepOrchestratorWithContext(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := stats.deleted.Load(), int64(len(sweepables)); got != expected {
+		t.Errorf("incorrect deleted count. Expected: %d, got: %d", expected, got)
+	}
+
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	if err := dryRunResources.write(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var report struct {
+		Resources []dryRunResource `json:"resources"`
+	}
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []dryRunResource{
+		{Sweeper: "aws_example_thing", Region: "us-west-2", ID: "", Reason: "sweep.panicSweepable does not support dry-run"},
+		{Sweeper: "aws_example_thing", Region: "us-west-2", ID: "id-1", Reason: `"tf-acc-test-1" has prefix "tf-acc-test"`},
+		{Sweeper: "aws_example_thing", Region: "us-west-2", ID: "id-2", Reason: "no reason recorded"},
+		{Sweeper: "aws_example_thing", Region: "us-west-2", ID: "id-3", Reason: `tag "Purpose" is "testing"`},
+		{Sweeper: "aws_example_thing", Region: "us-west-2", ID: "my-tf-acc-test-4", Reason: `"my-tf-acc-test-4" contains "tf-acc-test"`},
+	}
+
+	if diff := cmp.Diff(report.Resources, expected); diff != "" {
+		t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestDeleteResourceDryRun(t *testing.T) { //nolint:paralleltest
+	t.Setenv(envvar.SweepDryRun, "1")
+	dryRunResources = &dryRunReport{}
+
+	r := &schema.Resource{
+		Delete: func(*schema.ResourceData, interface{}) error {
+			return errors.New("Delete called in dry-run mode")
+		},
+	}
+	d := r.Data(nil)
+	d.SetId("tf-acc-test-1")
+
+	if err := DeleteResource(context.Background(), r, d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(dryRunResources.resources), 1; got != expected {
+		t.Errorf("incorrect number of resources in report. Expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DeleteBucket":      false,
+		"DescribeInstances": true,
+		"GetCallerIdentity": true,
+		"ListQueues":        true,
+		"TerminateInstance": false,
+		"":                  false,
+	}
+
+	for name, expected := range testCases {
+		if got := isReadOnlyOperation(name); got != expected {
+			t.Errorf("isReadOnlyOperation(%q) = %t, expected %t", name, got, expected)
+		}
+	}
+}
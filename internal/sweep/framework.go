@@ -2,6 +2,7 @@ package sweep
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -17,27 +18,86 @@ import (
 
 // Terraform Plugin Framework variants of sweeper helpers.
 
+// FrameworkAttribute is the value of a single attribute in the state of a Terraform Plugin Framework resource.
+type FrameworkAttribute struct {
+	Path  path.Path
+	Value any
+}
+
+func NewFrameworkAttribute(attributePath path.Path, value any) FrameworkAttribute {
+	return FrameworkAttribute{
+		Path:  attributePath,
+		Value: value,
+	}
+}
+
 type SweepFrameworkResource struct {
-	factory func(context.Context) (fwresource.ResourceWithConfigure, error)
-	id      string // TODO Currently we can only delete a resource if "id" is the only attribute used.
-	meta    interface{}
+	attributes []FrameworkAttribute
+	factory    func(context.Context) (fwresource.ResourceWithConfigure, error)
+	meta       interface{}
+	options    SweepableOptions
+}
+
+// NewSweepFrameworkResource returns a sweepable Terraform Plugin Framework resource whose Delete only needs the "id" attribute.
+func NewSweepFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, optFns ...SweepableOptionsFunc) *SweepFrameworkResource {
+	return NewSweepFrameworkResourceWithAttributes(factory, []FrameworkAttribute{NewFrameworkAttribute(path.Root("id"), id)}, meta, optFns...)
 }
 
-func NewSweepFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) *SweepFrameworkResource {
+// NewSweepFrameworkResourceWithAttributes returns a sweepable Terraform Plugin Framework resource whose Delete needs the specified attributes,
+// for example the components of a composite identifier.
+func NewSweepFrameworkResourceWithAttributes(factory func(context.Context) (fwresource.ResourceWithConfigure, error), attributes []FrameworkAttribute, meta interface{}, optFns ...SweepableOptionsFunc) *SweepFrameworkResource {
 	return &SweepFrameworkResource{
-		factory: factory,
-		id:      id,
-		meta:    meta,
+		attributes: attributes,
+		factory:    factory,
+		meta:       meta,
+		options:    newSweepableOptions(optFns...),
+	}
+}
+
+// id returns a string identifying the resource, for use in logs and reports.
+// This is the value of the "id" attribute if set, otherwise all attribute values.
+func (sr *SweepFrameworkResource) id() string {
+	return frameworkAttributesID(sr.attributes)
+}
+
+func frameworkAttributesID(attributes []FrameworkAttribute) string {
+	parts := make([]string, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		if attribute.Path.Equal(path.Root("id")) {
+			if v, ok := attribute.Value.(string); ok {
+				return v
+			}
+		}
+
+		parts = append(parts, fmt.Sprintf("%s=%v", attribute.Path, attribute.Value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func (sr *SweepFrameworkResource) describe() dryRunResource {
+	id := sr.id()
+	reason := sr.options.MatchReason
+
+	if reason == "" {
+		reason = matchReason(id)
+	}
+
+	return dryRunResource{
+		ID:     id,
+		Region: metaRegion(sr.meta),
+		Reason: reason,
 	}
 }
 
 func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	err := tfresource.RetryContext(ctx, timeout, func() *resource.RetryError {
-		err := DeleteFrameworkResource(sr.factory, sr.id, sr.meta)
+		err := deleteFrameworkResource(ctx, sr.factory, sr.attributes, sr.meta)
 
 		if err != nil {
 			if strings.Contains(err.Error(), "Throttling") {
-				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sr.id, err)
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sr.id(), err)
 				return resource.RetryableError(err)
 			}
 
@@ -48,14 +108,21 @@ func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Durat
 	}, optFns...)
 
 	if tfresource.TimedOut(err) {
-		err = DeleteFrameworkResource(sr.factory, sr.id, sr.meta)
+		err = deleteFrameworkResource(ctx, sr.factory, sr.attributes, sr.meta)
 	}
 
 	return err
 }
 
 func DeleteFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) error {
-	ctx := context.Background()
+	return deleteFrameworkResource(context.Background(), factory, []FrameworkAttribute{NewFrameworkAttribute(path.Root("id"), id)}, meta)
+}
+
+func deleteFrameworkResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), attributes []FrameworkAttribute, meta interface{}) error {
+	if isDryRun() {
+		dryRunResources.add(ctx, (&SweepFrameworkResource{attributes: attributes, meta: meta}).describe())
+		return nil
+	}
 
 	resource, err := factory(ctx)
 
@@ -68,12 +135,18 @@ func DeleteFrameworkResource(factory func(context.Context) (fwresource.ResourceW
 	schemaResp := fwresource.SchemaResponse{}
 	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 
-	// Simple Terraform State that contains just the resource ID.
+	// Simple Terraform State that contains just the specified attributes.
 	state := tfsdk.State{
 		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
 		Schema: schemaResp.Schema,
 	}
-	state.SetAttribute(ctx, path.Root("id"), id)
+
+	for _, attribute := range attributes {
+		if err := fwdiag.DiagnosticsError(state.SetAttribute(ctx, attribute.Path, attribute.Value)); err != nil {
+			return fmt.Errorf("setting %s: %w", attribute.Path, err)
+		}
+	}
+
 	response := fwresource.DeleteResponse{}
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
 
@@ -0,0 +1,132 @@
+package sweep
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testFrameworkResourceModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	ID     types.String `tfsdk:"id"`
+	Key    types.String `tfsdk:"key"`
+	Size   types.Int64  `tfsdk:"size"`
+}
+
+type testFrameworkResource struct {
+	deleted *testFrameworkResourceModel
+}
+
+func (r *testFrameworkResource) Metadata(_ context.Context, _ fwresource.MetadataRequest, response *fwresource.MetadataResponse) {
+	response.TypeName = "aws_example_thing"
+}
+
+func (r *testFrameworkResource) Schema(_ context.Context, _ fwresource.SchemaRequest, response *fwresource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{Required: true},
+			"id":     schema.StringAttribute{Computed: true},
+			"key":    schema.StringAttribute{Required: true},
+			"size":   schema.Int64Attribute{Optional: true},
+		},
+	}
+}
+
+func (r *testFrameworkResource) Configure(context.Context, fwresource.ConfigureRequest, *fwresource.ConfigureResponse) {
+}
+
+func (r *testFrameworkResource) Create(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse) {
+}
+
+func (r *testFrameworkResource) Read(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse) {
+}
+
+func (r *testFrameworkResource) Update(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse) {
+}
+
+func (r *testFrameworkResource) Delete(ctx context.Context, request fwresource.DeleteRequest, response *fwresource.DeleteResponse) {
+	var data testFrameworkResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	r.deleted = &data
+}
+
+func TestSweepFrameworkResourceWithAttributes(t *testing.T) {
+	t.Parallel()
+
+	r := &testFrameworkResource{}
+	factory := func(context.Context) (fwresource.ResourceWithConfigure, error) {
+		return r, nil
+	}
+
+	sweepable := NewSweepFrameworkResourceWithAttributes(factory, []FrameworkAttribute{
+		NewFrameworkAttribute(path.Root("bucket"), "tf-acc-test-bucket"),
+		NewFrameworkAttribute(path.Root("key"), "a/b"),
+		NewFrameworkAttribute(path.Root("size"), int64(42)),
+	}, nil)
+
+	if got, expected := sweepable.id(), `bucket=tf-acc-test-bucket,key=a/b,size=42`; got != expected {
+		t.Errorf("incorrect id. Expected: %s, got: %s", expected, got)
+	}
+
+	if err := sweepable.Delete(context.Background(), time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &testFrameworkResourceModel{
+		Bucket: types.StringValue("tf-acc-test-bucket"),
+		ID:     types.StringNull(),
+		Key:    types.StringValue("a/b"),
+		Size:   types.Int64Value(42),
+	}
+
+	if diff := cmp.Diff(r.deleted, expected); diff != "" {
+		t.Errorf("unexpected state diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepFrameworkResourceID(t *testing.T) {
+	t.Parallel()
+
+	r := &testFrameworkResource{}
+	factory := func(context.Context) (fwresource.ResourceWithConfigure, error) {
+		return r, nil
+	}
+
+	sweepable := NewSweepFrameworkResource(factory, "id-1", nil)
+
+	if got, expected := sweepable.id(), "id-1"; got != expected {
+		t.Errorf("incorrect id. Expected: %s, got: %s", expected, got)
+	}
+
+	if err := sweepable.Delete(context.Background(), time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := r.deleted.ID.ValueString(), "id-1"; got != expected {
+		t.Errorf("incorrect deleted id. Expected: %s, got: %s", expected, got)
+	}
+}
+
+func TestSweepFrameworkResourceInvalidAttribute(t *testing.T) {
+	t.Parallel()
+
+	factory := func(context.Context) (fwresource.ResourceWithConfigure, error) {
+		return &testFrameworkResource{}, nil
+	}
+
+	sweepable := NewSweepFrameworkResourceWithAttributes(factory, []FrameworkAttribute{
+		NewFrameworkAttribute(path.Root("missing"), "value"),
+	}, nil)
+
+	if err := sweepable.Delete(context.Background(), time.Minute); err == nil {
+		t.Fatal("expected error")
+	}
+}
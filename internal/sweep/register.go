@@ -0,0 +1,50 @@
+package sweep
+
+import (
+	"context"
+	"log"
+)
+
+// SweeperFn is a function that deletes leaked resources of a single resource type in the specified region.
+// The context should be passed to SweepOrchestratorWithContext so that deletions are included in the run summary.
+type SweeperFn func(ctx context.Context, region string) error
+
+type sweeper struct {
+	name         string
+	dependencies []string
+	f            SweeperFn
+}
+
+// sweepers contains all registered sweepers, keyed by name.
+var sweepers = make(map[string]*sweeper)
+
+// Sweeper is a sweeper registered with AddTestSweepers.
+// It mirrors the Terraform Plugin SDK's resource.Sweeper, but its function receives the run context.
+type Sweeper struct {
+	// Name is the name of the sweeper. It must be unique.
+	Name string
+
+	// F is the sweeper function.
+	F SweeperFn
+
+	// Dependencies are the names of sweepers that must complete before this sweeper is run.
+	Dependencies []string
+}
+
+// AddTestSweepers registers a sweeper.
+// Sweeper names must be unique to help ensure a given sweeper is only run once per region.
+func AddTestSweepers(name string, s *Sweeper) {
+	if s.Name != name {
+		log.Fatalf("[ERR] Error adding sweeper (%s): sweeper name (%s) does not match", name, s.Name)
+	}
+
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding sweeper (%s): sweeper already exists", name)
+	}
+
+	sweepers[name] = &sweeper{
+		name:         name,
+		dependencies: s.Dependencies,
+		f:            s.F,
+	}
+}
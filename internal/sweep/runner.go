@@ -0,0 +1,308 @@
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// defaultSweeperParallelism is the maximum number of sweepers run concurrently across all regions.
+const defaultSweeperParallelism = 10
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise it runs the tests.
+// It replaces resource.TestMain, whose sweeper runner ignores sweepers registered via Register.
+// The -sweep, -sweep-run and -sweep-allow-failures flags are those defined by the Terraform Plugin SDK.
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if v := flagValue("sweep"); v != "" {
+		regions := strings.Split(v, ",")
+		allowFailures := flagValue("sweep-allow-failures") == "true"
+
+		summary, err := runSweepers(context.Background(), regions, filterSweepers(flagValue("sweep-run"), sweepers), allowFailures, defaultSweeperParallelism)
+
+		summary.write(os.Stdout, isDryRun())
+
+		if isDryRun() {
+			path := envvar.GetWithDefault(envvar.SweepDryRunReport, defaultDryRunReportPath)
+
+			if err := dryRunResources.write(path); err != nil {
+				log.Printf("[ERROR] writing dry-run report (%s): %s", path, err)
+				os.Exit(1)
+			}
+
+			log.Printf("[INFO] Wrote dry-run report: %s", path)
+		}
+
+		if err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	resource.TestMain(m)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated values in f,
+// together with all of their (transitive) dependencies.
+func filterSweepers(f string, source map[string]*sweeper) map[string]*sweeper {
+	filters := strings.Split(strings.ToLower(f), ",")
+
+	if len(filters) == 1 && filters[0] == "" {
+		return source
+	}
+
+	result := make(map[string]*sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := source[name]
+
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, filter := range filters {
+			if strings.Contains(strings.ToLower(name), filter) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// Dependencies on sweepers that aren't present are ignored.
+func sweeperGraph(sweepers map[string]*sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	for _, name := range sortedSweeperNames(sweepers) {
+		g.AddNode(name)
+	}
+
+	for _, name := range sortedSweeperNames(sweepers) {
+		for _, dependency := range sweepers[name].dependencies {
+			if !g.HasNode(dependency) {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Check for dependency cycles.
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// runSweepers runs the specified sweepers in each region.
+// A sweeper is run in a region once all of its dependencies have completed in that region.
+// Sweepers with no outstanding dependencies are run concurrently, up to parallelism at a time.
+// Unless allowFailures is set, a sweeper is not run if any of its dependencies failed.
+func runSweepers(ctx context.Context, regions []string, sweepers map[string]*sweeper, allowFailures bool, parallelism int) (summary, error) {
+	result := make(summary, len(sweepers))
+
+	for name := range sweepers {
+		result[name] = new(sweeperStats)
+	}
+
+	g, err := sweeperGraph(sweepers)
+
+	if err != nil {
+		return result, err
+	}
+
+	var (
+		errsMu sync.Mutex
+		errs   *multierror.Error
+		wg     sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, parallelism)
+
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+		done := make(map[string]chan struct{}, len(sweepers))
+		failed := make(map[string]*atomic.Bool, len(sweepers))
+
+		for name := range sweepers {
+			done[name] = make(chan struct{})
+			failed[name] = new(atomic.Bool)
+		}
+
+		for name, s := range sweepers {
+			name, s := name, s
+			stats := result[name]
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer close(done[name])
+
+				dependencies, _ := g.DirectDependenciesOf(name)
+
+				for _, dependency := range dependencies {
+					<-done[dependency]
+
+					if failed[dependency].Load() && !allowFailures {
+						log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): dependency (%s) failed", name, region, dependency)
+						failed[name].Store(true)
+						stats.skippedRuns.Add(1)
+
+						return
+					}
+				}
+
+				semaphore <- struct{}{}
+				defer func() { <-semaphore }()
+
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+				start := time.Now()
+				run := &sweeperRun{name: name, region: region, stats: stats}
+				err := s.f(contextWithSweeperRun(ctx, run), region)
+				elapsed := time.Since(start)
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, elapsed)
+
+				switch {
+				case err == nil:
+				case SkipSweepError(err):
+					log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, err)
+					stats.skippedRuns.Add(1)
+				default:
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+					failed[name].Store(true)
+
+					// Resources that failed to delete have already been counted.
+					if run.failedResources.Load() == 0 {
+						stats.failed.Add(1)
+					}
+
+					errsMu.Lock()
+					errs = multierror.Append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err))
+					errsMu.Unlock()
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	return result, errs.ErrorOrNil()
+}
+
+func sortedSweeperNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// sweeperStats contains per-resource type sweeper run statistics.
+// Resources are counted as deleted, skipped (already deleted) or failed.
+// A sweeper run that fails without any resource failing is counted as a single failure.
+// Sweeper runs that are skipped, e.g. because a dependency failed, are counted separately.
+type sweeperStats struct {
+	deleted     atomic.Int64
+	skipped     atomic.Int64
+	failed      atomic.Int64
+	skippedRuns atomic.Int64
+}
+
+// summary contains sweeper run statistics, keyed by sweeper name.
+type summary map[string]*sweeperStats
+
+// write writes the summary as a table.
+// In dry-run mode resources are counted as deleted, but only reported.
+func (s summary) write(w io.Writer, dryRun bool) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	if dryRun {
+		fmt.Fprintln(tw, "SWEEPER\tWOULD DELETE\tSKIPPED\tFAILED\tSKIPPED RUNS")
+	} else {
+		fmt.Fprintln(tw, "SWEEPER\tDELETED\tSKIPPED\tFAILED\tSKIPPED RUNS")
+	}
+
+	for _, name := range sortedSweeperNames(s) {
+		stats := s[name]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", name, stats.deleted.Load(), stats.skipped.Load(), stats.failed.Load(), stats.skippedRuns.Load())
+	}
+
+	tw.Flush()
+}
+
+// sweeperRun identifies a single run of a sweeper in a region.
+type sweeperRun struct {
+	name   string
+	region string
+	stats  *sweeperStats
+
+	// failedResources is the number of resources that failed to delete during the run.
+	failedResources atomic.Int64
+}
+
+type sweeperRunKey struct{}
+
+func contextWithSweeperRun(ctx context.Context, run *sweeperRun) context.Context {
+	return context.WithValue(ctx, sweeperRunKey{}, run)
+}
+
+// sweeperRunFromContext returns the sweeper run from the specified context.
+// Returns a throwaway value if the context has none, for example when a sweeper ignores its context.
+func sweeperRunFromContext(ctx context.Context) *sweeperRun {
+	if run, ok := ctx.Value(sweeperRunKey{}).(*sweeperRun); ok {
+		return run
+	}
+
+	return &sweeperRun{stats: new(sweeperStats)}
+}
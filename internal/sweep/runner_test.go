@@ -0,0 +1,230 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+type testSweepable struct {
+	err error
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return s.err
+}
+
+type testRecorder struct {
+	mu    sync.Mutex
+	order map[string][]string
+}
+
+func (r *testRecorder) sweeper(name string, err error, sweepables ...Sweepable) *sweeper {
+	return &sweeper{
+		name: name,
+		f: func(ctx context.Context, region string) error {
+			r.mu.Lock()
+			r.order[region] = append(r.order[region], name)
+			r.mu.Unlock()
+
+			if err != nil {
+				return err
+			}
+
+			return SweepOrchestratorWithContext(ctx, sweepables)
+		},
+	}
+}
+
+func (s summary) counts() map[string][4]int64 {
+	counts := make(map[string][4]int64, len(s))
+
+	for name, stats := range s {
+		counts[name] = [4]int64{stats.deleted.Load(), stats.skipped.Load(), stats.failed.Load(), stats.skippedRuns.Load()}
+	}
+
+	return counts
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	r := &testRecorder{order: make(map[string][]string)}
+	sweepers := map[string]*sweeper{
+		"a": r.sweeper("a", nil, testSweepable{}, testSweepable{}),
+		"b": r.sweeper("b", nil, testSweepable{}, testSweepable{err: &resource.NotFoundError{}}),
+		"c": r.sweeper("c", nil),
+		"d": r.sweeper("d", nil, testSweepable{}),
+	}
+	sweepers["a"].dependencies = []string{"b", "missing"}
+	sweepers["b"].dependencies = []string{"c"}
+
+	summary, err := runSweepers(context.Background(), []string{"us-west-2", " us-east-1"}, sweepers, false, 2)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, region := range []string{"us-west-2", "us-east-1"} {
+		order := r.order[region]
+
+		if got, expected := len(order), 4; got != expected {
+			t.Fatalf("incorrect number of sweepers run in %s. Expected: %d, got: %d", region, expected, got)
+		}
+
+		if !(slices.Index(order, "c") < slices.Index(order, "b") && slices.Index(order, "b") < slices.Index(order, "a")) {
+			t.Errorf("incorrect order in %s: %v", region, order)
+		}
+	}
+
+	expected := map[string][4]int64{
+		"a": {4, 0, 0, 0},
+		"b": {2, 2, 0, 0},
+		"c": {0, 0, 0, 0},
+		"d": {2, 0, 0, 0},
+	}
+
+	if diff := cmp.Diff(summary.counts(), expected); diff != "" {
+		t.Errorf("unexpected summary diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAddTestSweepers(t *testing.T) {
+	const name = "aws_test_add_test_sweepers"
+
+	AddTestSweepers(name, &Sweeper{
+		Name: name,
+		F: func(ctx context.Context, region string) error {
+			return SweepOrchestratorWithContext(ctx, []Sweepable{testSweepable{}, testSweepable{}, testSweepable{err: errors.New("test")}})
+		},
+	})
+	t.Cleanup(func() {
+		delete(sweepers, name)
+	})
+
+	summary, err := runSweepers(context.Background(), []string{"us-west-2"}, filterSweepers(name, sweepers), true, 1)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := map[string][4]int64{
+		name: {2, 0, 1, 0},
+	}
+
+	if diff := cmp.Diff(summary.counts(), expected); diff != "" {
+		t.Errorf("unexpected summary diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRunSweepersFailure(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowFailures bool
+		expectedOrder []string
+		expected      map[string][4]int64
+	}{
+		"no allow failures": {
+			expectedOrder: []string{"c", "b"},
+			expected: map[string][4]int64{
+				"a": {0, 0, 0, 1},
+				"b": {0, 0, 1, 0},
+				"c": {1, 1, 0, 0},
+			},
+		},
+		"allow failures": {
+			allowFailures: true,
+			expectedOrder: []string{"c", "b", "a"},
+			expected: map[string][4]int64{
+				"a": {1, 0, 0, 0},
+				"b": {0, 0, 1, 0},
+				"c": {1, 1, 0, 0},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &testRecorder{order: make(map[string][]string)}
+			sweepers := map[string]*sweeper{
+				"a": r.sweeper("a", nil, testSweepable{}),
+				"b": r.sweeper("b", errors.New("DependencyViolation")),
+				"c": r.sweeper("c", nil, testSweepable{}, testSweepable{err: &resource.NotFoundError{}}),
+			}
+			sweepers["a"].dependencies = []string{"b"}
+			sweepers["b"].dependencies = []string{"c"}
+
+			summary, err := runSweepers(context.Background(), []string{"us-west-2"}, sweepers, testCase.allowFailures, 1)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if diff := cmp.Diff(r.order["us-west-2"], testCase.expectedOrder); diff != "" {
+				t.Errorf("unexpected order diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(summary.counts(), testCase.expected); diff != "" {
+				t.Errorf("unexpected summary diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepersCycle(t *testing.T) {
+	t.Parallel()
+
+	r := &testRecorder{order: make(map[string][]string)}
+	sweepers := map[string]*sweeper{
+		"a": r.sweeper("a", nil),
+		"b": r.sweeper("b", nil),
+	}
+	sweepers["a"].dependencies = []string{"b"}
+	sweepers["b"].dependencies = []string{"a"}
+
+	_, err := runSweepers(context.Background(), []string{"us-west-2"}, sweepers, false, 1)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := len(r.order); got != 0 {
+		t.Errorf("expected no sweepers to run, got: %v", r.order)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	source := map[string]*sweeper{
+		"aws_example_thing":    {name: "aws_example_thing", dependencies: []string{"aws_other_thing"}},
+		"aws_other_thing":      {name: "aws_other_thing", dependencies: []string{"aws_missing_thing"}},
+		"aws_unrelated_thing":  {name: "aws_unrelated_thing"},
+		"aws_example_thing_v2": {name: "aws_example_thing_v2"},
+	}
+
+	testCases := map[string][]string{
+		"":                  {"aws_example_thing", "aws_example_thing_v2", "aws_other_thing", "aws_unrelated_thing"},
+		"AWS_EXAMPLE_THING": {"aws_example_thing", "aws_example_thing_v2", "aws_other_thing"},
+		"other,unrelated":   {"aws_other_thing", "aws_unrelated_thing"},
+		"nothing":           {},
+	}
+
+	for filter, expected := range testCases {
+		if diff := cmp.Diff(sortedSweeperNames(filterSweepers(filter, source)), expected); diff != "" {
+			t.Errorf("filter %q: unexpected diff (+wanted, -got): %s", filter, diff)
+		}
+	}
+}
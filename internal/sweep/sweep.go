@@ -9,6 +9,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -33,8 +34,11 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
+// Sweepers run concurrently, so access is guarded by sweeperClientsMu.
 var SweeperClients map[string]interface{}
 
+var sweeperClientsMu sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
@@ -42,6 +46,10 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 }
 
 func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (interface{}, error) {
+	// The lock is held while the client is configured so that each regional client is only configured once.
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := SweeperClients[region]; ok {
 		return client, nil
 	}
@@ -85,13 +93,23 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		}
 	}
 
+	client := &conns.AWSClient{}
+
+	if isDryRun() {
+		addDryRunHandlers(client)
+	}
+
 	// configures a default client for the region, using the above env vars
-	client, diags := conf.ConfigureProvider(ctx, &conns.AWSClient{})
+	client, diags := conf.ConfigureProvider(ctx, client)
 
 	if diags.HasError() {
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
+	if SweeperClients == nil {
+		SweeperClients = make(map[string]interface{})
+	}
+
 	SweeperClients[region] = client
 
 	return client, nil
@@ -104,17 +122,41 @@ type Sweepable interface {
 type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
+	options  SweepableOptions
 	resource *schema.Resource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}, optFns ...SweepableOptionsFunc) *SweepResource {
 	return &SweepResource{
 		d:        d,
 		meta:     meta,
+		options:  newSweepableOptions(optFns...),
 		resource: resource,
 	}
 }
 
+func (sr *SweepResource) describe() dryRunResource {
+	reason := sr.options.MatchReason
+
+	if reason == "" {
+		values := []string{sr.d.Id()}
+
+		if _, ok := sr.resource.Schema["name"]; ok {
+			if v, ok := sr.d.GetOk("name"); ok {
+				values = append(values, v.(string))
+			}
+		}
+
+		reason = matchReason(values...)
+	}
+
+	return dryRunResource{
+		ID:     sr.d.Id(),
+		Region: metaRegion(sr.meta),
+		Reason: reason,
+	}
+}
+
 func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	err := tfresource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		err := DeleteResource(ctx, sr.resource, sr.d, sr.meta)
@@ -144,12 +186,41 @@ func SweepOrchestrator(sweepables []Sweepable) error {
 
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	var g multierror.Group
+	run := sweeperRunFromContext(ctx)
+	stats := run.stats
+
+	if isDryRun() {
+		for _, sweepable := range sweepables {
+			if v, ok := sweepable.(dryRunDescriber); ok {
+				dryRunResources.add(ctx, v.describe())
+			} else {
+				dryRunResources.add(ctx, dryRunResource{Reason: fmt.Sprintf("%T does not support dry-run", sweepable)})
+			}
+			stats.deleted.Add(1)
+		}
+
+		return nil
+	}
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+
+			switch {
+			case err == nil:
+				stats.deleted.Add(1)
+			case tfresource.NotFound(err):
+				// The resource has already been deleted.
+				stats.skipped.Add(1)
+				return nil
+			default:
+				stats.failed.Add(1)
+				run.failedResources.Add(1)
+			}
+
+			return err
 		})
 	}
 
@@ -233,6 +304,12 @@ func SkipSweepError(err error) bool {
 }
 
 func DeleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if isDryRun() {
+		// Sweepers that don't use SweepOrchestrator call DeleteResource directly.
+		dryRunResources.add(ctx, (&SweepResource{d: d, meta: meta, resource: resource}).describe())
+		return nil
+	}
+
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
@@ -261,3 +338,12 @@ func PartitionDNSSuffix(region string) string {
 	}
 	return "amazonaws.com"
 }
+
+// metaRegion returns the region of the specified provider meta, if any.
+func metaRegion(meta interface{}) string {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		return v.Region
+	}
+
+	return ""
+}
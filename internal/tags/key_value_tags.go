@@ -33,6 +33,14 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// PolicyConfig contains a tagging standard that resource tags must conform to.
+type PolicyConfig struct {
+	// Tag values must match the regular expression for their key.
+	AllowedValuePatterns map[string]*regexp.Regexp
+	ForbiddenKeys        KeyValueTags
+	RequiredKeys         KeyValueTags
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -103,6 +111,57 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	return result
 }
 
+// Violations returns a description of each way in which the given tags violate the policy, sorted.
+// Tags with nil values, e.g. values not yet known during planning, are not checked against the allowed value patterns.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var result []string
+
+	for k := range pc.RequiredKeys {
+		if !tags.KeyExists(k) {
+			result = append(result, fmt.Sprintf("required tag key %q is missing", k))
+		}
+	}
+
+	for k := range pc.ForbiddenKeys {
+		if tags.KeyExists(k) {
+			result = append(result, fmt.Sprintf("tag key %q is forbidden", k))
+		}
+	}
+
+	for k, re := range pc.AllowedValuePatterns {
+		v := tags.KeyValue(k)
+
+		if v == nil {
+			continue
+		}
+
+		if !re.MatchString(*v) {
+			result = append(result, fmt.Sprintf("value %q of tag key %q does not match %q", *v, k, re.String()))
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// ViolationsError returns an error describing each way in which the given tags violate the policy,
+// or nil if the tags comply with the policy.
+// Plugin SDK and Plugin Framework resources report the same error.
+func (pc *PolicyConfig) ViolationsError(tags KeyValueTags) error {
+	violations := pc.Violations(tags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(`"tags" violate the "tag_policy" configuration block of the provider: %s`, strings.Join(violations, "; "))
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
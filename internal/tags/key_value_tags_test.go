@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -309,6 +310,116 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	policyConfig := &PolicyConfig{
+		AllowedValuePatterns: map[string]*regexp.Regexp{
+			"env": regexp.MustCompile(`^(dev|prod)$`),
+		},
+		ForbiddenKeys: New([]string{"owner"}),
+		RequiredKeys:  New([]string{"cost-center", "env"}),
+	}
+
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		policyConfig *PolicyConfig
+		want         []string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			policyConfig: nil,
+			want:         nil,
+		},
+		{
+			name: "compliant",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+				"env":         "prod",
+			}),
+			policyConfig: policyConfig,
+			want:         nil,
+		},
+		{
+			name:         "no tags",
+			tags:         New(map[string]string{}),
+			policyConfig: policyConfig,
+			want: []string{
+				`required tag key "cost-center" is missing`,
+				`required tag key "env" is missing`,
+			},
+		},
+		{
+			name: "forbidden key and disallowed value",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+				"env":         "test",
+				"owner":       "me",
+			}),
+			policyConfig: policyConfig,
+			want: []string{
+				`tag key "owner" is forbidden`,
+				`value "test" of tag key "env" does not match "^(dev|prod)$"`,
+			},
+		},
+		{
+			name: "unknown value",
+			tags: KeyValueTags{
+				"cost-center": &TagData{Value: testStringPtr("1234")},
+				"env":         &TagData{},
+			},
+			policyConfig: policyConfig,
+			want:         nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("got %q; want %q", got, testCase.want)
+			}
+
+			for i := range got {
+				if got[i] != testCase.want[i] {
+					t.Errorf("got %q; want %q", got, testCase.want)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsPolicyConfigViolationsError(t *testing.T) {
+	t.Parallel()
+
+	policyConfig := &PolicyConfig{
+		ForbiddenKeys: New([]string{"owner"}),
+		RequiredKeys:  New([]string{"env"}),
+	}
+
+	if err := policyConfig.ViolationsError(New(map[string]string{"env": "prod"})); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := policyConfig.ViolationsError(New(map[string]string{"owner": "me"}))
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), `"tags" violate the "tag_policy" configuration block of the provider: required tag key "env" is missing; tag key "owner" is forbidden`; got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -14,15 +14,20 @@ import (
 
 // Find JSON diff functions in the json.go file.
 
+// unknownVariableValue is the Terraform Plugin SDK's placeholder for a map element whose value is not yet known.
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
 // SetTagsDiff sets the new plan difference with the result of
 // merging resource tags on to those defined at the provider-level;
 // returns an error if unsuccessful or if the resource tags are identical
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// Also returns an error if the merged tags violate the provider-level tag policy.
 func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagPolicyConfig := meta.(*conns.AWSClient).TagPolicyConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -30,6 +35,24 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	// The policy cannot be checked until all tag keys are known.
+	if diff.NewValueKnown("tags") {
+		policyTags := make(tftags.KeyValueTags)
+
+		for k, v := range defaultTagsConfig.MergeTags(resourceTags) {
+			if v != nil && aws.StringValue(v.Value) == unknownVariableValue {
+				policyTags[k] = &tftags.TagData{}
+				continue
+			}
+
+			policyTags[k] = v
+		}
+
+		if err := tagPolicyConfig.ViolationsError(policyTags); err != nil {
+			return err
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
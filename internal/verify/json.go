@@ -15,6 +15,12 @@ import (
 )
 
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return PolicyStringsEquivalent(old, new)
+}
+
+// PolicyStringsEquivalent returns whether two IAM policy documents are equivalent.
+// Empty documents are equivalent to each other.
+func PolicyStringsEquivalent(old, new string) bool {
 	if strings.TrimSpace(old) == "" && strings.TrimSpace(new) == "" {
 		return true
 	}
@@ -9,14 +9,17 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamcatalog"
 )
 
 var accountIDRegexp = regexp.MustCompile(`^(aws|aws-managed|\d{12})$`)
+var accountIDOnlyRegexp = regexp.MustCompile(`^\d{12}$`)
 var partitionRegexp = regexp.MustCompile(`^aws(-[a-z]+)*$`)
 var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
 
@@ -38,52 +41,78 @@ func Valid4ByteASN(v interface{}, k string) (ws []string, errors []error) {
 func ValidARN(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
-	if value == "" {
-		return ws, errors
+	for _, err := range validateARN(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+	}
+
+	return ws, errors
+}
+
+// ValidateARN validates that the specified string is an ARN with a valid partition,
+// region and account ID and a non-empty resource.
+// The empty string is valid.
+func ValidateARN(s string) error {
+	if errs := validateARN(s); len(errs) > 0 {
+		return fmt.Errorf("%q is an invalid ARN: %w", s, errs[0])
 	}
 
-	parsedARN, err := arn.Parse(value)
+	return nil
+}
+
+func validateARN(s string) []error {
+	if s == "" {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(s)
 
 	if err != nil {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
-		return ws, errors
+		return []error{err}
 	}
 
+	var errs []error
+
 	if parsedARN.Partition == "" {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing partition value", k, value))
+		errs = append(errs, fmt.Errorf("missing partition value"))
 	} else if !partitionRegexp.MatchString(parsedARN.Partition) {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, partitionRegexp))
+		errs = append(errs, fmt.Errorf("invalid partition value (expecting to match regular expression: %s)", partitionRegexp))
 	}
 
 	if parsedARN.Region != "" && !regionRegexp.MatchString(parsedARN.Region) {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, regionRegexp))
+		errs = append(errs, fmt.Errorf("invalid region value (expecting to match regular expression: %s)", regionRegexp))
 	}
 
 	if parsedARN.AccountID != "" && !accountIDRegexp.MatchString(parsedARN.AccountID) {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid account ID value (expecting to match regular expression: %s)", k, value, accountIDRegexp))
+		errs = append(errs, fmt.Errorf("invalid account ID value (expecting to match regular expression: %s)", accountIDRegexp))
 	}
 
 	if parsedARN.Resource == "" {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing resource value", k, value))
+		errs = append(errs, fmt.Errorf("missing resource value"))
 	}
 
-	return ws, errors
+	return errs
 }
 
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
-	// http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
-	pattern := `^\d{12}$`
-	if !regexp.MustCompile(pattern).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q doesn't look like AWS Account ID (exactly 12 digits): %q",
-			k, value))
+	if err := ValidateAccountID(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
 	}
 
 	return
 }
 
+// ValidateAccountID validates that the specified string is an AWS account ID.
+func ValidateAccountID(s string) error {
+	// http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
+	if !accountIDOnlyRegexp.MatchString(s) {
+		return fmt.Errorf("doesn't look like AWS Account ID (exactly 12 digits): %q", s)
+	}
+
+	return nil
+}
+
 // ValidateCIDRBlock validates that the specified CIDR block is valid:
 // - The CIDR block parses to an IP address and network
 // - The CIDR block is the CIDR block for the network
@@ -112,22 +141,38 @@ func ValidCIDRNetworkAddress(v interface{}, k string) (ws []string, errors []err
 }
 
 func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
-	// IAM Policy documents need to be valid JSON, and pass legacy parsing
-	value := v.(string)
-	if len(value) < 1 {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
-		return
-	}
-	if value[:1] != "{" {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy", k))
+	if err := ValidateIAMPolicyJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
 		return
 	}
-	if _, err := structure.NormalizeJsonString(v); err != nil {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+	for _, w := range IAMPolicyCatalogWarnings(v.(string)) {
+		ws = append(ws, fmt.Sprintf("%q %s", k, w))
 	}
 	return
 }
 
+// IAMPolicyCatalogWarnings returns a warning for each action and condition key
+// in the specified IAM policy that is not in the bundled IAM catalog.
+func IAMPolicyCatalogWarnings(s string) []string {
+	var ws []string
+	for _, w := range iamcatalog.CheckPolicy(s) {
+		ws = append(ws, w.String())
+	}
+	return ws
+}
+
+// ValidateIAMPolicyJSON validates that the specified string is a JSON object
+// that passes legacy IAM policy parsing.
+func ValidateIAMPolicyJSON(s string) error {
+	if len(s) < 1 || s[:1] != "{" {
+		return fmt.Errorf("contains an invalid JSON policy")
+	}
+	if _, err := structure.NormalizeJsonString(s); err != nil {
+		return fmt.Errorf("contains an invalid JSON: %w", err)
+	}
+	return nil
+}
+
 // ValidateIPv4CIDRBlock validates that the specified CIDR block is valid:
 // - The CIDR block parses to an IP address and network
 // - The IP address is an IPv4 address
@@ -231,8 +276,8 @@ func ValidLaunchTemplateName(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
-// validateMulticastIPAddress validates that the specified string is a multicast IP address.
-func validateMulticastIPAddress(s string) error {
+// ValidateMulticastIPAddress validates that the specified string is a multicast IP address.
+func ValidateMulticastIPAddress(s string) error {
 	ip := net.ParseIP(s)
 	if ip == nil {
 		return fmt.Errorf("%q is not a valid IP address", s)
@@ -246,7 +291,7 @@ func validateMulticastIPAddress(s string) error {
 }
 
 func ValidMulticastIPAddress(v interface{}, k string) (ws []string, errors []error) {
-	if err := validateMulticastIPAddress(v.(string)); err != nil {
+	if err := ValidateMulticastIPAddress(v.(string)); err != nil {
 		errors = append(errors, err)
 		return
 	}
@@ -255,44 +300,64 @@ func ValidMulticastIPAddress(v interface{}, k string) (ws []string, errors []err
 }
 
 func ValidOnceADayWindowFormat(v interface{}, k string) (ws []string, errors []error) {
+	if err := ValidateOnceADayWindowFormat(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
+	}
+	return
+}
+
+// ValidateOnceADayWindowFormat validates that the specified string is a daily time window, e.g. "04:00-05:00".
+// The empty string is valid.
+func ValidateOnceADayWindowFormat(s string) error {
 	// valid time format is "hh24:mi"
 	validTimeFormat := "([0-1][0-9]|2[0-3]):([0-5][0-9])"
 	validTimeFormatConsolidated := "^(" + validTimeFormat + "-" + validTimeFormat + "|)$"
 
-	value := v.(string)
-	if !regexp.MustCompile(validTimeFormatConsolidated).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q must satisfy the format of \"hh24:mi-hh24:mi\".", k))
+	if !regexp.MustCompile(validTimeFormatConsolidated).MatchString(s) {
+		return fmt.Errorf("must satisfy the format of \"hh24:mi-hh24:mi\"")
 	}
-	return
+	return nil
 }
 
 func ValidOnceAWeekWindowFormat(v interface{}, k string) (ws []string, errors []error) {
+	if err := ValidateOnceAWeekWindowFormat(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
+	}
+	return
+}
+
+// ValidateOnceAWeekWindowFormat validates that the specified string is a weekly time window, e.g. "sun:04:00-sun:05:00".
+// The empty string is valid.
+func ValidateOnceAWeekWindowFormat(s string) error {
 	// valid time format is "ddd:hh24:mi"
 	validTimeFormat := "(sun|mon|tue|wed|thu|fri|sat):([0-1][0-9]|2[0-3]):([0-5][0-9])"
 	validTimeFormatConsolidated := "^(" + validTimeFormat + "-" + validTimeFormat + "|)$"
 
-	value := strings.ToLower(v.(string))
-	if !regexp.MustCompile(validTimeFormatConsolidated).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\".", k))
+	if !regexp.MustCompile(validTimeFormatConsolidated).MatchString(strings.ToLower(s)) {
+		return fmt.Errorf("must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\"")
 	}
-	return
+	return nil
 }
 
 func ValidRegionName(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
+	if err := ValidateRegionName(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
+	}
 
-	if value == "" {
-		return ws, errors
+	return
+}
+
+// ValidateRegionName validates that the specified string is a well-formed AWS Region name.
+// The empty string is valid.
+func ValidateRegionName(s string) error {
+	if s == "" {
+		return nil
 	}
-	if !regionRegexp.MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q region name is malformed(%q): %q",
-			k, regionRegexp, value))
+	if !regionRegexp.MatchString(s) {
+		return fmt.Errorf("region name is malformed(%q): %q", regionRegexp, s)
 	}
 
-	return
+	return nil
 }
 
 func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []error) {
@@ -308,6 +373,40 @@ func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidateS3BucketName validates an S3 bucket name for the specified region.
+// Buckets outside of the us-east-1 region have to be DNS-compliant.
+// Legacy bucket names in the us-east-1 region may also contain uppercase letters and underscores.
+func ValidateS3BucketName(value string, region string) error {
+	if region != endpoints.UsEast1RegionID {
+		if (len(value) < 3) || (len(value) > 63) {
+			return fmt.Errorf("%q must contain from 3 to 63 characters", value)
+		}
+		if !regexp.MustCompile(`^[0-9a-z-.]+$`).MatchString(value) {
+			return fmt.Errorf("only lowercase alphanumeric characters and hyphens allowed in %q", value)
+		}
+		if regexp.MustCompile(`^(?:[0-9]{1,3}\.){3}[0-9]{1,3}$`).MatchString(value) {
+			return fmt.Errorf("%q must not be formatted as an IP address", value)
+		}
+		if strings.HasPrefix(value, `.`) {
+			return fmt.Errorf("%q cannot start with a period", value)
+		}
+		if strings.HasSuffix(value, `.`) {
+			return fmt.Errorf("%q cannot end with a period", value)
+		}
+		if strings.Contains(value, `..`) {
+			return fmt.Errorf("%q can be only one period between labels", value)
+		}
+	} else {
+		if len(value) > 255 {
+			return fmt.Errorf("%q must contain less than 256 characters", value)
+		}
+		if !regexp.MustCompile(`^[0-9a-zA-Z-._]+$`).MatchString(value) {
+			return fmt.Errorf("only alphanumeric characters, hyphens, periods, and underscores allowed in %q", value)
+		}
+	}
+	return nil
+}
+
 // ValidTypeStringNullableBoolean provides custom error messaging for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified).
 // This ValidateFunc returns a custom message since the message with
@@ -354,14 +453,20 @@ func ValidTypeStringNullableFloat(v interface{}, k string) (ws []string, es []er
 // https://docs.aws.amazon.com/iot/latest/apireference/API_CloudwatchMetricAction.html
 // https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_RestoreDBInstanceToPointInTime.html
 func ValidUTCTimestamp(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	_, err := time.Parse(time.RFC3339, value)
-	if err != nil {
-		errors = append(errors, fmt.Errorf("%q must be in RFC3339 time format %q. Example: %s", k, time.RFC3339, err))
+	if err := ValidateUTCTimestamp(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
 	}
 	return
 }
 
+// ValidateUTCTimestamp validates that the specified string is a timestamp in RFC3339 format.
+func ValidateUTCTimestamp(s string) error {
+	if _, err := time.Parse(time.RFC3339, s); err != nil {
+		return fmt.Errorf("must be in RFC3339 time format %q. Example: %w", time.RFC3339, err)
+	}
+	return nil
+}
+
 var ValidStringDateOrPositiveInt = validation.Any(
 	validation.IsRFC3339Time,
 	validation.StringMatch(regexp.MustCompile(`^\d+$`), "must be a positive integer value"),
@@ -1,6 +1,7 @@
 package verify
 
 import (
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -443,6 +444,14 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 			t.Fatalf("Expected %q not to trigger a validation error.", tc.Value)
 		}
 	}
+
+	ws, errors := ValidIAMPolicyJSON(`{"Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:GetObjects"],"Resource":"*"}]}`, "json")
+	if len(errors) != 0 {
+		t.Fatalf("Expected no validation errors, got %v", errors)
+	}
+	if expected := []string{`"json" statement 0 (Sid "Read"): action "s3:GetObjects" is not a known s3 action`}; !reflect.DeepEqual(ws, expected) {
+		t.Fatalf("Expected warnings %q, got %q", expected, ws)
+	}
 }
 
 func TestValidStringIsJSONOrYAML(t *testing.T) {
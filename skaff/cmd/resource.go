@@ -11,12 +11,17 @@ var (
 	name          string
 	force         bool
 	v1            bool
+	operations    resource.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if operations != (resource.Operations{}) {
+			return resource.CreateFromOperations(name, snakeName, operations, force, !v1)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1)
 	},
 }
@@ -28,4 +33,9 @@ func init() {
 	resourceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
+	resourceCmd.Flags().StringVar(&operations.Create, "create", "", "AWS SDK operation creating the resource (e.g., CreateScheduleGroup)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read", "", "AWS SDK operation reading the resource (e.g., GetScheduleGroup)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update", "", "AWS SDK operation updating the resource, if any (e.g., UpdateSchedule)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete", "", "AWS SDK operation deleting the resource (e.g., DeleteScheduleGroup)")
+	resourceCmd.Flags().StringVar(&operations.List, "list", "", "AWS SDK operation listing resources, if any, used by the sweeper (e.g., ListScheduleGroups)")
 }
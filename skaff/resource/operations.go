@@ -0,0 +1,612 @@
+package resource
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Operations are the names of the AWS SDK operations from which a resource is scaffolded.
+type Operations struct {
+	Create string
+	Read   string
+	Update string // Optional. If not set, all arguments force a new resource.
+	Delete string
+	List   string // Optional. Used by the sweeper.
+}
+
+func (o Operations) validate() error {
+	if o.Create == "" || o.Read == "" || o.Delete == "" {
+		return fmt.Errorf("error checking: create, read and delete operations are all required")
+	}
+
+	return nil
+}
+
+// Field names which are never scaffolded as resource attributes.
+var skippedFields = map[string]bool{
+	"_":                     true,
+	"ClientToken":           true,
+	"DryRun":                true,
+	"MaxResults":            true,
+	"NextToken":             true,
+	"ResultMetadata":        true,
+	"noSmithyDocumentSerde": true,
+}
+
+// sdkPackage is the parsed source of an AWS SDK for Go service package.
+type sdkPackage struct {
+	name string
+	v2   bool
+	// Keyed by the qualified name used in generated code, e.g. "scheduler.GetScheduleGroupInput" or "types.Target".
+	structs map[string]*ast.StructType
+	enums   map[string][]enumValue
+	funcs   map[string]bool
+	consts  map[string]bool
+}
+
+type enumValue struct {
+	Name  string // Qualified constant name, e.g. "types.ScheduleGroupStateActive"
+	Value string
+}
+
+func newSDKPackage(name string, v2 bool) *sdkPackage {
+	return &sdkPackage{
+		name:    name,
+		v2:      v2,
+		structs: make(map[string]*ast.StructType),
+		enums:   make(map[string][]enumValue),
+		funcs:   make(map[string]bool),
+		consts:  make(map[string]bool),
+	}
+}
+
+// loadSDKPackage parses the source of the specified AWS SDK for Go service package and,
+// for AWS SDK for Go v2, its types package.
+func loadSDKPackage(name string, v2 bool) (*sdkPackage, error) {
+	p := newSDKPackage(name, v2)
+
+	if v2 {
+		importPath := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", name)
+
+		if err := p.parse(importPath, name); err != nil {
+			return nil, err
+		}
+
+		if err := p.parse(importPath+"/types", "types"); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := p.parse(fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", name), name); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p *sdkPackage) parse(importPath, qualifier string) error {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", importPath).Output()
+
+	if err != nil {
+		return fmt.Errorf("locating package (%s): %w", importPath, err)
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), strings.TrimSpace(string(out)), func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return fmt.Errorf("parsing package (%s): %w", importPath, err)
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			p.addFile(file, qualifier)
+		}
+	}
+
+	return nil
+}
+
+func (p *sdkPackage) addFile(file *ast.File, qualifier string) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if qualifier == p.name {
+				p.funcs[decl.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					switch t := spec.Type.(type) {
+					case *ast.StructType:
+						p.structs[qualifier+"."+spec.Name.Name] = t
+					case *ast.Ident:
+						if t.Name == "string" {
+							if _, ok := p.enums[qualifier+"."+spec.Name.Name]; !ok {
+								p.enums[qualifier+"."+spec.Name.Name] = nil
+							}
+						}
+					}
+				case *ast.ValueSpec:
+					p.addConsts(spec, qualifier)
+				}
+			}
+		}
+	}
+}
+
+func (p *sdkPackage) addConsts(spec *ast.ValueSpec, qualifier string) {
+	for i, name := range spec.Names {
+		p.consts[qualifier+"."+name.Name] = true
+
+		if i >= len(spec.Values) {
+			continue
+		}
+
+		lit, ok := spec.Values[i].(*ast.BasicLit)
+
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(lit.Value)
+
+		if err != nil {
+			continue
+		}
+
+		// AWS SDK for Go v2 enum constants are typed; AWS SDK for Go v1 enum constants are named <Enum><Value>.
+		enum := ""
+		if ident, ok := spec.Type.(*ast.Ident); ok {
+			enum = qualifier + "." + ident.Name
+		}
+
+		p.enums[enum] = append(p.enums[enum], enumValue{Name: qualifier + "." + name.Name, Value: value})
+	}
+}
+
+// enumValues returns the values of the specified enum type.
+func (p *sdkPackage) enumValues(enum string) []enumValue {
+	if p.v2 {
+		return p.enums[enum]
+	}
+
+	var values []enumValue
+	for _, v := range p.enums[""] {
+		if strings.HasPrefix(v.Name, enum) {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// isEnum returns whether the specified qualified type is an AWS SDK for Go v2 string enum.
+func (p *sdkPackage) isEnum(name string) bool {
+	_, ok := p.enums[name]
+
+	return ok && p.v2
+}
+
+// attribute is a scaffolded resource attribute.
+type attribute struct {
+	Name      string // Terraform attribute name
+	FieldName string // AWS SDK struct field name
+
+	Type             string // e.g. "schema.TypeString"
+	Elem             string // For collections of primitives, e.g. "schema.TypeString"
+	Attributes       []*attribute
+	MaxItems         int
+	Required         bool
+	Optional         bool
+	Computed         bool
+	ForceNew         bool
+	ValidateFunc     string
+	ValidateDiagFunc string
+
+	// Expand is the expression converting the configuration value "v" to the AWS SDK value.
+	// Empty if the conversion must be written by hand.
+	Expand string
+	// Flatten is the format of the expression converting an AWS SDK value to the state value.
+	// Empty if the conversion must be written by hand.
+	Flatten string
+	// StringValue is whether the AWS SDK value is a (pointer to) string.
+	StringValue bool
+	Pointer     bool
+}
+
+// FlattenExpr returns the expression converting the AWS SDK value of the specified variable's field.
+func (a *attribute) FlattenExpr(v string) string {
+	return fmt.Sprintf(a.Flatten, fmt.Sprintf("%s.%s", v, a.FieldName))
+}
+
+// typeRef is a resolved AWS SDK type expression.
+type typeRef struct {
+	pointer bool
+	slice   *typeRef
+	mapOf   *typeRef
+	name    string // Builtin or qualified type name
+}
+
+func (p *sdkPackage) resolve(expr ast.Expr, qualifier string) *typeRef {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		t := p.resolve(expr.X, qualifier)
+		if t != nil {
+			t.pointer = true
+		}
+		return t
+	case *ast.ArrayType:
+		if elem := p.resolve(expr.Elt, qualifier); elem != nil {
+			return &typeRef{slice: elem}
+		}
+	case *ast.MapType:
+		if key, ok := expr.Key.(*ast.Ident); ok && key.Name == "string" {
+			if elem := p.resolve(expr.Value, qualifier); elem != nil {
+				return &typeRef{mapOf: elem}
+			}
+		}
+	case *ast.Ident:
+		switch expr.Name {
+		case "string", "bool", "int32", "int64", "float32", "float64":
+			return &typeRef{name: expr.Name}
+		default:
+			return &typeRef{name: qualifier + "." + expr.Name}
+		}
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok {
+			return &typeRef{name: x.Name + "." + expr.Sel.Name}
+		}
+	}
+
+	return nil
+}
+
+// structFields returns the scaffoldable fields of the specified struct in declaration order.
+func (p *sdkPackage) structFields(name string) []*ast.Field {
+	s, ok := p.structs[name]
+
+	if !ok {
+		return nil
+	}
+
+	var fields []*ast.Field
+	for _, field := range s.Fields.List {
+		if len(field.Names) != 1 || skippedFields[field.Names[0].Name] || !field.Names[0].IsExported() {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// isRequired returns whether the specified input struct field is required.
+func (p *sdkPackage) isRequired(field *ast.Field) bool {
+	if p.v2 {
+		return field.Doc != nil && strings.Contains(field.Doc.Text(), "This member is required.")
+	}
+
+	return structTag(field, "required") == "true"
+}
+
+func structTag(field *ast.Field, key string) string {
+	if field.Tag == nil {
+		return ""
+	}
+
+	tag, err := strconv.Unquote(field.Tag.Value)
+
+	if err != nil {
+		return ""
+	}
+
+	return reflect.StructTag(tag).Get(key)
+}
+
+// qualifierOf returns the package qualifier of the specified qualified type name.
+func qualifierOf(name string) string {
+	qualifier, _, _ := strings.Cut(name, ".")
+
+	return qualifier
+}
+
+const maxNestingDepth = 3
+
+// attributes returns the attributes corresponding to the specified struct's fields.
+// The names of fields whose types cannot be scaffolded are returned separately.
+func (p *sdkPackage) attributes(structName string, depth int) ([]*attribute, []string) {
+	var attributes []*attribute
+	var unsupported []string
+
+	for _, field := range p.structFields(structName) {
+		fieldName := field.Names[0].Name
+		a := p.attribute(fieldName, p.resolve(field.Type, qualifierOf(structName)), depth)
+
+		if a == nil {
+			unsupported = append(unsupported, fieldName)
+
+			continue
+		}
+
+		if p.isRequired(field) {
+			a.Required = true
+		} else {
+			a.Optional = true
+		}
+
+		if enum := structTag(field, "enum"); enum != "" && !p.v2 {
+			a.ValidateFunc = fmt.Sprintf("validation.StringInSlice(%s.%s_Values(), false)", p.name, enum)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	return attributes, unsupported
+}
+
+func (p *sdkPackage) attribute(fieldName string, t *typeRef, depth int) *attribute {
+	if t == nil {
+		return nil
+	}
+
+	a := &attribute{
+		Name:      ToSnakeCase(fieldName, ""),
+		FieldName: fieldName,
+		Pointer:   t.pointer,
+		Flatten:   "%s",
+	}
+
+	switch {
+	case t.slice != nil:
+		elem := t.slice
+
+		if elemType := primitiveSchemaType(elem.name); elemType != "" && elem.slice == nil && elem.mapOf == nil {
+			a.Type = "schema.TypeSet"
+			a.Elem = elemType
+
+			if elem.name == "string" {
+				if elem.pointer {
+					a.Expand = "flex.ExpandStringSet(v.(*schema.Set))"
+					a.Flatten = "aws.StringValueSlice(%s)"
+				} else {
+					a.Expand = "flex.ExpandStringValueSet(v.(*schema.Set))"
+				}
+			} else {
+				a.Flatten = ""
+			}
+
+			return a
+		}
+
+		if p.isEnum(elem.name) {
+			a.Type = "schema.TypeSet"
+			a.Elem = "schema.TypeString"
+			a.Flatten = ""
+
+			return a
+		}
+
+		if _, ok := p.structs[elem.name]; ok && depth < maxNestingDepth {
+			a.Type = "schema.TypeList"
+			a.Attributes, _ = p.attributes(elem.name, depth+1)
+			a.Flatten = ""
+
+			return a
+		}
+	case t.mapOf != nil:
+		if t.mapOf.name == "string" {
+			a.Type = "schema.TypeMap"
+			a.Elem = "schema.TypeString"
+
+			if t.mapOf.pointer {
+				a.Expand = "flex.ExpandStringMap(v.(map[string]interface{}))"
+				a.Flatten = "aws.StringValueMap(%s)"
+			} else {
+				a.Expand = "flex.ExpandStringValueMap(v.(map[string]interface{}))"
+			}
+
+			return a
+		}
+	case t.name == "time.Time":
+		a.Type = "schema.TypeString"
+		if p.v2 {
+			a.Flatten = "aws.ToTime(%s).Format(time.RFC3339)"
+		} else {
+			a.Flatten = "aws.TimeValue(%s).Format(time.RFC3339)"
+		}
+
+		return a
+	case p.isEnum(t.name):
+		a.Type = "schema.TypeString"
+		a.StringValue = true
+		a.ValidateDiagFunc = fmt.Sprintf("enum.Validate[%s]()", t.name)
+		a.Expand = fmt.Sprintf("%s(v.(string))", t.name)
+
+		return a
+	case primitiveSchemaType(t.name) != "":
+		a.Type = primitiveSchemaType(t.name)
+		a.StringValue = t.name == "string"
+		a.Expand = expandPrimitive(t.name, t.pointer)
+
+		if t.name == "int32" && t.pointer {
+			a.Flatten = "aws.ToInt32(%s)"
+		}
+
+		return a
+	default:
+		if _, ok := p.structs[t.name]; ok && depth < maxNestingDepth {
+			a.Type = "schema.TypeList"
+			a.MaxItems = 1
+			a.Attributes, _ = p.attributes(t.name, depth+1)
+			a.Flatten = ""
+
+			return a
+		}
+	}
+
+	return nil
+}
+
+func primitiveSchemaType(name string) string {
+	switch name {
+	case "string":
+		return "schema.TypeString"
+	case "bool":
+		return "schema.TypeBool"
+	case "int32", "int64":
+		return "schema.TypeInt"
+	case "float32", "float64":
+		return "schema.TypeFloat"
+	default:
+		return ""
+	}
+}
+
+func expandPrimitive(name string, pointer bool) string {
+	var expr string
+
+	switch name {
+	case "string":
+		expr = "v.(string)"
+	case "bool":
+		expr = "v.(bool)"
+	case "int32":
+		expr = "int32(v.(int))"
+	case "int64":
+		expr = "int64(v.(int))"
+	case "float32":
+		expr = "float32(v.(float64))"
+	case "float64":
+		expr = "v.(float64)"
+	}
+
+	if !pointer {
+		return expr
+	}
+
+	return fmt.Sprintf("aws.%s(%s)", strings.ToUpper(name[0:1])+name[1:], expr)
+}
+
+// field returns the named field of the specified struct.
+func (p *sdkPackage) field(structName, fieldName string) *ast.Field {
+	for _, field := range p.structFields(structName) {
+		if field.Names[0].Name == fieldName {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// identifier returns the name of the first required field of the specified input struct
+// and whether the field is a pointer.
+func (p *sdkPackage) identifier(structName string) (string, bool) {
+	for _, field := range p.structFields(structName) {
+		if p.isRequired(field) {
+			_, pointer := field.Type.(*ast.StarExpr)
+
+			return field.Names[0].Name, pointer
+		}
+	}
+
+	return "", false
+}
+
+// singleStructField returns the name and qualified type of the only field of the specified struct
+// if that field is a pointer to a struct, e.g. the resource description in an operation's output.
+func (p *sdkPackage) singleStructField(structName string) (string, string) {
+	fields := p.structFields(structName)
+
+	if len(fields) != 1 {
+		return "", ""
+	}
+
+	t := p.resolve(fields[0].Type, qualifierOf(structName))
+
+	if t == nil || !t.pointer || t.slice != nil || t.mapOf != nil {
+		return "", ""
+	}
+
+	if _, ok := p.structs[t.name]; !ok {
+		return "", ""
+	}
+
+	return fields[0].Names[0].Name, t.name
+}
+
+// listItems returns the name and element type of the first field of the specified struct which is a slice of structs.
+func (p *sdkPackage) listItems(structName string) (string, string) {
+	for _, field := range p.structFields(structName) {
+		t := p.resolve(field.Type, qualifierOf(structName))
+
+		if t == nil || t.slice == nil {
+			continue
+		}
+
+		if _, ok := p.structs[t.slice.name]; ok {
+			return field.Names[0].Name, t.slice.name
+		}
+	}
+
+	return "", ""
+}
+
+// notFound returns the AWS SDK for Go v2 error type or AWS SDK for Go v1 error code
+// indicating that a resource does not exist.
+func (p *sdkPackage) notFound() string {
+	if p.v2 {
+		if _, ok := p.structs["types.ResourceNotFoundException"]; ok {
+			return "types.ResourceNotFoundException"
+		}
+
+		return p.firstWithSuffix(p.structNames(), "NotFoundException")
+	}
+
+	if v := p.name + ".ErrCodeResourceNotFoundException"; p.consts[v] {
+		return v
+	}
+
+	return p.firstWithSuffix(p.constNames(), "NotFoundException")
+}
+
+func (p *sdkPackage) structNames() []string {
+	names := make([]string, 0, len(p.structs))
+	for name := range p.structs {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func (p *sdkPackage) constNames() []string {
+	names := make([]string, 0, len(p.consts))
+	for name := range p.consts {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func (p *sdkPackage) firstWithSuffix(names []string, suffix string) string {
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.HasSuffix(name, suffix) {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,196 @@
+package resource
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+const testSDKv2Source = `
+package example
+
+type CreateWidgetInput struct {
+	// This member is required.
+	Name *string
+
+	Description *string
+	Size        int32
+	Labels      []string
+	Tags        map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+	// This member is required.
+	WidgetArn *string
+}
+
+type GetWidgetInput struct {
+	// This member is required.
+	Name *string
+}
+
+type GetWidgetOutput struct {
+	Widget *types.Widget
+}
+
+type UpdateWidgetInput struct {
+	// This member is required.
+	Name *string
+
+	Description *string
+}
+
+type UpdateWidgetOutput struct{}
+
+type DeleteWidgetInput struct {
+	// This member is required.
+	Name *string
+}
+
+type DeleteWidgetOutput struct{}
+`
+
+const testSDKv2TypesSource = `
+package types
+
+type Widget struct {
+	Arn         *string
+	Description *string
+	Name        *string
+	Size        int32
+	Labels      []string
+	State       WidgetState
+}
+
+type WidgetState string
+
+const (
+	WidgetStateCreating WidgetState = "CREATING"
+	WidgetStateActive   WidgetState = "ACTIVE"
+	WidgetStateDeleting WidgetState = "DELETING"
+)
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+`
+
+func testSDKPackage(t *testing.T) *sdkPackage {
+	t.Helper()
+
+	p := newSDKPackage("example", true)
+
+	for qualifier, src := range map[string]string{
+		"example": testSDKv2Source,
+		"types":   testSDKv2TypesSource,
+	} {
+		file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("parsing source: %s", err)
+		}
+
+		p.addFile(file, qualifier)
+	}
+
+	return p
+}
+
+func TestOperationsTemplateData(t *testing.T) {
+	p := testSDKPackage(t)
+
+	otd, err := newOperationsTemplateData(&TemplateData{Resource: "Widget", AWSGoSDKV2: true}, p, Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := otd.IDField, "Name"; got != want {
+		t.Errorf("IDField = %q, want %q", got, want)
+	}
+
+	if got, want := otd.FindType, "types.Widget"; got != want {
+		t.Errorf("FindType = %q, want %q", got, want)
+	}
+
+	if got, want := otd.NotFound, "types.ResourceNotFoundException"; got != want {
+		t.Errorf("NotFound = %q, want %q", got, want)
+	}
+
+	if !otd.HasTags {
+		t.Error("HasTags = false, want true")
+	}
+
+	attributes := make(map[string]*attribute)
+	for _, a := range otd.Attributes {
+		attributes[a.Name] = a
+	}
+
+	testCases := []struct {
+		Name     string
+		Type     string
+		Required bool
+		Optional bool
+		Computed bool
+		ForceNew bool
+	}{
+		{Name: "arn", Type: "schema.TypeString", Computed: true},
+		{Name: "description", Type: "schema.TypeString", Optional: true},
+		{Name: "labels", Type: "schema.TypeSet", Optional: true, ForceNew: true},
+		{Name: "name", Type: "schema.TypeString", Required: true, ForceNew: true},
+		{Name: "size", Type: "schema.TypeInt", Optional: true, ForceNew: true},
+		{Name: "state", Type: "schema.TypeString", Computed: true},
+	}
+
+	if got, want := len(attributes), len(testCases); got != want {
+		t.Errorf("len(Attributes) = %d, want %d", got, want)
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			a, ok := attributes[testCase.Name]
+
+			if !ok {
+				t.Fatal("attribute not found")
+			}
+
+			if a.Type != testCase.Type {
+				t.Errorf("Type = %q, want %q", a.Type, testCase.Type)
+			}
+
+			if a.Required != testCase.Required || a.Optional != testCase.Optional || a.Computed != testCase.Computed || a.ForceNew != testCase.ForceNew {
+				t.Errorf("Required, Optional, Computed, ForceNew = %t, %t, %t, %t, want %t, %t, %t, %t",
+					a.Required, a.Optional, a.Computed, a.ForceNew,
+					testCase.Required, testCase.Optional, testCase.Computed, testCase.ForceNew)
+			}
+		})
+	}
+
+	if got, want := otd.Status, "string(out.State)"; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+
+	if !otd.WaitCreated() || otd.WaitUpdated() || !otd.WaitDeleted() {
+		t.Errorf("WaitCreated, WaitUpdated, WaitDeleted = %t, %t, %t, want true, false, true", otd.WaitCreated(), otd.WaitUpdated(), otd.WaitDeleted())
+	}
+
+	if got, want := otd.StateSlice(otd.StatesPending), "enum.Slice(types.WidgetStateCreating)"; got != want {
+		t.Errorf("StateSlice(StatesPending) = %q, want %q", got, want)
+	}
+}
+
+func TestOperationsValidate(t *testing.T) {
+	if err := (Operations{Create: "CreateWidget", Read: "GetWidget"}).validate(); err == nil {
+		t.Error("expected error for missing delete operation")
+	}
+
+	if err := (Operations{Create: "CreateWidget", Read: "GetWidget", Delete: "DeleteWidget"}).validate(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -59,43 +59,68 @@ func HumanResName(upper string) string {
 }
 
 func Create(resName, snakeName string, comments, force, v2 bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, v2)
+	if err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, v2 bool) (*TemplateData, error) {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return nil, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return nil, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return nil, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return nil, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return nil, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return nil, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return nil, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	return &TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -107,28 +132,10 @@ func Create(resName, snakeName string, comments, force, v2 bool) error {
 		AWSServiceName:       sn,
 		AWSGoSDKV2:           v2,
 		HumanResourceName:    HumanResName(resName),
-	}
-
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
-
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
-	}
-
-	return nil
+	}, nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+func writeTemplate(templateName, filename, tmpl string, force bool, td any) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
@@ -0,0 +1,652 @@
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed sdkresource.tmpl
+var sdkResourceTmpl string
+
+//go:embed sdkresourcetest.tmpl
+var sdkResourceTestTmpl string
+
+//go:embed exports.tmpl
+var exportsTmpl string
+
+//go:embed find.tmpl
+var findTmpl string
+
+//go:embed status.tmpl
+var statusTmpl string
+
+//go:embed wait.tmpl
+var waitTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+type OperationsTemplateData struct {
+	TemplateData
+
+	Operations Operations
+	SDKPackage string // AWS SDK package name, e.g. "scheduler"
+
+	Attributes  []*attribute
+	CreateArgs  []*attribute
+	UpdateArgs  []*attribute
+	ReadAttrs   []*attribute
+	Unsupported []string
+	HasTags     bool
+
+	// The resource's identifier, i.e. the first required field of the read operation's input.
+	IDField     string
+	IDAttribute string
+	IDPointer   bool
+	// Expression for the identifier of a newly created resource.
+	CreateIDExpr    string
+	UpdateIDField   string
+	UpdateIDPointer bool
+	DeleteIDField   string
+	DeleteIDPointer bool
+
+	FindType     string // e.g. "types.ScheduleGroup" or "scheduler.GetScheduleGroupOutput"
+	FindAccessor string // e.g. ".ScheduleGroup", empty if the read output describes the resource
+
+	NotFound string // AWS SDK for Go v2 error type or AWS SDK for Go v1 error code
+
+	// Status expression and states, empty if the resource has no status.
+	Status         string
+	StatesPending  []string
+	StatesCreated  []string
+	StatesUpdating []string
+	StatesDeleting []string
+
+	ListItems         string // Field of the list operation's output containing the resources
+	ListItemIDField   string
+	ListItemIDPointer bool
+	ListSDKPaginator  bool
+	ListPagesWithCtx  bool
+
+	Append bool // Whether the template is appended to an existing file
+}
+
+// States used to classify enum values for status waiters.
+var (
+	pendingStates  = []string{"CREATING", "PENDING", "PROVISIONING", "IN_PROGRESS", "STARTING"}
+	createdStates  = []string{"ACTIVE", "AVAILABLE", "READY", "CREATED", "ENABLED", "RUNNING", "IN_SERVICE", "SUCCEEDED"}
+	updatingStates = []string{"UPDATING", "MODIFYING"}
+	deletingStates = []string{"DELETING"}
+)
+
+// CreateFromOperations scaffolds a resource from the specified AWS SDK operations of the working directory's service.
+func CreateFromOperations(resName, snakeName string, ops Operations, force, v2 bool) error {
+	if err := ops.validate(); err != nil {
+		return err
+	}
+
+	td, err := newTemplateData(resName, snakeName, false, v2)
+	if err != nil {
+		return err
+	}
+
+	p, err := loadSDKPackage(td.ServicePackage, v2)
+	if err != nil {
+		return fmt.Errorf("loading AWS SDK package: %w", err)
+	}
+
+	otd, err := newOperationsTemplateData(td, p, ops)
+	if err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err = writeTemplate("newres", f, sdkResourceTmpl, force, otd); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err = writeTemplate("restest", tf, sdkResourceTestTmpl, force, otd); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	// The templates import every package that the scaffolded code may use.
+	for _, filename := range []string{f, tf} {
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("error reading file (%s): %s", filename, err)
+		}
+
+		if src, err = removeUnusedImports(src, nil); err != nil {
+			return fmt.Errorf("error formatting file (%s): %s", filename, err)
+		}
+
+		if err := os.WriteFile(filename, src, 0644); err != nil {
+			return fmt.Errorf("error writing to file (%s): %s", filename, err)
+		}
+	}
+
+	for _, v := range []struct {
+		name, filename, tmpl string
+	}{
+		{"exports", "exports_test.go", exportsTmpl},
+		{"find", "find.go", findTmpl},
+		{"status", "status.go", statusTmpl},
+		{"wait", "wait.go", waitTmpl},
+		{"sweep", "sweep.go", sweepTmpl},
+	} {
+		if v.name == "sweep" && otd.ListItems == "" {
+			continue
+		}
+
+		if (v.name == "status" || v.name == "wait") && !otd.WaitCreated() && !otd.WaitUpdated() && !otd.WaitDeleted() {
+			continue
+		}
+
+		if err = appendTemplate(v.name, v.filename, v.tmpl, *otd); err != nil {
+			return fmt.Errorf("writing %s template: %w", v.name, err)
+		}
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newOperationsTemplateData(td *TemplateData, p *sdkPackage, ops Operations) (*OperationsTemplateData, error) {
+	otd := &OperationsTemplateData{
+		TemplateData: *td,
+		Operations:   ops,
+		SDKPackage:   p.name,
+		NotFound:     p.notFound(),
+	}
+
+	input := func(op string) string { return fmt.Sprintf("%s.%sInput", p.name, op) }
+	output := func(op string) string { return fmt.Sprintf("%s.%sOutput", p.name, op) }
+
+	for _, op := range []string{ops.Create, ops.Read, ops.Update, ops.Delete, ops.List} {
+		if _, ok := p.structs[input(op)]; op != "" && !ok {
+			return nil, fmt.Errorf("error checking: operation (%s) not found in AWS SDK package (%s)", op, p.name)
+		}
+	}
+
+	otd.IDField, otd.IDPointer = p.identifier(input(ops.Read))
+	if otd.IDField == "" {
+		return nil, fmt.Errorf("error checking: operation (%s) has no required input", ops.Read)
+	}
+	otd.IDAttribute = ToSnakeCase(otd.IDField, "")
+	otd.DeleteIDField, otd.DeleteIDPointer = p.identifier(input(ops.Delete))
+
+	// The resource is described either by the read operation's output or by its only field.
+	otd.FindType = output(ops.Read)
+	if field, t := p.singleStructField(output(ops.Read)); field != "" {
+		otd.FindType = t
+		otd.FindAccessor = "." + field
+	}
+
+	attributes := make(map[string]*attribute)
+
+	createArgs, unsupported := p.attributes(input(ops.Create), 0)
+	otd.Unsupported = append(otd.Unsupported, unsupported...)
+
+	updatable := make(map[string]bool)
+	if ops.Update != "" {
+		otd.UpdateIDField, otd.UpdateIDPointer = p.identifier(input(ops.Update))
+
+		updateArgs, unsupported := p.attributes(input(ops.Update), 0)
+		otd.Unsupported = append(otd.Unsupported, unsupported...)
+
+		for _, a := range updateArgs {
+			if a.FieldName == otd.UpdateIDField || a.Name == "tags" {
+				continue
+			}
+
+			a.Required = false
+			a.Optional = true
+			updatable[a.Name] = true
+			otd.UpdateArgs = append(otd.UpdateArgs, a)
+			attributes[a.Name] = a
+		}
+	}
+
+	for _, a := range createArgs {
+		if a.Name == "tags" {
+			otd.HasTags = true
+
+			continue
+		}
+
+		if !updatable[a.Name] {
+			a.ForceNew = true
+		} else {
+			// Prefer the create operation's optionality.
+			attributes[a.Name].Required, attributes[a.Name].Optional = a.Required, a.Optional
+		}
+
+		otd.CreateArgs = append(otd.CreateArgs, a)
+
+		if _, ok := attributes[a.Name]; !ok {
+			attributes[a.Name] = a
+		}
+	}
+
+	readAttrs, _ := p.attributes(otd.FindType, 0)
+	for _, a := range readAttrs {
+		if a.Name == "tags" || a.Name == "id" {
+			continue
+		}
+
+		otd.ReadAttrs = append(otd.ReadAttrs, a)
+
+		if _, ok := attributes[a.Name]; !ok {
+			setComputed(a)
+			attributes[a.Name] = a
+		}
+	}
+
+	for _, a := range attributes {
+		otd.Attributes = append(otd.Attributes, a)
+	}
+	sort.Slice(otd.Attributes, func(i, j int) bool {
+		return otd.Attributes[i].Name < otd.Attributes[j].Name
+	})
+
+	// The new resource's identifier is taken from the create operation's output if possible.
+	switch {
+	case p.field(output(ops.Create), otd.IDField) != nil:
+		otd.CreateIDExpr = stringValue(p.v2, "out."+otd.IDField)
+	case attributes[otd.IDAttribute] != nil && attributes[otd.IDAttribute].StringValue && !attributes[otd.IDAttribute].Computed:
+		otd.CreateIDExpr = fmt.Sprintf("d.Get(%q).(string)", otd.IDAttribute)
+	default:
+		if field, t := p.singleStructField(output(ops.Create)); field != "" && p.field(t, otd.IDField) != nil {
+			otd.CreateIDExpr = stringValue(p.v2, fmt.Sprintf("out.%s.%s", field, otd.IDField))
+		}
+	}
+
+	otd.setStatus(p)
+
+	if ops.List != "" {
+		var itemType string
+		otd.ListItems, itemType = p.listItems(output(ops.List))
+
+		for _, fieldName := range []string{otd.IDField, "Arn", "Id", "Name"} {
+			if field := p.field(itemType, fieldName); field != nil {
+				_, otd.ListItemIDPointer = field.Type.(*ast.StarExpr)
+				otd.ListItemIDField = fieldName
+				break
+			}
+		}
+
+		otd.ListSDKPaginator = p.v2 && p.funcs[fmt.Sprintf("New%sPaginator", ops.List)]
+		otd.ListPagesWithCtx = !p.v2 && p.funcs[fmt.Sprintf("%sPagesWithContext", ops.List)]
+	}
+
+	return otd, nil
+}
+
+// setStatus sets the status expression and states from the resource's "Status" or "State" field.
+func (otd *OperationsTemplateData) setStatus(p *sdkPackage) {
+	for _, fieldName := range []string{"Status", "State"} {
+		field := p.field(otd.FindType, fieldName)
+
+		if field == nil {
+			continue
+		}
+
+		t := p.resolve(field.Type, qualifierOf(otd.FindType))
+
+		if t == nil {
+			continue
+		}
+
+		var values []enumValue
+
+		switch {
+		case p.isEnum(t.name):
+			otd.Status = fmt.Sprintf("string(out.%s)", fieldName)
+			values = p.enumValues(t.name)
+		case t.name == "string":
+			otd.Status = stringValue(p.v2, "out."+fieldName)
+			if enum := structTag(field, "enum"); enum != "" {
+				values = p.enumValues(p.name + "." + enum)
+			}
+		default:
+			continue
+		}
+
+		for _, v := range values {
+			state := strings.ToUpper(v.Value)
+
+			switch {
+			case contains(pendingStates, state):
+				otd.StatesPending = append(otd.StatesPending, v.Name)
+			case contains(createdStates, state):
+				otd.StatesCreated = append(otd.StatesCreated, v.Name)
+			case contains(updatingStates, state):
+				otd.StatesUpdating = append(otd.StatesUpdating, v.Name)
+			case contains(deletingStates, state):
+				otd.StatesDeleting = append(otd.StatesDeleting, v.Name)
+			}
+		}
+
+		return
+	}
+}
+
+// WaitCreated returns whether the resource's creation is waited for.
+func (otd OperationsTemplateData) WaitCreated() bool {
+	return otd.Status != "" && len(otd.StatesCreated) > 0
+}
+
+// WaitUpdated returns whether the resource's update is waited for.
+func (otd OperationsTemplateData) WaitUpdated() bool {
+	return otd.Status != "" && otd.Operations.Update != "" && len(otd.StatesUpdating) > 0 && len(otd.StatesCreated) > 0
+}
+
+// WaitDeleted returns whether the resource's deletion is waited for.
+func (otd OperationsTemplateData) WaitDeleted() bool {
+	return otd.Status != "" && len(otd.StatesDeleting) > 0
+}
+
+// StateSlice returns the expression for a slice of the specified state constants.
+func (otd OperationsTemplateData) StateSlice(states []string) string {
+	if len(states) == 0 {
+		return "[]string{}"
+	}
+
+	if otd.AWSGoSDKV2 {
+		return fmt.Sprintf("enum.Slice(%s)", strings.Join(states, ", "))
+	}
+
+	return fmt.Sprintf("[]string{%s}", strings.Join(states, ", "))
+}
+
+// ConfigArgs returns the test configuration's arguments, one per required attribute, with placeholder values.
+func (otd OperationsTemplateData) ConfigArgs() []string {
+	var args []string
+
+	for _, a := range otd.Attributes {
+		if !a.Required {
+			continue
+		}
+
+		var v string
+
+		switch a.Type {
+		case "schema.TypeString":
+			v = `"test"`
+			if a.Name == "name" || a.Name == otd.IDAttribute {
+				v = "%[1]q"
+			}
+		case "schema.TypeBool":
+			v = "false"
+		case "schema.TypeInt", "schema.TypeFloat":
+			v = "1"
+		case "schema.TypeMap":
+			v = `{ key = "value" }`
+		default:
+			switch a.Elem {
+			case "":
+				args = append(args, fmt.Sprintf("%s {\n    # TODO\n  }", a.Name))
+				continue
+			case "schema.TypeString":
+				v = `["test"]`
+			case "schema.TypeBool":
+				v = "[false]"
+			default:
+				v = "[1]"
+			}
+		}
+
+		args = append(args, fmt.Sprintf("%s = %s", a.Name, v))
+	}
+
+	return args
+}
+
+// ConfigUsesName returns whether the test configuration's arguments use the random resource name.
+func (otd OperationsTemplateData) ConfigUsesName() bool {
+	for _, v := range otd.ConfigArgs() {
+		if strings.Contains(v, "%[1]q") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExpandExpr returns the expression converting the specified configuration value to the AWS SDK value.
+func (a *attribute) ExpandExpr(v string) string {
+	return strings.ReplaceAll(a.Expand, "v.(", v+".(")
+}
+
+func setComputed(a *attribute) {
+	a.Required, a.Optional, a.Computed, a.ForceNew = false, false, true, false
+	a.ValidateFunc, a.ValidateDiagFunc = "", ""
+
+	for _, v := range a.Attributes {
+		setComputed(v)
+	}
+}
+
+func stringValue(v2 bool, expr string) string {
+	if v2 {
+		return fmt.Sprintf("aws.ToString(%s)", expr)
+	}
+
+	return fmt.Sprintf("aws.StringValue(%s)", expr)
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeUnusedImports removes the imports of packages that the specified Go source doesn't use and formats the source.
+// Only the specified import paths are candidates for removal or, if nil, all import paths.
+func removeUnusedImports(src []byte, paths map[string]bool) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if v, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := v.X.(*ast.Ident); ok {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	// Imports are removed line by line so that no empty import groups remain.
+	unused := make(map[int]bool)
+	for _, spec := range file.Imports {
+		path := importPath(spec)
+		if paths != nil && !paths[path] {
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if !used[name] && name != "_" {
+			unused[fset.Position(spec.Pos()).Line] = true
+		}
+	}
+
+	var buffer bytes.Buffer
+	for i, line := range strings.SplitAfter(string(src), "\n") {
+		if !unused[i+1] {
+			buffer.WriteString(line)
+		}
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+// mergeImports adds the imports of the standalone Go source which are missing from the existing Go source.
+// Standard library imports are added to the first group of the existing import declaration and others to the last.
+func mergeImports(existing, standalone []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", existing, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	other, err := parser.ParseFile(token.NewFileSet(), "", standalone, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]bool)
+	for _, spec := range file.Imports {
+		paths[importPath(spec)] = true
+	}
+
+	var std, nonStd []string
+	for _, spec := range other.Imports {
+		if paths[importPath(spec)] {
+			continue
+		}
+
+		line := spec.Path.Value
+		if spec.Name != nil {
+			line = spec.Name.Name + " " + line
+		}
+
+		if strings.Contains(strings.Split(importPath(spec), "/")[0], ".") {
+			nonStd = append(nonStd, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+
+	if len(std) == 0 && len(nonStd) == 0 {
+		return existing, nil
+	}
+
+	var decl *ast.GenDecl
+	for _, v := range file.Decls {
+		if v, ok := v.(*ast.GenDecl); ok && v.Tok == token.IMPORT && v.Lparen.IsValid() {
+			decl = v
+			break
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	if decl == nil {
+		offset := fset.Position(file.Name.End()).Offset
+		buffer.Write(existing[:offset])
+		fmt.Fprintf(&buffer, "\n\nimport (\n%s\n)\n", strings.Join(append(std, nonStd...), "\n"))
+		buffer.Write(existing[offset:])
+
+		return buffer.Bytes(), nil
+	}
+
+	lparen, rparen := fset.Position(decl.Lparen).Offset+1, fset.Position(decl.Rparen).Offset
+	buffer.Write(existing[:lparen])
+	for _, v := range std {
+		fmt.Fprintf(&buffer, "\n%s", v)
+	}
+	buffer.Write(existing[lparen:rparen])
+	for _, v := range nonStd {
+		fmt.Fprintf(&buffer, "%s\n", v)
+	}
+	buffer.Write(existing[rparen:])
+
+	return buffer.Bytes(), nil
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	path, _ := strconv.Unquote(spec.Path.Value)
+
+	return path
+}
+
+// appendTemplate writes the template to the specified file or, if the file exists, appends the template's body to it
+// and adds any missing imports.
+func appendTemplate(templateName, filename, tmpl string, td OperationsTemplateData) error {
+	existing, err := os.ReadFile(filename)
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	exists := err == nil
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	// The standalone file declares all of the imports that the template's body may use.
+	var standalone bytes.Buffer
+	td.Append = false
+	if err := tplate.Execute(&standalone, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents := standalone.Bytes()
+	var paths map[string]bool
+
+	if exists {
+		var body bytes.Buffer
+		td.Append = true
+		if err := tplate.Execute(&body, td); err != nil {
+			return fmt.Errorf("error executing template: %s", err)
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), "", standalone.Bytes(), parser.ImportsOnly)
+		if err != nil {
+			return fmt.Errorf("error parsing template output: %s", err)
+		}
+
+		paths = make(map[string]bool)
+		for _, spec := range file.Imports {
+			paths[importPath(spec)] = true
+		}
+
+		if contents, err = mergeImports(existing, standalone.Bytes()); err != nil {
+			return fmt.Errorf("error adding imports to file (%s): %s", filename, err)
+		}
+		contents = append(contents, body.Bytes()...)
+	}
+
+	if contents, err = removeUnusedImports(contents, paths); err != nil {
+		return fmt.Errorf("error formatting file (%s): %s", filename, err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
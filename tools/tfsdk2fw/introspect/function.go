@@ -0,0 +1,343 @@
+package introspect
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Function describes the AWS API usage of a Plugin SDK resource handler function.
+type Function struct {
+	Name         string   // e.g. resourceVPCCreate
+	ConnAccessor string   // AWSClient method returning the API client, e.g. EC2Conn
+	Calls        []string // Source of each distinct call made with the API client, in order
+	APICall      *APICall // The first API client method call, if any
+	Imports      []string // Import specs needed to construct the first API call's input
+}
+
+// APICall describes a call to an API client method.
+type APICall struct {
+	Method    string // e.g. CreateVpcWithContext
+	InputType string // e.g. ec2.CreateVpcInput. Empty if it can't be determined
+}
+
+// FuncName returns the unqualified name of the specified function.
+// Returns false if fn is not a named, package-level function.
+func FuncName(fn any) (string, bool) {
+	v := reflect.ValueOf(fn)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return "", false
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+
+	if f == nil {
+		return "", false
+	}
+
+	name := f.Name()
+	// e.g. github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceVPCCreate
+	name = name[strings.LastIndex(name, "/")+1:]
+	_, name, _ = strings.Cut(name, ".")
+
+	// Closures are named like resourceVPC.func1 and method values like (*T).M-fm.
+	if name == "" || strings.ContainsAny(name, ".()-") {
+		return "", false
+	}
+
+	return name, true
+}
+
+// ForHandler parses the source of the specified Plugin SDK resource handler function.
+func ForHandler(fn any) (*Function, error) {
+	name, ok := FuncName(fn)
+
+	if !ok {
+		return nil, fmt.Errorf("not a named function")
+	}
+
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	filename, _ := f.FileLine(f.Entry())
+
+	return Parse(filename, nil, name)
+}
+
+// Parse parses the named function's source.
+// If src is nil the source is read from filename.
+func Parse(filename string, src any, name string) (*Function, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var decl *ast.FuncDecl
+
+	for _, v := range file.Decls {
+		if v, ok := v.(*ast.FuncDecl); ok && v.Recv == nil && v.Name.Name == name {
+			decl = v
+			break
+		}
+	}
+
+	if decl == nil || decl.Body == nil {
+		return nil, fmt.Errorf("function %s not found in %s", name, filename)
+	}
+
+	function := &Function{
+		Name: name,
+	}
+	connVar := "conn"
+
+	// Find the API client, e.g. `conn := meta.(*conns.AWSClient).EC2Conn()`.
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if function.ConnAccessor != "" {
+			return false
+		}
+
+		assign, ok := n.(*ast.AssignStmt)
+
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		if accessor := connAccessor(assign.Rhs[0]); accessor != "" {
+			function.ConnAccessor = accessor
+
+			if v, ok := assign.Lhs[0].(*ast.Ident); ok {
+				connVar = v.Name
+			}
+		}
+
+		return true
+	})
+
+	if function.ConnAccessor == "" {
+		return function, nil
+	}
+
+	seen := make(map[string]bool)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		isAPICall := false
+
+		if v, ok := call.Fun.(*ast.SelectorExpr); ok && isIdent(v.X, connVar) {
+			isAPICall = true
+		} else if !hasIdentArg(call, connVar) {
+			return true
+		}
+
+		source := nodeSource(fset, call)
+
+		if !seen[source] {
+			seen[source] = true
+			function.Calls = append(function.Calls, source)
+		}
+
+		if isAPICall && function.APICall == nil {
+			function.APICall = &APICall{
+				Method: call.Fun.(*ast.SelectorExpr).Sel.Name,
+			}
+
+			if typ := inputType(decl.Body, call); typ != nil {
+				function.APICall.InputType = nodeSource(fset, typ)
+				function.Imports = importsFor(file, typ)
+			}
+		}
+
+		// Don't descend into the arguments of calls already recorded.
+		return false
+	})
+
+	return function, nil
+}
+
+// connAccessor returns the AWSClient method name if expr is of the form `meta.(*conns.AWSClient).EC2Conn()`.
+func connAccessor(expr ast.Expr) string {
+	call, ok := expr.(*ast.CallExpr)
+
+	if !ok || len(call.Args) != 0 {
+		return ""
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	if !ok {
+		return ""
+	}
+
+	assert, ok := sel.X.(*ast.TypeAssertExpr)
+
+	if !ok {
+		return ""
+	}
+
+	if star, ok := assert.Type.(*ast.StarExpr); !ok || !isSelector(star.X, "conns", "AWSClient") {
+		return ""
+	}
+
+	if name := sel.Sel.Name; strings.HasSuffix(name, "Conn") || strings.HasSuffix(name, "Client") {
+		return name
+	}
+
+	return ""
+}
+
+// inputType returns the type of the API call's input, e.g. `ec2.CreateVpcInput`.
+// The input is either a composite literal argument or a variable assigned one in body.
+func inputType(body *ast.BlockStmt, call *ast.CallExpr) ast.Expr {
+	for _, arg := range call.Args {
+		if typ := compositeLitType(arg); typ != nil {
+			return typ
+		}
+
+		ident, ok := arg.(*ast.Ident)
+
+		if !ok || ident.Name == "ctx" {
+			continue
+		}
+
+		var typ ast.Expr
+
+		ast.Inspect(body, func(n ast.Node) bool {
+			if typ != nil {
+				return false
+			}
+
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				for i, lhs := range n.Lhs {
+					if isIdent(lhs, ident.Name) && i < len(n.Rhs) {
+						typ = compositeLitType(n.Rhs[i])
+					}
+				}
+			case *ast.ValueSpec:
+				for i, name := range n.Names {
+					if name.Name == ident.Name && i < len(n.Values) {
+						typ = compositeLitType(n.Values[i])
+					}
+				}
+			}
+
+			return true
+		})
+
+		if typ != nil {
+			return typ
+		}
+	}
+
+	return nil
+}
+
+// compositeLitType returns T if expr is of the form `&pkg.T{...}`.
+func compositeLitType(expr ast.Expr) ast.Expr {
+	unary, ok := expr.(*ast.UnaryExpr)
+
+	if !ok || unary.Op != token.AND {
+		return nil
+	}
+
+	lit, ok := unary.X.(*ast.CompositeLit)
+
+	if !ok {
+		return nil
+	}
+
+	if _, ok := lit.Type.(*ast.SelectorExpr); !ok {
+		return nil
+	}
+
+	return lit.Type
+}
+
+// importsFor returns the import specs of the packages referred to by a qualified type.
+func importsFor(file *ast.File, typ ast.Expr) []string {
+	sel, ok := typ.(*ast.SelectorExpr)
+
+	if !ok {
+		return nil
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+
+	if !ok {
+		return nil
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+
+		if err != nil {
+			continue
+		}
+
+		if spec.Name != nil {
+			if spec.Name.Name == pkg.Name {
+				return []string{fmt.Sprintf("%s %q", spec.Name.Name, path)}
+			}
+
+			continue
+		}
+
+		if path[strings.LastIndex(path, "/")+1:] == pkg.Name {
+			return []string{strconv.Quote(path)}
+		}
+	}
+
+	return nil
+}
+
+func hasIdentArg(call *ast.CallExpr, name string) bool {
+	for _, arg := range call.Args {
+		if isIdent(arg, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	v, ok := expr.(*ast.Ident)
+
+	return ok && v.Name == name
+}
+
+func isSelector(expr ast.Expr, x, sel string) bool {
+	v, ok := expr.(*ast.SelectorExpr)
+
+	return ok && isIdent(v.X, x) && v.Sel.Name == sel
+}
+
+func nodeSource(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+
+	if err := printer.Fprint(&buf, fset, node); err != nil {
+		return ""
+	}
+
+	// Multi-line calls, e.g. with function literal arguments, are collapsed to their first line.
+	source, _, found := strings.Cut(buf.String(), "\n")
+
+	if found {
+		source += " ..."
+	}
+
+	return source
+}
@@ -0,0 +1,138 @@
+package introspect_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/introspect"
+)
+
+const testSource = `package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func resourceVPCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.CreateVpcInput{
+		CidrBlock: aws.String(d.Get("cidr_block").(string)),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout, func() (interface{}, error) {
+		return conn.CreateVpcWithContext(ctx, input)
+	}, "UnsupportedOperation")
+
+	if err != nil {
+		return diag.Errorf("creating EC2 VPC: %s", err)
+	}
+
+	if _, err := WaitVPCCreated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for EC2 VPC (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVPCRead(ctx, d, meta)
+}
+
+func resourceVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	_, err := conn.DeleteVpcWithContext(ctx, &tfec2.DeleteVpcInput{
+		VpcId: aws.String(d.Id()),
+	})
+
+	return diag.FromErr(err)
+}
+
+func resourceVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		expected *introspect.Function
+	}{
+		"create": {
+			name: "resourceVPCCreate",
+			expected: &introspect.Function{
+				Name:         "resourceVPCCreate",
+				ConnAccessor: "EC2Conn",
+				Calls: []string{
+					"conn.CreateVpcWithContext(ctx, input)",
+					"WaitVPCCreated(ctx, conn, d.Id())",
+				},
+				APICall: &introspect.APICall{
+					Method:    "CreateVpcWithContext",
+					InputType: "ec2.CreateVpcInput",
+				},
+				Imports: []string{`"github.com/aws/aws-sdk-go/service/ec2"`},
+			},
+		},
+		"delete": {
+			name: "resourceVPCDelete",
+			expected: &introspect.Function{
+				Name:         "resourceVPCDelete",
+				ConnAccessor: "EC2Conn",
+				Calls: []string{
+					"conn.DeleteVpcWithContext(ctx, &tfec2.DeleteVpcInput{ ...",
+				},
+				APICall: &introspect.APICall{
+					Method:    "DeleteVpcWithContext",
+					InputType: "tfec2.DeleteVpcInput",
+				},
+				Imports: []string{`tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"`},
+			},
+		},
+		"no API client": {
+			name: "resourceVPCRead",
+			expected: &introspect.Function{
+				Name: "resourceVPCRead",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := introspect.Parse("vpc.go", testSource, testCase.name)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v; want %#v", got, testCase.expected)
+			}
+		})
+	}
+
+	if _, err := introspect.Parse("vpc.go", testSource, "resourceVPCUpdate"); err == nil {
+		t.Error("expected error for missing function")
+	}
+}
+
+func TestFuncName(t *testing.T) {
+	t.Parallel()
+
+	if got, ok := introspect.FuncName(introspect.Parse); !ok || got != "Parse" {
+		t.Errorf("got %q, %t; want %q, true", got, ok, "Parse")
+	}
+
+	if _, ok := introspect.FuncName(func() {}); ok {
+		t.Error("expected closure to have no name")
+	}
+
+	if _, ok := introspect.FuncName(nil); ok {
+		t.Error("expected nil to have no name")
+	}
+}
@@ -0,0 +1,108 @@
+package introspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// sampleARN is a syntactically valid ARN for attributes migrated to fwtypes.ARN.
+	sampleARN = "arn:partition:service:region:123456789012:resource/sample"
+)
+
+// SampleState returns the JSON encoding of a state for the specified Plugin SDK resource
+// in which every attribute and nested block has a non-null sample value.
+// Nested blocks contain a single element and the timeouts block is null.
+func SampleState(resource *schema.Resource) ([]byte, error) {
+	state, err := sampleObject(nil, resource.Schema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := state["id"]; !ok {
+		state["id"] = "sample"
+	}
+
+	return json.MarshalIndent(state, "", "  ")
+}
+
+func sampleObject(path []string, s map[string]*schema.Schema) (map[string]interface{}, error) {
+	object := make(map[string]interface{}, len(s))
+
+	for name, property := range s {
+		v, err := sampleValue(append(path, name), property)
+
+		if err != nil {
+			return nil, err
+		}
+
+		object[name] = v
+	}
+
+	return object, nil
+}
+
+func sampleValue(path []string, property *schema.Schema) (interface{}, error) {
+	name := path[len(path)-1]
+
+	switch property.Type {
+	case schema.TypeBool:
+		return true, nil
+
+	case schema.TypeFloat:
+		return 1.5, nil
+
+	case schema.TypeInt:
+		return 1, nil
+
+	case schema.TypeString:
+		if name == "arn" || strings.HasSuffix(name, "_arn") {
+			return sampleARN, nil
+		}
+
+		return "sample", nil
+
+	case schema.TypeList, schema.TypeSet:
+		switch v := property.Elem.(type) {
+		case *schema.Schema:
+			elem, err := sampleValue(path, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []interface{}{elem}, nil
+
+		case *schema.Resource:
+			elem, err := sampleObject(path, v.Schema)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []interface{}{elem}, nil
+		}
+
+	case schema.TypeMap:
+		switch v := property.Elem.(type) {
+		case nil:
+			return map[string]interface{}{"key": "sample"}, nil
+
+		case *schema.Schema:
+			// Map elements are never ARNs.
+			elem, err := sampleValue(append(path, "key"), v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]interface{}{"key": elem}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), property.Type)
+}
@@ -0,0 +1,74 @@
+package introspect_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/introspect"
+)
+
+func TestSampleState(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	b, err := introspect.SampleState(resource)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got map[string]interface{}
+
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"arn":        "arn:partition:service:region:123456789012:resource/sample",
+		"enabled":    true,
+		"id":         "sample",
+		"rule":       []interface{}{map[string]interface{}{"priority": float64(1)}},
+		"subnet_ids": []interface{}{"sample"},
+		"tags":       map[string]interface{}{"key": "sample"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v; want %#v", got, expected)
+	}
+}
@@ -10,17 +10,16 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/introspect"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
 
-// TODO
-// * timeouts
-
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
 	resourceType   = flag.String("resource", "", "Resource type")
@@ -103,6 +102,7 @@ type migrator struct {
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, a test that decodes Plugin SDK state using the generated schema is generated alongside it.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -126,17 +126,52 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_state_test.go"
+	m.infof("generating state compatibility test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("statetest", resourceStateTestImpl, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	// Sample state must be generated from the unmodified Plugin SDK schema.
+	var sampleState []byte
+
+	if !m.IsDataSource {
+		var err error
+
+		if sampleState, err = introspect.SampleState(m.Resource); err != nil {
+			return nil, fmt.Errorf("generating sample state: %w", err)
+		}
+	}
+
+	sbNestedStructs := strings.Builder{}
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		Generator:          m.Generator,
+		IsDataSource:       m.IsDataSource,
+		NestedStructWriter: &sbNestedStructs,
+		SchemaWriter:       &sbSchema,
+		StructNamePrefix:   "resource" + m.Name,
+		StructWriter:       &sbStruct,
+	}
+
+	if m.IsDataSource {
+		emitter.StructNamePrefix = "dataSource" + m.Name
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -157,10 +192,54 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
+		NestedStructs:                sbNestedStructs.String(),
 		PackageName:                  m.PackageName,
+		SampleState:                  string(sampleState),
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
+		CreateTimeout:                durationExpr(emitter.DefaultCreateTimeout),
+		ReadTimeout:                  durationExpr(emitter.DefaultReadTimeout),
+		UpdateTimeout:                durationExpr(emitter.DefaultUpdateTimeout),
+		DeleteTimeout:                durationExpr(emitter.DefaultDeleteTimeout),
+	}
+
+	if !m.IsDataSource {
+		r := m.Resource
+
+		// The same API calls as the Plugin SDK resource's CRUD handlers.
+		handlers := []struct {
+			function *introspect.Function
+			action   string
+			body     *string
+			dataVar  string
+			flatten  bool
+		}{
+			{m.introspectHandler(r.CreateWithoutTimeout, r.CreateContext, r.Create), "creating", &templateData.CreateBody, "data", true},
+			{m.introspectHandler(r.ReadWithoutTimeout, r.ReadContext, r.Read), "reading", &templateData.ReadBody, "data", true},
+			{m.introspectHandler(r.UpdateWithoutTimeout, r.UpdateContext, r.Update), "updating", &templateData.UpdateBody, "new", true},
+			{m.introspectHandler(r.DeleteWithoutTimeout, r.DeleteContext, r.Delete), "deleting", &templateData.DeleteBody, "data", false},
+		}
+
+		for _, v := range handlers {
+			if v.function == nil {
+				continue
+			}
+
+			*v.body = handlerBody(v.function, fmt.Sprintf("%s %s", v.action, m.TFTypeName), v.dataVar, v.flatten)
+
+			if v.function.APICall != nil && v.function.APICall.InputType != "" {
+				templateData.ImportFlex = true
+
+				for _, v := range v.function.Imports {
+					if !slices.Contains(templateData.APIImports, v) {
+						templateData.APIImports = append(templateData.APIImports, v)
+					}
+				}
+			}
+		}
+
+		m.addStateUpgraders(templateData)
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -182,10 +261,143 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// introspectHandler returns the AWS API usage of the first named Plugin SDK resource handler function.
+func (m *migrator) introspectHandler(handlers ...any) *introspect.Function {
+	for _, v := range handlers {
+		name, ok := introspect.FuncName(v)
+
+		if !ok {
+			continue
+		}
+
+		function, err := introspect.ForHandler(v)
+
+		if err != nil {
+			m.Generator.Warnf("introspecting %s: %s", name, err)
+
+			return nil
+		}
+
+		return function
+	}
+
+	return nil
+}
+
+// addStateUpgraders adds the Plugin SDK resource's state upgrade functions to the template data.
+// The upgrade functions must be named functions covering contiguous schema versions up to the current version.
+func (m *migrator) addStateUpgraders(templateData *templateData) {
+	r := m.Resource
+
+	if r.SchemaVersion == 0 {
+		return
+	}
+
+	templateData.SchemaVersion = r.SchemaVersion
+
+	upgraders := slices.Clone(r.StateUpgraders)
+	slices.SortFunc(upgraders, func(a, b schema.StateUpgrader) bool {
+		return a.Version < b.Version
+	})
+
+	if len(upgraders) == 0 || upgraders[len(upgraders)-1].Version != r.SchemaVersion-1 {
+		m.Generator.Warnf("no state upgrader for schema version %d", r.SchemaVersion-1)
+
+		return
+	}
+
+	var funcs []string
+
+	for i, v := range upgraders {
+		if i > 0 && v.Version != upgraders[i-1].Version+1 {
+			m.Generator.Warnf("no state upgrader for schema version %d", upgraders[i-1].Version+1)
+
+			return
+		}
+
+		name, ok := introspect.FuncName(v.Upgrade)
+
+		if !ok {
+			m.Generator.Warnf("state upgrader for schema version %d is not a named function", v.Version)
+
+			return
+		}
+
+		funcs = append(funcs, name)
+	}
+
+	if r.MigrateState != nil && upgraders[0].Version > 0 {
+		m.Generator.Warnf("MigrateState (schema versions before %d) is not migrated", upgraders[0].Version)
+	}
+
+	templateData.FirstStateUpgradeVersion = upgraders[0].Version
+	templateData.StateUpgradeFuncs = funcs
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
 
+// handlerBody generates the Plugin Framework code that makes a Plugin SDK resource handler's first API call.
+// Request data is expanded into the API input and, if flatten is true, the API output is flattened into the response data.
+// All of the handler's calls using its API client are listed for review.
+func handlerBody(function *introspect.Function, action, dataVar string, flatten bool) string {
+	sb := strings.Builder{}
+
+	if len(function.Calls) > 0 {
+		fprintf(&sb, "// TODO Review the API calls made by the Plugin SDK resource's %s function:\n", function.Name)
+		for _, v := range function.Calls {
+			fprintf(&sb, "//   %s\n", v)
+		}
+	}
+
+	call := function.APICall
+
+	if call == nil || call.InputType == "" {
+		return strings.TrimSuffix(sb.String(), "\n")
+	}
+
+	fprintf(&sb, "conn := r.Meta().%s()\n\n", function.ConnAccessor)
+	fprintf(&sb, "input := &%s{}\n\n", call.InputType)
+	fprintf(&sb, "response.Diagnostics.Append(flex.Expand(ctx, %s, input)...)\n\n", dataVar)
+	fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+
+	if flatten {
+		fprintf(&sb, "output, err := conn.%s(ctx, input)\n\n", call.Method)
+	} else {
+		fprintf(&sb, "_, err := conn.%s(ctx, input)\n\n", call.Method)
+	}
+
+	fprintf(&sb, "if err != nil {\nresponse.Diagnostics.AddError(%q, err.Error())\n\nreturn\n}\n", action)
+
+	if flatten {
+		fprintf(&sb, "\nresponse.Diagnostics.Append(flex.Flatten(ctx, output, &%s)...)\n\n", dataVar)
+		fprintf(&sb, "if response.Diagnostics.HasError() {\nreturn\n}\n")
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// durationExpr returns a Go expression for the specified duration in nanoseconds, e.g. `10 * time.Minute`.
+func durationExpr(ns int64) string {
+	d := time.Duration(ns)
+
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "Hour"},
+		{time.Minute, "Minute"},
+		{time.Second, "Second"},
+	} {
+		if d%v.unit == 0 {
+			return fmt.Sprintf("%d * time.%s", d/v.unit, v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 type emitter struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -200,11 +412,18 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
-	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
+	NestedStructWriter            io.Writer // Model struct types for nested blocks.
+	ProviderPlanModifierPackages  []string  // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
+	StructNamePrefix              string // e.g. resourceInstance
 	StructWriter                  io.Writer
 }
 
+// nestedStructName returns the name of the model struct type for the nested block at the specified path.
+func (e *emitter) nestedStructName(path []string) string {
+	return fmt.Sprintf("%s%sData", e.StructNamePrefix, naming.ToCamelCase(strings.Join(path, "_")))
+}
+
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 	if _, ok := resource.Schema["id"]; ok {
@@ -263,8 +482,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -286,10 +503,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,10 +511,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
-
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -322,12 +533,24 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		err := e.emitBlockProperty(append(path, name), property)
+		// Each nested block is modeled as a slice of its own struct type.
+		blockPath := append(slices.Clone(path), name)
+		structName := e.nestedStructName(blockPath)
+		fprintf(e.StructWriter, "%s []%s `tfsdk:%q`\n", naming.ToCamelCase(name), structName, name)
+
+		parentStructWriter := e.StructWriter
+		sbStruct := strings.Builder{}
+		e.StructWriter = &sbStruct
+
+		err := e.emitBlockProperty(blockPath, property)
+
+		e.StructWriter = parentStructWriter
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(e.NestedStructWriter, "type %s struct {\n%s}\n\n", structName, sbStruct.String())
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -354,9 +577,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +585,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +593,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +606,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +614,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +631,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +642,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +653,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -883,24 +1090,39 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	APIImports                    []string // Import specs for AWS API packages
+	CreateBody                    string
+	CreateTimeout                 string // e.g. 10 * time.Minute
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
+	DeleteBody                    string
+	DeleteTimeout                 string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	FirstStateUpgradeVersion      int
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
+	ImportFlex                    bool
 	ImportProviderFrameworkTypes  bool
 	Name                          string // e.g. Instance
+	NestedStructs                 string
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
+	ReadBody                      string
+	ReadTimeout                   string
+	SampleState                   string // JSON
 	Schema                        string
+	SchemaVersion                 int
+	StateUpgradeFuncs             []string // Plugin SDK state upgrade functions, in schema version order
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	UpdateBody                    string
+	UpdateTimeout                 string
 }
 
 //go:embed datasource.tmpl
@@ -908,3 +1130,6 @@ var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed resource_state_test.tmpl
+var resourceStateTestImpl string